@@ -76,7 +76,7 @@ func validateObjectDetails(s *pb.ChangeSnapshot, info *useCaseInfo) error {
 	var invalidDetailFound bool
 	id := pbtypes.GetString(s.Data.Details, bundle.RelationKeyId.String())
 	for k, v := range s.Data.Details.Fields {
-		if k == bundle.RelationKeyLinks.String() {
+		if k == bundle.RelationKeyLinks.String() || k == bundle.RelationKeyMentions.String() || k == bundle.RelationKeyBlockLinks.String() {
 			continue
 		}
 		var (
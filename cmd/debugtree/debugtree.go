@@ -34,6 +34,10 @@ var (
 	changeIdx   = flag.Int("c", -1, "build tree before given index and print change")
 	objectStore = flag.Bool("o", false, "show object store info")
 	fileHashes  = flag.Bool("h", false, "show file hashes in state")
+	diffIdx     = flag.String("d", "", "diff states after two change indexes, e.g. 5,10")
+	replay      = flag.Bool("p", false, "replay changes one by one and print emitted events")
+	failedOnly  = flag.Bool("e", false, "replay changes and print only changes failed to apply")
+	compareLs   = flag.Bool("l", false, "compare the final state with the object store snapshot")
 )
 
 func main() {
@@ -77,6 +81,25 @@ func main() {
 		tf.Close()
 	}
 
+	if *replay || *failedOnly {
+		fmt.Println("Replaying changes...")
+		if err = replayChanges(importer, *failedOnly); err != nil {
+			log.Fatal("can't replay changes:", err)
+		}
+	}
+
+	if *diffIdx != "" {
+		if err = diffStates(importer, *diffIdx); err != nil {
+			log.Fatal("can't diff states:", err)
+		}
+	}
+
+	if *compareLs {
+		if err = compareLocalStore(importer, archive); err != nil {
+			log.Fatal("can't compare with object store:", err)
+		}
+	}
+
 	if *changeIdx != -1 {
 		ch, err := importer.ChangeAt(*changeIdx)
 		if err != nil {
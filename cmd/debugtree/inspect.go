@@ -0,0 +1,105 @@
+//go:build cgo
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/debug/treearchive"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func replayChanges(importer treearchive.TreeImporter, failedOnly bool) error {
+	var failed int
+	err := importer.Replay(func(st *state.State, step treearchive.ReplayStep) bool {
+		if step.Err != nil {
+			failed++
+		}
+		if failedOnly && step.Err == nil {
+			return true
+		}
+		fmt.Printf("#%d %s\n", step.Idx, step.Change.Id)
+		if step.Err != nil {
+			fmt.Println("\tFAILED:", step.Err)
+			fmt.Println(pbtypes.Sprint(step.Change.Model))
+		}
+		if !failedOnly {
+			for _, ev := range step.Events {
+				fmt.Println("\t" + pbtypes.Sprint(ev.Msg))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println("changes failed to apply:", failed)
+	return nil
+}
+
+func diffStates(importer treearchive.TreeImporter, indexes string) error {
+	parts := strings.Split(indexes, ",")
+	if len(parts) != 2 {
+		return fmt.Errorf("expected two indexes, got %q", indexes)
+	}
+	idx1, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return err
+	}
+	idx2, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return err
+	}
+	states, err := importer.StatesAt(idx1, idx2)
+	if err != nil {
+		return err
+	}
+	st1, st2 := states[idx1], states[idx2]
+	if st1 == nil || st2 == nil {
+		return fmt.Errorf("no such index in tree")
+	}
+	diff := treearchive.DiffStates(st1, st2)
+	fmt.Printf("Diff between #%d and #%d:\n", idx1, idx2)
+	if diff.IsEmpty() {
+		fmt.Println("\tstates are equal")
+		return nil
+	}
+	if diff.Details != nil {
+		fmt.Println("Details:")
+		fmt.Println(pbtypes.Sprint(diff.Details))
+	}
+	for _, id := range diff.Added {
+		fmt.Println("Added block:", pbtypes.Sprint(st2.Pick(id).Model()))
+	}
+	for _, id := range diff.Removed {
+		fmt.Println("Removed block:", pbtypes.Sprint(st1.Pick(id).Model()))
+	}
+	for _, id := range diff.Changed {
+		fmt.Println("Changed block:", id)
+		fmt.Println("\tbefore:", pbtypes.Sprint(st1.Pick(id).Model()))
+		fmt.Println("\tafter:", pbtypes.Sprint(st2.Pick(id).Model()))
+	}
+	return nil
+}
+
+func compareLocalStore(importer treearchive.TreeImporter, archive treearchive.TreeArchive) error {
+	info, err := archive.LocalStore()
+	if err != nil {
+		return err
+	}
+	st, err := importer.State(false)
+	if err != nil {
+		return err
+	}
+	diff := treearchive.DiffLocalStore(st, info)
+	if diff == nil {
+		fmt.Println("object store details are equal to the state")
+		return nil
+	}
+	fmt.Println("Details of the state different from the object store (removed ones are null):")
+	fmt.Println(pbtypes.Sprint(diff))
+	return nil
+}
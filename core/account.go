@@ -132,6 +132,7 @@ func (mw *Middleware) AccountCreate(cctx context.Context, req *pb.RpcAccountCrea
 	}
 
 	cfg := anytype.BootstrapConfig(true, os.Getenv("ANYTYPE_STAGING") == "1", true)
+	cfg.LocalOnly = req.LocalOnly
 
 	derivationResult, err := core.WalletAccountAt(mw.mnemonic, 0)
 	if err != nil {
@@ -529,6 +530,9 @@ func (mw *Middleware) AccountConfigUpdate(_ context.Context, req *pb.RpcAccountC
 	if err != nil {
 		return response(pb.RpcAccountConfigUpdateResponseError_FAILED_TO_WRITE_CONFIG, err)
 	}
+	if req.TimeZone != "" {
+		conf.SetTimeZone(req.TimeZone)
+	}
 
 	return response(pb.RpcAccountConfigUpdateResponseError_NULL, err)
 }
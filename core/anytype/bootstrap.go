@@ -25,6 +25,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/backup"
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/block/bookmark"
 	decorator "github.com/anyproto/anytype-heart/core/block/bookmark/bookmarkimporter"
@@ -33,6 +34,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/converter"
 	"github.com/anyproto/anytype-heart/core/block/export"
 	importer "github.com/anyproto/anytype-heart/core/block/import"
+	"github.com/anyproto/anytype-heart/core/block/object/backlinks"
 	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
 	"github.com/anyproto/anytype-heart/core/block/object/objectgraph"
 	"github.com/anyproto/anytype-heart/core/block/process"
@@ -42,12 +44,15 @@ import (
 	"github.com/anyproto/anytype-heart/core/debug"
 	"github.com/anyproto/anytype-heart/core/event"
 	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/files/filecache"
 	"github.com/anyproto/anytype-heart/core/filestorage"
+	"github.com/anyproto/anytype-heart/core/filestorage/bandwidth"
 	"github.com/anyproto/anytype-heart/core/filestorage/filesync"
 	"github.com/anyproto/anytype-heart/core/filestorage/rpcstore"
 	"github.com/anyproto/anytype-heart/core/history"
 	"github.com/anyproto/anytype-heart/core/indexer"
 	"github.com/anyproto/anytype-heart/core/kanban"
+	"github.com/anyproto/anytype-heart/core/objectshare"
 	"github.com/anyproto/anytype-heart/core/recordsbatcher"
 	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/core/session"
@@ -69,6 +74,7 @@ import (
 	"github.com/anyproto/anytype-heart/space/peermanager"
 	"github.com/anyproto/anytype-heart/space/peerstore"
 	"github.com/anyproto/anytype-heart/space/storage"
+	"github.com/anyproto/anytype-heart/space/syncstats"
 	"github.com/anyproto/anytype-heart/space/syncstatusprovider"
 	"github.com/anyproto/anytype-heart/space/typeprovider"
 	"github.com/anyproto/anytype-heart/util/builtinobjects"
@@ -210,6 +216,7 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(nodeconfstore.New()).
 		Register(nodeConf).
 		Register(peerstore.New()).
+		Register(syncstats.New()).
 		Register(syncstatusprovider.New()).
 		Register(storage.New()).
 		Register(secureservice.New()).
@@ -224,6 +231,7 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(coordinatorclient.New()).
 		Register(credentialprovider.New()).
 		Register(commonspace.New()).
+		Register(bandwidth.New()).
 		Register(rpcstore.New()).
 		Register(spaceService).
 		Register(fileStore).
@@ -231,6 +239,7 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(filestorage.New(eventService.Send)).
 		Register(fileSyncService).
 		Register(localdiscovery.New()).
+		Register(localdiscovery.NewManual()).
 		Register(peermanager.New()).
 		Register(sbtProvider).
 		Register(relationService).
@@ -263,7 +272,11 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(objectCreator).
 		Register(kanban.New()).
 		Register(editor.NewObjectFactory(tempDirService, sbtProvider, layoutConverter)).
-		Register(graphRenderer)
+		Register(graphRenderer).
+		Register(backlinks.New(blockService)).
+		Register(filecache.New(blockService)).
+		Register(backup.New()).
+		Register(objectshare.New(tempDirService))
 }
 
 func MiddlewareVersion() string {
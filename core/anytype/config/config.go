@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/anyproto/any-sync/app"
 	//nolint:misspell
@@ -37,10 +39,15 @@ type FileConfig interface {
 }
 
 type ConfigRequired struct {
-	HostAddr            string `json:",omitempty"`
-	CustomFileStorePath string `json:",omitempty"`
-	TimeZone            string `json:",omitempty"`
-	LegacyFileStorePath string `json:",omitempty"`
+	HostAddr            string           `json:",omitempty"`
+	CustomFileStorePath string           `json:",omitempty"`
+	TimeZone            string           `json:",omitempty"`
+	LegacyFileStorePath string           `json:",omitempty"`
+	Backup              *BackupConfig    `json:",omitempty" ignored:"true"`
+	Bandwidth           *BandwidthConfig `json:",omitempty" ignored:"true"`
+	FileCache           *FileCacheConfig `json:",omitempty" ignored:"true"`
+	// LocalOnly disables all configured nodes, spaces and files are synced only with peers of the local network
+	LocalOnly bool `json:",omitempty"`
 }
 
 type Config struct {
@@ -59,19 +66,49 @@ type Config struct {
 	FS                     FSConfig
 	DisableFileConfig      bool `ignored:"true"` // set in order to skip reading/writing config from/to file
 	CreateBuiltinTemplates bool
+
+	// location is the loaded TimeZone, it's reset when the time zone is changed
+	location *time.Location
 }
 
+// timeZoneMu guards TimeZone and the location of configs, because the time zone is changed while the account is running
+var timeZoneMu sync.RWMutex
+
 type FSConfig struct {
 	IPFSStorageAddr string
 }
 
+// BackupConfig is the schedule of automatic backups, backups are disabled when Dir is empty
+type BackupConfig struct {
+	Dir        string `json:",omitempty"`
+	Interval   int64  `json:",omitempty"` // seconds
+	KeepDaily  int    `json:",omitempty"`
+	KeepWeekly int    `json:",omitempty"`
+}
+
+// BandwidthConfig limits the traffic of file sync, zero limits mean no limit
+type BandwidthConfig struct {
+	UploadLimit   int64 `json:",omitempty"` // bytes per second
+	DownloadLimit int64 `json:",omitempty"` // bytes per second
+	// Metered defers uploads of files larger than MeteredMaxFileSize and disables loading of files not requested by the user
+	Metered            bool  `json:",omitempty"`
+	MeteredMaxFileSize int64 `json:",omitempty"`
+}
+
+// FileCacheConfig limits the local storage of files, files not pinned and synced to the file node are evicted
+// starting from the least recently used. Zero MaxSize means no limit
+type FileCacheConfig struct {
+	MaxSize int64 `json:",omitempty"` // bytes
+}
+
 type DebugAPIConfig struct {
 	debugserver.Config
 	IsEnabled bool
 }
 
 const (
-	ConfigFileName = "config.json"
+	ConfigFileName      = "config.json"
+	localOnlyNodeConfId = "local"
 )
 
 var DefaultConfig = Config{
@@ -110,6 +147,12 @@ func WithCreateBuiltinTemplates(createBuiltinTemplates bool) func(*Config) {
 	}
 }
 
+func WithLocalOnly(localOnly bool) func(*Config) {
+	return func(c *Config) {
+		c.LocalOnly = localOnly
+	}
+}
+
 func DisableFileConfig(disable bool) func(*Config) {
 	return func(c *Config) {
 		c.DisableFileConfig = disable
@@ -154,6 +197,15 @@ func (c *Config) initFromFileAndEnv(repoPath string) error {
 			return nil
 		}
 
+		// local only mode can be enabled for the new account before the config file is created
+		if c.LocalOnly && !confRequired.LocalOnly {
+			confRequired.LocalOnly = true
+			c.ConfigRequired = confRequired
+			if err := writeConfig(); err != nil {
+				return err
+			}
+		}
+
 		// Do not overwrite the legacy file store path from file if it's already set in memory
 		if confRequired.LegacyFileStorePath == "" && c.LegacyFileStorePath != "" {
 			confRequired.LegacyFileStorePath = c.LegacyFileStorePath
@@ -217,6 +269,38 @@ func (c *Config) Name() (name string) {
 	return CName
 }
 
+// SetTimeZone changes the time zone of the running account, the config file is updated separately
+func (c *Config) SetTimeZone(timeZone string) {
+	timeZoneMu.Lock()
+	defer timeZoneMu.Unlock()
+	c.TimeZone = timeZone
+	c.location = nil
+}
+
+// Location returns the time zone of the account. The local time zone is used when the time zone is not set or unknown
+func (c *Config) Location() *time.Location {
+	timeZoneMu.RLock()
+	loc, timeZone := c.location, c.TimeZone
+	timeZoneMu.RUnlock()
+	if loc != nil {
+		return loc
+	}
+	loc = time.Local
+	if timeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timeZone); err != nil {
+			log.Errorf("failed to load time zone %s: %v", timeZone, err)
+			loc = time.Local
+		}
+	}
+	timeZoneMu.Lock()
+	if c.TimeZone == timeZone {
+		c.location = loc
+	}
+	timeZoneMu.Unlock()
+	return loc
+}
+
 func (c *Config) DSConfig() clientds.Config {
 	return c.DS
 }
@@ -231,6 +315,33 @@ func (c *Config) FSConfig() (FSConfig, error) {
 	return FSConfig{IPFSStorageAddr: res.CustomFileStorePath}, nil
 }
 
+func (c *Config) BackupConfig() (BackupConfig, error) {
+	res := ConfigRequired{}
+	err := GetFileConfig(c.GetConfigPath(), &res)
+	if err != nil || res.Backup == nil {
+		return BackupConfig{}, err
+	}
+	return *res.Backup, nil
+}
+
+func (c *Config) BandwidthConfig() (BandwidthConfig, error) {
+	res := ConfigRequired{}
+	err := GetFileConfig(c.GetConfigPath(), &res)
+	if err != nil || res.Bandwidth == nil {
+		return BandwidthConfig{}, err
+	}
+	return *res.Bandwidth, nil
+}
+
+func (c *Config) FileCacheConfig() (FileCacheConfig, error) {
+	res := ConfigRequired{}
+	err := GetFileConfig(c.GetConfigPath(), &res)
+	if err != nil || res.FileCache == nil {
+		return FileCacheConfig{}, err
+	}
+	return *res.FileCache, nil
+}
+
 func (c *Config) GetConfigPath() string {
 	return filepath.Join(c.RepoPath, ConfigFileName)
 }
@@ -291,10 +402,18 @@ func (c *Config) GetNodeConf() (conf nodeconf.Configuration) {
 	if err := yaml.Unmarshal(nodesConfYmlBytes, &conf); err != nil {
 		panic(fmt.Errorf("unable to parse node config: %v", err))
 	}
+	if c.LocalOnly {
+		conf.Id = localOnlyNodeConfId
+		conf.Nodes = nil
+	}
 	return
 }
 
 func (c *Config) GetNodeConfStorePath() string {
+	if c.LocalOnly {
+		// the configuration received from the coordinator must not be loaded in local only mode
+		return filepath.Join(c.RepoPath, "nodeconf_local")
+	}
 	return filepath.Join(c.RepoPath, "nodeconf")
 }
 
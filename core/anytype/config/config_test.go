@@ -0,0 +1,43 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_LocalOnly(t *testing.T) {
+	t.Run("nodes are disabled", func(t *testing.T) {
+		c := New(WithLocalOnly(true))
+		c.RepoPath = t.TempDir()
+		conf := c.GetNodeConf()
+		assert.Empty(t, conf.Nodes)
+		assert.Equal(t, localOnlyNodeConfId, conf.Id)
+		remote := New()
+		remote.RepoPath = c.RepoPath
+		assert.NotEmpty(t, remote.GetNodeConf().Nodes)
+		assert.NotEqual(t, remote.GetNodeConfStorePath(), c.GetNodeConfStorePath())
+	})
+	t.Run("mode is saved to the file", func(t *testing.T) {
+		repoPath := t.TempDir()
+		c := New(WithLocalOnly(true))
+		require.NoError(t, c.initFromFileAndEnv(repoPath))
+
+		c = New()
+		require.NoError(t, c.initFromFileAndEnv(repoPath))
+		assert.True(t, c.LocalOnly)
+	})
+}
+
+func TestConfig_Location(t *testing.T) {
+	c := New()
+	assert.Equal(t, time.Local, c.Location())
+
+	c.SetTimeZone("Asia/Tokyo")
+	assert.Equal(t, "Asia/Tokyo", c.Location().String())
+
+	c.SetTimeZone("Unknown/Zone")
+	assert.Equal(t, time.Local, c.Location())
+}
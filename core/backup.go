@@ -0,0 +1,198 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/backup"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+func (mw *Middleware) AccountBackup(cctx context.Context, req *pb.RpcAccountBackupRequest) *pb.RpcAccountBackupResponse {
+	response := func(stats *backup.Stats, code pb.RpcAccountBackupResponseErrorCode, err error) *pb.RpcAccountBackupResponse {
+		m := &pb.RpcAccountBackupResponse{Stats: backupStats(stats), Error: &pb.RpcAccountBackupResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if req.Path == "" {
+		return response(nil, pb.RpcAccountBackupResponseError_BAD_INPUT, fmt.Errorf("empty path"))
+	}
+	a := mw.GetApp()
+	if a == nil {
+		return response(nil, pb.RpcAccountBackupResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	stats, err := a.MustComponent(backup.CName).(backup.Service).Backup(cctx, req.Path)
+	if err != nil {
+		return response(nil, pb.RpcAccountBackupResponseError_UNKNOWN_ERROR, oserror.TransformError(err))
+	}
+	return response(stats, pb.RpcAccountBackupResponseError_NULL, nil)
+}
+
+func (mw *Middleware) AccountVerifyBackup(cctx context.Context, req *pb.RpcAccountVerifyBackupRequest) *pb.RpcAccountVerifyBackupResponse {
+	response := func(manifest *backup.Manifest, stats *backup.Stats, code pb.RpcAccountVerifyBackupResponseErrorCode, err error) *pb.RpcAccountVerifyBackupResponse {
+		m := &pb.RpcAccountVerifyBackupResponse{Stats: backupStats(stats), Error: &pb.RpcAccountVerifyBackupResponseError{Code: code}}
+		if manifest != nil {
+			m.AccountId = manifest.AccountId
+			m.CreatedDate = manifest.CreatedAt
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	a := mw.GetApp()
+	if a == nil {
+		return response(nil, nil, pb.RpcAccountVerifyBackupResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	manifest, stats, err := a.MustComponent(backup.CName).(backup.Service).Verify(req.Path)
+	switch {
+	case errors.Is(err, backup.ErrDecrypt):
+		return response(nil, nil, pb.RpcAccountVerifyBackupResponseError_DIFFERENT_ACCOUNT, err)
+	case errors.Is(err, backup.ErrInvalidArchive), errors.Is(err, backup.ErrTruncatedArchive):
+		return response(nil, nil, pb.RpcAccountVerifyBackupResponseError_CORRUPTED_ARCHIVE, err)
+	case err != nil:
+		return response(nil, nil, pb.RpcAccountVerifyBackupResponseError_UNKNOWN_ERROR, oserror.TransformError(err))
+	}
+	return response(manifest, stats, pb.RpcAccountVerifyBackupResponseError_NULL, nil)
+}
+
+func (mw *Middleware) AccountRecoverFromBackup(cctx context.Context, req *pb.RpcAccountRecoverFromBackupRequest) *pb.RpcAccountRecoverFromBackupResponse {
+	response := func(accountId string, stats *backup.Stats, code pb.RpcAccountRecoverFromBackupResponseErrorCode, err error) *pb.RpcAccountRecoverFromBackupResponse {
+		m := &pb.RpcAccountRecoverFromBackupResponse{AccountId: accountId, Stats: backupStats(stats), Error: &pb.RpcAccountRecoverFromBackupResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if req.Path == "" || req.RootPath == "" {
+		return response("", nil, pb.RpcAccountRecoverFromBackupResponseError_BAD_INPUT, fmt.Errorf("empty path"))
+	}
+	mw.accountSearchCancel()
+	mw.m.Lock()
+	defer mw.m.Unlock()
+	if mw.mnemonic == "" {
+		return response("", nil, pb.RpcAccountRecoverFromBackupResponseError_BAD_INPUT, fmt.Errorf("mnemonic is not set"))
+	}
+	if err := mw.stop(); err != nil {
+		return response("", nil, pb.RpcAccountRecoverFromBackupResponseError_UNKNOWN_ERROR, err)
+	}
+
+	res, err := core.WalletAccountAt(mw.mnemonic, 0)
+	if err != nil {
+		return response("", nil, pb.RpcAccountRecoverFromBackupResponseError_UNKNOWN_ERROR, err)
+	}
+	address := res.Identity.GetPublic().Account()
+	mw.rootPath = req.RootPath
+	if err = os.MkdirAll(mw.rootPath, 0700); err != nil {
+		return response("", nil, pb.RpcAccountRecoverFromBackupResponseError_UNKNOWN_ERROR, oserror.TransformError(err))
+	}
+	if mw.isAccountExistsOnDisk(address) {
+		return response("", nil, pb.RpcAccountRecoverFromBackupResponseError_ACCOUNT_ALREADY_EXISTS, fmt.Errorf("account %s already exists", address))
+	}
+	if err = core.WalletInitRepo(mw.rootPath, res.Identity); err != nil {
+		return response("", nil, pb.RpcAccountRecoverFromBackupResponseError_UNKNOWN_ERROR, err)
+	}
+
+	repoPath := filepath.Join(mw.rootPath, address)
+	_, stats, err := backup.Restore(req.Path, repoPath, res.Identity)
+	if err != nil {
+		// remove the partially restored repo, so the restore can be retried
+		if rmErr := os.RemoveAll(repoPath); rmErr != nil {
+			log.Errorf("failed to remove repo after failed restore: %s", rmErr)
+		}
+		switch {
+		case errors.Is(err, backup.ErrDecrypt):
+			return response("", nil, pb.RpcAccountRecoverFromBackupResponseError_DIFFERENT_ACCOUNT, err)
+		case errors.Is(err, backup.ErrInvalidArchive), errors.Is(err, backup.ErrTruncatedArchive):
+			return response("", nil, pb.RpcAccountRecoverFromBackupResponseError_CORRUPTED_ARCHIVE, err)
+		}
+		return response("", nil, pb.RpcAccountRecoverFromBackupResponseError_UNKNOWN_ERROR, oserror.TransformError(err))
+	}
+	return response(address, stats, pb.RpcAccountRecoverFromBackupResponseError_NULL, nil)
+}
+
+func backupStats(stats *backup.Stats) *pb.RpcAccountBackupStats {
+	if stats == nil {
+		return nil
+	}
+	return &pb.RpcAccountBackupStats{
+		Spaces:     int64(stats.Spaces),
+		Trees:      int64(stats.Trees),
+		Changes:    int64(stats.Changes),
+		AclRecords: int64(stats.AclRecords),
+		Files:      int64(stats.Files),
+	}
+}
+
+func (mw *Middleware) AccountBackupSetSchedule(cctx context.Context, req *pb.RpcAccountBackupSetScheduleRequest) *pb.RpcAccountBackupSetScheduleResponse {
+	response := func(code pb.RpcAccountBackupSetScheduleResponseErrorCode, err error) *pb.RpcAccountBackupSetScheduleResponse {
+		m := &pb.RpcAccountBackupSetScheduleResponse{Error: &pb.RpcAccountBackupSetScheduleResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if req.Schedule == nil {
+		return response(pb.RpcAccountBackupSetScheduleResponseError_BAD_INPUT, fmt.Errorf("empty schedule"))
+	}
+	a := mw.GetApp()
+	if a == nil {
+		return response(pb.RpcAccountBackupSetScheduleResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	err := a.MustComponent(backup.CName).(backup.Service).SetSchedule(config.BackupConfig{
+		Dir:        req.Schedule.Dir,
+		Interval:   req.Schedule.Interval,
+		KeepDaily:  int(req.Schedule.KeepDaily),
+		KeepWeekly: int(req.Schedule.KeepWeekly),
+	})
+	switch {
+	case errors.Is(err, backup.ErrInvalidSchedule):
+		return response(pb.RpcAccountBackupSetScheduleResponseError_BAD_INPUT, err)
+	case err != nil:
+		return response(pb.RpcAccountBackupSetScheduleResponseError_UNKNOWN_ERROR, oserror.TransformError(err))
+	}
+	return response(pb.RpcAccountBackupSetScheduleResponseError_NULL, nil)
+}
+
+func (mw *Middleware) AccountBackupGetStatus(cctx context.Context, req *pb.RpcAccountBackupGetStatusRequest) *pb.RpcAccountBackupGetStatusResponse {
+	response := func(status *backup.Status, code pb.RpcAccountBackupGetStatusResponseErrorCode, err error) *pb.RpcAccountBackupGetStatusResponse {
+		m := &pb.RpcAccountBackupGetStatusResponse{Error: &pb.RpcAccountBackupGetStatusResponseError{Code: code}}
+		if status != nil {
+			m.Schedule = &pb.RpcAccountBackupSetScheduleSchedule{
+				Dir:        status.Schedule.Dir,
+				Interval:   status.Schedule.Interval,
+				KeepDaily:  int32(status.Schedule.KeepDaily),
+				KeepWeekly: int32(status.Schedule.KeepWeekly),
+			}
+			m.LastArchive = status.LastArchive
+			m.LastError = status.LastError
+			if !status.LastBackupAt.IsZero() {
+				m.LastBackupDate = status.LastBackupAt.Unix()
+			}
+			if !status.NextBackupAt.IsZero() {
+				m.NextBackupDate = status.NextBackupAt.Unix()
+			}
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	a := mw.GetApp()
+	if a == nil {
+		return response(nil, pb.RpcAccountBackupGetStatusResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	status, err := a.MustComponent(backup.CName).(backup.Service).Status()
+	if err != nil {
+		return response(nil, pb.RpcAccountBackupGetStatusResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(status, pb.RpcAccountBackupGetStatusResponseError_NULL, nil)
+}
@@ -0,0 +1,348 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anyproto/any-sync/util/crypto"
+
+	"github.com/anyproto/anytype-heart/core/debug/treearchive/ziptreestorage"
+)
+
+// Archive layout. Entries are written in this order, so the restore can be done in one pass.
+// Trees are written in the layout of the tree archive: an entry per change and the entry with heads after them
+const (
+	manifestEntry = "manifest.json"
+	configEntry   = "config.json"
+	spacesDir     = "spaces"
+	spaceEntry    = "space.json"
+	treesDir      = "trees"
+	filesDir      = "files"
+)
+
+type Manifest struct {
+	Version   int    `json:"version"`
+	AccountId string `json:"accountId"`
+	CreatedAt int64  `json:"createdAt"`
+	// Parent is the file name of the previous archive in the same directory, if it's set the archive is incremental
+	Parent string `json:"parent,omitempty"`
+}
+
+type Stats struct {
+	Spaces     int
+	Trees      int
+	Changes    int
+	AclRecords int
+	Files      int
+}
+
+func (s *Stats) add(o *Stats) {
+	s.Spaces += o.Spaces
+	s.Trees += o.Trees
+	s.Changes += o.Changes
+	s.AclRecords += o.AclRecords
+	s.Files += o.Files
+}
+
+type rawRecord struct {
+	Id      string `json:"id"`
+	Payload []byte `json:"payload"`
+}
+
+type rawChange struct {
+	Id        string `json:"id"`
+	RawChange []byte `json:"rawChange"`
+}
+
+type spaceData struct {
+	Header       []byte      `json:"header"`
+	SettingsRoot rawChange   `json:"settingsRoot"`
+	AclRootId    string      `json:"aclRootId"`
+	AclHead      string      `json:"aclHead"`
+	AclRecords   []rawRecord `json:"aclRecords"`
+	Deleted      bool        `json:"deleted,omitempty"`
+	Hash         string      `json:"hash,omitempty"`
+	// TreeStatuses are deleted statuses of trees, they are written to every archive,
+	// because the status is changed without changes of the tree
+	TreeStatuses map[string]string `json:"treeStatuses,omitempty"`
+	// RemovedTrees are trees removed from the storage since the parent archive
+	RemovedTrees []string `json:"removedTrees,omitempty"`
+}
+
+// treeData is the tree read from the archive, in incremental archives it contains only changes added since the parent
+type treeData struct {
+	Id      string
+	Heads   []string
+	Changes []rawChange
+}
+
+// archiveWriter writes the tar stream compressed with gzip and encrypted with the backup key
+type archiveWriter struct {
+	enc *encryptWriter
+	gz  *gzip.Writer
+	tw  *tar.Writer
+	// entry is created by Create and written to the stream with the next entry, because sizes of tar entries are
+	// written before the data
+	entry     *bytes.Buffer
+	entryName string
+}
+
+func newArchiveWriter(w io.Writer, key crypto.SymKey) (*archiveWriter, error) {
+	enc, err := newEncryptWriter(w, key)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(enc)
+	return &archiveWriter{enc: enc, gz: gz, tw: tar.NewWriter(gz)}, nil
+}
+
+func (a *archiveWriter) writeJson(name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return a.writeBytes(name, data)
+}
+
+// Create implements ziptreestorage.EntryCreator
+func (a *archiveWriter) Create(name string) (io.Writer, error) {
+	if err := a.flushEntry(); err != nil {
+		return nil, err
+	}
+	a.entry, a.entryName = &bytes.Buffer{}, name
+	return a.entry, nil
+}
+
+func (a *archiveWriter) flushEntry() error {
+	if a.entry == nil {
+		return nil
+	}
+	entry := a.entry
+	a.entry = nil
+	return a.writeBytes(a.entryName, entry.Bytes())
+}
+
+// dir returns the creator of entries in the directory of the archive
+func (a *archiveWriter) dir(name string) ziptreestorage.EntryCreator {
+	return dirEntryCreator{aw: a, dir: name}
+}
+
+type dirEntryCreator struct {
+	aw  *archiveWriter
+	dir string
+}
+
+func (d dirEntryCreator) Create(name string) (io.Writer, error) {
+	return d.aw.Create(path.Join(d.dir, name))
+}
+
+func (a *archiveWriter) writeBytes(name string, data []byte) error {
+	if err := a.flushEntry(); err != nil {
+		return err
+	}
+	if err := a.tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data))}); err != nil {
+		return err
+	}
+	_, err := a.tw.Write(data)
+	return err
+}
+
+func (a *archiveWriter) writeFile(name string, size int64, r io.Reader) error {
+	if err := a.flushEntry(); err != nil {
+		return err
+	}
+	if err := a.tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: size}); err != nil {
+		return err
+	}
+	_, err := io.Copy(a.tw, r)
+	return err
+}
+
+func (a *archiveWriter) Close() error {
+	if err := a.flushEntry(); err != nil {
+		return err
+	}
+	if err := a.tw.Close(); err != nil {
+		return err
+	}
+	if err := a.gz.Close(); err != nil {
+		return err
+	}
+	return a.enc.Close()
+}
+
+// archiveHandler receives entries of the archive, restore writes them to the repo
+type archiveHandler interface {
+	config(data []byte) error
+	space(id string, data *spaceData) error
+	tree(spaceId string, data *treeData) error
+	file(name string, r io.Reader) error
+}
+
+// readArchive decrypts the archive and passes its entries to the handler, with nil handler entries are only validated.
+// Authentication of the whole archive is checked, so the data is consistent only when there is no error
+func readArchive(r io.Reader, key crypto.SymKey, h archiveHandler) (*Manifest, *Stats, error) {
+	dec, err := newDecryptReader(r, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	gz, err := gzip.NewReader(dec)
+	if err != nil {
+		return nil, nil, archiveError(err)
+	}
+	tr := tar.NewReader(gz)
+
+	var (
+		manifest *Manifest
+		stats    = &Stats{}
+		ar       = &archiveReader{h: h, stats: stats, trees: map[string]*treeData{}}
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, archiveError(err)
+		}
+		if manifest == nil {
+			if hdr.Name != manifestEntry {
+				return nil, nil, fmt.Errorf("%w: manifest is missing", ErrInvalidArchive)
+			}
+			manifest = &Manifest{}
+			if err = json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, nil, archiveError(err)
+			}
+			continue
+		}
+		if err = ar.readEntry(hdr.Name, tr); err != nil {
+			return nil, nil, fmt.Errorf("entry %s: %w", hdr.Name, archiveError(err))
+		}
+	}
+	if manifest == nil {
+		return nil, nil, fmt.Errorf("%w: manifest is missing", ErrInvalidArchive)
+	}
+	if len(ar.trees) > 0 {
+		return nil, nil, fmt.Errorf("%w: heads of trees are missing", ErrInvalidArchive)
+	}
+	// read the rest of the stream to check the authentication of the last chunk
+	if _, err = io.Copy(io.Discard, gz); err != nil {
+		return nil, nil, archiveError(err)
+	}
+	return manifest, stats, nil
+}
+
+// readManifest decrypts only the beginning of the archive with the manifest, the rest of the archive is not checked
+func readManifest(r io.Reader, key crypto.SymKey) (*Manifest, error) {
+	dec, err := newDecryptReader(r, key)
+	if err != nil {
+		return nil, err
+	}
+	gz, err := gzip.NewReader(dec)
+	if err != nil {
+		return nil, archiveError(err)
+	}
+	tr := tar.NewReader(gz)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, archiveError(err)
+	}
+	if hdr.Name != manifestEntry {
+		return nil, fmt.Errorf("%w: manifest is missing", ErrInvalidArchive)
+	}
+	manifest := &Manifest{}
+	if err = json.NewDecoder(tr).Decode(manifest); err != nil {
+		return nil, archiveError(err)
+	}
+	return manifest, nil
+}
+
+// archiveReader passes entries of the archive to the handler, changes of trees are collected until heads of the tree
+type archiveReader struct {
+	h     archiveHandler
+	stats *Stats
+	// trees are trees being read by space and tree ids
+	trees map[string]*treeData
+}
+
+func (ar *archiveReader) readEntry(name string, r io.Reader) error {
+	parts := strings.Split(name, "/")
+	switch {
+	case name == configEntry:
+		data, err := io.ReadAll(r)
+		if err != nil || ar.h == nil {
+			return err
+		}
+		return ar.h.config(data)
+	case len(parts) == 3 && parts[0] == spacesDir && parts[2] == spaceEntry:
+		data := &spaceData{}
+		if err := json.NewDecoder(r).Decode(data); err != nil {
+			return err
+		}
+		ar.stats.Spaces++
+		ar.stats.AclRecords += len(data.AclRecords)
+		if ar.h == nil {
+			return nil
+		}
+		return ar.h.space(parts[1], data)
+	case len(parts) == 5 && parts[0] == spacesDir && parts[2] == treesDir:
+		return ar.readTreeEntry(parts[1], parts[3], parts[4], r)
+	case len(parts) > 1 && parts[0] == filesDir:
+		name = path.Clean(strings.TrimPrefix(name, filesDir+"/"))
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("invalid file path")
+		}
+		ar.stats.Files++
+		if ar.h == nil {
+			_, err := io.Copy(io.Discard, r)
+			return err
+		}
+		return ar.h.file(name, r)
+	}
+	return fmt.Errorf("unknown entry")
+}
+
+// readTreeEntry collects changes of the tree, the tree is passed to the handler when its heads are read
+func (ar *archiveReader) readTreeEntry(spaceId, treeId, name string, r io.Reader) error {
+	key := path.Join(spaceId, treeId)
+	tree, ok := ar.trees[key]
+	if !ok {
+		tree = &treeData{Id: treeId}
+		ar.trees[key] = tree
+	}
+	if name != ziptreestorage.DataEntry {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		tree.Changes = append(tree.Changes, rawChange{Id: name, RawChange: data})
+		return nil
+	}
+	heads := &ziptreestorage.HeadsJsonEntry{}
+	if err := json.NewDecoder(r).Decode(heads); err != nil {
+		return err
+	}
+	delete(ar.trees, key)
+	tree.Heads = heads.Heads
+	ar.stats.Trees++
+	ar.stats.Changes += len(tree.Changes)
+	if ar.h == nil {
+		return nil
+	}
+	return ar.h.tree(spaceId, tree)
+}
+
+// archiveError wraps errors of the decrypted stream, errors of decryption are returned as is
+func archiveError(err error) error {
+	if errors.Is(err, ErrDecrypt) || errors.Is(err, ErrTruncatedArchive) || errors.Is(err, ErrInvalidArchive) {
+		return err
+	}
+	return fmt.Errorf("%w: %s", ErrInvalidArchive, err)
+}
@@ -0,0 +1,490 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonspace/object/tree/treechangeproto"
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/anyproto/any-sync/commonspace/spacestorage"
+	"github.com/anyproto/any-sync/consensus/consensusproto"
+	"github.com/anyproto/any-sync/util/crypto"
+	"golang.org/x/exp/slices"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/debug/treearchive/ziptreestorage"
+	"github.com/anyproto/anytype-heart/core/filestorage"
+	"github.com/anyproto/anytype-heart/core/wallet"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/space/storage"
+)
+
+const CName = "backup"
+
+var log = logging.Logger("anytype-mw-backup")
+
+type Service interface {
+	app.ComponentRunnable
+	// Backup writes the encrypted archive with spaces, files and the config of the account
+	Backup(ctx context.Context, path string) (*Stats, error)
+	// Verify decrypts the archive made by the account and checks its integrity
+	Verify(path string) (*Manifest, *Stats, error)
+	// SetSchedule saves the schedule of automatic backups, backups are disabled when the directory is empty
+	SetSchedule(cfg config.BackupConfig) error
+	// Status returns the schedule and the result of the last automatic backup
+	Status() (*Status, error)
+}
+
+type service struct {
+	wallet    wallet.Wallet
+	config    *config.Config
+	storage   storage.ClientStorage
+	processes process.Service
+
+	stateMu sync.Mutex
+	state   *scheduleState
+	trigger chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.wallet = app.MustComponent[wallet.Wallet](a)
+	s.config = app.MustComponent[*config.Config](a)
+	s.storage = a.MustComponent(spacestorage.CName).(storage.ClientStorage)
+	s.processes = a.MustComponent(process.CName).(process.Service)
+	s.trigger = make(chan struct{}, 1)
+	s.done = make(chan struct{})
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) Run(ctx context.Context) (err error) {
+	if s.state, err = loadState(s.statePath()); err != nil {
+		log.Errorf("failed to load backup state: %s", err)
+		s.state = &scheduleState{}
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	go s.scheduleLoop()
+	return nil
+}
+
+func (s *service) Close(ctx context.Context) (err error) {
+	if s.cancel != nil {
+		s.cancel()
+		<-s.done
+	}
+	return nil
+}
+
+func (s *service) Backup(ctx context.Context, path string) (*Stats, error) {
+	src, key, err := s.newSource()
+	if err != nil {
+		return nil, err
+	}
+	stats, _, err := writeFile(ctx, path, key, src)
+	return stats, err
+}
+
+func (s *service) newSource() (*source, crypto.SymKey, error) {
+	key, err := DeriveKey(s.wallet.GetAccountPrivkey())
+	if err != nil {
+		return nil, nil, fmt.Errorf("derive key: %w", err)
+	}
+	fileCfg, err := s.config.FSConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("get file config: %w", err)
+	}
+	filesPath := fileCfg.IPFSStorageAddr
+	if filesPath == "" {
+		filesPath = filepath.Join(s.wallet.RepoPath(), filestorage.FlatfsDirName)
+	}
+	return &source{
+		accountId:  s.wallet.GetAccountPrivkey().GetPublic().Account(),
+		storage:    s.storage,
+		configPath: s.config.GetConfigPath(),
+		filesPath:  filesPath,
+	}, key, nil
+}
+
+func (s *service) Verify(path string) (*Manifest, *Stats, error) {
+	key, err := DeriveKey(s.wallet.GetAccountPrivkey())
+	if err != nil {
+		return nil, nil, fmt.Errorf("derive key: %w", err)
+	}
+	return Verify(path, key)
+}
+
+// Verify decrypts the archive and checks that all its entries are readable
+func Verify(path string, key crypto.SymKey) (*Manifest, *Stats, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	return readArchive(f, key, nil)
+}
+
+// source is the data of the account to back up
+type source struct {
+	accountId  string
+	storage    storage.ClientStorage
+	configPath string
+	filesPath  string
+	// parent is the file name of the previous archive in the same directory. If it's set, the archive is incremental:
+	// only changes of trees added since baseHeads, removed trees and files modified since the time are written
+	parent    string
+	baseHeads treeHeads
+	since     time.Time
+	progress  process.Progress
+}
+
+// treeHeads are heads of trees by space ids
+type treeHeads map[string]map[string][]string
+
+func (h treeHeads) changed(spaceId, treeId string, heads []string) bool {
+	prev, ok := h[spaceId][treeId]
+	return !ok || !slices.Equal(prev, heads)
+}
+
+// writeFile writes the archive to the temporary file and renames it, so the incomplete archive never has the target name
+func writeFile(ctx context.Context, path string, key crypto.SymKey, src *source) (stats *Stats, heads treeHeads, err error) {
+	tmpPath := path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(tmpPath)
+		}
+	}()
+	if stats, heads, err = writeArchive(ctx, f, key, src); err != nil {
+		return nil, nil, err
+	}
+	if err = f.Sync(); err != nil {
+		return nil, nil, err
+	}
+	if err = f.Close(); err != nil {
+		return nil, nil, err
+	}
+	if err = os.Rename(tmpPath, path); err != nil {
+		return nil, nil, err
+	}
+	return stats, heads, nil
+}
+
+// writeArchive writes the archive and returns heads of all trees of the account
+func writeArchive(ctx context.Context, w io.Writer, key crypto.SymKey, src *source) (*Stats, treeHeads, error) {
+	aw, err := newArchiveWriter(w, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = aw.writeJson(manifestEntry, &Manifest{
+		Version:   archiveVersion,
+		AccountId: src.accountId,
+		CreatedAt: time.Now().Unix(),
+		Parent:    src.parent,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if src.configPath != "" {
+		data, err := os.ReadFile(src.configPath)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, nil, fmt.Errorf("read config: %w", err)
+		}
+		if err == nil {
+			if err = aw.writeBytes(configEntry, data); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	stats := &Stats{}
+	heads := treeHeads{}
+	spaceIds, err := src.storage.AllSpaceIds()
+	if err != nil {
+		return nil, nil, fmt.Errorf("list spaces: %w", err)
+	}
+	if src.progress != nil {
+		// files are counted as one more step
+		src.progress.SetTotal(int64(len(spaceIds) + 1))
+	}
+	for _, spaceId := range spaceIds {
+		if err = ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		if err = src.writeSpace(ctx, aw, spaceId, stats, heads); err != nil {
+			return nil, nil, fmt.Errorf("space %s: %w", spaceId, err)
+		}
+		if src.progress != nil {
+			src.progress.AddDone(1)
+		}
+	}
+	if src.filesPath != "" {
+		if err = src.writeFiles(ctx, aw, stats); err != nil {
+			return nil, nil, fmt.Errorf("files: %w", err)
+		}
+	}
+	if src.progress != nil {
+		src.progress.AddDone(1)
+	}
+	if err = aw.Close(); err != nil {
+		return nil, nil, err
+	}
+	return stats, heads, nil
+}
+
+func (src *source) writeSpace(ctx context.Context, aw *archiveWriter, spaceId string, stats *Stats, heads treeHeads) error {
+	ss, err := src.storage.WaitSpaceStorage(ctx, spaceId)
+	if err != nil {
+		return err
+	}
+	defer ss.Close(ctx)
+
+	header, err := ss.SpaceHeader()
+	if err != nil {
+		return fmt.Errorf("get header: %w", err)
+	}
+	settingsRoot, err := ss.TreeRoot(ss.SpaceSettingsId())
+	if err != nil {
+		return fmt.Errorf("get settings root: %w", err)
+	}
+	aclStorage, err := ss.AclStorage()
+	if err != nil {
+		return fmt.Errorf("get acl storage: %w", err)
+	}
+	aclHead, err := aclStorage.Head()
+	if err != nil {
+		return fmt.Errorf("get acl head: %w", err)
+	}
+	records, ok := aclStorage.(storage.AclRecordsIterator)
+	if !ok {
+		return fmt.Errorf("acl storage doesn't support iteration")
+	}
+	data := &spaceData{
+		Header:       header.RawHeader,
+		SettingsRoot: rawChange{Id: settingsRoot.Id, RawChange: settingsRoot.RawChange},
+		AclRootId:    aclStorage.Id(),
+		AclHead:      aclHead,
+	}
+	err = records.IterateRecords(func(rec *consensusproto.RawRecordWithId) error {
+		data.AclRecords = append(data.AclRecords, rawRecord{Id: rec.Id, Payload: rec.Payload})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("read acl records: %w", err)
+	}
+	if data.Deleted, err = ss.IsSpaceDeleted(); err != nil {
+		return fmt.Errorf("get deleted status: %w", err)
+	}
+	// hash is not written for spaces which have never been synced
+	if data.Hash, err = ss.ReadSpaceHash(); err != nil {
+		data.Hash = ""
+	}
+	treeIds, err := ss.StoredIds()
+	if err != nil {
+		return fmt.Errorf("list trees: %w", err)
+	}
+	for _, treeId := range treeIds {
+		status, err := ss.TreeDeletedStatus(treeId)
+		if err != nil {
+			return fmt.Errorf("tree %s: get deleted status: %w", treeId, err)
+		}
+		if status != "" {
+			if data.TreeStatuses == nil {
+				data.TreeStatuses = map[string]string{}
+			}
+			data.TreeStatuses[treeId] = status
+		}
+	}
+	if src.parent != "" {
+		for treeId := range src.baseHeads[spaceId] {
+			if !slices.Contains(treeIds, treeId) {
+				data.RemovedTrees = append(data.RemovedTrees, treeId)
+			}
+		}
+		sort.Strings(data.RemovedTrees)
+	}
+	if err = aw.writeJson(path.Join(spacesDir, spaceId, spaceEntry), data); err != nil {
+		return err
+	}
+	stats.Spaces++
+	stats.AclRecords += len(data.AclRecords)
+
+	heads[spaceId] = make(map[string][]string, len(treeIds))
+	for _, treeId := range treeIds {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		ts, err := ss.TreeStorage(treeId)
+		if err != nil {
+			return fmt.Errorf("tree %s: %w", treeId, err)
+		}
+		treeHeads, err := ts.Heads()
+		if err != nil {
+			return fmt.Errorf("tree %s: %w", treeId, err)
+		}
+		heads[spaceId][treeId] = treeHeads
+		var baseHeads []string
+		if src.parent != "" {
+			if !src.baseHeads.changed(spaceId, treeId, treeHeads) {
+				continue
+			}
+			baseHeads = src.baseHeads[spaceId][treeId]
+		}
+		changes, err := writeTree(aw.dir(path.Join(spacesDir, spaceId, treesDir)), ts, treeHeads, baseHeads)
+		if err != nil {
+			return fmt.Errorf("tree %s: %w", treeId, err)
+		}
+		stats.Trees++
+		stats.Changes += changes
+	}
+	return nil
+}
+
+// writeTree writes the tree by the storage of the tree archive and returns the number of written changes.
+// When base heads are set, ancestors of them are skipped, because they are written to the parent archives
+func writeTree(ec ziptreestorage.EntryCreator, ts treestorage.TreeStorage, heads, baseHeads []string) (int, error) {
+	iter, ok := ts.(storage.TreeChangesIterator)
+	if !ok {
+		return 0, fmt.Errorf("tree storage doesn't support iteration")
+	}
+	var (
+		root    *treechangeproto.RawTreeChangeWithId
+		changes []*treechangeproto.RawTreeChangeWithId
+	)
+	err := iter.IterateChanges(func(change *treechangeproto.RawTreeChangeWithId) error {
+		if change.Id == ts.Id() {
+			root = change
+		} else {
+			changes = append(changes, change)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if root == nil {
+		return 0, fmt.Errorf("root is missing")
+	}
+	if len(baseHeads) > 0 {
+		changes = newChanges(changes, baseHeads)
+	}
+	// the root is always written, so the tree can be created from the incremental archive
+	ws, err := ziptreestorage.NewZipTreeWriteStorage(root, ec)
+	if err != nil {
+		return 0, err
+	}
+	if err = ws.AddRawChangesSetHeads(changes, heads); err != nil {
+		return 0, err
+	}
+	if err = ws.(flushableStorage).FlushStorage(); err != nil {
+		return 0, err
+	}
+	return len(changes) + 1, nil
+}
+
+type flushableStorage interface {
+	FlushStorage() error
+}
+
+// newChanges returns changes which are not ancestors of the base heads. Changes which can't be parsed are treated
+// as new, so the archive could repeat some changes, but never misses them
+func newChanges(changes []*treechangeproto.RawTreeChangeWithId, baseHeads []string) []*treechangeproto.RawTreeChangeWithId {
+	prevIds := make(map[string][]string, len(changes))
+	for _, ch := range changes {
+		prevIds[ch.Id] = previousIds(ch)
+	}
+	known := map[string]bool{}
+	queue := append([]string{}, baseHeads...)
+	for len(queue) > 0 {
+		id := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if known[id] {
+			continue
+		}
+		known[id] = true
+		queue = append(queue, prevIds[id]...)
+	}
+	res := make([]*treechangeproto.RawTreeChangeWithId, 0, len(changes)-len(known))
+	for _, ch := range changes {
+		if !known[ch.Id] {
+			res = append(res, ch)
+		}
+	}
+	return res
+}
+
+func previousIds(ch *treechangeproto.RawTreeChangeWithId) []string {
+	raw := &treechangeproto.RawTreeChange{}
+	if err := raw.Unmarshal(ch.RawChange); err != nil {
+		return nil
+	}
+	change := &treechangeproto.TreeChange{}
+	if err := change.Unmarshal(raw.Payload); err != nil {
+		return nil
+	}
+	return change.TreeHeadIds
+}
+
+func (src *source) writeFiles(ctx context.Context, aw *archiveWriter, stats *Stats) error {
+	root := src.filesPath
+	if _, err := os.Stat(root); os.IsNotExist(err) {
+		log.Warnf("files directory %s doesn't exist", root)
+		return nil
+	}
+	return filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		// blocks of files are immutable, so new blocks are found by the modification time
+		if src.parent != "" && info.ModTime().Before(src.since) {
+			return nil
+		}
+		if err = aw.writeFile(path.Join(filesDir, filepath.ToSlash(rel)), info.Size(), f); err != nil {
+			return err
+		}
+		stats.Files++
+		return nil
+	})
+}
@@ -0,0 +1,344 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/treechangeproto"
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/anyproto/any-sync/commonspace/spacestorage"
+	"github.com/anyproto/any-sync/commonspace/spacesyncproto"
+	"github.com/anyproto/any-sync/consensus/consensusproto"
+	"github.com/anyproto/any-sync/util/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dsbadgerv3 "github.com/textileio/go-ds-badger3"
+
+	"github.com/anyproto/anytype-heart/core/filestorage"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore/clientds"
+	"github.com/anyproto/anytype-heart/space/storage"
+)
+
+func newKey(t *testing.T) (crypto.PrivKey, crypto.SymKey) {
+	accountKey, _, err := crypto.GenerateRandomEd25519KeyPair()
+	require.NoError(t, err)
+	key, err := DeriveKey(accountKey)
+	require.NoError(t, err)
+	return accountKey, key
+}
+
+func TestEncryptedStream(t *testing.T) {
+	_, key := newKey(t)
+	data := bytes.Repeat([]byte("0123456789"), chunkSize/4)
+
+	encrypt := func() []byte {
+		buf := &bytes.Buffer{}
+		w, err := newEncryptWriter(buf, key)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+	decrypt := func(encrypted []byte, key crypto.SymKey) ([]byte, error) {
+		r, err := newDecryptReader(bytes.NewReader(encrypted), key)
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(r)
+	}
+
+	t.Run("roundtrip", func(t *testing.T) {
+		res, err := decrypt(encrypt(), key)
+		require.NoError(t, err)
+		assert.Equal(t, data, res)
+	})
+	t.Run("tampered data", func(t *testing.T) {
+		encrypted := encrypt()
+		encrypted[len(encrypted)/2] ^= 1
+		_, err := decrypt(encrypted, key)
+		assert.ErrorIs(t, err, ErrDecrypt)
+	})
+	t.Run("truncated on the chunk boundary", func(t *testing.T) {
+		encrypted := encrypt()
+		headerSize := len(archiveMagic) + 1 + saltSize
+		firstChunk := 5 + chunkSize + 16
+		_, err := decrypt(encrypted[:headerSize+firstChunk], key)
+		assert.ErrorIs(t, err, ErrTruncatedArchive)
+	})
+	t.Run("wrong key", func(t *testing.T) {
+		_, otherKey := newKey(t)
+		_, err := decrypt(encrypt(), otherKey)
+		assert.ErrorIs(t, err, ErrDecrypt)
+	})
+	t.Run("not an archive", func(t *testing.T) {
+		_, err := decrypt([]byte("PK\x03\x04 zip file"), key)
+		assert.ErrorIs(t, err, ErrInvalidArchive)
+	})
+}
+
+func openStorage(t *testing.T, path string) (storage.ClientStorage, func()) {
+	ds, err := dsbadgerv3.NewDatastore(path, &clientds.DefaultConfig.Spacestore)
+	require.NoError(t, err)
+	return storage.NewWithDB(ds.DB), func() { require.NoError(t, ds.Close()) }
+}
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	accountKey, key := newKey(t)
+	srcRepo := t.TempDir()
+
+	src, closeSrc := openStorage(t, filepath.Join(srcRepo, clientds.SpaceDSDir))
+	ss, err := src.CreateSpaceStorage(spacestorage.SpaceStorageCreatePayload{
+		AclWithId:           &consensusproto.RawRecordWithId{Id: "aclRoot", Payload: []byte("aclRoot")},
+		SpaceHeaderWithId:   &spacesyncproto.RawSpaceHeaderWithId{Id: "space1", RawHeader: []byte("header")},
+		SpaceSettingsWithId: &treechangeproto.RawTreeChangeWithId{Id: "settings", RawChange: []byte("settings")},
+	})
+	require.NoError(t, err)
+	acl, err := ss.AclStorage()
+	require.NoError(t, err)
+	require.NoError(t, acl.AddRawRecord(ctx, &consensusproto.RawRecordWithId{Id: "aclRec", Payload: []byte("aclRec")}))
+	require.NoError(t, acl.SetHead("aclRec"))
+	_, err = ss.CreateTreeStorage(treestorage.TreeStorageCreatePayload{
+		RootRawChange: &treechangeproto.RawTreeChangeWithId{Id: "tree1", RawChange: []byte("root")},
+		Changes: []*treechangeproto.RawTreeChangeWithId{
+			{Id: "tree1", RawChange: []byte("root")},
+			{Id: "ch1", RawChange: []byte("ch1")},
+			{Id: "ch2", RawChange: []byte("ch2")},
+		},
+		Heads: []string{"ch2"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ss.SetTreeDeletedStatus("tree1", spacestorage.TreeDeletedStatusQueued))
+
+	filesPath := filepath.Join(srcRepo, filestorage.FlatfsDirName)
+	require.NoError(t, os.MkdirAll(filepath.Join(filesPath, "AB"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(filesPath, "AB", "CIDAB.data"), []byte("block"), 0600))
+	configPath := filepath.Join(srcRepo, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"CustomFileStorePath":"/old","TimeZone":"UTC"}`), 0600))
+
+	archivePath := filepath.Join(t.TempDir(), "account.backup")
+	stats, _, err := writeFile(ctx, archivePath, key, &source{
+		accountId:  "account",
+		storage:    src,
+		configPath: configPath,
+		filesPath:  filesPath,
+	})
+	require.NoError(t, err)
+	closeSrc()
+	assert.Equal(t, &Stats{Spaces: 1, Trees: 2, Changes: 4, AclRecords: 2, Files: 1}, stats)
+
+	manifest, verified, err := Verify(archivePath, key)
+	require.NoError(t, err)
+	assert.Equal(t, "account", manifest.AccountId)
+	assert.Equal(t, stats, verified)
+
+	dstRepo := t.TempDir()
+	_, _, err = Restore(archivePath, dstRepo, accountKey)
+	require.NoError(t, err)
+
+	t.Run("spaces", func(t *testing.T) {
+		dst, closeDst := openStorage(t, filepath.Join(dstRepo, clientds.SpaceDSDir))
+		defer closeDst()
+		ss, err := dst.WaitSpaceStorage(ctx, "space1")
+		require.NoError(t, err)
+		acl, err := ss.AclStorage()
+		require.NoError(t, err)
+		head, err := acl.Head()
+		require.NoError(t, err)
+		assert.Equal(t, "aclRec", head)
+		assert.Equal(t, "settings", ss.SpaceSettingsId())
+
+		ts, err := ss.TreeStorage("tree1")
+		require.NoError(t, err)
+		heads, err := ts.Heads()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ch2"}, heads)
+		ch, err := ts.GetRawChange(ctx, "ch1")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("ch1"), ch.RawChange)
+		status, err := ss.TreeDeletedStatus("tree1")
+		require.NoError(t, err)
+		assert.Equal(t, spacestorage.TreeDeletedStatusQueued, status)
+	})
+	t.Run("files and config", func(t *testing.T) {
+		data, err := os.ReadFile(filepath.Join(dstRepo, filestorage.FlatfsDirName, "AB", "CIDAB.data"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("block"), data)
+		data, err = os.ReadFile(filepath.Join(dstRepo, "config.json"))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"TimeZone":"UTC"}`, string(data))
+	})
+	t.Run("restore to not empty repo", func(t *testing.T) {
+		_, _, err := Restore(archivePath, dstRepo, accountKey)
+		assert.ErrorIs(t, err, ErrRepoNotEmpty)
+	})
+}
+
+// treeChange returns the change with links to previous changes, so the incremental backup can find new changes
+func treeChange(t *testing.T, id string, prevIds ...string) *treechangeproto.RawTreeChangeWithId {
+	payload, err := (&treechangeproto.TreeChange{TreeHeadIds: prevIds, ChangesData: []byte(id)}).Marshal()
+	require.NoError(t, err)
+	raw, err := (&treechangeproto.RawTreeChange{Payload: payload}).Marshal()
+	require.NoError(t, err)
+	return &treechangeproto.RawTreeChangeWithId{Id: id, RawChange: raw}
+}
+
+func TestIncrementalBackup(t *testing.T) {
+	ctx := context.Background()
+	accountKey, key := newKey(t)
+	srcRepo := t.TempDir()
+	src, closeSrc := openStorage(t, filepath.Join(srcRepo, clientds.SpaceDSDir))
+	defer closeSrc()
+	ss, err := src.CreateSpaceStorage(spacestorage.SpaceStorageCreatePayload{
+		AclWithId:           &consensusproto.RawRecordWithId{Id: "aclRoot", Payload: []byte("aclRoot")},
+		SpaceHeaderWithId:   &spacesyncproto.RawSpaceHeaderWithId{Id: "space1", RawHeader: []byte("header")},
+		SpaceSettingsWithId: &treechangeproto.RawTreeChangeWithId{Id: "settings", RawChange: []byte("settings")},
+	})
+	require.NoError(t, err)
+	createTree := func(root *treechangeproto.RawTreeChangeWithId, changes ...*treechangeproto.RawTreeChangeWithId) treestorage.TreeStorage {
+		heads := []string{root.Id}
+		if len(changes) > 0 {
+			heads = []string{changes[len(changes)-1].Id}
+		}
+		ts, err := ss.CreateTreeStorage(treestorage.TreeStorageCreatePayload{
+			RootRawChange: root,
+			Changes:       append([]*treechangeproto.RawTreeChangeWithId{root}, changes...),
+			Heads:         heads,
+		})
+		require.NoError(t, err)
+		return ts
+	}
+	ts := createTree(treeChange(t, "tree1"), treeChange(t, "ch1", "tree1"))
+	removed := createTree(treeChange(t, "tree3"))
+	filesPath := filepath.Join(srcRepo, filestorage.FlatfsDirName)
+	require.NoError(t, os.MkdirAll(filesPath, 0700))
+
+	dir := t.TempDir()
+	now := time.Now()
+	fullName := archiveName(now.Add(-time.Hour))
+	_, heads, err := writeFile(ctx, filepath.Join(dir, fullName), key, &source{accountId: "account", storage: src, filesPath: filesPath})
+	require.NoError(t, err)
+
+	require.NoError(t, ts.AddRawChangesSetHeads([]*treechangeproto.RawTreeChangeWithId{treeChange(t, "ch2", "ch1")}, []string{"ch2"}))
+	require.NoError(t, ss.SetTreeDeletedStatus("tree1", spacestorage.TreeDeletedStatusQueued))
+	createTree(treeChange(t, "tree2"))
+	require.NoError(t, removed.Delete())
+
+	incPath := filepath.Join(dir, archiveName(now))
+	stats, _, err := writeFile(ctx, incPath, key, &source{
+		accountId: "account",
+		storage:   src,
+		filesPath: filesPath,
+		parent:    fullName,
+		baseHeads: heads,
+		since:     now.Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	// the settings tree is not changed, so only the changed and the new trees are written,
+	// only the root and the new change are written for the changed tree
+	assert.Equal(t, 2, stats.Trees)
+	assert.Equal(t, 3, stats.Changes)
+
+	dstRepo := t.TempDir()
+	_, total, err := Restore(incPath, dstRepo, accountKey)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total.Spaces)
+
+	dst, closeDst := openStorage(t, filepath.Join(dstRepo, clientds.SpaceDSDir))
+	defer closeDst()
+	dss, err := dst.WaitSpaceStorage(ctx, "space1")
+	require.NoError(t, err)
+	for treeId, head := range map[string]string{"tree1": "ch2", "tree2": "tree2", "settings": "settings"} {
+		dts, err := dss.TreeStorage(treeId)
+		require.NoError(t, err)
+		treeHeads, err := dts.Heads()
+		require.NoError(t, err)
+		assert.Equal(t, []string{head}, treeHeads)
+	}
+	dts, err := dss.TreeStorage("tree1")
+	require.NoError(t, err)
+	for _, id := range []string{"tree1", "ch1", "ch2"} {
+		ok, err := dts.HasChange(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, ok, id)
+	}
+	status, err := dss.TreeDeletedStatus("tree1")
+	require.NoError(t, err)
+	assert.Equal(t, spacestorage.TreeDeletedStatusQueued, status)
+	ok, err := dss.HasTree("tree3")
+	require.NoError(t, err)
+	assert.False(t, ok, "the tree removed after the full backup")
+
+	t.Run("missing parent", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dir, fullName)))
+		_, _, err := Restore(incPath, t.TempDir(), accountKey)
+		assert.Error(t, err)
+	})
+}
+
+func TestNewChanges(t *testing.T) {
+	// ch3 is the remote change based on the old change, so it's new, though it's not a descendant of the base head
+	changes := []*treechangeproto.RawTreeChangeWithId{
+		treeChange(t, "ch1", "root"),
+		treeChange(t, "ch2", "ch1"),
+		treeChange(t, "ch3", "ch1"),
+		treeChange(t, "ch4", "ch2", "ch3"),
+		{Id: "broken", RawChange: []byte("broken")},
+	}
+	var ids []string
+	for _, ch := range newChanges(changes, []string{"ch2"}) {
+		ids = append(ids, ch.Id)
+	}
+	assert.Equal(t, []string{"ch3", "ch4", "broken"}, ids)
+}
+
+func TestRetain(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC).AddDate(0, 0, d)
+	}
+	var archives []archiveInfo
+	// full archive each monday, incremental ones are based on the previous day
+	for d := 27; d >= 0; d-- {
+		a := archiveInfo{name: archiveName(day(d)), created: day(d)}
+		if day(d).Weekday() != time.Monday {
+			a.parent = archiveName(day(d - 1))
+		}
+		archives = append(archives, a)
+	}
+
+	keep := retain(archives, 2, 2, time.UTC)
+	var kept []string
+	for _, a := range archives {
+		if keep[a.name] {
+			kept = append(kept, a.created.Format("01-02"))
+		}
+	}
+	// 2 latest days and the sunday of the previous week, with their chains down to mondays
+	assert.Equal(t, []string{
+		"05-28", "05-27", "05-26", "05-25", "05-24", "05-23", "05-22",
+		"05-21", "05-20", "05-19", "05-18", "05-17", "05-16", "05-15",
+	}, kept)
+	assert.Empty(t, retain(nil, 1, 1, time.UTC))
+
+	t.Run("days are taken in the location", func(t *testing.T) {
+		loc := time.FixedZone("UTC+3", 3*60*60)
+		// in UTC+3 both archives are made on May 2
+		late := time.Date(2023, 5, 1, 22, 0, 0, 0, time.UTC)
+		early := time.Date(2023, 5, 1, 21, 30, 0, 0, time.UTC)
+		archives := []archiveInfo{
+			{name: archiveName(late), created: late},
+			{name: archiveName(early), created: early},
+			{name: archiveName(early.Add(-time.Hour)), created: early.Add(-time.Hour)},
+		}
+		keep := retain(archives, 2, 0, loc)
+		assert.Equal(t, map[string]bool{archives[0].name: true, archives[2].name: true}, keep)
+		keep = retain(archives, 2, 0, time.UTC)
+		assert.Equal(t, map[string]bool{archives[0].name: true}, keep)
+	})
+}
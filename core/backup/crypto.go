@@ -0,0 +1,206 @@
+package backup
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/anyproto/any-sync/util/crypto"
+)
+
+const (
+	archiveMagic   = "ANYBACKUP"
+	archiveVersion = 1
+	saltSize       = 32
+	chunkSize      = 64 * 1024
+	// backupKeyPath is the slip-21 path of the key derived from the account key
+	backupKeyPath = "m/SLIP-0021/anytype/backup"
+)
+
+var (
+	ErrInvalidArchive   = errors.New("file is not a backup archive")
+	ErrTruncatedArchive = errors.New("backup archive is truncated")
+	ErrDecrypt          = errors.New("failed to decrypt backup archive: wrong account or corrupted data")
+)
+
+// DeriveKey returns the key of the account backups, so archives can be decrypted only by the owner of the mnemonic
+func DeriveKey(accountKey crypto.PrivKey) (crypto.SymKey, error) {
+	raw, err := accountKey.Raw()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.DeriveSymmetricKey(raw, backupKeyPath)
+}
+
+// newArchiveCipher derives the unique key of the archive from the backup key and the random salt of the archive,
+// so nonces of chunks can be sequential numbers
+func newArchiveCipher(key crypto.SymKey, salt []byte) (cipher.AEAD, error) {
+	raw, err := key.Raw()
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, raw)
+	mac.Write(salt)
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce makes the nonce from the number of the chunk and the flag of the last chunk,
+// so reordered, dropped or truncated chunks fail the authentication
+func chunkNonce(aead cipher.AEAD, counter uint64, final bool) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce, counter)
+	if final {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// encryptWriter writes the header of the archive and then the data split into chunks encrypted with AES-GCM.
+// Every chunk is prefixed with its length and the flag of the last chunk
+type encryptWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	buf     []byte
+	counter uint64
+}
+
+func newEncryptWriter(w io.Writer, key crypto.SymKey) (*encryptWriter, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := newArchiveCipher(key, salt)
+	if err != nil {
+		return nil, err
+	}
+	header := append([]byte(archiveMagic), archiveVersion)
+	if _, err = w.Write(append(header, salt...)); err != nil {
+		return nil, err
+	}
+	return &encryptWriter{w: w, aead: aead, buf: make([]byte, 0, chunkSize)}, nil
+}
+
+func (e *encryptWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		if len(e.buf) == chunkSize {
+			if err = e.writeChunk(false); err != nil {
+				return
+			}
+		}
+		l := copy(e.buf[len(e.buf):chunkSize], p)
+		e.buf = e.buf[:len(e.buf)+l]
+		p = p[l:]
+		n += l
+	}
+	return
+}
+
+// Close writes the last chunk, it doesn't close the underlying writer
+func (e *encryptWriter) Close() error {
+	return e.writeChunk(true)
+}
+
+func (e *encryptWriter) writeChunk(final bool) error {
+	sealed := e.aead.Seal(nil, chunkNonce(e.aead, e.counter, final), e.buf, nil)
+	header := make([]byte, 5)
+	binary.BigEndian.PutUint32(header, uint32(len(sealed)))
+	if final {
+		header[4] = 1
+	}
+	if _, err := e.w.Write(header); err != nil {
+		return err
+	}
+	if _, err := e.w.Write(sealed); err != nil {
+		return err
+	}
+	e.counter++
+	e.buf = e.buf[:0]
+	return nil
+}
+
+type decryptReader struct {
+	r       *bufio.Reader
+	aead    cipher.AEAD
+	buf     []byte
+	counter uint64
+	final   bool
+}
+
+func newDecryptReader(r io.Reader, key crypto.SymKey) (*decryptReader, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(archiveMagic)+1+saltSize)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, ErrInvalidArchive
+	}
+	if string(header[:len(archiveMagic)]) != archiveMagic {
+		return nil, ErrInvalidArchive
+	}
+	if v := header[len(archiveMagic)]; v != archiveVersion {
+		return nil, fmt.Errorf("unsupported backup version %d", v)
+	}
+	aead, err := newArchiveCipher(key, header[len(archiveMagic)+1:])
+	if err != nil {
+		return nil, err
+	}
+	return &decryptReader{r: br, aead: aead}, nil
+}
+
+func (d *decryptReader) Read(p []byte) (n int, err error) {
+	for len(d.buf) == 0 {
+		if d.final {
+			return 0, io.EOF
+		}
+		if err = d.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n = copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return
+}
+
+func (d *decryptReader) readChunk() error {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(d.r, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return ErrTruncatedArchive
+		}
+		return err
+	}
+	size := binary.BigEndian.Uint32(header)
+	if size > chunkSize+uint32(d.aead.Overhead()) {
+		return ErrDecrypt
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(d.r, sealed); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return ErrTruncatedArchive
+		}
+		return err
+	}
+	final := header[4] == 1
+	plain, err := d.aead.Open(nil, chunkNonce(d.aead, d.counter, final), sealed, nil)
+	if err != nil {
+		return ErrDecrypt
+	}
+	if final {
+		if _, err = d.r.ReadByte(); err != io.EOF {
+			return ErrDecrypt
+		}
+	}
+	d.counter++
+	d.final = final
+	d.buf = plain
+	return nil
+}
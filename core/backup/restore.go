@@ -0,0 +1,259 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/treechangeproto"
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/anyproto/any-sync/commonspace/spacestorage"
+	"github.com/anyproto/any-sync/commonspace/spacesyncproto"
+	"github.com/anyproto/any-sync/consensus/consensusproto"
+	"github.com/anyproto/any-sync/util/crypto"
+	dsbadgerv3 "github.com/textileio/go-ds-badger3"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/filestorage"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore/clientds"
+	"github.com/anyproto/anytype-heart/space/storage"
+)
+
+var ErrRepoNotEmpty = errors.New("repo already has spaces, backup can be restored only to the new repo")
+
+// Restore writes spaces, files and the config from the archive to the repo of the account.
+// Incremental archives are restored together with all previous archives they are based on.
+// The app must not be running, the repo must not contain spaces yet
+func Restore(archivePath, repoPath string, accountKey crypto.PrivKey) (*Manifest, *Stats, error) {
+	key, err := DeriveKey(accountKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("derive key: %w", err)
+	}
+	spaceStorePath := filepath.Join(repoPath, clientds.SpaceDSDir)
+	if _, err = os.Stat(spaceStorePath); !os.IsNotExist(err) {
+		return nil, nil, ErrRepoNotEmpty
+	}
+	chain, err := archiveChain(archivePath, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	// check archives before writing anything to the repo
+	for _, p := range chain {
+		if _, _, err = Verify(p, key); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", filepath.Base(p), err)
+		}
+	}
+
+	ds, err := dsbadgerv3.NewDatastore(spaceStorePath, &clientds.DefaultConfig.Spacestore)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open space storage: %w", err)
+	}
+	defer ds.Close()
+
+	r := &restorer{
+		repoPath: repoPath,
+		storage:  storage.NewWithDB(ds.DB),
+		spaces:   map[string]spacestorage.SpaceStorage{},
+	}
+	var (
+		manifest *Manifest
+		total    = &Stats{}
+	)
+	for _, p := range chain {
+		var stats *Stats
+		if manifest, stats, err = restoreArchive(p, key, r); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", filepath.Base(p), err)
+		}
+		total.add(stats)
+	}
+	return manifest, total, nil
+}
+
+// archiveChain returns paths of the archive and all archives it's based on, starting from the full one
+func archiveChain(archivePath string, key crypto.SymKey) ([]string, error) {
+	var chain []string
+	visited := map[string]bool{}
+	for p := archivePath; ; {
+		if visited[p] {
+			return nil, fmt.Errorf("%w: cycle of incremental archives", ErrInvalidArchive)
+		}
+		visited[p] = true
+		chain = append([]string{p}, chain...)
+		manifest, err := readManifestFile(p, key)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(p), err)
+		}
+		if manifest.Parent == "" {
+			return chain, nil
+		}
+		p = filepath.Join(filepath.Dir(p), filepath.Base(manifest.Parent))
+	}
+}
+
+func readManifestFile(path string, key crypto.SymKey) (*Manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readManifest(f, key)
+}
+
+func restoreArchive(path string, key crypto.SymKey, r *restorer) (*Manifest, *Stats, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	return readArchive(f, key, r)
+}
+
+type restorer struct {
+	repoPath string
+	storage  storage.ClientStorage
+	spaces   map[string]spacestorage.SpaceStorage
+}
+
+// config writes the config without the custom path of the files, because files are restored to the repo
+func (r *restorer) config(data []byte) error {
+	cfg := map[string]interface{}{}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return err
+	}
+	delete(cfg, "CustomFileStorePath")
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(r.repoPath, config.ConfigFileName), data, 0600)
+}
+
+func (r *restorer) space(id string, data *spaceData) error {
+	ss, err := r.spaceStorage(id, data)
+	if err != nil {
+		return err
+	}
+	aclStorage, err := ss.AclStorage()
+	if err != nil {
+		return err
+	}
+	for _, rec := range data.AclRecords {
+		err = aclStorage.AddRawRecord(context.Background(), &consensusproto.RawRecordWithId{Id: rec.Id, Payload: rec.Payload})
+		if err != nil {
+			return fmt.Errorf("add acl record: %w", err)
+		}
+	}
+	if err = aclStorage.SetHead(data.AclHead); err != nil {
+		return err
+	}
+	if data.Deleted {
+		if err = ss.SetSpaceDeleted(); err != nil {
+			return err
+		}
+	}
+	if data.Hash != "" {
+		if err = ss.WriteSpaceHash(data.Hash); err != nil {
+			return err
+		}
+	}
+	for treeId, status := range data.TreeStatuses {
+		if err = ss.SetTreeDeletedStatus(treeId, status); err != nil {
+			return err
+		}
+	}
+	for _, treeId := range data.RemovedTrees {
+		if ok, _ := ss.HasTree(treeId); !ok {
+			continue
+		}
+		ts, err := ss.TreeStorage(treeId)
+		if err != nil {
+			return err
+		}
+		if err = ts.Delete(); err != nil {
+			return fmt.Errorf("remove tree %s: %w", treeId, err)
+		}
+	}
+	r.spaces[id] = ss
+	return nil
+}
+
+// spaceStorage returns the storage of the space restored from the previous archive or creates the new one
+func (r *restorer) spaceStorage(id string, data *spaceData) (spacestorage.SpaceStorage, error) {
+	if ss, ok := r.spaces[id]; ok {
+		return ss, nil
+	}
+	var aclRoot *consensusproto.RawRecordWithId
+	for _, rec := range data.AclRecords {
+		if rec.Id == data.AclRootId {
+			aclRoot = &consensusproto.RawRecordWithId{Id: rec.Id, Payload: rec.Payload}
+		}
+	}
+	if aclRoot == nil {
+		return nil, fmt.Errorf("acl root is missing")
+	}
+	ss, err := r.storage.CreateSpaceStorage(spacestorage.SpaceStorageCreatePayload{
+		AclWithId:         aclRoot,
+		SpaceHeaderWithId: &spacesyncproto.RawSpaceHeaderWithId{Id: id, RawHeader: data.Header},
+		SpaceSettingsWithId: &treechangeproto.RawTreeChangeWithId{
+			Id:        data.SettingsRoot.Id,
+			RawChange: data.SettingsRoot.RawChange,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create space storage: %w", err)
+	}
+	return ss, nil
+}
+
+func (r *restorer) tree(spaceId string, data *treeData) error {
+	ss, ok := r.spaces[spaceId]
+	if !ok {
+		return fmt.Errorf("space %s is missing", spaceId)
+	}
+	changes := make([]*treechangeproto.RawTreeChangeWithId, 0, len(data.Changes))
+	var root *treechangeproto.RawTreeChangeWithId
+	for _, ch := range data.Changes {
+		change := &treechangeproto.RawTreeChangeWithId{Id: ch.Id, RawChange: ch.RawChange}
+		if ch.Id == data.Id {
+			root = change
+		}
+		changes = append(changes, change)
+	}
+	if root == nil {
+		return fmt.Errorf("root of the tree %s is missing", data.Id)
+	}
+	// the tree exists when it is the settings tree created with the space or it was restored from the previous archive
+	if ok, _ := ss.HasTree(data.Id); ok {
+		ts, err := ss.TreeStorage(data.Id)
+		if err != nil {
+			return err
+		}
+		return ts.AddRawChangesSetHeads(changes, data.Heads)
+	}
+	_, err := ss.CreateTreeStorage(treestorage.TreeStorageCreatePayload{
+		RootRawChange: root,
+		Changes:       changes,
+		Heads:         data.Heads,
+	})
+	return err
+}
+
+func (r *restorer) file(name string, rd io.Reader) error {
+	p := filepath.Join(r.repoPath, filestorage.FlatfsDirName, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(p, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, rd); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,114 @@
+package backup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/anyproto/any-sync/util/crypto"
+)
+
+const (
+	archivePrefix     = "anytype-backup-"
+	archiveExt        = ".anybackup"
+	archiveTimeLayout = "20060102-150405"
+)
+
+func archiveName(t time.Time) string {
+	return archivePrefix + t.UTC().Format(archiveTimeLayout) + archiveExt
+}
+
+type archiveInfo struct {
+	name    string
+	created time.Time
+	parent  string
+}
+
+// listArchives returns archives of the directory made by the scheduler, the newest go first.
+// Archives which can't be decrypted with the key are skipped
+func listArchives(dir string, key crypto.SymKey) ([]archiveInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var res []archiveInfo
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, archivePrefix) || !strings.HasSuffix(name, archiveExt) {
+			continue
+		}
+		created, err := time.Parse(archiveTimeLayout, strings.TrimSuffix(strings.TrimPrefix(name, archivePrefix), archiveExt))
+		if err != nil {
+			continue
+		}
+		manifest, err := readManifestFile(filepath.Join(dir, name), key)
+		if err != nil {
+			log.Warnf("skip archive %s: %s", name, err)
+			continue
+		}
+		res = append(res, archiveInfo{name: name, created: created, parent: manifest.Parent})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].created.After(res[j].created)
+	})
+	return res, nil
+}
+
+// retain returns names of archives to keep: the newest one, the last archive of each of keepDaily latest days
+// and of each of keepWeekly latest weeks, and all archives the kept incremental archives are based on.
+// Days and weeks are taken in the location. Archives must be sorted from the newest
+func retain(archives []archiveInfo, keepDaily, keepWeekly int, loc *time.Location) map[string]bool {
+	keep := map[string]bool{}
+	if len(archives) == 0 {
+		return keep
+	}
+	keep[archives[0].name] = true
+	days := map[string]bool{}
+	weeks := map[string]bool{}
+	for _, a := range archives {
+		created := a.created.In(loc)
+		day := created.Format("2006-01-02")
+		if !days[day] && len(days) < keepDaily {
+			days[day] = true
+			keep[a.name] = true
+		}
+		year, week := created.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%d", year, week)
+		if !weeks[weekKey] && len(weeks) < keepWeekly {
+			weeks[weekKey] = true
+			keep[a.name] = true
+		}
+	}
+
+	parents := make(map[string]string, len(archives))
+	for _, a := range archives {
+		parents[a.name] = a.parent
+	}
+	for name := range keep {
+		for p := parents[name]; p != "" && !keep[p]; p = parents[p] {
+			keep[p] = true
+		}
+	}
+	return keep
+}
+
+// prune removes archives of the directory not retained by the policy
+func prune(dir string, key crypto.SymKey, keepDaily, keepWeekly int, loc *time.Location) error {
+	archives, err := listArchives(dir, key)
+	if err != nil {
+		return err
+	}
+	keep := retain(archives, keepDaily, keepWeekly, loc)
+	for _, a := range archives {
+		if keep[a.name] {
+			continue
+		}
+		if err = os.Remove(filepath.Join(dir, a.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
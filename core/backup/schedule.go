@@ -0,0 +1,265 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+const (
+	defaultInterval   = 24 * time.Hour
+	defaultKeepDaily  = 7
+	defaultKeepWeekly = 4
+	// fullBackupInterval is the max age of the full archive incremental archives are based on
+	fullBackupInterval = 7 * 24 * time.Hour
+	// checkInterval is the period of checking whether the backup is due, failed backups are retried with it
+	checkInterval = 10 * time.Minute
+	stateFileName = "backup_state.json"
+)
+
+var ErrInvalidSchedule = errors.New("invalid backup schedule")
+
+type Status struct {
+	Schedule     config.BackupConfig
+	LastBackupAt time.Time
+	LastArchive  string
+	LastError    string
+	NextBackupAt time.Time
+}
+
+// scheduleState is kept in the repo, Heads are heads of trees written to the archive chain ending with LastArchive
+type scheduleState struct {
+	LastBackupAt int64     `json:"lastBackupAt,omitempty"`
+	LastFullAt   int64     `json:"lastFullAt,omitempty"`
+	LastArchive  string    `json:"lastArchive,omitempty"`
+	LastError    string    `json:"lastError,omitempty"`
+	Heads        treeHeads `json:"heads,omitempty"`
+}
+
+func loadState(path string) (*scheduleState, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &scheduleState{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	st := &scheduleState{}
+	if err = json.Unmarshal(data, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+func (st *scheduleState) save(path string) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+func (s *service) statePath() string {
+	return filepath.Join(s.wallet.RepoPath(), stateFileName)
+}
+
+func (s *service) SetSchedule(cfg config.BackupConfig) error {
+	if cfg.Dir != "" && !filepath.IsAbs(cfg.Dir) {
+		return fmt.Errorf("%w: directory must be an absolute path", ErrInvalidSchedule)
+	}
+	if cfg.Interval < 0 || cfg.KeepDaily < 0 || cfg.KeepWeekly < 0 {
+		return fmt.Errorf("%w: negative values are not allowed", ErrInvalidSchedule)
+	}
+	if cfg.Dir != "" {
+		if err := os.MkdirAll(cfg.Dir, 0700); err != nil {
+			return err
+		}
+	}
+	if err := config.WriteJsonConfig(s.config.GetConfigPath(), config.ConfigRequired{Backup: &cfg}); err != nil {
+		return err
+	}
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (s *service) Status() (*Status, error) {
+	cfg, err := s.config.BackupConfig()
+	if err != nil {
+		return nil, err
+	}
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	st := &Status{Schedule: withDefaults(cfg)}
+	if s.state == nil {
+		return st, nil
+	}
+	st.LastArchive = s.state.LastArchive
+	st.LastError = s.state.LastError
+	if s.state.LastBackupAt != 0 {
+		st.LastBackupAt = time.Unix(s.state.LastBackupAt, 0)
+	}
+	if cfg.Dir != "" {
+		st.NextBackupAt = s.nextBackupAt(st.Schedule)
+	}
+	return st, nil
+}
+
+func withDefaults(cfg config.BackupConfig) config.BackupConfig {
+	if cfg.Interval == 0 {
+		cfg.Interval = int64(defaultInterval / time.Second)
+	}
+	if cfg.KeepDaily == 0 {
+		cfg.KeepDaily = defaultKeepDaily
+	}
+	if cfg.KeepWeekly == 0 {
+		cfg.KeepWeekly = defaultKeepWeekly
+	}
+	return cfg
+}
+
+// nextBackupAt must be called under stateMu
+func (s *service) nextBackupAt(cfg config.BackupConfig) time.Time {
+	if s.state.LastBackupAt == 0 {
+		return time.Now()
+	}
+	return time.Unix(s.state.LastBackupAt, 0).Add(time.Duration(cfg.Interval) * time.Second)
+}
+
+func (s *service) scheduleLoop() {
+	defer close(s.done)
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		s.backupIfDue()
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-s.trigger:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *service) backupIfDue() {
+	cfg, err := s.config.BackupConfig()
+	if err != nil {
+		log.Errorf("failed to get backup config: %s", err)
+		return
+	}
+	if cfg.Dir == "" {
+		return
+	}
+	cfg = withDefaults(cfg)
+	s.stateMu.Lock()
+	due := !time.Now().Before(s.nextBackupAt(cfg))
+	s.stateMu.Unlock()
+	if !due {
+		return
+	}
+
+	progress := process.NewProgress(pb.ModelProcess_Backup)
+	progress.SetProgressMessage("backup to " + cfg.Dir)
+	if err = s.processes.Add(progress); err != nil {
+		log.Errorf("failed to add backup process: %s", err)
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-progress.Canceled():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err = s.scheduledBackup(ctx, cfg, progress)
+	if err != nil {
+		log.Errorf("scheduled backup failed: %s", err)
+		progress.FinishWithError(err)
+		return
+	}
+	progress.Finish()
+}
+
+// scheduledBackup writes the incremental archive based on the last archive, or the full one when the last archive
+// is missing or the chain is older than fullBackupInterval. The written archive is verified, then old archives are pruned
+func (s *service) scheduledBackup(ctx context.Context, cfg config.BackupConfig, progress process.Progress) (err error) {
+	src, key, err := s.newSource()
+	if err != nil {
+		return err
+	}
+	src.progress = progress
+
+	s.stateMu.Lock()
+	st := *s.state
+	s.stateMu.Unlock()
+
+	now := time.Now()
+	defer func() {
+		if err != nil {
+			st.LastError = err.Error()
+		}
+		s.stateMu.Lock()
+		s.state = &st
+		s.stateMu.Unlock()
+		if saveErr := st.save(s.statePath()); saveErr != nil {
+			log.Errorf("failed to save backup state: %s", saveErr)
+		}
+	}()
+
+	if st.canContinueChain(cfg.Dir, now) {
+		src.parent = filepath.Base(st.LastArchive)
+		src.baseHeads = st.Heads
+		src.since = time.Unix(st.LastBackupAt, 0)
+	}
+	path := filepath.Join(cfg.Dir, archiveName(now))
+	stats, heads, err := writeFile(ctx, path, key, src)
+	if err != nil {
+		return err
+	}
+	_, verified, err := Verify(path, key)
+	if err == nil && *verified != *stats {
+		err = fmt.Errorf("archive contains %+v, but %+v was written", *verified, *stats)
+	}
+	if err != nil {
+		os.Remove(path)
+		return fmt.Errorf("verify archive: %w", err)
+	}
+
+	if src.parent == "" {
+		st.LastFullAt = now.Unix()
+	}
+	st.LastBackupAt = now.Unix()
+	st.LastArchive = path
+	st.LastError = ""
+	st.Heads = heads
+	// days of the retention policy are days of the account
+	if err = prune(cfg.Dir, key, cfg.KeepDaily, cfg.KeepWeekly, s.config.Location()); err != nil {
+		// the archive is written, so the failure of pruning is not the failure of the backup
+		log.Errorf("failed to remove old backups: %s", err)
+	}
+	return nil
+}
+
+// canContinueChain checks that the next archive can be incremental
+func (st *scheduleState) canContinueChain(dir string, now time.Time) bool {
+	if st.LastArchive == "" || st.Heads == nil || filepath.Dir(st.LastArchive) != filepath.Clean(dir) {
+		return false
+	}
+	if now.Sub(time.Unix(st.LastFullAt, 0)) >= fullBackupInterval {
+		return false
+	}
+	_, err := os.Stat(st.LastArchive)
+	return err == nil
+}
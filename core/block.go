@@ -2,11 +2,13 @@ package core
 
 import (
 	"context"
+	"errors"
 
 	"github.com/globalsign/mgo/bson"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/block/simple/latex"
 	"github.com/anyproto/anytype-heart/core/block/source"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
@@ -26,7 +28,12 @@ func (mw *Middleware) BlockCreate(cctx context.Context, req *pb.RpcBlockCreateRe
 	}
 	var id string
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		contextId := req.ContextId
+		if req.TargetId != "" {
+			req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.TargetId)
+		}
 		id, err = bs.CreateBlock(ctx, *req)
+		id = block.SyncedBlockId(contextId, req.ContextId, id)
 		return
 	})
 	if err != nil {
@@ -286,6 +293,7 @@ func (mw *Middleware) BlockListDelete(cctx context.Context, req *pb.RpcBlockList
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.UnlinkBlock(ctx, *req)
 	})
 	if err != nil {
@@ -328,6 +336,7 @@ func (mw *Middleware) BlockSetFields(cctx context.Context, req *pb.RpcBlockSetFi
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.BlockId)
 		return bs.SetFields(ctx, *req)
 	})
 	if err != nil {
@@ -348,6 +357,11 @@ func (mw *Middleware) BlockListSetFields(cctx context.Context, req *pb.RpcBlockL
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		blockIds := make([]*string, 0, len(req.BlockFields))
+		for _, f := range req.BlockFields {
+			blockIds = append(blockIds, &f.BlockId)
+		}
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIds...)
 		return bs.SetFieldsList(ctx, *req)
 	})
 	if err != nil {
@@ -440,6 +454,7 @@ func (mw *Middleware) BlockTextSetColor(cctx context.Context, req *pb.RpcBlockTe
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.BlockId)
 		return bs.SetTextColor(nil, req.ContextId, req.Color, req.BlockId)
 	})
 	if err != nil {
@@ -460,6 +475,7 @@ func (mw *Middleware) BlockListSetBackgroundColor(cctx context.Context, req *pb.
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.SetBackgroundColor(ctx, req.ContextId, req.Color, req.BlockIds...)
 	})
 	if err != nil {
@@ -500,6 +516,7 @@ func (mw *Middleware) BlockListSetAlign(cctx context.Context, req *pb.RpcBlockLi
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.SetAlign(ctx, req.ContextId, req.Align, req.BlockIds...)
 	})
 	if err != nil {
@@ -520,6 +537,7 @@ func (mw *Middleware) BlockListSetVerticalAlign(cctx context.Context, req *pb.Rp
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.SetVerticalAlign(ctx, req.ContextId, req.VerticalAlign, req.BlockIds...)
 	})
 	if err != nil {
@@ -540,6 +558,12 @@ func (mw *Middleware) BlockListMoveToExistingObject(cctx context.Context, req *p
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		// blocks are moved inside the source object only, moving between the source and the embedding object
+		// changes the synced block of the embedding object
+		if req.ContextId == req.TargetContextId && req.DropTargetId != "" {
+			req.ContextId = bs.SyncedBlockSource(req.ContextId, append(blockIdPtrs(req.BlockIds), &req.DropTargetId)...)
+			req.TargetContextId = req.ContextId
+		}
 		return bs.MoveBlocks(ctx, *req)
 	})
 	if err != nil {
@@ -606,6 +630,7 @@ func (mw *Middleware) BlockTextListSetStyle(cctx context.Context, req *pb.RpcBlo
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.SetTextStyle(ctx, req.ContextId, req.Style, req.BlockIds...)
 	})
 	if err != nil {
@@ -626,6 +651,7 @@ func (mw *Middleware) BlockDivListSetStyle(cctx context.Context, req *pb.RpcBloc
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.SetDivStyle(ctx, req.ContextId, req.Style, req.BlockIds...)
 	})
 	if err != nil {
@@ -646,6 +672,7 @@ func (mw *Middleware) BlockTextListSetColor(cctx context.Context, req *pb.RpcBlo
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.SetTextColor(ctx, req.ContextId, req.Color, req.BlockIds...)
 	})
 	if err != nil {
@@ -666,6 +693,7 @@ func (mw *Middleware) BlockTextListSetMark(cctx context.Context, req *pb.RpcBloc
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.SetTextMark(ctx, req.ContextId, req.Mark, req.BlockIds...)
 	})
 	if err != nil {
@@ -710,6 +738,7 @@ func (mw *Middleware) BlockTextListClearStyle(cctx context.Context, req *pb.RpcB
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.ClearTextStyle(ctx, req.ContextId, req.BlockIds...)
 	})
 	if err != nil {
@@ -730,6 +759,7 @@ func (mw *Middleware) BlockTextListClearContent(cctx context.Context, req *pb.Rp
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.ClearTextContent(ctx, req.ContextId, req.BlockIds...)
 	})
 	if err != nil {
@@ -751,6 +781,7 @@ func (mw *Middleware) BlockTextSetText(cctx context.Context, req *pb.RpcBlockTex
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.BlockId)
 		return bs.SetTextText(ctx, *req)
 	})
 	if err != nil {
@@ -771,6 +802,7 @@ func (mw *Middleware) BlockLatexSetText(cctx context.Context, req *pb.RpcBlockLa
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.BlockId)
 		return bs.SetLatexText(ctx, *req)
 	})
 	if err != nil {
@@ -779,6 +811,30 @@ func (mw *Middleware) BlockLatexSetText(cctx context.Context, req *pb.RpcBlockLa
 	return response(pb.RpcBlockLatexSetTextResponseError_NULL, nil)
 }
 
+func (mw *Middleware) BlockLatexSetLabel(cctx context.Context, req *pb.RpcBlockLatexSetLabelRequest) *pb.RpcBlockLatexSetLabelResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcBlockLatexSetLabelResponseErrorCode, err error) *pb.RpcBlockLatexSetLabelResponse {
+		m := &pb.RpcBlockLatexSetLabelResponse{Error: &pb.RpcBlockLatexSetLabelResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.BlockId)
+		return bs.SetLatexLabel(ctx, *req)
+	})
+	if errors.Is(err, latex.ErrInvalidLabel) {
+		return response(pb.RpcBlockLatexSetLabelResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcBlockLatexSetLabelResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcBlockLatexSetLabelResponseError_NULL, nil)
+}
+
 func (mw *Middleware) BlockTextSetStyle(cctx context.Context, req *pb.RpcBlockTextSetStyleRequest) *pb.RpcBlockTextSetStyleResponse {
 	ctx := mw.newContext(cctx)
 	response := func(code pb.RpcBlockTextSetStyleResponseErrorCode, err error) *pb.RpcBlockTextSetStyleResponse {
@@ -791,6 +847,7 @@ func (mw *Middleware) BlockTextSetStyle(cctx context.Context, req *pb.RpcBlockTe
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.BlockId)
 		return bs.SetTextStyle(ctx, req.ContextId, req.Style, req.BlockId)
 	})
 	if err != nil {
@@ -811,6 +868,7 @@ func (mw *Middleware) BlockTextSetIcon(cctx context.Context, req *pb.RpcBlockTex
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.BlockId)
 		return bs.SetTextIcon(ctx, req.ContextId, req.IconImage, req.IconEmoji, req.BlockId)
 	})
 	if err != nil {
@@ -831,6 +889,7 @@ func (mw *Middleware) BlockTextSetChecked(cctx context.Context, req *pb.RpcBlock
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.BlockId)
 		return bs.SetTextChecked(ctx, *req)
 	})
 	if err != nil {
@@ -865,6 +924,7 @@ func (mw *Middleware) BlockFileListSetStyle(cctx context.Context, req *pb.RpcBlo
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, blockIdPtrs(req.BlockIds)...)
 		return bs.SetFileStyle(ctx, req.ContextId, req.Style, req.BlockIds...)
 	})
 	if err != nil {
@@ -913,7 +973,10 @@ func (mw *Middleware) BlockSplit(cctx context.Context, req *pb.RpcBlockSplitRequ
 	}
 	var id string
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		contextId := req.ContextId
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.BlockId)
 		id, err = bs.SplitBlock(ctx, *req)
+		id = block.SyncedBlockId(contextId, req.ContextId, id)
 		return
 	})
 	if err != nil {
@@ -934,6 +997,7 @@ func (mw *Middleware) BlockMerge(cctx context.Context, req *pb.RpcBlockMergeRequ
 		return m
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		req.ContextId = bs.SyncedBlockSource(req.ContextId, &req.FirstBlockId, &req.SecondBlockId)
 		return bs.MergeBlock(ctx, *req)
 	})
 	if err != nil {
@@ -1088,3 +1152,12 @@ func (mw *Middleware) BlockListTurnInto(cctx context.Context, req *pb.RpcBlockLi
 	}
 	return response(pb.RpcBlockListTurnIntoResponseError_NULL, nil)
 }
+
+// blockIdPtrs returns pointers to the block ids of the request, so they could be replaced with ids of the synced blocks
+func blockIdPtrs(ids []string) []*string {
+	ptrs := make([]*string, 0, len(ids))
+	for i := range ids {
+		ptrs = append(ptrs, &ids[i])
+	}
+	return ptrs
+}
@@ -0,0 +1,321 @@
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/bulkedit"
+	"github.com/anyproto/anytype-heart/core/block/editor/basic"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/schema"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	bulkEditWorkers   = 10
+	bulkEditUndoLimit = 20
+)
+
+var ErrBulkEditCanceled = errors.New("bulk edit is canceled")
+
+type BulkEditResult struct {
+	ObjectIds []string
+	Failures  []*pb.RpcObjectBulkEditFailure
+	UndoToken string
+}
+
+// BulkEdit applies operations to details of objects of the space by parallel workers. Failed objects don't stop
+// the operation and are returned as failures. When the process is canceled, ErrBulkEditCanceled is returned with
+// the result of objects edited before the cancellation
+func (s *Service) BulkEdit(req pb.RpcObjectBulkEditRequest) (*BulkEditResult, error) {
+	if req.SpaceId == "" {
+		req.SpaceId = s.clientService.AccountId()
+	}
+	return s.bulkEditor().edit(req)
+}
+
+// BulkEditUndo restores values of relations changed by the bulk edit as they were before the bulk edit.
+// Objects with values changed after the bulk edit are not restored and are returned as failures
+func (s *Service) BulkEditUndo(token string) (failures []*pb.RpcObjectBulkEditFailure, found bool, err error) {
+	return s.bulkEditor().undo(token)
+}
+
+func (s *Service) bulkEditor() *bulkEditor {
+	return &bulkEditor{spaces: s, store: s.objectStore, process: s.process, undoStore: s.bulkEditUndo}
+}
+
+// SpaceObjectIds returns ids of objects stored in the space
+func (s *Service) SpaceObjectIds(ctx context.Context, spaceId string) ([]string, error) {
+	spc, err := s.clientService.GetSpace(ctx, spaceId)
+	if err != nil {
+		return nil, err
+	}
+	return spc.StoredIds(), nil
+}
+
+type processAdder interface {
+	Add(p process.Process) (err error)
+}
+
+type bulkEditQuerier interface {
+	Query(schema schema.Schema, q database.Query) (records []database.Record, total int, err error)
+}
+
+type spaceObjects interface {
+	GetObject(ctx context.Context, spaceId, id string) (sb smartblock.SmartBlock, err error)
+	SpaceObjectIds(ctx context.Context, spaceId string) ([]string, error)
+}
+
+// spacePicker picks objects of the space
+type spacePicker struct {
+	spaces  spaceObjects
+	spaceId string
+}
+
+func (p spacePicker) PickBlock(ctx context.Context, id string) (smartblock.SmartBlock, error) {
+	return p.spaces.GetObject(ctx, p.spaceId, id)
+}
+
+type bulkEditor struct {
+	spaces    spaceObjects
+	store     bulkEditQuerier
+	process   processAdder
+	undoStore *bulkedit.UndoStore
+}
+
+func (e *bulkEditor) edit(req pb.RpcObjectBulkEditRequest) (*BulkEditResult, error) {
+	if err := bulkedit.ValidateOperations(req.Operations); err != nil {
+		return nil, err
+	}
+	ids, err := e.objectIds(req)
+	if err != nil {
+		return nil, err
+	}
+	ids, outside, err := e.splitBySpace(req.SpaceId, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get objects of space: %w", err)
+	}
+	// objects of other spaces matching filters are not requested, so they are skipped
+	if len(req.Filters) > 0 {
+		outside = nil
+	}
+	picker := spacePicker{spaces: e.spaces, spaceId: req.SpaceId}
+
+	progress := process.NewProgress(pb.ModelProcess_BulkEdit)
+	defer progress.Finish()
+	if err = e.process.Add(progress); err != nil {
+		return nil, fmt.Errorf("add process: %w", err)
+	}
+	progress.SetTotal(int64(len(ids)))
+	progress.SetProgressMessage("edit objects")
+
+	var (
+		undo   = map[string]bulkedit.ObjectEdit{}
+		undoMu sync.Mutex
+	)
+	failed, canceled := runBulk(ids, progress, func(id string) error {
+		edit, err := editObject(picker, id, req.Operations)
+		if err != nil || len(edit.Prev) == 0 {
+			return err
+		}
+		undoMu.Lock()
+		undo[id] = edit
+		undoMu.Unlock()
+		return nil
+	})
+
+	res := &BulkEditResult{}
+	for _, id := range ids {
+		if _, ok := undo[id]; ok {
+			res.ObjectIds = append(res.ObjectIds, id)
+		}
+	}
+	for _, id := range outside {
+		failed[id] = fmt.Errorf("object is not in space %s", req.SpaceId)
+	}
+	res.Failures = bulkFailures(append(ids, outside...), failed)
+	if len(undo) > 0 {
+		res.UndoToken = e.undoStore.Put(bulkedit.Edit{SpaceId: req.SpaceId, Objects: undo})
+	}
+	if canceled {
+		return res, ErrBulkEditCanceled
+	}
+	return res, nil
+}
+
+func (e *bulkEditor) undo(token string) (failures []*pb.RpcObjectBulkEditFailure, found bool, err error) {
+	edit, found := e.undoStore.Take(token)
+	if !found {
+		return nil, false, nil
+	}
+	ids := lo.Keys(edit.Objects)
+	sort.Strings(ids)
+	progress := process.NewProgress(pb.ModelProcess_BulkEdit)
+	defer progress.Finish()
+	if err = e.process.Add(progress); err != nil {
+		return nil, true, fmt.Errorf("add process: %w", err)
+	}
+	progress.SetTotal(int64(len(ids)))
+	progress.SetProgressMessage("undo edit of objects")
+
+	// the undo isn't interrupted, otherwise the rest of values would be lost with the token
+	picker := spacePicker{spaces: e.spaces, spaceId: edit.SpaceId}
+	failed, _ := runBulk(ids, process.NewNoOp(), func(id string) error {
+		defer progress.AddDone(1)
+		return Do(picker, id, func(sb smartblock.SmartBlock) error {
+			b, ok := sb.(basic.DetailsSettable)
+			if !ok {
+				return fmt.Errorf("details of object %s can't be set", id)
+			}
+			// later changes are not overwritten
+			if edit.Objects[id].ChangedSince(sb.CombinedDetails()) {
+				return fmt.Errorf("values of object %s are changed after the edit", id)
+			}
+			return b.SetDetails(nil, edit.Objects[id].Prev, true)
+		})
+	})
+	return bulkFailures(ids, failed), true, nil
+}
+
+// objectIds returns requested ids, or objects matching filters among requested ids. Archived and deleted objects
+// are not matched by filters
+func (e *bulkEditor) objectIds(req pb.RpcObjectBulkEditRequest) ([]string, error) {
+	if len(req.Filters) == 0 {
+		if len(req.ObjectIds) == 0 {
+			return nil, fmt.Errorf("objects are not set")
+		}
+		return lo.Uniq(req.ObjectIds), nil
+	}
+	filters := append(req.Filters[:len(req.Filters):len(req.Filters)],
+		&model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeyIsArchived.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.Bool(false),
+		},
+		&model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeyIsDeleted.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.Bool(false),
+		},
+	)
+	if len(req.ObjectIds) > 0 {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeyId.String(),
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       pbtypes.StringList(req.ObjectIds),
+		})
+	}
+	records, _, err := e.store.Query(nil, database.Query{Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+	ids := make([]string, 0, len(records))
+	for _, rec := range records {
+		ids = append(ids, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+	}
+	return ids, nil
+}
+
+// splitBySpace splits ids into objects stored in the space and other objects
+func (e *bulkEditor) splitBySpace(spaceId string, ids []string) (inSpace, outside []string, err error) {
+	stored, err := e.spaces.SpaceObjectIds(context.Background(), spaceId)
+	if err != nil {
+		return nil, nil, err
+	}
+	storedIds := lo.SliceToMap(stored, func(id string) (string, struct{}) {
+		return id, struct{}{}
+	})
+	for _, id := range ids {
+		if _, ok := storedIds[id]; ok {
+			inSpace = append(inSpace, id)
+		} else {
+			outside = append(outside, id)
+		}
+	}
+	return inSpace, outside, nil
+}
+
+// editObject applies operations to the object and returns values of changed relations before and after the edit
+func editObject(picker Picker, id string, ops []*pb.RpcObjectBulkEditOperation) (edit bulkedit.ObjectEdit, err error) {
+	err = Do(picker, id, func(sb smartblock.SmartBlock) error {
+		b, ok := sb.(basic.DetailsSettable)
+		if !ok {
+			return fmt.Errorf("details of object %s can't be set", id)
+		}
+		updates, undo, err := bulkedit.Apply(sb.CombinedDetails(), ops)
+		if err != nil || len(updates) == 0 {
+			return err
+		}
+		if err = b.SetDetails(nil, updates, true); err != nil {
+			return err
+		}
+		edit = bulkedit.ObjectEdit{Applied: updates, Prev: undo}
+		return nil
+	})
+	return
+}
+
+// runBulk calls f for every id by bulkEditWorkers workers. Ids are not processed after the progress is canceled
+func runBulk(ids []string, progress process.Progress, f func(id string) error) (failed map[string]error, canceled bool) {
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		queue = make(chan string)
+	)
+	failed = map[string]error{}
+	for i := 0; i < bulkEditWorkers && i < len(ids); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				// the id may be received together with the cancellation
+				select {
+				case <-progress.Canceled():
+					continue
+				default:
+				}
+				if err := f(id); err != nil {
+					mu.Lock()
+					failed[id] = err
+					mu.Unlock()
+				}
+				progress.AddDone(1)
+			}
+		}()
+	}
+loop:
+	for _, id := range ids {
+		select {
+		case <-progress.Canceled():
+			break loop
+		case queue <- id:
+		}
+	}
+	close(queue)
+	wg.Wait()
+	select {
+	case <-progress.Canceled():
+		canceled = true
+	default:
+	}
+	return failed, canceled
+}
+
+func bulkFailures(ids []string, failed map[string]error) (failures []*pb.RpcObjectBulkEditFailure) {
+	for _, id := range ids {
+		if err, ok := failed[id]; ok {
+			failures = append(failures, &pb.RpcObjectBulkEditFailure{ObjectId: id, Description: err.Error()})
+		}
+	}
+	return
+}
@@ -0,0 +1,177 @@
+package bulkedit
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+// ValidateOperations checks operations before they are applied to objects
+func ValidateOperations(ops []*pb.RpcObjectBulkEditOperation) error {
+	if len(ops) == 0 {
+		return fmt.Errorf("operations are empty")
+	}
+	for _, op := range ops {
+		if op.RelationKey == "" {
+			return fmt.Errorf("relation key is empty")
+		}
+		switch op.Type {
+		case pb.RpcObjectBulkEditOperation_Set:
+			if op.Value == nil {
+				return fmt.Errorf("value of %s is empty", op.RelationKey)
+			}
+		case pb.RpcObjectBulkEditOperation_AddOption, pb.RpcObjectBulkEditOperation_RemoveOption:
+			if len(pbtypes.GetStringListValue(op.Value)) == 0 {
+				return fmt.Errorf("options of %s are empty", op.RelationKey)
+			}
+		case pb.RpcObjectBulkEditOperation_Increment:
+			if _, ok := op.Value.GetKind().(*types.Value_NumberValue); !ok {
+				return fmt.Errorf("increment of %s is not a number", op.RelationKey)
+			}
+		}
+	}
+	return nil
+}
+
+// Apply applies operations to details of the object. It returns new values of changed relations and previous values
+// of the same relations to undo the change, nil value means the relation is removed
+func Apply(details *types.Struct, ops []*pb.RpcObjectBulkEditOperation) (updates, undo []*pb.RpcObjectSetDetailsDetail, err error) {
+	values := map[string]*types.Value{}
+	var keys []string
+	current := func(key string) *types.Value {
+		if v, ok := values[key]; ok {
+			return v
+		}
+		return pbtypes.Get(details, key)
+	}
+	for _, op := range ops {
+		value, err := applyOperation(current(op.RelationKey), op)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op.RelationKey, err)
+		}
+		if slice.FindPos(keys, op.RelationKey) == -1 {
+			keys = append(keys, op.RelationKey)
+		}
+		values[op.RelationKey] = value
+	}
+
+	for _, key := range keys {
+		prev, value := pbtypes.Get(details, key), values[key]
+		if prev == nil && value == nil || prev != nil && prev.Equal(value) {
+			continue
+		}
+		updates = append(updates, &pb.RpcObjectSetDetailsDetail{Key: key, Value: value})
+		undo = append(undo, &pb.RpcObjectSetDetailsDetail{Key: key, Value: prev})
+	}
+	return updates, undo, nil
+}
+
+func applyOperation(value *types.Value, op *pb.RpcObjectBulkEditOperation) (*types.Value, error) {
+	switch op.Type {
+	case pb.RpcObjectBulkEditOperation_Set:
+		return op.Value, nil
+	case pb.RpcObjectBulkEditOperation_Clear:
+		return nil, nil
+	case pb.RpcObjectBulkEditOperation_AddOption:
+		ids := pbtypes.GetStringListValue(value)
+		for _, id := range pbtypes.GetStringListValue(op.Value) {
+			if slice.FindPos(ids, id) == -1 {
+				ids = append(ids, id)
+			}
+		}
+		return pbtypes.StringList(ids), nil
+	case pb.RpcObjectBulkEditOperation_RemoveOption:
+		removed := pbtypes.GetStringListValue(op.Value)
+		ids := slice.Filter(pbtypes.GetStringListValue(value), func(id string) bool {
+			return slice.FindPos(removed, id) == -1
+		})
+		if len(ids) == 0 {
+			return nil, nil
+		}
+		return pbtypes.StringList(ids), nil
+	case pb.RpcObjectBulkEditOperation_Increment:
+		var n float64
+		switch v := value.GetKind().(type) {
+		case nil, *types.Value_NullValue:
+		case *types.Value_NumberValue:
+			n = v.NumberValue
+		default:
+			return nil, fmt.Errorf("value is not a number")
+		}
+		return pbtypes.Float64(n + op.Value.GetNumberValue()), nil
+	}
+	return nil, fmt.Errorf("unknown operation %s", op.Type)
+}
+
+// Edit is the bulk edit of objects of the space to undo
+type Edit struct {
+	SpaceId string
+	// Objects are changes of edited objects by the object id
+	Objects map[string]ObjectEdit
+}
+
+// ObjectEdit keeps values of relations changed in the object by the bulk edit
+type ObjectEdit struct {
+	// Applied are values set by the edit
+	Applied []*pb.RpcObjectSetDetailsDetail
+	// Prev are values before the edit to restore them, nil value means the relation is removed
+	Prev []*pb.RpcObjectSetDetailsDetail
+}
+
+// ChangedSince reports whether values set by the edit are changed in details since then
+func (e ObjectEdit) ChangedSince(details *types.Struct) bool {
+	for _, d := range e.Applied {
+		if !pbtypes.Get(details, d.Key).Equal(d.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// UndoStore keeps edits of the last bulk operations. The oldest entry is dropped when the limit is reached
+type UndoStore struct {
+	limit   int
+	tokens  []string
+	entries map[string]Edit
+	mu      sync.Mutex
+}
+
+func NewUndoStore(limit int) *UndoStore {
+	return &UndoStore{
+		limit:   limit,
+		entries: map[string]Edit{},
+	}
+}
+
+// Put saves the edit and returns the token to undo it
+func (u *UndoStore) Put(edit Edit) string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	token := bson.NewObjectId().Hex()
+	u.tokens = append(u.tokens, token)
+	u.entries[token] = edit
+	if len(u.tokens) > u.limit {
+		delete(u.entries, u.tokens[0])
+		u.tokens = u.tokens[1:]
+	}
+	return token
+}
+
+// Take returns the edit by the token and forgets it, so the operation is undone only once
+func (u *UndoStore) Take(token string) (Edit, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	edit, ok := u.entries[token]
+	if !ok {
+		return Edit{}, false
+	}
+	delete(u.entries, token)
+	u.tokens = slice.Remove(u.tokens, token)
+	return edit, true
+}
@@ -0,0 +1,110 @@
+package bulkedit
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func op(key string, t pb.RpcObjectBulkEditOperationType, value *types.Value) *pb.RpcObjectBulkEditOperation {
+	return &pb.RpcObjectBulkEditOperation{RelationKey: key, Type: t, Value: value}
+}
+
+func TestApply(t *testing.T) {
+	details := &types.Struct{Fields: map[string]*types.Value{
+		"status":   pbtypes.String("todo"),
+		"tag":      pbtypes.StringList([]string{"t1", "t2"}),
+		"estimate": pbtypes.Float64(3),
+		"name":     pbtypes.String("task"),
+	}}
+
+	updates, undo, err := Apply(details, []*pb.RpcObjectBulkEditOperation{
+		op("status", pb.RpcObjectBulkEditOperation_Set, pbtypes.String("done")),
+		op("tag", pb.RpcObjectBulkEditOperation_AddOption, pbtypes.StringList([]string{"t2", "t3"})),
+		op("tag", pb.RpcObjectBulkEditOperation_RemoveOption, pbtypes.String("t1")),
+		op("estimate", pb.RpcObjectBulkEditOperation_Increment, pbtypes.Float64(2)),
+		op("points", pb.RpcObjectBulkEditOperation_Increment, pbtypes.Float64(1)),
+		op("name", pb.RpcObjectBulkEditOperation_Clear, nil),
+		op("description", pb.RpcObjectBulkEditOperation_Clear, nil),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*pb.RpcObjectSetDetailsDetail{
+		{Key: "status", Value: pbtypes.String("done")},
+		{Key: "tag", Value: pbtypes.StringList([]string{"t2", "t3"})},
+		{Key: "estimate", Value: pbtypes.Float64(5)},
+		{Key: "points", Value: pbtypes.Float64(1)},
+		{Key: "name"},
+	}, updates)
+	assert.Equal(t, []*pb.RpcObjectSetDetailsDetail{
+		{Key: "status", Value: pbtypes.String("todo")},
+		{Key: "tag", Value: pbtypes.StringList([]string{"t1", "t2"})},
+		{Key: "estimate", Value: pbtypes.Float64(3)},
+		{Key: "points"},
+		{Key: "name", Value: pbtypes.String("task")},
+	}, undo)
+
+	t.Run("unchanged values are skipped", func(t *testing.T) {
+		updates, undo, err := Apply(details, []*pb.RpcObjectBulkEditOperation{
+			op("status", pb.RpcObjectBulkEditOperation_Set, pbtypes.String("todo")),
+			op("tag", pb.RpcObjectBulkEditOperation_AddOption, pbtypes.String("t1")),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, updates)
+		assert.Empty(t, undo)
+	})
+	t.Run("increment of not a number", func(t *testing.T) {
+		_, _, err := Apply(details, []*pb.RpcObjectBulkEditOperation{
+			op("status", pb.RpcObjectBulkEditOperation_Increment, pbtypes.Float64(1)),
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateOperations(t *testing.T) {
+	assert.Error(t, ValidateOperations(nil))
+	assert.Error(t, ValidateOperations([]*pb.RpcObjectBulkEditOperation{op("", pb.RpcObjectBulkEditOperation_Clear, nil)}))
+	assert.Error(t, ValidateOperations([]*pb.RpcObjectBulkEditOperation{op("status", pb.RpcObjectBulkEditOperation_Set, nil)}))
+	assert.Error(t, ValidateOperations([]*pb.RpcObjectBulkEditOperation{op("tag", pb.RpcObjectBulkEditOperation_AddOption, pbtypes.StringList(nil))}))
+	assert.Error(t, ValidateOperations([]*pb.RpcObjectBulkEditOperation{op("estimate", pb.RpcObjectBulkEditOperation_Increment, pbtypes.String("1"))}))
+	assert.NoError(t, ValidateOperations([]*pb.RpcObjectBulkEditOperation{op("name", pb.RpcObjectBulkEditOperation_Clear, nil)}))
+}
+
+func TestUndoStore(t *testing.T) {
+	u := NewUndoStore(2)
+	undo := Edit{SpaceId: "space1", Objects: map[string]ObjectEdit{"obj1": {Prev: []*pb.RpcObjectSetDetailsDetail{{Key: "status"}}}}}
+	first := u.Put(undo)
+	second := u.Put(undo)
+	third := u.Put(undo)
+
+	_, ok := u.Take(first)
+	assert.False(t, ok, "the oldest token is dropped")
+	got, ok := u.Take(second)
+	assert.True(t, ok)
+	assert.Equal(t, undo, got)
+	_, ok = u.Take(second)
+	assert.False(t, ok, "the token is used once")
+	_, ok = u.Take(third)
+	assert.True(t, ok)
+}
+
+func TestObjectEdit_ChangedSince(t *testing.T) {
+	edit := ObjectEdit{Applied: []*pb.RpcObjectSetDetailsDetail{
+		{Key: "status", Value: pbtypes.String("done")},
+		{Key: "name"},
+	}}
+	details := func(status, name string) *types.Struct {
+		d := &types.Struct{Fields: map[string]*types.Value{"status": pbtypes.String(status)}}
+		if name != "" {
+			d.Fields["name"] = pbtypes.String(name)
+		}
+		return d
+	}
+	assert.False(t, edit.ChangedSince(details("done", "")))
+	assert.True(t, edit.ChangedSince(details("todo", "")))
+	assert.True(t, edit.ChangedSince(details("done", "task")), "the removed value is set again")
+}
@@ -0,0 +1,253 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/bulkedit"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/schema"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const testBulkSpaceId = "space1"
+
+type testBulkObjects struct {
+	objects map[string]*smarttest.SmartTest
+	// spaceIds are spaces of objects
+	spaceIds map[string]string
+	// onPick is called before the object is returned
+	onPick func(id string)
+	// records are details of objects in the store
+	records  []*types.Struct
+	progress process.Progress
+}
+
+func newTestBulkEditor(t *testing.T, statuses map[string]string) (*bulkEditor, *testBulkObjects) {
+	objects := &testBulkObjects{
+		objects:  map[string]*smarttest.SmartTest{},
+		spaceIds: map[string]string{},
+		onPick:   func(string) {},
+	}
+	for id, status := range statuses {
+		sb := smarttest.New(id)
+		require.NoError(t, sb.SetDetails(nil, []*pb.RpcObjectSetDetailsDetail{{Key: "status", Value: pbtypes.String(status)}}, false))
+		objects.objects[id] = sb
+		objects.spaceIds[id] = testBulkSpaceId
+	}
+	return &bulkEditor{
+		spaces:    objects,
+		store:     objects,
+		process:   objects,
+		undoStore: bulkedit.NewUndoStore(bulkEditUndoLimit),
+	}, objects
+}
+
+func (o *testBulkObjects) status(id string) string {
+	return pbtypes.GetString(o.objects[id].CombinedDetails(), "status")
+}
+
+func (o *testBulkObjects) GetObject(ctx context.Context, spaceId, id string) (smartblock.SmartBlock, error) {
+	o.onPick(id)
+	sb, ok := o.objects[id]
+	if !ok || o.spaceIds[id] != spaceId {
+		return nil, fmt.Errorf("object %s is not found in space %s", id, spaceId)
+	}
+	return sb, nil
+}
+
+func (o *testBulkObjects) SpaceObjectIds(ctx context.Context, spaceId string) (ids []string, err error) {
+	for id, objectSpaceId := range o.spaceIds {
+		if objectSpaceId == spaceId {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (o *testBulkObjects) Query(_ schema.Schema, q database.Query) (records []database.Record, total int, err error) {
+	f, err := database.NewFilters(q, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, details := range o.records {
+		if f.FilterObj.FilterObject(pbtypes.ValueGetter(details)) {
+			records = append(records, database.Record{Details: details})
+		}
+	}
+	return records, len(records), nil
+}
+
+func (o *testBulkObjects) Add(p process.Process) error {
+	o.progress = p.(process.Progress)
+	return nil
+}
+
+func TestBulkEdit(t *testing.T) {
+	setDone := []*pb.RpcObjectBulkEditOperation{{
+		RelationKey: "status",
+		Type:        pb.RpcObjectBulkEditOperation_Set,
+		Value:       pbtypes.String("done"),
+	}}
+
+	t.Run("failed objects don't stop the edit and the edit is undone", func(t *testing.T) {
+		e, objects := newTestBulkEditor(t, map[string]string{"obj1": "todo", "obj2": "todo", "obj3": "done"})
+
+		res, err := e.edit(pb.RpcObjectBulkEditRequest{
+			SpaceId:    testBulkSpaceId,
+			ObjectIds:  []string{"obj1", "missing", "obj2", "obj3"},
+			Operations: setDone,
+		})
+		require.NoError(t, err)
+		// obj3 is not changed, so it isn't in the result
+		assert.Equal(t, []string{"obj1", "obj2"}, res.ObjectIds)
+		require.Len(t, res.Failures, 1)
+		assert.Equal(t, "missing", res.Failures[0].ObjectId)
+		assert.Equal(t, "done", objects.status("obj1"))
+		assert.Equal(t, "done", objects.status("obj2"))
+
+		failures, found, err := e.undo(res.UndoToken)
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Empty(t, failures)
+		assert.Equal(t, "todo", objects.status("obj1"))
+		assert.Equal(t, "todo", objects.status("obj2"))
+		assert.Equal(t, "done", objects.status("obj3"))
+
+		_, found, err = e.undo(res.UndoToken)
+		require.NoError(t, err)
+		assert.False(t, found, "the token is used once")
+	})
+	t.Run("canceled edit returns edited objects and the undo token", func(t *testing.T) {
+		statuses := map[string]string{}
+		ids := make([]string, 0, bulkEditWorkers*3)
+		for i := 0; i < bulkEditWorkers*3; i++ {
+			id := fmt.Sprintf("obj%02d", i)
+			statuses[id] = "todo"
+			ids = append(ids, id)
+		}
+		e, objects := newTestBulkEditor(t, statuses)
+		objects.onPick = func(id string) {
+			if id == "obj00" {
+				require.NoError(t, objects.progress.Cancel())
+			}
+			// objects taken by workers before the cancellation are still edited
+			<-objects.progress.Canceled()
+		}
+
+		res, err := e.edit(pb.RpcObjectBulkEditRequest{SpaceId: testBulkSpaceId, ObjectIds: ids, Operations: setDone})
+		assert.ErrorIs(t, err, ErrBulkEditCanceled)
+		require.NotNil(t, res)
+		assert.Contains(t, res.ObjectIds, "obj00")
+		assert.LessOrEqual(t, len(res.ObjectIds), bulkEditWorkers)
+		assert.NotEmpty(t, res.UndoToken)
+		for _, id := range ids {
+			edited := objects.status(id) == "done"
+			assert.Equal(t, edited, lo.Contains(res.ObjectIds, id), id)
+		}
+
+		objects.onPick = func(string) {}
+		_, found, err := e.undo(res.UndoToken)
+		require.NoError(t, err)
+		assert.True(t, found)
+		for _, id := range ids {
+			assert.Equal(t, "todo", objects.status(id), id)
+		}
+	})
+	t.Run("archived and deleted objects are not matched by filters", func(t *testing.T) {
+		e, objects := newTestBulkEditor(t, map[string]string{"obj1": "todo", "obj2": "todo", "obj3": "todo"})
+		object := func(id string, key bundle.RelationKey) *types.Struct {
+			details := &types.Struct{Fields: map[string]*types.Value{
+				bundle.RelationKeyId.String(): pbtypes.String(id),
+				"status":                      pbtypes.String("todo"),
+			}}
+			if key != "" {
+				details.Fields[key.String()] = pbtypes.Bool(true)
+			}
+			return details
+		}
+		objects.records = []*types.Struct{
+			object("obj1", ""),
+			object("obj2", bundle.RelationKeyIsArchived),
+			object("obj3", bundle.RelationKeyIsDeleted),
+		}
+
+		ids, err := e.objectIds(pb.RpcObjectBulkEditRequest{Filters: []*model.BlockContentDataviewFilter{{
+			RelationKey: "status",
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.String("todo"),
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"obj1"}, ids)
+	})
+	t.Run("objects of other spaces are not edited", func(t *testing.T) {
+		e, objects := newTestBulkEditor(t, map[string]string{"obj1": "todo", "obj2": "todo", "obj3": "todo"})
+		objects.spaceIds["obj2"] = "space2"
+		objects.spaceIds["obj3"] = "space2"
+		for _, id := range []string{"obj1", "obj2", "obj3"} {
+			objects.records = append(objects.records, &types.Struct{Fields: map[string]*types.Value{
+				bundle.RelationKeyId.String(): pbtypes.String(id),
+				"status":                      pbtypes.String("todo"),
+			}})
+		}
+
+		res, err := e.edit(pb.RpcObjectBulkEditRequest{
+			SpaceId:    testBulkSpaceId,
+			ObjectIds:  []string{"obj1", "obj2"},
+			Operations: setDone,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"obj1"}, res.ObjectIds)
+		require.Len(t, res.Failures, 1, "requested object of other space is a failure")
+		assert.Equal(t, "obj2", res.Failures[0].ObjectId)
+		assert.Equal(t, "todo", objects.status("obj2"))
+
+		res, err = e.edit(pb.RpcObjectBulkEditRequest{
+			SpaceId: testBulkSpaceId,
+			Filters: []*model.BlockContentDataviewFilter{{
+				RelationKey: "status",
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String("todo"),
+			}},
+			Operations: setDone,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.ObjectIds, "obj1 is already done")
+		assert.Empty(t, res.Failures, "matched objects of other spaces are skipped")
+		assert.Equal(t, "todo", objects.status("obj2"))
+		assert.Equal(t, "todo", objects.status("obj3"))
+	})
+	t.Run("undo skips objects changed after the edit", func(t *testing.T) {
+		e, objects := newTestBulkEditor(t, map[string]string{"obj1": "todo", "obj2": "todo"})
+
+		res, err := e.edit(pb.RpcObjectBulkEditRequest{
+			SpaceId:    testBulkSpaceId,
+			ObjectIds:  []string{"obj1", "obj2"},
+			Operations: setDone,
+		})
+		require.NoError(t, err)
+		require.NoError(t, objects.objects["obj2"].SetDetails(nil, []*pb.RpcObjectSetDetailsDetail{
+			{Key: "status", Value: pbtypes.String("review")},
+		}, false))
+
+		failures, found, err := e.undo(res.UndoToken)
+		require.NoError(t, err)
+		assert.True(t, found)
+		require.Len(t, failures, 1)
+		assert.Equal(t, "obj2", failures[0].ObjectId)
+		assert.Equal(t, "todo", objects.status("obj1"))
+		assert.Equal(t, "review", objects.status("obj2"), "later change isn't overwritten")
+	})
+}
@@ -61,6 +61,18 @@ func (s *Service) createCache() ocache.OCache {
 }
 
 func (s *Service) cacheLoad(ctx context.Context, id string) (value ocache.Object, err error) {
+	value, err = s.loadObject(ctx, id)
+	if err != nil {
+		return
+	}
+	// the source object could be unloaded from the cache while it's embedded by opened objects
+	if sb, ok := value.(smartblock.SmartBlock); ok && s.syncedBlocks.IsEmbedded(id) {
+		s.addSyncedBlocksSourceHook(sb)
+	}
+	return
+}
+
+func (s *Service) loadObject(ctx context.Context, id string) (value ocache.Object, err error) {
 	// TODO Pass options as parameter?
 	opts := ctx.Value(optsKey).(cacheOpts)
 
@@ -0,0 +1,55 @@
+package block
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/basic"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// MoveInCalendar moves the object to another day keeping the time of the day and the duration of the span
+func (s *Service) MoveInCalendar(ctx *session.Context, req pb.RpcObjectCalendarMoveRequest) error {
+	if req.DateRelationKey == "" {
+		return fmt.Errorf("date relation is not set")
+	}
+	return Do(s, req.ContextId, func(sb smartblock.SmartBlock) error {
+		b, ok := sb.(basic.DetailsSettable)
+		if !ok {
+			return fmt.Errorf("details of object %s can't be set", req.ContextId)
+		}
+		var (
+			details = sb.CombinedDetails()
+			date    = pbtypes.GetInt64(details, req.DateRelationKey)
+			moved   = moveToDay(date, req.Date, s.location())
+		)
+
+		newDetails := []*pb.RpcObjectSetDetailsDetail{
+			{Key: req.DateRelationKey, Value: pbtypes.Int64(moved.Unix())},
+		}
+		if req.EndDateRelationKey != "" {
+			if end := pbtypes.GetInt64(details, req.EndDateRelationKey); end != 0 && date != 0 {
+				shift := moved.Unix() - date
+				newDetails = append(newDetails, &pb.RpcObjectSetDetailsDetail{
+					Key: req.EndDateRelationKey, Value: pbtypes.Int64(end + shift),
+				})
+			}
+		}
+		return b.SetDetails(ctx, newDetails, true)
+	})
+}
+
+// moveToDay returns the date moved to the day keeping the time of the day, days start in the given time zone.
+// Empty dates are moved to the start of the day
+func moveToDay(date, day int64, loc *time.Location) time.Time {
+	d := time.Unix(day, 0).In(loc)
+	moved := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+	if date != 0 {
+		prev := time.Unix(date, 0).In(loc)
+		moved = moved.Add(prev.Sub(time.Date(prev.Year(), prev.Month(), prev.Day(), 0, 0, 0, 0, loc)))
+	}
+	return moved
+}
@@ -0,0 +1,25 @@
+package block
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoveToDay(t *testing.T) {
+	// days start in the time zone of the account, which differs from the local one
+	loc := time.FixedZone("UTC+14", 14*60*60)
+	day := func(d int) time.Time {
+		return time.Date(2026, time.October, d, 0, 0, 0, 0, loc)
+	}
+
+	t.Run("time of the day is kept", func(t *testing.T) {
+		// the date is on the 5th in the account zone and on the 4th in UTC
+		date := day(5).Add(3 * time.Hour)
+		assert.Equal(t, day(9).Add(3*time.Hour).Unix(), moveToDay(date.Unix(), day(9).Unix(), loc).Unix())
+	})
+	t.Run("any time of the target day", func(t *testing.T) {
+		assert.Equal(t, day(9).Unix(), moveToDay(0, day(9).Add(20*time.Hour).Unix(), loc).Unix())
+	})
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/simple/link"
 	"github.com/anyproto/anytype-heart/core/block/simple/text"
 	"github.com/anyproto/anytype-heart/core/block/source"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -296,6 +298,12 @@ func (s *Service) SetLatexText(ctx *session.Context, req pb.RpcBlockLatexSetText
 	})
 }
 
+func (s *Service) SetLatexLabel(ctx *session.Context, req pb.RpcBlockLatexSetLabelRequest) error {
+	return Do(s, req.ContextId, func(b basic.CommonOperations) error {
+		return b.SetLatexLabel(ctx, req)
+	})
+}
+
 func (s *Service) SetTextStyle(
 	ctx *session.Context, contextId string, style model.BlockContentTextStyle, blockIds ...string,
 ) error {
@@ -477,6 +485,9 @@ func (s *Service) UploadFile(req pb.RpcFileUploadRequest) (hash string, err erro
 	}
 
 	upl.SetStyle(req.Style)
+	if req.ContentDefinedChunking {
+		upl.AddOptions(files.WithContentDefinedChunking())
+	}
 	if req.Type != model.BlockContentFile_None {
 		upl.SetType(req.Type)
 	} else {
@@ -607,11 +618,27 @@ func (s *Service) ModifyDetails(
 		if err != nil {
 			return err
 		}
+		unlock, err := s.validateChangedDetails(objectId, b.CombinedDetails(), dets)
+		// unique values are locked until the details are applied and indexed
+		defer unlock()
+		if err != nil {
+			return err
+		}
 
 		return b.Apply(b.NewState().SetDetails(dets))
 	})
 }
 
+// validateChangedDetails checks changed values against rules of relations
+func (s *Service) validateChangedDetails(objectId string, prev, details *types.Struct) (unlock func(), err error) {
+	diff := pbtypes.StructDiff(prev, details)
+	keys := make([]string, 0, len(diff.GetFields()))
+	for key := range diff.GetFields() {
+		keys = append(keys, key)
+	}
+	return relation.ValidateDetails(s.relationService, s.objectStore, objectId, details, keys)
+}
+
 // ModifyLocalDetails modifies local details of the object in cache,
 // and if it is not found, sets pending details in object store
 func (s *Service) ModifyLocalDetails(
@@ -831,6 +858,20 @@ func (s *Service) TableSort(ctx *session.Context, req pb.RpcBlockTableSortReques
 	return err
 }
 
+func (s *Service) TableColumnSetType(ctx *session.Context, req pb.RpcBlockTableColumnSetTypeRequest) (err error) {
+	err = DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
+		return e.ColumnSetType(st, req)
+	})
+	return err
+}
+
+func (s *Service) TableCellSetFormula(ctx *session.Context, req pb.RpcBlockTableCellSetFormulaRequest) (err error) {
+	err = DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
+		return e.CellSetFormula(st, req)
+	})
+	return err
+}
+
 func (s *Service) TableColumnListFill(ctx *session.Context, req pb.RpcBlockTableColumnListFillRequest) (err error) {
 	err = DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		return e.ColumnListFill(st, req)
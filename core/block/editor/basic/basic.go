@@ -43,6 +43,7 @@ type CommonOperations interface {
 	SetFields(ctx *session.Context, fields ...*pb.RpcBlockListSetFieldsRequestBlockField) (err error)
 	SetDivStyle(ctx *session.Context, style model.BlockContentDivStyle, ids ...string) (err error)
 	SetLatexText(ctx *session.Context, req pb.RpcBlockLatexSetTextRequest) error
+	SetLatexLabel(ctx *session.Context, req pb.RpcBlockLatexSetLabelRequest) error
 
 	SetRelationKey(ctx *session.Context, req pb.RpcBlockRelationSetKeyRequest) error
 	AddRelationAndSet(ctx *session.Context, service relation.Service, req pb.RpcBlockRelationAddRequest) error
@@ -365,6 +366,37 @@ func (bs *basic) SetLatexText(ctx *session.Context, req pb.RpcBlockLatexSetTextR
 	return bs.Apply(s, smartblock.NoEvent)
 }
 
+func (bs *basic) SetLatexLabel(ctx *session.Context, req pb.RpcBlockLatexSetLabelRequest) (err error) {
+	s := bs.NewStateCtx(ctx)
+	b := s.Get(req.BlockId)
+	if b == nil {
+		return smartblock.ErrSimpleBlockNotFound
+	}
+
+	eq, ok := b.(latex.Block)
+	if !ok {
+		return fmt.Errorf("unexpected block type: %T (want latex)", b)
+	}
+	if req.Label != "" {
+		var used bool
+		if err = s.Iterate(func(b simple.Block) (isContinue bool) {
+			if l, ok := b.(latex.Block); ok && b.Model().Id != req.BlockId && l.Label() == req.Label {
+				used = true
+			}
+			return !used
+		}); err != nil {
+			return
+		}
+		if used {
+			return fmt.Errorf("%w: %q is already used in the object", latex.ErrInvalidLabel, req.Label)
+		}
+	}
+	if err = eq.SetLabel(req.Label); err != nil {
+		return
+	}
+	return bs.Apply(s)
+}
+
 func (bs *basic) AddRelationAndSet(ctx *session.Context, relationService relation.Service, req pb.RpcBlockRelationAddRequest) (err error) {
 	s := bs.NewStateCtx(ctx)
 	b := s.Get(req.BlockId)
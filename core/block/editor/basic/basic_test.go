@@ -14,6 +14,8 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/restriction"
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/relation"
+	"github.com/anyproto/anytype-heart/core/relation/relationutils"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -458,3 +460,29 @@ func TestBasic_ReplaceLink(t *testing.T) {
 	assert.Equal(t, newId, res.Pick(newBlocks[0].Model().Id).Model().GetLink().TargetBlockId)
 	assert.Equal(t, newId, res.Pick(newBlocks[1].Model().Id).Model().GetText().GetMarks().Marks[0].Param)
 }
+
+type testRelationService struct {
+	relation.Service
+	fetched []string
+}
+
+func (s *testRelationService) FetchKey(key string, opts ...relation.FetchOption) (*relationutils.Relation, error) {
+	s.fetched = append(s.fetched, key)
+	return &relationutils.Relation{Relation: &model.Relation{Key: key, Format: model.RelationFormat_shorttext}}, nil
+}
+
+func TestBasic_ValidateRules(t *testing.T) {
+	relations := &testRelationService{}
+	b := NewBasic(smarttest.New("test"), nil, relations, converter.NewLayoutConverter(nil, nil)).(*basic)
+	prev := &types.Struct{Fields: map[string]*types.Value{"number": pbtypes.String("INV-1")}}
+	updates := []*detailUpdate{
+		{key: "number", value: pbtypes.String("INV-1")},
+		{key: "name", value: pbtypes.String("invoice")},
+		{key: "description"},
+	}
+
+	unlock, err := b.validateRules(updates, prev, applyDetailUpdates(prev, updates))
+	require.NoError(t, err)
+	unlock()
+	assert.Equal(t, []string{"name"}, relations.fetched, "only changed values are validated")
+}
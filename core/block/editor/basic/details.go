@@ -7,6 +7,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -30,6 +31,12 @@ func (bs *basic) SetDetails(ctx *session.Context, details []*pb.RpcObjectSetDeta
 	// have to apply changes later
 	updates := bs.collectDetailUpdates(details, s)
 	newDetails := applyDetailUpdates(s.CombinedDetails(), updates)
+	unlock, err := bs.validateRules(updates, bs.CombinedDetails(), newDetails)
+	// unique values are locked until the details are applied and indexed
+	defer unlock()
+	if err != nil {
+		return
+	}
 	s.SetDetails(newDetails)
 
 	if err = bs.Apply(s, smartblock.NoRestrictions); err != nil {
@@ -91,6 +98,17 @@ func (bs *basic) createDetailUpdate(st *state.State, detail *pb.RpcObjectSetDeta
 	}, nil
 }
 
+// validateRules checks changed values against rules of relations, values set to the same ones are not checked
+func (bs *basic) validateRules(updates []*detailUpdate, prev, details *types.Struct) (unlock func(), err error) {
+	keys := make([]string, 0, len(updates))
+	for _, update := range updates {
+		if !pbtypes.Get(prev, update.key).Equal(update.value) {
+			keys = append(keys, update.key)
+		}
+	}
+	return relation.ValidateDetails(bs.relationService, bs.objectStore, bs.Id(), details, keys)
+}
+
 func (bs *basic) setDetailSpecialCases(st *state.State, detail *pb.RpcObjectSetDetailsDetail) error {
 	if detail.Key == bundle.RelationKeyType.String() {
 		// special case when client sets the type's detail directly instead of using setObjectType command
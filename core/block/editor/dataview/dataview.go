@@ -21,6 +21,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
 	smartblock2 "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/database/query"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
@@ -212,6 +213,15 @@ func (d *sdataview) UpdateView(ctx *session.Context, blockID string, viewID stri
 		return err
 	}
 
+	oldView, err := dvBlock.GetView(viewID)
+	if err != nil {
+		return err
+	}
+	if oldView.Query != view.Query {
+		if err = d.setViewQuery(dvBlock, viewID, view.Query); err != nil {
+			return err
+		}
+	}
 	if err = dvBlock.SetViewFields(viewID, view); err != nil {
 		return err
 	}
@@ -320,9 +330,29 @@ func (d *sdataview) CreateView(ctx *session.Context, id string,
 		}}
 	}
 	tb.AddView(view)
+	if view.Query != "" {
+		if err = d.setViewQuery(tb, view.Id, view.Query); err != nil {
+			return nil, err
+		}
+		if v, err := tb.GetView(view.Id); err == nil {
+			view = *v
+		}
+	}
 	return &view, d.Apply(s)
 }
 
+// setViewQuery replaces the filter compiled from the previous query of the view with the filter of the new one
+func (d *sdataview) setViewQuery(dvBlock dataview.Block, viewID string, text string) error {
+	f, err := query.ViewFilter(text, d.objectStore)
+	if err != nil {
+		return err
+	}
+	if f == nil {
+		return dvBlock.RemoveFilters(viewID, []string{query.ViewFilterId})
+	}
+	return dvBlock.ReplaceFilter(viewID, query.ViewFilterId, f)
+}
+
 func defaultLastModifiedDateSort() []*model.BlockContentDataviewSort {
 	return []*model.BlockContentDataviewSort{
 		{
@@ -153,6 +153,8 @@ type DocInfo struct {
 	Heads      []string
 	Creator    string
 	State      *state.State
+	// Tree is the change tree of the object, it's filled for the indexer only
+	Tree objecttree.ReadableObjectTree
 }
 
 type InitContext struct {
@@ -885,6 +887,62 @@ func (sb *smartBlock) injectLinksDetails(s *state.State) {
 	links = slice.Remove(links, sb.Id())
 	// todo: we need to move it to the injectDerivedDetails, but we don't call it now on apply
 	s.SetLocalDetail(bundle.RelationKeyLinks.String(), pbtypes.StringList(links))
+	mentions := slice.Remove(mentionedObjects(s), sb.Id())
+	s.SetLocalDetail(bundle.RelationKeyMentions.String(), pbtypes.StringList(mentions))
+	blockLinks := slice.Remove(linkedByBlocks(s), sb.Id())
+	s.SetLocalDetail(bundle.RelationKeyBlockLinks.String(), pbtypes.StringList(blockLinks))
+}
+
+// linkedByBlocks returns objects referenced by the blocks other than the text and objects of the collection,
+// it's a subset of navigational links
+func linkedByBlocks(s *state.State) []string {
+	var ids []string
+	if !internalflag.NewFromState(s).Has(model.InternalFlag_collectionDontIndexLinks) {
+		ids = append(ids, s.GetStoreSlice(template.CollectionStoreKey)...)
+	}
+	err := s.Iterate(func(b simple.Block) (isContinue bool) {
+		if b.Model().GetText() != nil {
+			return true
+		}
+		if f := b.Model().GetFile(); f != nil {
+			if f.Hash != "" && f.Type != model.BlockContentFile_Image {
+				ids = append(ids, f.Hash)
+			}
+			return true
+		}
+		if dv := b.Model().GetDataview(); dv != nil {
+			if dv.TargetObjectId != "" {
+				ids = append(ids, dv.TargetObjectId)
+			}
+			return true
+		}
+		if ls, ok := b.(linkSource); ok {
+			ids = ls.FillSmartIds(ids)
+		}
+		return true
+	})
+	if err != nil {
+		log.With("objectID", s.RootId()).Errorf("failed to iterate over simple blocks: %s", err)
+	}
+	return lo.Uniq(ids)
+}
+
+// mentionedObjects returns objects referenced from the text of the blocks, it's a subset of navigational links
+func mentionedObjects(s *state.State) []string {
+	var ids []string
+	err := s.Iterate(func(b simple.Block) (isContinue bool) {
+		if b.Model().GetText() == nil {
+			return true
+		}
+		if ls, ok := b.(linkSource); ok {
+			ids = ls.FillSmartIds(ids)
+		}
+		return true
+	})
+	if err != nil {
+		log.With("objectID", s.RootId()).Errorf("failed to iterate over simple blocks: %s", err)
+	}
+	return lo.Uniq(ids)
 }
 
 func (sb *smartBlock) injectLocalDetails(s *state.State) error {
@@ -1272,6 +1330,9 @@ func (sb *smartBlock) getDocInfo(st *state.State) DocInfo {
 
 func (sb *smartBlock) runIndexer(s *state.State, opts ...IndexOption) {
 	docInfo := sb.getDocInfo(s)
+	if sb.ObjectTree != nil {
+		docInfo.Tree = sb.ObjectTree
+	}
 
 	if err := sb.indexer.Index(context.TODO(), docInfo, opts...); err != nil {
 		log.Errorf("index object %s error: %s", sb.Id(), err)
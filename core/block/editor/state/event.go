@@ -71,6 +71,16 @@ func (s *State) applyEvent(ev *pb.EventMessage) (err error) {
 			return
 		}
 
+	case *pb.EventMessageValueOfBlockSetTableColumn:
+		if err = apply(o.BlockSetTableColumn.Id, func(b simple.Block) error {
+			if tc, ok := b.(table.ColumnBlock); ok {
+				return tc.ApplyEvent(o.BlockSetTableColumn)
+			}
+			return fmt.Errorf("not a table column block")
+		}); err != nil {
+			return
+		}
+
 	case *pb.EventMessageValueOfBlockSetDiv:
 		if err = apply(o.BlockSetDiv.Id, func(b simple.Block) error {
 			if d, ok := b.(base.DivBlock); ok {
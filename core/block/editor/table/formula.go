@@ -0,0 +1,435 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Formula errors are shown as the values of the cells
+var (
+	ErrFormulaSyntax    = errors.New("#ERROR!")
+	ErrFormulaRef       = errors.New("#REF!")
+	ErrFormulaValue     = errors.New("#VALUE!")
+	ErrFormulaCycle     = errors.New("#CYCLE!")
+	ErrFormulaDivByZero = errors.New("#DIV/0!")
+)
+
+const (
+	// maxColumnLetters limits column references to ZZZZ, so column numbers can't overflow
+	maxColumnLetters = 4
+	// maxRangeCells is the max number of table cells one range may cover
+	maxRangeCells = 100000
+)
+
+// CellAddress is the zero-based position of the cell, in formulas it's written as A1
+type CellAddress struct {
+	Row, Col int
+}
+
+func (a CellAddress) String() string {
+	return columnName(a.Col) + strconv.Itoa(a.Row+1)
+}
+
+// columnName converts zero-based column number to letters: A, B, ..., Z, AA, AB, ...
+func columnName(col int) string {
+	var name []byte
+	for col >= 0 {
+		name = append([]byte{byte('A' + col%26)}, name...)
+		col = col/26 - 1
+	}
+	return string(name)
+}
+
+// cellValue is the value of the cell used in formulas. Empty and non-numeric cells are skipped by aggregate functions
+type cellValue struct {
+	num   float64
+	isNum bool
+	empty bool
+}
+
+type cellResolver interface {
+	cellValue(addr CellAddress) (cellValue, error)
+	// size returns the number of rows and columns of the table
+	size() (rows, cols int)
+}
+
+type formulaNode interface {
+	eval(r cellResolver) (float64, error)
+}
+
+type numberNode float64
+
+func (n numberNode) eval(cellResolver) (float64, error) {
+	return float64(n), nil
+}
+
+type refNode CellAddress
+
+func (n refNode) eval(r cellResolver) (float64, error) {
+	v, err := r.cellValue(CellAddress(n))
+	if err != nil {
+		return 0, err
+	}
+	if v.empty {
+		return 0, nil
+	}
+	if !v.isNum {
+		return 0, ErrFormulaValue
+	}
+	return v.num, nil
+}
+
+type rangeNode struct {
+	from, to CellAddress
+}
+
+func (n rangeNode) eval(cellResolver) (float64, error) {
+	// ranges are allowed only as function arguments
+	return 0, ErrFormulaValue
+}
+
+// values returns values of the range cells, cells outside the table are skipped,
+// so ranges like A2:A100 can be used for totals of the growing table
+func (n rangeNode) values(r cellResolver) ([]cellValue, error) {
+	fromRow, toRow := n.from.Row, n.to.Row
+	if fromRow > toRow {
+		fromRow, toRow = toRow, fromRow
+	}
+	fromCol, toCol := n.from.Col, n.to.Col
+	if fromCol > toCol {
+		fromCol, toCol = toCol, fromCol
+	}
+	// the range is limited by the table, otherwise ranges like A1:ZZZZ999999999 are iterated for ages
+	rows, cols := r.size()
+	if fromRow < 0 {
+		fromRow = 0
+	}
+	if fromCol < 0 {
+		fromCol = 0
+	}
+	if toRow >= rows {
+		toRow = rows - 1
+	}
+	if toCol >= cols {
+		toCol = cols - 1
+	}
+	if fromRow > toRow || fromCol > toCol {
+		return nil, nil
+	}
+	if (toRow-fromRow+1)*(toCol-fromCol+1) > maxRangeCells {
+		return nil, ErrFormulaRef
+	}
+	var res []cellValue
+	for row := fromRow; row <= toRow; row++ {
+		for col := fromCol; col <= toCol; col++ {
+			v, err := r.cellValue(CellAddress{Row: row, Col: col})
+			if errors.Is(err, ErrFormulaRef) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+type unaryNode struct {
+	arg formulaNode
+}
+
+func (n unaryNode) eval(r cellResolver) (float64, error) {
+	v, err := n.arg.eval(r)
+	return -v, err
+}
+
+type binaryNode struct {
+	op          byte
+	left, right formulaNode
+}
+
+func (n binaryNode) eval(r cellResolver) (float64, error) {
+	left, err := n.left.eval(r)
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.right.eval(r)
+	if err != nil {
+		return 0, err
+	}
+	switch n.op {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	case '/':
+		if right == 0 {
+			return 0, ErrFormulaDivByZero
+		}
+		return left / right, nil
+	}
+	return 0, ErrFormulaSyntax
+}
+
+type funcNode struct {
+	name string
+	args []formulaNode
+}
+
+func (n funcNode) eval(r cellResolver) (float64, error) {
+	var nums []float64
+	for _, arg := range n.args {
+		rng, ok := arg.(rangeNode)
+		if !ok {
+			v, err := arg.eval(r)
+			if err != nil {
+				return 0, err
+			}
+			nums = append(nums, v)
+			continue
+		}
+		values, err := rng.values(r)
+		if err != nil {
+			return 0, err
+		}
+		for _, v := range values {
+			if v.isNum {
+				nums = append(nums, v.num)
+			}
+		}
+	}
+
+	switch n.name {
+	case "SUM":
+		var sum float64
+		for _, v := range nums {
+			sum += v
+		}
+		return sum, nil
+	case "AVG", "AVERAGE":
+		if len(nums) == 0 {
+			return 0, ErrFormulaDivByZero
+		}
+		var sum float64
+		for _, v := range nums {
+			sum += v
+		}
+		return sum / float64(len(nums)), nil
+	case "COUNT":
+		return float64(len(nums)), nil
+	case "MIN", "MAX":
+		if len(nums) == 0 {
+			return 0, nil
+		}
+		res := nums[0]
+		for _, v := range nums[1:] {
+			if n.name == "MIN" {
+				res = math.Min(res, v)
+			} else {
+				res = math.Max(res, v)
+			}
+		}
+		return res, nil
+	}
+	return 0, ErrFormulaSyntax
+}
+
+var formulaFunctions = map[string]struct{}{
+	"SUM": {}, "AVG": {}, "AVERAGE": {}, "COUNT": {}, "MIN": {}, "MAX": {},
+}
+
+// parseFormula parses the formula with optional leading "=".
+// Supported are numbers, cell references (A1), ranges (A1:B3) as function arguments,
+// functions SUM, AVG (AVERAGE), COUNT, MIN, MAX, operators + - * / and parentheses
+func parseFormula(formula string) (formulaNode, error) {
+	p := &formulaParser{src: []rune(strings.TrimPrefix(strings.TrimSpace(formula), "="))}
+	node, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos != len(p.src) {
+		return nil, fmt.Errorf("%w: unexpected %q", ErrFormulaSyntax, string(p.src[p.pos:]))
+	}
+	return node, nil
+}
+
+type formulaParser struct {
+	src []rune
+	pos int
+}
+
+func (p *formulaParser) skipSpaces() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *formulaParser) peek() rune {
+	p.skipSpaces()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *formulaParser) parseExpr() (formulaNode, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for c := p.peek(); c == '+' || c == '-'; c = p.peek() {
+		p.pos++
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: byte(c), left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *formulaParser) parseTerm() (formulaNode, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for c := p.peek(); c == '*' || c == '/'; c = p.peek() {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: byte(c), left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *formulaParser) parseFactor() (formulaNode, error) {
+	c := p.peek()
+	switch {
+	case c == '-':
+		p.pos++
+		arg, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{arg: arg}, nil
+	case c == '(':
+		p.pos++
+		node, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("%w: expected )", ErrFormulaSyntax)
+		}
+		p.pos++
+		return node, nil
+	case unicode.IsDigit(c) || c == '.':
+		return p.parseNumber()
+	case unicode.IsLetter(c):
+		return p.parseIdent()
+	}
+	if c == 0 {
+		return nil, fmt.Errorf("%w: unexpected end of formula", ErrFormulaSyntax)
+	}
+	return nil, fmt.Errorf("%w: unexpected %q", ErrFormulaSyntax, c)
+}
+
+func (p *formulaParser) parseNumber() (formulaNode, error) {
+	start := p.pos
+	for p.pos < len(p.src) && (unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+		p.pos++
+	}
+	v, err := strconv.ParseFloat(string(p.src[start:p.pos]), 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid number", ErrFormulaSyntax)
+	}
+	return numberNode(v), nil
+}
+
+func (p *formulaParser) parseIdent() (formulaNode, error) {
+	start := p.pos
+	for p.pos < len(p.src) && (unicode.IsLetter(p.src[p.pos]) || unicode.IsDigit(p.src[p.pos])) {
+		p.pos++
+	}
+	ident := strings.ToUpper(string(p.src[start:p.pos]))
+	if _, ok := formulaFunctions[ident]; ok && p.peek() == '(' {
+		p.pos++
+		return p.parseArgs(ident)
+	}
+	from, err := parseCellAddress(ident)
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != ':' {
+		return refNode(from), nil
+	}
+	p.pos++
+	p.skipSpaces()
+	start = p.pos
+	for p.pos < len(p.src) && (unicode.IsLetter(p.src[p.pos]) || unicode.IsDigit(p.src[p.pos])) {
+		p.pos++
+	}
+	to, err := parseCellAddress(strings.ToUpper(string(p.src[start:p.pos])))
+	if err != nil {
+		return nil, err
+	}
+	return rangeNode{from: from, to: to}, nil
+}
+
+func (p *formulaParser) parseArgs(name string) (formulaNode, error) {
+	fn := funcNode{name: name}
+	if p.peek() == ')' {
+		p.pos++
+		return fn, nil
+	}
+	for {
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		fn.args = append(fn.args, arg)
+		switch p.peek() {
+		case ',', ';':
+			p.pos++
+		case ')':
+			p.pos++
+			return fn, nil
+		default:
+			return nil, fmt.Errorf("%w: expected ) in %s", ErrFormulaSyntax, name)
+		}
+	}
+}
+
+// parseCellAddress parses the upper-case reference like AB12
+func parseCellAddress(ref string) (CellAddress, error) {
+	i := 0
+	col := 0
+	for i < len(ref) && ref[i] >= 'A' && ref[i] <= 'Z' {
+		col = col*26 + int(ref[i]-'A'+1)
+		i++
+	}
+	if i == 0 || i == len(ref) || i > maxColumnLetters {
+		return CellAddress{}, fmt.Errorf("%w: invalid reference %s", ErrFormulaSyntax, ref)
+	}
+	for _, c := range ref[i:] {
+		// signs are not allowed in row numbers
+		if c < '0' || c > '9' {
+			return CellAddress{}, fmt.Errorf("%w: invalid reference %s", ErrFormulaSyntax, ref)
+		}
+	}
+	row, err := strconv.Atoi(ref[i:])
+	if err != nil || row < 1 {
+		return CellAddress{}, fmt.Errorf("%w: invalid reference %s", ErrFormulaSyntax, ref)
+	}
+	return CellAddress{Row: row - 1, Col: col - 1}, nil
+}
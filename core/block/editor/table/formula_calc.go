@@ -0,0 +1,327 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/table"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	// FormulaFieldKey is the key of the cell field with the formula, the text of the cell keeps the calculated value
+	FormulaFieldKey = "formula"
+	// InvalidValueFieldKey marks cells with values not matching the type of the column
+	InvalidValueFieldKey = "invalidValue"
+	// DateLayout is the format of values in date columns
+	DateLayout = "2006-01-02"
+)
+
+var ErrInvalidCellValue = errors.New("value doesn't match the column type")
+
+func (t *Editor) ColumnSetType(s *state.State, req pb.RpcBlockTableColumnSetTypeRequest) error {
+	if _, err := pickColumn(s, req.TargetId); err != nil {
+		return fmt.Errorf("pick column: %w", err)
+	}
+	col, ok := s.Get(req.TargetId).(table.ColumnBlock)
+	if !ok {
+		return fmt.Errorf("block is not a column")
+	}
+	// values of the cells are validated by the recalculation hook
+	col.SetType(req.Type)
+	return nil
+}
+
+func (t *Editor) CellSetFormula(s *state.State, req pb.RpcBlockTableCellSetFormulaRequest) error {
+	if _, _, err := ParseCellID(req.TargetId); err != nil {
+		return fmt.Errorf("parse cell id: %w", err)
+	}
+	if _, err := NewTable(s, req.TargetId); err != nil {
+		return fmt.Errorf("initialize table state: %w", err)
+	}
+	cell := s.Get(req.TargetId)
+	if cell == nil || cell.Model().GetText() == nil {
+		return fmt.Errorf("cell is not found")
+	}
+	formula := strings.TrimPrefix(strings.TrimSpace(req.Formula), "=")
+	if formula != "" {
+		if _, err := parseFormula(formula); err != nil {
+			return fmt.Errorf("parse formula: %w", err)
+		}
+	}
+	fields := pbtypes.CopyStruct(cell.Model().Fields)
+	if fields == nil {
+		fields = &types.Struct{Fields: map[string]*types.Value{}}
+	}
+	if formula == "" {
+		delete(fields.Fields, FormulaFieldKey)
+	} else {
+		fields.Fields[FormulaFieldKey] = pbtypes.String(formula)
+	}
+	cell.Model().Fields = fields
+	// the value of the cell is calculated by the recalculation hook
+	return nil
+}
+
+// recalculateTables recalculates tables changed in the state
+func (t *Editor) recalculateTables(info smartblock.ApplyInfo) error {
+	s := info.State
+	// search for the table only from blocks of the table structure, because it's expensive to find the parent of the block
+	changedIds := map[string]struct{}{}
+	s.IterateActive(func(b simple.Block) (isContinue bool) {
+		m := b.Model()
+		switch {
+		case m.GetText() != nil:
+			rowID, _, err := ParseCellID(m.Id)
+			if err != nil {
+				return true
+			}
+			if row := s.Pick(rowID); row != nil && row.Model().GetTableRow() != nil {
+				changedIds[rowID] = struct{}{}
+			}
+		case m.GetTable() != nil, m.GetTableRow() != nil, m.GetTableColumn() != nil,
+			m.GetLayout().GetStyle() == model.BlockContentLayout_TableRows,
+			m.GetLayout().GetStyle() == model.BlockContentLayout_TableColumns:
+			changedIds[m.Id] = struct{}{}
+		}
+		return true
+	})
+	if len(changedIds) == 0 {
+		return nil
+	}
+
+	tables := map[string]*Table{}
+	for id := range changedIds {
+		if tb, err := NewTable(s, id); err == nil {
+			tables[tb.Block().Model().Id] = tb
+		}
+	}
+	for id, tb := range tables {
+		if err := tb.Recalculate(); err != nil {
+			log.Errorf("recalculate table %s: %s", id, err)
+		}
+	}
+	return nil
+}
+
+// Recalculate updates values of the formula cells and marks cells with values not matching the column type
+func (tb Table) Recalculate() error {
+	calc := &calculator{
+		rows:    len(tb.RowIDs()),
+		cols:    len(tb.ColumnIDs()),
+		cells:   map[CellAddress]simple.Block{},
+		types:   make([]model.BlockContentTableColumnType, len(tb.ColumnIDs())),
+		results: map[CellAddress]formulaResult{},
+		visited: map[CellAddress]bool{},
+	}
+	for i, colID := range tb.ColumnIDs() {
+		if col := tb.s.Pick(colID); col != nil {
+			calc.types[i] = col.Model().GetTableColumn().GetType()
+		}
+	}
+	var addrs []CellAddress
+	err := tb.Iterate(func(b simple.Block, pos CellPosition) bool {
+		if b != nil {
+			addr := CellAddress{Row: pos.RowNumber, Col: pos.ColNumber}
+			calc.cells[addr] = b
+			addrs = append(addrs, addr)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		cell := calc.cells[addr]
+		var value string
+		var invalid bool
+		if calc.isFormula(cell) {
+			value = calc.formatResult(addr, calc.evaluate(addr))
+		} else {
+			value = cell.Model().GetText().GetText()
+			invalid = ValidateValue(calc.types[addr.Col], value) != nil
+		}
+		tb.updateCell(cell.Model().Id, value, invalid)
+	}
+	return nil
+}
+
+// updateCell sets the value and the validation flag of the cell, the cell is changed only when they differ
+func (tb Table) updateCell(id, value string, invalid bool) {
+	cell := tb.s.Pick(id)
+	if cell == nil || cell.Model().GetText() == nil {
+		return
+	}
+	textChanged := cell.Model().GetText().Text != value
+	flagChanged := pbtypes.GetBool(cell.Model().Fields, InvalidValueFieldKey) != invalid
+	if !textChanged && !flagChanged {
+		return
+	}
+	cell = tb.s.Get(id)
+	if tc, ok := cell.(text.Block); ok && textChanged {
+		tc.SetText(value, nil)
+	}
+	if flagChanged {
+		fields := pbtypes.CopyStruct(cell.Model().Fields)
+		if fields == nil {
+			fields = &types.Struct{Fields: map[string]*types.Value{}}
+		}
+		if invalid {
+			fields.Fields[InvalidValueFieldKey] = pbtypes.Bool(true)
+		} else {
+			delete(fields.Fields, InvalidValueFieldKey)
+		}
+		cell.Model().Fields = fields
+	}
+}
+
+type formulaResult struct {
+	value float64
+	err   error
+}
+
+type calculator struct {
+	rows, cols int
+	cells      map[CellAddress]simple.Block
+	types      []model.BlockContentTableColumnType
+	results    map[CellAddress]formulaResult
+	// visited is true while the formula of the cell is being evaluated, so references back to it are cycles
+	visited map[CellAddress]bool
+}
+
+func (c *calculator) isFormula(cell simple.Block) bool {
+	return pbtypes.GetString(cell.Model().Fields, FormulaFieldKey) != ""
+}
+
+func (c *calculator) cellValue(addr CellAddress) (cellValue, error) {
+	if addr.Row < 0 || addr.Col < 0 || addr.Row >= c.rows || addr.Col >= c.cols {
+		return cellValue{}, ErrFormulaRef
+	}
+	cell, ok := c.cells[addr]
+	if !ok {
+		return cellValue{empty: true}, nil
+	}
+	if c.isFormula(cell) {
+		res := c.evaluate(addr)
+		return cellValue{num: res.value, isNum: true}, res.err
+	}
+	return parseValue(c.types[addr.Col], cell.Model().GetText().GetText()), nil
+}
+
+func (c *calculator) size() (rows, cols int) {
+	return c.rows, c.cols
+}
+
+func (c *calculator) evaluate(addr CellAddress) formulaResult {
+	if res, ok := c.results[addr]; ok {
+		return res
+	}
+	if c.visited[addr] {
+		return formulaResult{err: ErrFormulaCycle}
+	}
+	c.visited[addr] = true
+	defer delete(c.visited, addr)
+
+	var res formulaResult
+	node, err := parseFormula(pbtypes.GetString(c.cells[addr].Model().Fields, FormulaFieldKey))
+	if err != nil {
+		res.err = ErrFormulaSyntax
+	} else {
+		res.value, res.err = node.eval(c)
+	}
+	c.results[addr] = res
+	return res
+}
+
+func (c *calculator) formatResult(addr CellAddress, res formulaResult) string {
+	if res.err != nil {
+		return res.err.Error()
+	}
+	return formatValue(c.types[addr.Col], res.value)
+}
+
+// ValidateValue checks that the value matches the column type, empty values are always valid
+func ValidateValue(t model.BlockContentTableColumnType, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	var err error
+	switch t {
+	case model.BlockContentTableColumn_Number:
+		_, err = strconv.ParseFloat(value, 64)
+	case model.BlockContentTableColumn_Date:
+		_, err = time.Parse(DateLayout, value)
+	case model.BlockContentTableColumn_Checkbox:
+		if value != "true" && value != "false" {
+			err = ErrInvalidCellValue
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCellValue, value)
+	}
+	return nil
+}
+
+// parseValue converts the value of the cell to the number, dates are converted to unix time and checkboxes to 0 or 1
+func parseValue(t model.BlockContentTableColumnType, value string) cellValue {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return cellValue{empty: true}
+	}
+	switch t {
+	case model.BlockContentTableColumn_Date:
+		if d, err := time.Parse(DateLayout, value); err == nil {
+			return cellValue{num: float64(d.Unix()), isNum: true}
+		}
+	case model.BlockContentTableColumn_Checkbox:
+		switch value {
+		case "true":
+			return cellValue{num: 1, isNum: true}
+		case "false":
+			return cellValue{num: 0, isNum: true}
+		}
+	default:
+		if v, err := strconv.ParseFloat(value, 64); err == nil {
+			return cellValue{num: v, isNum: true}
+		}
+	}
+	return cellValue{}
+}
+
+func formatValue(t model.BlockContentTableColumnType, v float64) string {
+	switch t {
+	case model.BlockContentTableColumn_Date:
+		return time.Unix(int64(v), 0).UTC().Format(DateLayout)
+	case model.BlockContentTableColumn_Checkbox:
+		return strconv.FormatBool(v != 0)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// lessValues compares values of the cells according to the column type, invalid values go last
+func lessValues(t model.BlockContentTableColumnType, a, b string) bool {
+	if t == model.BlockContentTableColumn_Text {
+		return strings.ToLower(a) < strings.ToLower(b)
+	}
+	va, vb := parseValue(t, a), parseValue(t, b)
+	if va.isNum != vb.isNum {
+		return va.isNum
+	}
+	if !va.isNum {
+		return strings.ToLower(a) < strings.ToLower(b)
+	}
+	return va.num < vb.num
+}
@@ -0,0 +1,175 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestParseFormula(t *testing.T) {
+	for _, f := range []string{"=1+2*3", "SUM(A1:B2, 3)", "-(A1 + b2) / 2", "avg(A1;A2)", "COUNT()"} {
+		_, err := parseFormula(f)
+		assert.NoError(t, err, f)
+	}
+	for _, f := range []string{"", "1+", "SUM(A1:B2", "A0", "FOO(1)", "1 2", "A1:"} {
+		_, err := parseFormula(f)
+		assert.ErrorIs(t, err, ErrFormulaSyntax, f)
+	}
+	for _, ref := range []string{"ZZZZZZZZZZZZZZ1", "AAAAA1", "A-1", "A+1", "A99999999999999999999"} {
+		_, err := parseCellAddress(ref)
+		assert.ErrorIs(t, err, ErrFormulaSyntax, ref)
+	}
+	addr, err := parseCellAddress("AB12")
+	require.NoError(t, err)
+	assert.Equal(t, CellAddress{Row: 11, Col: 27}, addr)
+	assert.Equal(t, "AB12", addr.String())
+}
+
+func mkFormulaBlock(formula string) *model.Block {
+	return &model.Block{
+		Fields:  &types.Struct{Fields: map[string]*types.Value{FormulaFieldKey: pbtypes.String(formula)}},
+		Content: &model.BlockContentOfText{Text: &model.BlockContentText{}},
+	}
+}
+
+func cellText(s *state.State, id string) string {
+	return s.Pick(id).Model().GetText().GetText()
+}
+
+func TestRecalculate(t *testing.T) {
+	s := mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2", "row3"},
+		[][]string{
+			{"row1-col1", "row1-col2"},
+			{"row2-col1", "row2-col2"},
+			{"row3-col1", "row3-col2"},
+		}, withBlockContents(map[string]*model.Block{
+			"row1-col1": mkFormulaBlock("SUM(A2:A10)"),
+			"row2-col1": mkTextBlock("1"),
+			"row3-col1": mkTextBlock("2.5"),
+			"row1-col2": mkFormulaBlock("A1 * 2 - COUNT(A1:A3)"),
+			"row2-col2": mkFormulaBlock("B2 + 1"),
+			"row3-col2": mkFormulaBlock("A4"),
+		}))
+	editor := NewEditor(nil)
+
+	require.NoError(t, editor.recalculateTables(smartblock.ApplyInfo{State: s}))
+	assert.Equal(t, "3.5", cellText(s, "row1-col1"))
+	assert.Equal(t, "4", cellText(s, "row1-col2"))
+	assert.Equal(t, ErrFormulaCycle.Error(), cellText(s, "row2-col2"))
+	assert.Equal(t, ErrFormulaRef.Error(), cellText(s, "row3-col2"))
+
+	t.Run("totals are updated after row edits", func(t *testing.T) {
+		s = s.NewState()
+		s.Get("row3-col1").(text.Block).SetText("10", nil)
+		require.NoError(t, editor.recalculateTables(smartblock.ApplyInfo{State: s}))
+		assert.Equal(t, "11", cellText(s, "row1-col1"))
+		assert.Equal(t, "19", cellText(s, "row1-col2"))
+
+		s = s.NewState()
+		require.NoError(t, editor.RowDelete(s, pb.RpcBlockTableRowDeleteRequest{TargetId: "row2"}))
+		require.NoError(t, editor.recalculateTables(smartblock.ApplyInfo{State: s}))
+		assert.Equal(t, "10", cellText(s, "row1-col1"))
+	})
+
+	t.Run("typed columns", func(t *testing.T) {
+		s = s.NewState()
+		require.NoError(t, editor.ColumnSetType(s, pb.RpcBlockTableColumnSetTypeRequest{TargetId: "col2", Type: model.BlockContentTableColumn_Checkbox}))
+		require.NoError(t, editor.CellSetFormula(s, pb.RpcBlockTableCellSetFormulaRequest{TargetId: "row3-col2", Formula: "=A2"}))
+		require.NoError(t, editor.CellSetFormula(s, pb.RpcBlockTableCellSetFormulaRequest{TargetId: "row3-col2", Formula: ""}))
+		s.Get("row3-col2").(text.Block).SetText("yes", nil)
+		require.NoError(t, editor.recalculateTables(smartblock.ApplyInfo{State: s}))
+		assert.True(t, pbtypes.GetBool(s.Pick("row3-col2").Model().Fields, InvalidValueFieldKey))
+
+		s.Get("row3-col2").(text.Block).SetText("true", nil)
+		require.NoError(t, editor.recalculateTables(smartblock.ApplyInfo{State: s}))
+		assert.False(t, pbtypes.GetBool(s.Pick("row3-col2").Model().Fields, InvalidValueFieldKey))
+	})
+}
+
+func TestRecalculateRemoteState(t *testing.T) {
+	s := mkTestTable([]string{"col1"}, []string{"row1", "row2"}, [][]string{{"row1-col1"}, {"row2-col1"}},
+		withBlockContents(map[string]*model.Block{
+			"row1-col1": mkFormulaBlock("SUM(A1:ZZZZ999999999)"),
+			"row2-col1": mkTextBlock("2"),
+		}))
+	editor := NewEditor(nil)
+	require.NoError(t, editor.recalculateTables(smartblock.ApplyInfo{State: s}))
+	assert.Equal(t, ErrFormulaCycle.Error(), cellText(s, "row1-col1"))
+
+	s.Get("row1-col1").Model().Fields = mkFormulaBlock("SUM(A2:ZZZZ999999999)").Fields
+	require.NoError(t, editor.recalculateTables(smartblock.ApplyInfo{State: s}))
+	assert.Equal(t, "2", cellText(s, "row1-col1"))
+
+	// the rebuilt state contains all blocks of the object, as the state built from remote changes
+	rebuilt := s.Copy()
+	rebuilt.Get("row2-col1").(text.Block).SetText("5", nil)
+	rebuilt.SetParent(s)
+	require.NoError(t, editor.recalculateTables(smartblock.ApplyInfo{State: rebuilt}))
+	assert.Equal(t, "5", cellText(rebuilt, "row1-col1"))
+}
+
+func TestCellSetFormula(t *testing.T) {
+	s := mkTestTable([]string{"col1"}, []string{"row1"}, [][]string{{"row1-col1"}})
+	editor := NewEditor(nil)
+	err := editor.CellSetFormula(s, pb.RpcBlockTableCellSetFormulaRequest{TargetId: "row1-col1", Formula: "=SUM(A1:"})
+	assert.ErrorIs(t, err, ErrFormulaSyntax)
+
+	require.NoError(t, editor.CellSetFormula(s, pb.RpcBlockTableCellSetFormulaRequest{TargetId: "row1-col1", Formula: "=2*3"}))
+	assert.Equal(t, "2*3", pbtypes.GetString(s.Pick("row1-col1").Model().Fields, FormulaFieldKey))
+}
+
+func TestValueTypes(t *testing.T) {
+	assert.NoError(t, ValidateValue(model.BlockContentTableColumn_Number, " -1.5 "))
+	assert.ErrorIs(t, ValidateValue(model.BlockContentTableColumn_Number, "1,5"), ErrInvalidCellValue)
+	assert.NoError(t, ValidateValue(model.BlockContentTableColumn_Date, "2023-07-01"))
+	assert.ErrorIs(t, ValidateValue(model.BlockContentTableColumn_Date, "01.07.2023"), ErrInvalidCellValue)
+	assert.NoError(t, ValidateValue(model.BlockContentTableColumn_Checkbox, ""))
+
+	date := parseValue(model.BlockContentTableColumn_Date, "2023-07-01")
+	assert.Equal(t, "2023-07-01", formatValue(model.BlockContentTableColumn_Date, date.num))
+	assert.True(t, lessValues(model.BlockContentTableColumn_Number, "9", "10"))
+	assert.True(t, lessValues(model.BlockContentTableColumn_Number, "10", "abc"))
+}
+
+func TestRangeBounds(t *testing.T) {
+	s := mkTestTable([]string{"col1"}, []string{"row1", "row2"}, [][]string{{"row1-col1"}, {"row2-col1"}},
+		withBlockContents(map[string]*model.Block{
+			"row1-col1": mkFormulaBlock("SUM(ZZZZZZZZZZZZZZ1:A1)"),
+			"row2-col1": mkTextBlock("2"),
+		}))
+	editor := NewEditor(nil)
+	require.NoError(t, editor.recalculateTables(smartblock.ApplyInfo{State: s}))
+	assert.Equal(t, ErrFormulaSyntax.Error(), cellText(s, "row1-col1"))
+
+	r := &testCellResolver{rows: 2, cols: 1}
+	values, err := rangeNode{from: CellAddress{Row: -5, Col: -5}, to: CellAddress{Row: 1, Col: 0}}.values(r)
+	require.NoError(t, err)
+	assert.Len(t, values, 2)
+	_, err = refNode(CellAddress{Row: -1, Col: 0}).eval(&calculator{rows: 2, cols: 1})
+	assert.ErrorIs(t, err, ErrFormulaRef)
+
+	r = &testCellResolver{rows: 1000, cols: 1000}
+	_, err = rangeNode{from: CellAddress{}, to: CellAddress{Row: 999, Col: 999}}.values(r)
+	assert.ErrorIs(t, err, ErrFormulaRef)
+}
+
+type testCellResolver struct {
+	rows, cols int
+}
+
+func (r *testCellResolver) cellValue(CellAddress) (cellValue, error) {
+	return cellValue{num: 1, isNum: true}, nil
+}
+
+func (r *testCellResolver) size() (rows, cols int) {
+	return r.rows, r.cols
+}
@@ -39,6 +39,8 @@ type TableEditor interface {
 	Expand(s *state.State, req pb.RpcBlockTableExpandRequest) error
 	Sort(s *state.State, req pb.RpcBlockTableSortRequest) error
 	CellCreate(s *state.State, rowID string, colID string, b *model.Block) (string, error)
+	ColumnSetType(s *state.State, req pb.RpcBlockTableColumnSetTypeRequest) error
+	CellSetFormula(s *state.State, req pb.RpcBlockTableCellSetFormulaRequest) error
 }
 
 type Editor struct {
@@ -62,6 +64,8 @@ func NewEditor(sb smartblock.SmartBlock) *Editor {
 	}
 	if sb != nil {
 		sb.AddHook(t.cleanupTables, smartblock.HookOnBlockClose)
+		// before apply hooks are called for remote changes as well, so values are recalculated on the state append and rebuild
+		sb.AddHook(t.recalculateTables, smartblock.HookBeforeApply)
 	}
 	return &t
 }
@@ -660,7 +664,7 @@ func (t *Editor) Expand(s *state.State, req pb.RpcBlockTableExpandRequest) error
 }
 
 func (t *Editor) Sort(s *state.State, req pb.RpcBlockTableSortRequest) error {
-	_, err := pickColumn(s, req.ColumnId)
+	col, err := pickColumn(s, req.ColumnId)
 	if err != nil {
 		return fmt.Errorf("pick column: %w", err)
 	}
@@ -672,8 +676,9 @@ func (t *Editor) Sort(s *state.State, req pb.RpcBlockTableSortRequest) error {
 
 	rows := s.Get(tb.Rows().Id)
 	sorter := tableSorter{
-		rowIDs: make([]string, 0, len(rows.Model().ChildrenIds)),
-		values: make([]string, len(rows.Model().ChildrenIds)),
+		rowIDs:  make([]string, 0, len(rows.Model().ChildrenIds)),
+		values:  make([]string, len(rows.Model().ChildrenIds)),
+		colType: col.Model().GetTableColumn().GetType(),
 	}
 
 	var headers []string
@@ -749,8 +754,9 @@ func (t *Editor) CellCreate(s *state.State, rowID string, colID string, b *model
 }
 
 type tableSorter struct {
-	rowIDs []string
-	values []string
+	rowIDs  []string
+	values  []string
+	colType model.BlockContentTableColumnType
 }
 
 func (t tableSorter) Len() int {
@@ -758,7 +764,7 @@ func (t tableSorter) Len() int {
 }
 
 func (t tableSorter) Less(i, j int) bool {
-	return strings.ToLower(t.values[i]) < strings.ToLower(t.values[j])
+	return lessValues(t.colType, t.values[i], t.values[j])
 }
 
 func (t tableSorter) Swap(i, j int) {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/filestorage"
 	"github.com/anyproto/anytype-heart/pb"
 	oserror "github.com/anyproto/anytype-heart/util/os"
 )
@@ -36,7 +37,7 @@ func (s *Service) DownloadFile(req *pb.RpcFileDownloadRequest) (string, error) {
 
 	progress.SetProgressMessage("saving file")
 	var countReader *datacounter.ReaderCounter
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), filestorage.CtxKeyRemoteLoadRequested, true))
 	defer cancel()
 	go func() {
 		for {
@@ -18,6 +18,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/simple/link"
 	"github.com/anyproto/anytype-heart/core/block/simple/text"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database/filter"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -84,9 +85,9 @@ func handleDataviewBlock(block simple.Block, oldIDtoNew map[string]string, st *s
 	}
 
 	for _, view := range dataView.GetViews() {
-		for _, filter := range view.GetFilters() {
-			updateObjectIDsInFilter(filter, oldIDtoNew)
-		}
+		filter.Walk(view.GetFilters(), func(f *model.BlockContentDataviewFilter) {
+			updateObjectIDsInFilter(f, oldIDtoNew)
+		})
 		for _, relation := range view.Relations {
 			relationID := addr.RelationKeyToIdPrefix + relation.Key
 			if newID, ok := oldIDtoNew[relationID]; ok && newID != relationID {
@@ -4,6 +4,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 func TestReplaceChunks(t *testing.T) {
@@ -57,3 +62,32 @@ func TestReplaceChunks(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleDataviewBlock(t *testing.T) {
+	nested := &model.BlockContentDataviewFilter{
+		RelationKey: "assignee",
+		Format:      model.RelationFormat_object,
+		Condition:   model.BlockContentDataviewFilter_In,
+		Value:       pbtypes.StringList([]string{"old2"}),
+	}
+	top := &model.BlockContentDataviewFilter{
+		RelationKey: "tag",
+		Format:      model.RelationFormat_tag,
+		Condition:   model.BlockContentDataviewFilter_Equal,
+		Value:       pbtypes.String("old1"),
+	}
+	b := simple.New(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+		Views: []*model.BlockContentDataviewView{{
+			Id: "view",
+			Filters: []*model.BlockContentDataviewFilter{top, {
+				Operator:      model.BlockContentDataviewFilter_Or,
+				NestedFilters: []*model.BlockContentDataviewFilter{nested},
+			}},
+		}},
+	}}})
+
+	handleDataviewBlock(b, map[string]string{"old1": "new1", "old2": "new2"}, state.NewDoc("root", nil).(*state.State))
+
+	assert.Equal(t, []string{"new1"}, pbtypes.GetStringListValue(top.Value))
+	assert.Equal(t, []string{"new2"}, pbtypes.GetStringListValue(nested.Value))
+}
@@ -0,0 +1,337 @@
+package backlinks
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/getblock"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/link"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const CName = "backlinks"
+
+// defaultUnlinkedLimit limits the number of objects loaded to search for unlinked mentions
+const defaultUnlinkedLimit = 50
+
+var log = logging.Logger("anytype-mw-backlinks")
+
+var ErrMentionChanged = errors.New("text of the block doesn't contain the object name in the given range anymore")
+
+type Backlink = pb.RpcObjectBacklinksBacklink
+
+type Service interface {
+	app.Component
+	// List returns references to the object from other objects, and optionally unlinked mentions of the object name.
+	// Archived and deleted objects are skipped
+	List(req *pb.RpcObjectBacklinksListRequest) (backlinks, unlinked []*Backlink, err error)
+	// LinkMention turns the unlinked mention into the mention mark
+	LinkMention(ctx *session.Context, req *pb.RpcObjectBacklinksLinkMentionRequest) error
+}
+
+type service struct {
+	picker      getblock.Picker
+	objectStore objectstore.ObjectStore
+}
+
+func New(picker getblock.Picker) Service {
+	return &service{picker: picker}
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.objectStore = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) List(req *pb.RpcObjectBacklinksListRequest) (backlinks, unlinked []*Backlink, err error) {
+	inbound, err := s.objectStore.GetInboundLinksByID(req.ContextId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get inbound links: %w", err)
+	}
+	linking, err := s.activeIds(inbound)
+	if err != nil {
+		return nil, nil, fmt.Errorf("filter inbound links: %w", err)
+	}
+	for _, id := range linking {
+		if id == req.ContextId {
+			continue
+		}
+		err = getblock.Do(s.picker, id, func(sb smartblock.SmartBlock) error {
+			backlinks = append(backlinks, FindReferences(sb.NewState(), req.ContextId)...)
+			return nil
+		})
+		if err != nil {
+			log.With("objectID", id).Errorf("failed to find backlinks: %v", err)
+		}
+	}
+	if !req.IncludeUnlinked {
+		return backlinks, nil, nil
+	}
+
+	details, err := s.objectStore.GetDetails(req.ContextId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get details: %w", err)
+	}
+	name := strings.TrimSpace(pbtypes.GetString(details.GetDetails(), bundle.RelationKeyName.String()))
+	if name == "" {
+		return backlinks, nil, nil
+	}
+	limit := int(req.UnlinkedLimit)
+	if limit <= 0 {
+		limit = defaultUnlinkedLimit
+	}
+	records, _, err := s.objectStore.Query(nil, database.Query{FullText: name, Filters: activeFilters(), Limit: limit})
+	if err != nil {
+		return nil, nil, fmt.Errorf("search for the object name: %w", err)
+	}
+	for _, rec := range records {
+		id := pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
+		if id == "" || id == req.ContextId || lo.Contains(inbound, id) {
+			continue
+		}
+		err = getblock.Do(s.picker, id, func(sb smartblock.SmartBlock) error {
+			unlinked = append(unlinked, FindUnlinkedMentions(sb.NewState(), name)...)
+			return nil
+		})
+		if err != nil {
+			log.With("objectID", id).Errorf("failed to find unlinked mentions: %v", err)
+		}
+	}
+	return
+}
+
+// activeIds returns ids of objects that are not archived or deleted keeping the order
+func (s *service) activeIds(ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	filters := append(activeFilters(), &model.BlockContentDataviewFilter{
+		RelationKey: bundle.RelationKeyId.String(),
+		Condition:   model.BlockContentDataviewFilter_In,
+		Value:       pbtypes.StringList(ids),
+	})
+	records, _, err := s.objectStore.Query(nil, database.Query{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	active := make(map[string]struct{}, len(records))
+	for _, rec := range records {
+		active[pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())] = struct{}{}
+	}
+	return lo.Filter(ids, func(id string, _ int) bool {
+		_, ok := active[id]
+		return ok
+	}), nil
+}
+
+// activeFilters skip archived and deleted objects
+func activeFilters() []*model.BlockContentDataviewFilter {
+	return []*model.BlockContentDataviewFilter{
+		{
+			RelationKey: bundle.RelationKeyIsArchived.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.Bool(false),
+		},
+		{
+			RelationKey: bundle.RelationKeyIsDeleted.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.Bool(false),
+		},
+	}
+}
+
+func (s *service) LinkMention(ctx *session.Context, req *pb.RpcObjectBacklinksLinkMentionRequest) error {
+	if req.Range == nil || req.Range.From >= req.Range.To {
+		return fmt.Errorf("empty range")
+	}
+	details, err := s.objectStore.GetDetails(req.TargetObjectId)
+	if err != nil {
+		return fmt.Errorf("get details: %w", err)
+	}
+	name := strings.TrimSpace(pbtypes.GetString(details.GetDetails(), bundle.RelationKeyName.String()))
+	return getblock.Do(s.picker, req.ContextId, func(sb smartblock.SmartBlock) error {
+		st := sb.NewStateCtx(ctx)
+		tb, ok := st.Get(req.BlockId).(text.Block)
+		if !ok {
+			return smartblock.ErrSimpleBlockNotFound
+		}
+		content := tb.Model().GetText()
+		if !strings.EqualFold(textRange(content.Text, req.Range), name) {
+			return ErrMentionChanged
+		}
+		marks := content.Marks
+		if marks == nil {
+			marks = &model.BlockContentTextMarks{}
+		}
+		marks.Marks = append(marks.Marks, &model.BlockContentTextMark{
+			Range: &model.Range{From: req.Range.From, To: req.Range.To},
+			Type:  model.BlockContentTextMark_Mention,
+			Param: req.TargetObjectId,
+		})
+		tb.SetText(content.Text, marks)
+		return sb.Apply(st)
+	})
+}
+
+// FindReferences returns mentions, object marks and link blocks of the state pointing to the target object
+func FindReferences(s *state.State, targetId string) (res []*Backlink) {
+	s.Iterate(func(b simple.Block) (isContinue bool) {
+		m := b.Model()
+		if l := m.GetLink(); l != nil && l.TargetBlockId == targetId {
+			res = append(res, &Backlink{
+				ObjectId:      s.RootId(),
+				BlockId:       m.Id,
+				Type:          pb.RpcObjectBacklinksBacklink_LinkBlock,
+				TargetBlockId: l.TargetSubBlockId,
+			})
+			return true
+		}
+		t := m.GetText()
+		if t == nil || t.Marks == nil {
+			return true
+		}
+		for _, mark := range t.Marks.Marks {
+			var tp pb.RpcObjectBacklinksBacklinkType
+			switch mark.Type {
+			case model.BlockContentTextMark_Mention:
+				tp = pb.RpcObjectBacklinksBacklink_Mention
+			case model.BlockContentTextMark_Object:
+				tp = pb.RpcObjectBacklinksBacklink_Object
+			default:
+				continue
+			}
+			objectId, blockId := link.ParseBlockRef(mark.Param)
+			if objectId != targetId {
+				continue
+			}
+			res = append(res, &Backlink{
+				ObjectId:      s.RootId(),
+				BlockId:       m.Id,
+				Text:          t.Text,
+				Range:         mark.Range,
+				Type:          tp,
+				TargetBlockId: blockId,
+			})
+		}
+		return true
+	})
+	return
+}
+
+// FindUnlinkedMentions returns whole word occurrences of the name in the text blocks of the state that are not covered by links or mentions
+func FindUnlinkedMentions(s *state.State, name string) (res []*Backlink) {
+	s.Iterate(func(b simple.Block) (isContinue bool) {
+		t := b.Model().GetText()
+		if t == nil || t.Style == model.BlockContentText_Code {
+			return true
+		}
+		for _, r := range findWord(t.Text, name) {
+			if isLinked(t.Marks, r) {
+				continue
+			}
+			res = append(res, &Backlink{
+				ObjectId: s.RootId(),
+				BlockId:  b.Model().Id,
+				Text:     t.Text,
+				Range:    r,
+				Type:     pb.RpcObjectBacklinksBacklink_Unlinked,
+			})
+		}
+		return true
+	})
+	return
+}
+
+func isLinked(marks *model.BlockContentTextMarks, r *model.Range) bool {
+	for _, m := range marks.GetMarks() {
+		if m.Range == nil || m.Range.To <= r.From || m.Range.From >= r.To {
+			continue
+		}
+		switch m.Type {
+		case model.BlockContentTextMark_Mention, model.BlockContentTextMark_Object, model.BlockContentTextMark_Link:
+			return true
+		}
+	}
+	return false
+}
+
+// findWord returns case-insensitive whole word occurrences of the word in the text as utf-16 ranges
+func findWord(txt, word string) (res []*model.Range) {
+	runes := []rune(txt)
+	wordRunes := []rune(word)
+	if len(wordRunes) == 0 {
+		return
+	}
+	var offset int32
+	offsets := make([]int32, len(runes)+1)
+	for i, r := range runes {
+		offsets[i] = offset
+		offset += int32(utf16Len(r))
+	}
+	offsets[len(runes)] = offset
+
+	for i := 0; i+len(wordRunes) <= len(runes); i++ {
+		if i > 0 && isWordRune(runes[i-1]) {
+			continue
+		}
+		end := i + len(wordRunes)
+		if end < len(runes) && isWordRune(runes[end]) {
+			continue
+		}
+		if equalFoldRunes(runes[i:end], wordRunes) {
+			res = append(res, &model.Range{From: offsets[i], To: offsets[end]})
+			i = end - 1
+		}
+	}
+	return
+}
+
+// textRange returns the part of the text in the utf-16 range
+func textRange(txt string, r *model.Range) string {
+	u := utf16.Encode([]rune(txt))
+	if r.From < 0 || int(r.To) > len(u) || r.From > r.To {
+		return ""
+	}
+	return string(utf16.Decode(u[r.From:r.To]))
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func equalFoldRunes(a, b []rune) bool {
+	for i := range a {
+		if unicode.ToLower(a[i]) != unicode.ToLower(b[i]) {
+			return false
+		}
+	}
+	return true
+}
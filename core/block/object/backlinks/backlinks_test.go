@@ -0,0 +1,162 @@
+package backlinks
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/schema"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func newState(bs ...*model.Block) *state.State {
+	blocks := map[string]simple.Block{}
+	var ids []string
+	for _, b := range bs {
+		blocks[b.Id] = simple.New(b)
+		ids = append(ids, b.Id)
+	}
+	blocks["root"] = simple.New(&model.Block{Id: "root", ChildrenIds: ids})
+	return state.NewDoc("root", blocks).(*state.State)
+}
+
+func textBlock(id, txt string, marks ...*model.BlockContentTextMark) *model.Block {
+	return &model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text:  txt,
+		Marks: &model.BlockContentTextMarks{Marks: marks},
+	}}}
+}
+
+func TestFindReferences(t *testing.T) {
+	s := newState(
+		textBlock("t1", "see target and other",
+			&model.BlockContentTextMark{Range: &model.Range{From: 4, To: 10}, Type: model.BlockContentTextMark_Mention, Param: "target"},
+			&model.BlockContentTextMark{Range: &model.Range{From: 15, To: 20}, Type: model.BlockContentTextMark_Mention, Param: "other"},
+		),
+		textBlock("t2", "block ref",
+			&model.BlockContentTextMark{Range: &model.Range{From: 0, To: 5}, Type: model.BlockContentTextMark_Object, Param: "target#b1"},
+		),
+		&model.Block{Id: "l1", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "target"}}},
+	)
+	refs := FindReferences(s, "target")
+	require.Len(t, refs, 3)
+
+	assert.Equal(t, "t1", refs[0].BlockId)
+	assert.Equal(t, "see target and other", refs[0].Text)
+	assert.Equal(t, &model.Range{From: 4, To: 10}, refs[0].Range)
+	assert.Equal(t, pb.RpcObjectBacklinksBacklink_Mention, refs[0].Type)
+
+	assert.Equal(t, "t2", refs[1].BlockId)
+	assert.Equal(t, pb.RpcObjectBacklinksBacklink_Object, refs[1].Type)
+	assert.Equal(t, "b1", refs[1].TargetBlockId)
+
+	assert.Equal(t, "l1", refs[2].BlockId)
+	assert.Equal(t, pb.RpcObjectBacklinksBacklink_LinkBlock, refs[2].Type)
+	assert.Equal(t, "root", refs[2].ObjectId)
+}
+
+func TestFindUnlinkedMentions(t *testing.T) {
+	s := newState(
+		textBlock("t1", "Project plan: the project plan is ready, projects plans are not"),
+		textBlock("t2", "linked project plan",
+			&model.BlockContentTextMark{Range: &model.Range{From: 7, To: 19}, Type: model.BlockContentTextMark_Mention, Param: "target"},
+		),
+		textBlock("t3", "😀 project plan"),
+	)
+	mentions := FindUnlinkedMentions(s, "project plan")
+	require.Len(t, mentions, 3)
+	assert.Equal(t, "t1", mentions[0].BlockId)
+	assert.Equal(t, &model.Range{From: 0, To: 12}, mentions[0].Range)
+	assert.Equal(t, &model.Range{From: 18, To: 30}, mentions[1].Range)
+	assert.Equal(t, "t3", mentions[2].BlockId)
+	assert.Equal(t, &model.Range{From: 3, To: 15}, mentions[2].Range)
+	assert.Equal(t, "project plan", textRange("😀 project plan", mentions[2].Range))
+	assert.Equal(t, pb.RpcObjectBacklinksBacklink_Unlinked, mentions[2].Type)
+}
+
+type testObjects struct {
+	objectstore.ObjectStore
+	objects map[string]*smarttest.SmartTest
+	details map[string]*types.Struct
+	inbound []string
+}
+
+func (o *testObjects) PickBlock(_ context.Context, id string) (smartblock.SmartBlock, error) {
+	sb, ok := o.objects[id]
+	if !ok {
+		return nil, fmt.Errorf("object %s is not found", id)
+	}
+	return sb, nil
+}
+
+func (o *testObjects) GetInboundLinksByID(string) ([]string, error) {
+	return o.inbound, nil
+}
+
+func (o *testObjects) GetDetails(id string) (*model.ObjectDetails, error) {
+	return &model.ObjectDetails{Details: o.details[id]}, nil
+}
+
+// Query applies filters, the full text search matches all objects
+func (o *testObjects) Query(_ schema.Schema, q database.Query) (records []database.Record, total int, err error) {
+	f, err := database.NewFilters(q, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, details := range o.details {
+		if f.FilterObj.FilterObject(pbtypes.ValueGetter(details)) {
+			records = append(records, database.Record{Details: details})
+		}
+	}
+	return records, len(records), nil
+}
+
+func (o *testObjects) add(id string, details map[string]*types.Value, blocks ...*model.Block) {
+	sb := smarttest.New(id)
+	var ids []string
+	for _, b := range blocks {
+		sb.AddBlock(simple.New(b))
+		ids = append(ids, b.Id)
+	}
+	sb.AddBlock(simple.New(&model.Block{Id: id, ChildrenIds: ids}))
+	o.objects[id] = sb
+	details[bundle.RelationKeyId.String()] = pbtypes.String(id)
+	o.details[id] = &types.Struct{Fields: details}
+}
+
+func TestService_List(t *testing.T) {
+	objects := &testObjects{objects: map[string]*smarttest.SmartTest{}, details: map[string]*types.Struct{}}
+	mention := func() *model.Block {
+		return textBlock("t", "see Target",
+			&model.BlockContentTextMark{Range: &model.Range{From: 4, To: 10}, Type: model.BlockContentTextMark_Mention, Param: "target"},
+		)
+	}
+	objects.add("target", map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("Target")})
+	objects.add("linking", map[string]*types.Value{}, mention())
+	objects.add("archived", map[string]*types.Value{bundle.RelationKeyIsArchived.String(): pbtypes.Bool(true)}, mention())
+	objects.add("deleted", map[string]*types.Value{bundle.RelationKeyIsDeleted.String(): pbtypes.Bool(true)}, mention())
+	objects.add("unlinked", map[string]*types.Value{}, textBlock("t", "about Target"))
+	objects.add("archivedUnlinked", map[string]*types.Value{bundle.RelationKeyIsArchived.String(): pbtypes.Bool(true)}, textBlock("t", "about Target"))
+	objects.inbound = []string{"linking", "archived", "deleted"}
+	s := &service{picker: objects, objectStore: objects}
+
+	backlinks, unlinked, err := s.List(&pb.RpcObjectBacklinksListRequest{ContextId: "target", IncludeUnlinked: true})
+	require.NoError(t, err)
+	require.Len(t, backlinks, 1, "archived and deleted objects are skipped")
+	assert.Equal(t, "linking", backlinks[0].ObjectId)
+	require.Len(t, unlinked, 1, "archived objects are skipped")
+	assert.Equal(t, "unlinked", unlinked[0].ObjectId)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/metrics"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -51,6 +52,7 @@ type Creator struct {
 	blockService      BlockService
 	blockPicker       block.Picker
 	objectStore       objectstore.ObjectStore
+	relationService   relation.Service
 	collectionService CollectionService
 	bookmark          bookmark.Service
 	objectFactory     *editor.ObjectFactory
@@ -77,6 +79,7 @@ func (c *Creator) Init(a *app.App) (err error) {
 	c.blockService = a.MustComponent(block.CName).(BlockService)
 	c.blockPicker = a.MustComponent(block.CName).(block.Picker)
 	c.objectStore = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
+	c.relationService = a.MustComponent(relation.CName).(relation.Service)
 	c.bookmark = a.MustComponent(bookmark.CName).(bookmark.Service)
 	c.bookmark = a.MustComponent(bookmark.CName).(bookmark.Service)
 	c.objectFactory = app.MustComponent[*editor.ObjectFactory](a)
@@ -299,6 +302,17 @@ func (c *Creator) CreateObject(req block.DetailsGetter, forcedType bundle.TypeKe
 	if details.GetFields() == nil {
 		details = &types.Struct{Fields: map[string]*types.Value{}}
 	}
+	// only values passed by the client are checked, so required relations may be filled after the creation
+	keys := make([]string, 0, len(details.Fields))
+	for key := range details.Fields {
+		keys = append(keys, key)
+	}
+	unlock, err := relation.ValidateDetails(c.relationService, c.objectStore, "", details, keys)
+	// unique values are locked until the object is created and indexed
+	defer unlock()
+	if err != nil {
+		return "", nil, err
+	}
 
 	var internalFlags []*model.InternalFlag
 	if v, ok := req.(block.InternalFlagsGetter); ok {
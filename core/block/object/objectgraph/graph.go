@@ -31,7 +31,14 @@ var relationsSkipList = []bundle.RelationKey{
 }
 
 type Service interface {
-	ObjectGraph(req *pb.RpcObjectGraphRequest) ([]*types.Struct, []*pb.RpcObjectGraphEdge, error)
+	ObjectGraph(req *pb.RpcObjectGraphRequest) (*Graph, error)
+}
+
+type Graph struct {
+	Nodes []*types.Struct
+	Edges []*pb.RpcObjectGraphEdge
+	// NodesInfo contains weights and coordinates of the nodes in the same order as Nodes
+	NodesInfo []*pb.RpcObjectGraphNode
 }
 
 type Builder struct {
@@ -68,10 +75,20 @@ func (gr *Builder) Name() (name string) {
 	return CName
 }
 
-func (gr *Builder) ObjectGraph(req *pb.RpcObjectGraphRequest) ([]*types.Struct, []*pb.RpcObjectGraphEdge, error) {
-	records, err := gr.queryRecords(req)
+func (gr *Builder) ObjectGraph(req *pb.RpcObjectGraphRequest) (*Graph, error) {
+	relations, err := gr.provideRelations()
+	if err != nil {
+		return nil, err
+	}
+
+	var records []database.Record
+	if req.RootId != "" {
+		records, err = gr.queryNeighbourhood(req, relations)
+	} else {
+		records, err = gr.queryRecords(req)
+	}
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	nodes := make([]*types.Struct, 0, len(records))
@@ -79,13 +96,16 @@ func (gr *Builder) ObjectGraph(req *pb.RpcObjectGraphRequest) ([]*types.Struct,
 
 	existedNodes := fillExistedNodes(records)
 
-	relations, err := gr.provideRelations()
-	if err != nil {
-		return nil, nil, err
-	}
-
 	nodes, edges = gr.extractGraph(records, nodes, req, relations, edges, existedNodes)
-	return nodes, edges, nil
+	if req.RootId != "" {
+		// nodes reached only by edges of other types or by skipped relations are removed
+		nodes, edges = subgraph(nodes, edges, req.RootId, int(req.Depth))
+	}
+	nodesInfo := weigh(nodes, edges)
+	if req.Layout {
+		Layout(nodesInfo, edges, layoutIterations)
+	}
+	return &Graph{Nodes: nodes, Edges: edges, NodesInfo: nodesInfo}, nil
 }
 
 func isRelationShouldBeIncludedAsEdge(rel *relationutils.Relation) bool {
@@ -103,21 +123,28 @@ func (gr *Builder) extractGraph(
 	for _, rec := range records {
 		id := pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
 
-		nodes = append(nodes, pbtypes.Map(rec.Details, req.Keys...))
+		nodes = append(nodes, pbtypes.Map(rec.Details, nodeKeys(req)...))
 
-		outgoingRelationLink := make(map[string]struct{}, 10)
 		for k, v := range rec.Details.GetFields() {
 			rel := relations.GetByKey(k)
 			if !isRelationShouldBeIncludedAsEdge(rel) {
 				continue
 			}
 
-			edges = appendRelations(v, existedNodes, rel, edges, id, outgoingRelationLink)
+			edges = appendRelations(v, existedNodes, rel, edges, id)
 		}
 
-		edges = gr.appendLinks(rec, outgoingRelationLink, existedNodes, edges, id)
+		edges = gr.appendLinks(rec, existedNodes, edges, id)
 	}
-	return nodes, edges
+	return nodes, filterEdges(edges, req.EdgeTypes)
+}
+
+// nodeKeys returns requested keys of the nodes, id is always required to build the subgraph and layout
+func nodeKeys(req *pb.RpcObjectGraphRequest) []string {
+	if len(req.Keys) == 0 || lo.Contains(req.Keys, bundle.RelationKeyId.String()) {
+		return req.Keys
+	}
+	return append([]string{bundle.RelationKeyId.String()}, req.Keys...)
 }
 
 func (gr *Builder) provideRelations() (relationutils.Relations, error) {
@@ -129,13 +156,125 @@ func (gr *Builder) queryRecords(req *pb.RpcObjectGraphRequest) ([]database.Recor
 	records, _, err := gr.objectStore.Query(
 		nil,
 		database.Query{
-			Filters: req.Filters,
+			Filters: queryFilters(req),
 			Limit:   int(req.Limit),
 		},
 	)
 	return records, err
 }
 
+// queryNeighbourhood queries objects reachable from the root within the depth regardless of the edges direction.
+// Objects are queried breadth-first, only neighbours of the reached objects are queried on every level, so the limit
+// keeps objects closer to the root. The root is included even if it doesn't match the filters
+func (gr *Builder) queryNeighbourhood(req *pb.RpcObjectGraphRequest, relations relationutils.Relations) ([]database.Record, error) {
+	records, err := gr.objectStore.QueryByID([]string{req.RootId})
+	if err != nil {
+		return nil, err
+	}
+	reached := map[string]struct{}{req.RootId: {}}
+	limit := int(req.Limit)
+	current := records
+	for level := 0; len(current) > 0 && (req.Depth <= 0 || level < int(req.Depth)); level++ {
+		if limit > 0 && len(records) >= limit {
+			break
+		}
+		currentIds := make([]string, 0, len(current))
+		var outgoing []string
+		for _, rec := range current {
+			currentIds = append(currentIds, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+			outgoing = append(outgoing, outgoingIds(rec.Details, relations)...)
+		}
+		neighbourFilters := []*model.BlockContentDataviewFilter{{
+			// links include relations and blocks, so objects linking the current ones are incoming neighbours
+			RelationKey: bundle.RelationKeyLinks.String(),
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       pbtypes.StringList(currentIds),
+		}}
+		if len(outgoing) > 0 {
+			neighbourFilters = append(neighbourFilters, &model.BlockContentDataviewFilter{
+				RelationKey: bundle.RelationKeyId.String(),
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       pbtypes.StringList(lo.Uniq(outgoing)),
+			})
+		}
+
+		var next []database.Record
+		for _, filter := range neighbourFilters {
+			neighbours, _, err := gr.objectStore.Query(nil, database.Query{
+				Filters: append(queryFilters(req), filter),
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, rec := range neighbours {
+				id := pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
+				if _, ok := reached[id]; ok {
+					continue
+				}
+				reached[id] = struct{}{}
+				next = append(next, rec)
+			}
+		}
+		if limit > 0 && len(records)+len(next) > limit {
+			next = next[:limit-len(records)]
+		}
+		records = append(records, next...)
+		current = next
+	}
+	return records, nil
+}
+
+// outgoingIds returns ids of objects the object has edges to
+func outgoingIds(details *types.Struct, relations relationutils.Relations) []string {
+	ids := pbtypes.GetStringList(details, bundle.RelationKeyLinks.String())
+	for k, v := range details.GetFields() {
+		rel := relations.GetByKey(k)
+		if isRelationShouldBeIncludedAsEdge(rel) && !unallowedRelation(rel) {
+			ids = append(ids, pbtypes.GetStringListValue(v)...)
+		}
+	}
+	return ids
+}
+
+// queryFilters returns filters of the request together with its convenience filters converted to the dataview filters
+func queryFilters(req *pb.RpcObjectGraphRequest) []*model.BlockContentDataviewFilter {
+	filters := make([]*model.BlockContentDataviewFilter, 0, len(req.Filters)+4)
+	filters = append(filters, req.Filters...)
+	if len(req.TypeIds) > 0 {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeyType.String(),
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       pbtypes.StringList(req.TypeIds),
+		})
+	}
+	if len(req.TagIds) > 0 {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeyTag.String(),
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       pbtypes.StringList(req.TagIds),
+		})
+	}
+	dateKey := req.DateRelationKey
+	if dateKey == "" {
+		dateKey = bundle.RelationKeyLastModifiedDate.String()
+	}
+	if req.DateFrom != 0 {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: dateKey,
+			Condition:   model.BlockContentDataviewFilter_GreaterOrEqual,
+			Value:       pbtypes.Int64(req.DateFrom),
+		})
+	}
+	if req.DateTo != 0 {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: dateKey,
+			Condition:   model.BlockContentDataviewFilter_LessOrEqual,
+			Value:       pbtypes.Int64(req.DateTo),
+		})
+	}
+	return filters
+}
+
 func fillExistedNodes(records []database.Record) map[string]struct{} {
 	existedNodes := make(map[string]struct{}, len(records))
 	for _, rec := range records {
@@ -151,7 +290,6 @@ func appendRelations(
 	rel *relationutils.Relation,
 	edges []*pb.RpcObjectGraphEdge,
 	id string,
-	outgoingRelationLink map[string]struct{},
 ) []*pb.RpcObjectGraphEdge {
 	stringValues := pbtypes.GetStringListValue(v)
 	if len(stringValues) == 0 || unallowedRelation(rel) {
@@ -168,7 +306,6 @@ func appendRelations(
 				Description: rel.Description,
 				Hidden:      rel.Hidden,
 			})
-			outgoingRelationLink[l] = struct{}{}
 		}
 	}
 	return edges
@@ -182,31 +319,38 @@ func unallowedRelation(rel *relationutils.Relation) bool {
 		rel.Key == bundle.RelationKeyLastModifiedBy.String()
 }
 
+// appendLinks adds edges of links by blocks and mentions, the object linked both by a block and a mention
+// gets an edge of each type
 func (gr *Builder) appendLinks(
 	rec database.Record,
-	outgoingRelationLink map[string]struct{},
 	existedNodes map[string]struct{},
 	edges []*pb.RpcObjectGraphEdge,
 	id string,
 ) []*pb.RpcObjectGraphEdge {
-	links := pbtypes.GetStringList(rec.Details, bundle.RelationKeyLinks.String())
-	for _, link := range links {
-		sbType, err := gr.sbtProvider.Type(link)
-		if err != nil {
-			log.Error(err)
-		}
-		// ignore files because we index all file blocks as outgoing links
-		if sbType != smartblock.SmartBlockTypeFile {
-			if _, exists := outgoingRelationLink[link]; !exists {
-				if _, exists := existedNodes[link]; exists {
-					edges = append(edges, &pb.RpcObjectGraphEdge{
-						Source: id,
-						Target: link,
-						Name:   "",
-						Type:   pb.RpcObjectGraphEdge_Link,
-					})
-				}
+	for _, link := range []struct {
+		key      bundle.RelationKey
+		edgeType pb.RpcObjectGraphEdgeType
+	}{
+		{bundle.RelationKeyBlockLinks, pb.RpcObjectGraphEdge_Link},
+		{bundle.RelationKeyMentions, pb.RpcObjectGraphEdge_Mention},
+	} {
+		for _, target := range pbtypes.GetStringList(rec.Details, link.key.String()) {
+			if _, exists := existedNodes[target]; !exists {
+				continue
+			}
+			sbType, err := gr.sbtProvider.Type(target)
+			if err != nil {
+				log.Error(err)
+			}
+			// ignore files because we index all file blocks as outgoing links
+			if sbType == smartblock.SmartBlockTypeFile {
+				continue
 			}
+			edges = append(edges, &pb.RpcObjectGraphEdge{
+				Source: id,
+				Target: target,
+				Type:   link.edgeType,
+			})
 		}
 	}
 	return edges
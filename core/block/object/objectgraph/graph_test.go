@@ -1,11 +1,26 @@
 package objectgraph
 
 import (
+	"math"
+	"strconv"
 	"testing"
 
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
 	"github.com/anyproto/anytype-heart/core/relation/relationutils"
+	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/schema"
+	"github.com/anyproto/anytype-heart/space/typeprovider/mock_typeprovider"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 func Test_isRelationShouldBeIncludedAsEdge(t *testing.T) {
@@ -16,23 +31,23 @@ func Test_isRelationShouldBeIncludedAsEdge(t *testing.T) {
 		want bool
 	}{
 		{"creator",
-			&relationutils.Relation{bundle.MustGetRelation(bundle.RelationKeyCreator)},
+			&relationutils.Relation{Relation: bundle.MustGetRelation(bundle.RelationKeyCreator)},
 			false,
 		},
 		{"assignee",
-			&relationutils.Relation{bundle.MustGetRelation(bundle.RelationKeyAssignee)},
+			&relationutils.Relation{Relation: bundle.MustGetRelation(bundle.RelationKeyAssignee)},
 			true,
 		},
 		{"cover",
-			&relationutils.Relation{bundle.MustGetRelation(bundle.RelationKeyCoverId)},
+			&relationutils.Relation{Relation: bundle.MustGetRelation(bundle.RelationKeyCoverId)},
 			false,
 		},
 		{"file relation",
-			&relationutils.Relation{bundle.MustGetRelation(bundle.RelationKeyTrailer)},
+			&relationutils.Relation{Relation: bundle.MustGetRelation(bundle.RelationKeyTrailer)},
 			true,
 		},
 		{"custom relation",
-			&relationutils.Relation{&model.Relation{Name: "custom", Format: model.RelationFormat_object}},
+			&relationutils.Relation{Relation: &model.Relation{Name: "custom", Format: model.RelationFormat_object}},
 			true,
 		},
 	}
@@ -44,3 +59,164 @@ func Test_isRelationShouldBeIncludedAsEdge(t *testing.T) {
 		})
 	}
 }
+
+func node(id string) *types.Struct {
+	return &types.Struct{Fields: map[string]*types.Value{bundle.RelationKeyId.String(): pbtypes.String(id)}}
+}
+
+func edge(source, target string, tp pb.RpcObjectGraphEdgeType) *pb.RpcObjectGraphEdge {
+	return &pb.RpcObjectGraphEdge{Source: source, Target: target, Type: tp}
+}
+
+func TestSubgraph(t *testing.T) {
+	nodes := []*types.Struct{node("root"), node("a"), node("b"), node("c"), node("d")}
+	edges := []*pb.RpcObjectGraphEdge{
+		edge("root", "a", pb.RpcObjectGraphEdge_Link),
+		edge("b", "a", pb.RpcObjectGraphEdge_Mention),
+		edge("b", "c", pb.RpcObjectGraphEdge_Relation),
+	}
+
+	t.Run("depth limit", func(t *testing.T) {
+		n, e := subgraph(nodes, edges, "root", 2)
+		assert.Equal(t, []string{"root", "a", "b"}, lo.Map(n, func(s *types.Struct, _ int) string { return nodeId(s) }))
+		assert.Len(t, e, 2)
+	})
+	t.Run("no limit", func(t *testing.T) {
+		n, e := subgraph(nodes, edges, "root", 0)
+		assert.Len(t, n, 4)
+		assert.Len(t, e, 3)
+	})
+	t.Run("edge types", func(t *testing.T) {
+		filtered := filterEdges(append([]*pb.RpcObjectGraphEdge{}, edges...), []pb.RpcObjectGraphEdgeType{pb.RpcObjectGraphEdge_Link, pb.RpcObjectGraphEdge_Relation})
+		n, _ := subgraph(nodes, filtered, "root", 0)
+		assert.Len(t, n, 2)
+	})
+}
+
+func TestAppendLinksMention(t *testing.T) {
+	sbtProvider := mock_typeprovider.NewMockSmartBlockTypeProvider(t)
+	sbtProvider.EXPECT().Type(mock.Anything).Return(smartblock.SmartBlockTypePage, nil)
+	gr := &Builder{sbtProvider: sbtProvider}
+	rec := database.Record{Details: &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyLinks.String():      pbtypes.StringList([]string{"linked", "mentioned", "both"}),
+		bundle.RelationKeyBlockLinks.String(): pbtypes.StringList([]string{"linked", "both"}),
+		bundle.RelationKeyMentions.String():   pbtypes.StringList([]string{"mentioned", "both"}),
+	}}}
+	existed := map[string]struct{}{"linked": {}, "mentioned": {}, "both": {}}
+	edges := gr.appendLinks(rec, existed, nil, "root")
+	assert.Equal(t, []*pb.RpcObjectGraphEdge{
+		edge("root", "linked", pb.RpcObjectGraphEdge_Link),
+		edge("root", "both", pb.RpcObjectGraphEdge_Link),
+		edge("root", "mentioned", pb.RpcObjectGraphEdge_Mention),
+		edge("root", "both", pb.RpcObjectGraphEdge_Mention),
+	}, edges)
+}
+
+type testObjectStore struct {
+	objectstore.ObjectStore
+	records []database.Record
+	// queries are filters of the queries
+	queries [][]*model.BlockContentDataviewFilter
+}
+
+func (s *testObjectStore) object(id string, links ...string) {
+	s.records = append(s.records, database.Record{Details: &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyId.String():    pbtypes.String(id),
+		bundle.RelationKeyLinks.String(): pbtypes.StringList(links),
+	}}})
+}
+
+func (s *testObjectStore) QueryByID(ids []string) (records []database.Record, err error) {
+	for _, rec := range s.records {
+		if lo.Contains(ids, nodeId(rec.Details)) {
+			records = append(records, rec)
+		}
+	}
+	return records, nil
+}
+
+func (s *testObjectStore) Query(_ schema.Schema, q database.Query) (records []database.Record, total int, err error) {
+	s.queries = append(s.queries, q.Filters)
+	f, err := database.NewFilters(q, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, rec := range s.records {
+		if f.FilterObj.FilterObject(pbtypes.ValueGetter(rec.Details)) {
+			records = append(records, rec)
+		}
+	}
+	return records, len(records), nil
+}
+
+func TestQueryNeighbourhood(t *testing.T) {
+	store := &testObjectStore{}
+	// unrelated objects go first, so the limit of the whole query would drop neighbours of the root
+	for i := 0; i < 10; i++ {
+		store.object("unrelated" + strconv.Itoa(i))
+	}
+	store.object("root", "a")
+	store.object("a", "c")
+	store.object("b", "root")
+	store.object("c", "d")
+	store.object("d")
+	gr := &Builder{objectStore: store}
+	ids := func(records []database.Record) []string {
+		return lo.Map(records, func(rec database.Record, _ int) string { return nodeId(rec.Details) })
+	}
+
+	t.Run("depth", func(t *testing.T) {
+		records, err := gr.queryNeighbourhood(&pb.RpcObjectGraphRequest{RootId: "root", Depth: 2, Limit: 5}, nil)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"root", "a", "b", "c"}, ids(records))
+		for _, filters := range store.queries {
+			key := filters[len(filters)-1].RelationKey
+			assert.Contains(t, []string{bundle.RelationKeyId.String(), bundle.RelationKeyLinks.String()}, key, "only neighbours are queried")
+		}
+	})
+	t.Run("limit keeps objects closer to the root", func(t *testing.T) {
+		records, err := gr.queryNeighbourhood(&pb.RpcObjectGraphRequest{RootId: "root", Limit: 3}, nil)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"root", "a", "b"}, ids(records))
+	})
+}
+
+func TestWeigh(t *testing.T) {
+	edges := []*pb.RpcObjectGraphEdge{
+		edge("a", "b", pb.RpcObjectGraphEdge_Link),
+		edge("b", "a", pb.RpcObjectGraphEdge_Relation),
+		edge("a", "c", pb.RpcObjectGraphEdge_Mention),
+	}
+	info := weigh([]*types.Struct{node("a"), node("b"), node("c")}, edges)
+	assert.Equal(t, []int32{2, 2, 1}, lo.Map(edges, func(e *pb.RpcObjectGraphEdge, _ int) int32 { return e.Weight }))
+	assert.Equal(t, []int32{3, 2, 1}, lo.Map(info, func(n *pb.RpcObjectGraphNode, _ int) int32 { return n.Weight }))
+}
+
+func TestLayout(t *testing.T) {
+	var nodes []*pb.RpcObjectGraphNode
+	for i := 0; i < 20; i++ {
+		nodes = append(nodes, &pb.RpcObjectGraphNode{Id: strconv.Itoa(i)})
+	}
+	edges := []*pb.RpcObjectGraphEdge{edge("0", "19", pb.RpcObjectGraphEdge_Link)}
+	Layout(nodes, edges, layoutIterations)
+
+	dist := func(a, b *pb.RpcObjectGraphNode) float64 { return math.Hypot(a.X-b.X, a.Y-b.Y) }
+	for i := 1; i < 19; i++ {
+		assert.Less(t, dist(nodes[0], nodes[19]), dist(nodes[0], nodes[i]))
+	}
+
+	again := lo.Map(nodes, func(n *pb.RpcObjectGraphNode, _ int) *pb.RpcObjectGraphNode { return &pb.RpcObjectGraphNode{Id: n.Id} })
+	Layout(again, edges, layoutIterations)
+	assert.Equal(t, nodes, again)
+}
+
+func TestQueryFilters(t *testing.T) {
+	filters := queryFilters(&pb.RpcObjectGraphRequest{
+		TypeIds:  []string{"type1"},
+		DateFrom: 100,
+	})
+	require.Len(t, filters, 2)
+	assert.Equal(t, bundle.RelationKeyType.String(), filters[0].RelationKey)
+	assert.Equal(t, bundle.RelationKeyLastModifiedDate.String(), filters[1].RelationKey)
+	assert.Equal(t, model.BlockContentDataviewFilter_GreaterOrEqual, filters[1].Condition)
+}
@@ -0,0 +1,201 @@
+package objectgraph
+
+import (
+	"math"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+const (
+	layoutIterations = 100
+	// layoutDistance is the preferred distance between connected nodes
+	layoutDistance = 100.0
+	// layoutGravity pulls nodes to the center proportionally to the distance to it, so disconnected parts
+	// of the graph stay close to each other. It balances the repulsion of the disk with layoutDistance between nodes
+	layoutGravity = 1.0
+	// layoutTheta is the Barnes-Hut approximation threshold: a group of nodes is treated as a single
+	// node when its size divided by the distance to it is less than the threshold
+	layoutTheta = 1.0
+	// quadMaxDepth stops subdivision of the quadtree for the nodes with (almost) equal coordinates
+	quadMaxDepth = 40
+)
+
+// goldenAngle is used to place the nodes on a spiral before the first iteration
+var goldenAngle = math.Pi * (3 - math.Sqrt(5))
+
+// Layout sets coordinates of the nodes using the force-directed algorithm by Fruchterman and Reingold.
+// Repulsive forces are approximated with the Barnes-Hut quadtree, so an iteration takes O(n log n) time.
+// The result depends only on the order of nodes and edges
+func Layout(nodes []*pb.RpcObjectGraphNode, edges []*pb.RpcObjectGraphEdge, iterations int) {
+	if len(nodes) == 0 {
+		return
+	}
+	index := make(map[string]int, len(nodes))
+	x := make([]float64, len(nodes))
+	y := make([]float64, len(nodes))
+	for i, n := range nodes {
+		index[n.Id] = i
+		r := layoutDistance * math.Sqrt(float64(i)+0.5)
+		x[i] = r * math.Cos(float64(i)*goldenAngle)
+		y[i] = r * math.Sin(float64(i)*goldenAngle)
+	}
+	type link struct{ source, target int }
+	links := make([]link, 0, len(edges))
+	for _, e := range edges {
+		s, sOk := index[e.Source]
+		t, tOk := index[e.Target]
+		if sOk && tOk && s != t {
+			links = append(links, link{s, t})
+		}
+	}
+
+	var (
+		k    = layoutDistance
+		dx   = make([]float64, len(nodes))
+		dy   = make([]float64, len(nodes))
+		tree = &quadtree{}
+		temp = k * math.Sqrt(float64(len(nodes))) / 10
+	)
+	for it := 0; it < iterations; it++ {
+		tree.build(x, y)
+		for i := range nodes {
+			dx[i], dy[i] = tree.repulsion(x[i], y[i], i, k*k)
+			dx[i] -= x[i] * layoutGravity
+			dy[i] -= y[i] * layoutGravity
+		}
+
+		for _, l := range links {
+			vx, vy := x[l.source]-x[l.target], y[l.source]-y[l.target]
+			d := math.Hypot(vx, vy)
+			if d < 0.01 {
+				continue
+			}
+			f := d / k
+			dx[l.source] -= vx * f
+			dy[l.source] -= vy * f
+			dx[l.target] += vx * f
+			dy[l.target] += vy * f
+		}
+
+		for i := range nodes {
+			d := math.Hypot(dx[i], dy[i])
+			if d < 0.01 {
+				continue
+			}
+			step := math.Min(d, temp)
+			x[i] += dx[i] / d * step
+			y[i] += dy[i] / d * step
+		}
+		temp -= temp / float64(iterations-it)
+	}
+
+	for i, n := range nodes {
+		n.X, n.Y = x[i], y[i]
+	}
+}
+
+type quad struct {
+	x, y, size float64
+	// mass is the number of nodes in the quad, cx and cy are their center of mass
+	mass, cx, cy float64
+	// node is the index of the only node of the leaf quad or -1
+	node     int
+	children [4]int
+}
+
+// quadtree keeps quads in a slice, so it can be rebuilt on every iteration without allocations
+type quadtree struct {
+	quads []quad
+	stack []int
+	x, y  []float64
+}
+
+func (t *quadtree) build(x, y []float64) {
+	t.x, t.y = x, y
+	minX, minY, maxX, maxY := x[0], y[0], x[0], y[0]
+	for i := range x {
+		minX, maxX = math.Min(minX, x[i]), math.Max(maxX, x[i])
+		minY, maxY = math.Min(minY, y[i]), math.Max(maxY, y[i])
+	}
+	t.quads = t.quads[:0]
+	t.newQuad(minX, minY, math.Max(maxX-minX, maxY-minY)+1)
+	for i := range x {
+		t.insert(0, i, 0)
+	}
+}
+
+func (t *quadtree) newQuad(x, y, size float64) int {
+	t.quads = append(t.quads, quad{x: x, y: y, size: size, node: -1, children: [4]int{-1, -1, -1, -1}})
+	return len(t.quads) - 1
+}
+
+func (t *quadtree) insert(q, i, depth int) {
+	cur := &t.quads[q]
+	cur.cx = (cur.cx*cur.mass + t.x[i]) / (cur.mass + 1)
+	cur.cy = (cur.cy*cur.mass + t.y[i]) / (cur.mass + 1)
+	cur.mass++
+	if cur.mass == 1 {
+		cur.node = i
+		return
+	}
+	if depth >= quadMaxDepth {
+		return
+	}
+	if cur.node != -1 {
+		prev := cur.node
+		cur.node = -1
+		t.insertChild(q, prev, depth)
+	}
+	t.insertChild(q, i, depth)
+}
+
+func (t *quadtree) insertChild(q, i, depth int) {
+	cur := t.quads[q]
+	half := cur.size / 2
+	pos, x, y := 0, cur.x, cur.y
+	if t.x[i] >= cur.x+half {
+		pos, x = pos+1, x+half
+	}
+	if t.y[i] >= cur.y+half {
+		pos, y = pos+2, y+half
+	}
+	child := cur.children[pos]
+	if child == -1 {
+		child = t.newQuad(x, y, half)
+		t.quads[q].children[pos] = child
+	}
+	t.insert(child, i, depth+1)
+}
+
+// repulsion returns the sum of repulsive forces k2/d applied to the node i by all other nodes
+func (t *quadtree) repulsion(x, y float64, i int, k2 float64) (fx, fy float64) {
+	stack := append(t.stack[:0], 0)
+	defer func() { t.stack = stack }()
+	for len(stack) > 0 {
+		q := &t.quads[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		if q.node == i {
+			continue
+		}
+		vx, vy := x-q.cx, y-q.cy
+		d2 := vx*vx + vy*vy
+		isLeaf := q.node != -1 || q.children == [4]int{-1, -1, -1, -1}
+		if !isLeaf && q.size*q.size >= layoutTheta*layoutTheta*d2 {
+			for _, c := range q.children {
+				if c != -1 {
+					stack = append(stack, c)
+				}
+			}
+			continue
+		}
+		if d2 < 0.0001 {
+			// separate coincident nodes in a deterministic direction
+			angle := float64(i) * goldenAngle
+			vx, vy, d2 = math.Cos(angle)*0.01, math.Sin(angle)*0.01, 0.0001
+		}
+		f := k2 * q.mass / d2
+		fx += vx * f
+		fy += vy * f
+	}
+	return
+}
@@ -0,0 +1,101 @@
+package objectgraph
+
+import (
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// filterEdges keeps edges of the given types, all edges are kept if no types are given
+func filterEdges(edges []*pb.RpcObjectGraphEdge, edgeTypes []pb.RpcObjectGraphEdgeType) []*pb.RpcObjectGraphEdge {
+	if len(edgeTypes) == 0 {
+		return edges
+	}
+	allowed := make(map[pb.RpcObjectGraphEdgeType]struct{}, len(edgeTypes))
+	for _, t := range edgeTypes {
+		allowed[t] = struct{}{}
+	}
+	filtered := edges[:0]
+	for _, e := range edges {
+		if _, ok := allowed[e.Type]; ok {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+// subgraph keeps nodes reachable from the root within depth steps regardless of the edges direction.
+// Depth <= 0 means no limit
+func subgraph(nodes []*types.Struct, edges []*pb.RpcObjectGraphEdge, rootId string, depth int) ([]*types.Struct, []*pb.RpcObjectGraphEdge) {
+	adjacent := make(map[string][]string, len(nodes))
+	for _, e := range edges {
+		adjacent[e.Source] = append(adjacent[e.Source], e.Target)
+		adjacent[e.Target] = append(adjacent[e.Target], e.Source)
+	}
+
+	reached := map[string]struct{}{rootId: {}}
+	current := []string{rootId}
+	for level := 0; len(current) > 0 && (depth <= 0 || level < depth); level++ {
+		var next []string
+		for _, id := range current {
+			for _, n := range adjacent[id] {
+				if _, ok := reached[n]; !ok {
+					reached[n] = struct{}{}
+					next = append(next, n)
+				}
+			}
+		}
+		current = next
+	}
+
+	filteredNodes := make([]*types.Struct, 0, len(reached))
+	for _, n := range nodes {
+		if _, ok := reached[nodeId(n)]; ok {
+			filteredNodes = append(filteredNodes, n)
+		}
+	}
+	filteredEdges := make([]*pb.RpcObjectGraphEdge, 0, len(edges))
+	for _, e := range edges {
+		_, sourceOk := reached[e.Source]
+		_, targetOk := reached[e.Target]
+		if sourceOk && targetOk {
+			filteredEdges = append(filteredEdges, e)
+		}
+	}
+	return filteredNodes, filteredEdges
+}
+
+// weigh sets the weight of every edge to the number of edges between its nodes
+// and returns the nodes info with the weight equal to the number of the node edges
+func weigh(nodes []*types.Struct, edges []*pb.RpcObjectGraphEdge) []*pb.RpcObjectGraphNode {
+	type pair struct{ a, b string }
+	pairKey := func(e *pb.RpcObjectGraphEdge) pair {
+		if e.Source < e.Target {
+			return pair{e.Source, e.Target}
+		}
+		return pair{e.Target, e.Source}
+	}
+	pairs := make(map[pair]int32, len(edges))
+	degrees := make(map[string]int32, len(nodes))
+	for _, e := range edges {
+		pairs[pairKey(e)]++
+		degrees[e.Source]++
+		degrees[e.Target]++
+	}
+	for _, e := range edges {
+		e.Weight = pairs[pairKey(e)]
+	}
+
+	info := make([]*pb.RpcObjectGraphNode, 0, len(nodes))
+	for _, n := range nodes {
+		id := nodeId(n)
+		info = append(info, &pb.RpcObjectGraphNode{Id: id, Weight: degrees[id]})
+	}
+	return info
+}
+
+func nodeId(n *types.Struct) string {
+	return pbtypes.GetString(n, bundle.RelationKeyId.String())
+}
@@ -46,6 +46,9 @@ func (n *noOp) Canceled() chan struct{} {
 func (n *noOp) Finish() {
 }
 
+func (n *noOp) FinishWithError(err error) {
+}
+
 func (n *noOp) TryStep(delta int64) error {
 	return nil
 }
@@ -18,6 +18,8 @@ type Progress interface {
 	SetProgressMessage(msg string)
 	Canceled() chan struct{}
 	Finish()
+	// FinishWithError finishes the process with the error state, the error is sent as the progress message
+	FinishWithError(err error)
 	TryStep(delta int64) error
 }
 
@@ -41,6 +43,7 @@ type progress struct {
 
 	isCancelled bool
 	isDone      bool
+	err         error
 }
 
 func (p *progress) SetTotal(total int64) {
@@ -75,6 +78,17 @@ func (p *progress) Finish() {
 	p.isDone = true
 }
 
+func (p *progress) FinishWithError(err error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	if p.isDone {
+		return
+	}
+	p.err = err
+	close(p.done)
+	p.isDone = true
+}
+
 // nolint:revive
 func (p *progress) Id() string {
 	return p.id
@@ -105,6 +119,11 @@ func (p *progress) Info() pb.ModelProcess {
 	}
 	p.m.Lock()
 	defer p.m.Unlock()
+	message := p.pMessage
+	if p.err != nil {
+		state = pb.ModelProcess_Error
+		message = p.err.Error()
+	}
 	return pb.ModelProcess{
 		Id:    p.id,
 		Type:  p.pType,
@@ -112,7 +131,7 @@ func (p *progress) Info() pb.ModelProcess {
 		Progress: &pb.ModelProcessProgress{
 			Total:   atomic.LoadInt64(&p.totalCount),
 			Done:    atomic.LoadInt64(&p.doneCount),
-			Message: p.pMessage,
+			Message: message,
 		},
 	}
 }
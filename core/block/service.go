@@ -18,7 +18,9 @@ import (
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	bookmarksvc "github.com/anyproto/anytype-heart/core/block/bookmark"
+	"github.com/anyproto/anytype-heart/core/block/bulkedit"
 	"github.com/anyproto/anytype-heart/core/block/editor"
 	"github.com/anyproto/anytype-heart/core/block/editor/basic"
 	"github.com/anyproto/anytype-heart/core/block/editor/bookmark"
@@ -34,8 +36,10 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/core/block/restriction"
 	"github.com/anyproto/anytype-heart/core/block/source"
+	"github.com/anyproto/anytype-heart/core/block/syncedblock"
 	"github.com/anyproto/anytype-heart/core/event"
 	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/filestorage"
 	"github.com/anyproto/anytype-heart/core/filestorage/filesync"
 	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/core/session"
@@ -65,8 +69,7 @@ import (
 )
 
 const (
-	CName           = treemanager.CName
-	linkObjectShare = "anytype://object/share?"
+	CName = treemanager.CName
 )
 
 var (
@@ -104,6 +107,9 @@ func New(
 		layoutConverter: layoutConverter,
 		closing:         make(chan struct{}),
 		openedObjects:   make(map[string]bool),
+		syncedBlocks:    syncedblock.NewRegistry(),
+		bulkEditUndo:    bulkedit.NewUndoStore(bulkEditUndoLimit),
+		location:        func() *time.Location { return time.Local },
 	}
 }
 
@@ -158,6 +164,15 @@ type Service struct {
 
 	predefinedObjectWasMissing bool
 	openedObjects              map[string]bool
+	syncedBlocks               *syncedblock.Registry
+	bulkEditUndo               *bulkedit.UndoStore
+	relationChanges            relationChangesPruner
+	// location is the time zone of the account, objects are moved between days of the calendar in it
+	location func() *time.Location
+}
+
+type relationChangesPruner interface {
+	PruneRelationChanges(objectId string) error
 }
 
 func (s *Service) Name() string {
@@ -182,6 +197,10 @@ func (s *Service) Init(a *app.App) (err error) {
 	s.fileStore = app.MustComponent[filestore.FileStore](a)
 	s.fileSync = app.MustComponent[filesync.FileSync](a)
 	s.fileService = app.MustComponent[files.Service](a)
+	s.relationChanges = app.MustComponent[relationChangesPruner](a)
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.location = cfg.Location
+	}
 	s.cache = s.createCache()
 	s.app = a
 	return
@@ -199,7 +218,10 @@ func (s *Service) OpenBlock(
 	ctx *session.Context, id string, includeRelationsAsDependentObjects bool,
 ) (obj *model.ObjectView, err error) {
 	startTime := time.Now()
-	ob, err := s.getSmartblock(context.WithValue(context.TODO(), metrics.CtxKeyEntrypoint, "object_open"), id)
+	cctx := context.WithValue(context.TODO(), metrics.CtxKeyEntrypoint, "object_open")
+	// the opened file is loaded from the file node even in metered mode
+	cctx = context.WithValue(cctx, filestorage.CtxKeyRemoteLoadRequested, true)
+	ob, err := s.getSmartblock(cctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -208,6 +230,13 @@ func (s *Service) OpenBlock(
 	}
 	afterSmartBlockTime := time.Now()
 
+	var syncedRefs []syncedblock.Ref
+	defer func() {
+		// runs after the object is unlocked
+		if err == nil && obj != nil && len(syncedRefs) > 0 {
+			obj.SyncedBlocks = s.fetchSyncedBlocks(id, syncedRefs)
+		}
+	}()
 	ob.Lock()
 	defer ob.Unlock()
 	ob.SetEventFunc(s.sendEvent)
@@ -225,6 +254,7 @@ func (s *Service) OpenBlock(
 	if obj, err = ob.Show(ctx); err != nil {
 		return
 	}
+	syncedRefs = s.watchSyncedBlocks(ob)
 	afterShowTime := time.Now()
 	_, err = s.syncStatus.Watch(id, func() []string {
 		ob.Lock()
@@ -417,43 +447,6 @@ func (s *Service) SetIsHighlighted(req *pb.RpcWorkspaceSetIsHighlightedRequest)
 	// })
 }
 
-func (s *Service) ObjectShareByLink(req *pb.RpcObjectShareByLinkRequest) (link string, err error) {
-	return "", fmt.Errorf("not implemented")
-	// workspaceId, err := s.anytype.GetWorkspaceIdForObject(req.ObjectId)
-	// if err == core.ErrObjectDoesNotBelongToWorkspace {
-	//	workspaceId = s.Anytype().PredefinedBlocks().Account
-	// }
-	// var key string
-	// var addrs []string
-	// err = s.Do(workspaceId, func(b smartblock.SmartBlock) error {
-	//	workspace, ok := b.(*editor.Workspaces)
-	//	if !ok {
-	//		return fmt.Errorf("incorrect object with workspace id")
-	//	}
-	//	key, addrs, err = workspace.GetObjectKeyAddrs(req.ObjectId)
-	//	return err
-	// })
-	// if err != nil {
-	//	return "", err
-	// }
-	// payload := &model.ThreadDeeplinkPayload{
-	//	Key:   key,
-	//	Addrs: addrs,
-	// }
-	// marshalledPayload, err := proto.Marshal(payload)
-	// if err != nil {
-	//	return "", fmt.Errorf("failed to marshal deeplink payload: %w", err)
-	// }
-	// encodedPayload := base64.RawStdEncoding.EncodeToString(marshalledPayload)
-	//
-	// params := url.Values{}
-	// params.Add("id", req.ObjectId)
-	// params.Add("payload", encodedPayload)
-	// encoded := params.Encode()
-	//
-	// return fmt.Sprintf("%s%s", linkObjectShare, encoded), nil
-}
-
 // SetPagesIsArchived is deprecated
 func (s *Service) SetPagesIsArchived(req pb.RpcObjectListSetIsArchivedRequest) error {
 	return s.Do(s.anytype.PredefinedBlocks().Archive, func(b smartblock.SmartBlock) error {
@@ -690,6 +683,9 @@ func (s *Service) OnDelete(id string, workspaceRemove func() error) error {
 	if err := s.objectStore.DeleteObject(id); err != nil {
 		return fmt.Errorf("delete object from local store: %w", err)
 	}
+	if err := s.relationChanges.PruneRelationChanges(id); err != nil {
+		log.Error("failed to prune relation changes of deleted object", zap.Error(err))
+	}
 
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/base"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/database/filter"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
@@ -117,11 +118,7 @@ func (s *Dataview) AddView(view model.BlockContentDataviewView) {
 	if view.Id == "" {
 		view.Id = uuid.New().String()
 	}
-	for _, f := range view.Filters {
-		if f.Id == "" {
-			f.Id = bson.NewObjectId().Hex()
-		}
-	}
+	fillFilterIds(view.Filters...)
 	for _, s := range view.Sorts {
 		if s.Id == "" {
 			s.Id = bson.NewObjectId().Hex()
@@ -179,6 +176,10 @@ func (s *Dataview) SetView(viewID string, view model.BlockContentDataviewView) e
 	v.GroupBackgroundColors = view.GroupBackgroundColors
 	v.PageLimit = view.PageLimit
 	v.DefaultTemplateId = view.DefaultTemplateId
+	v.DateRelationKey = view.DateRelationKey
+	v.EndDateRelationKey = view.EndDateRelationKey
+	v.DependencyRelationKey = view.DependencyRelationKey
+	v.Query = view.Query
 
 	return nil
 }
@@ -200,6 +201,10 @@ func (d *Dataview) SetViewFields(viewID string, view *model.BlockContentDataview
 	v.GroupBackgroundColors = view.GroupBackgroundColors
 	v.PageLimit = view.PageLimit
 	v.DefaultTemplateId = view.DefaultTemplateId
+	v.DateRelationKey = view.DateRelationKey
+	v.EndDateRelationKey = view.EndDateRelationKey
+	v.DependencyRelationKey = view.DependencyRelationKey
+	v.Query = view.Query
 
 	return nil
 }
@@ -231,13 +236,7 @@ func (d *Dataview) DeleteRelation(relationKey string) error {
 	d.content.RelationLinks = pbtypes.RelationLinks(d.content.RelationLinks).Remove(relationKey)
 
 	for _, view := range d.content.Views {
-		var filteredFilters []*model.BlockContentDataviewFilter
-		for _, filter := range view.Filters {
-			if filter.RelationKey != relationKey {
-				filteredFilters = append(filteredFilters, filter)
-			}
-		}
-		view.Filters = filteredFilters
+		view.Filters = removeRelationFilters(view.Filters, relationKey)
 
 		var filteredSorts []*model.BlockContentDataviewSort
 		for _, sort := range view.Sorts {
@@ -291,13 +290,7 @@ func (d *Dataview) DeleteRelationOld(relationKey string) error {
 	}
 
 	for _, view := range d.content.Views {
-		var filteredFilters []*model.BlockContentDataviewFilter
-		for _, filter := range view.Filters {
-			if filter.RelationKey != relationKey {
-				filteredFilters = append(filteredFilters, filter)
-			}
-		}
-		view.Filters = filteredFilters
+		view.Filters = removeRelationFilters(view.Filters, relationKey)
 
 		var filteredSorts []*model.BlockContentDataviewSort
 		for _, sort := range view.Sorts {
@@ -431,3 +424,19 @@ func (s *Dataview) UpdateRelationOld(relationKey string, rel model.Relation) err
 
 	return nil
 }
+
+// removeRelationFilters removes filters by the relation including nested ones, groups left empty are removed too
+func removeRelationFilters(filters []*model.BlockContentDataviewFilter, relationKey string) []*model.BlockContentDataviewFilter {
+	var filtered []*model.BlockContentDataviewFilter
+	for _, f := range filters {
+		if filter.IsGroup(f) {
+			if f.NestedFilters = removeRelationFilters(f.NestedFilters, relationKey); len(f.NestedFilters) == 0 {
+				continue
+			}
+		} else if f.RelationKey == relationKey {
+			continue
+		}
+		filtered = append(filtered, f)
+	}
+	return filtered
+}
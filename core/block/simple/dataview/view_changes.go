@@ -18,7 +18,11 @@ func diffViewFields(a, b *model.BlockContentDataviewView) *pb.EventBlockDataview
 		a.GroupRelationKey == b.GroupRelationKey &&
 		a.GroupBackgroundColors == b.GroupBackgroundColors &&
 		a.PageLimit == b.PageLimit &&
-		a.DefaultTemplateId == b.DefaultTemplateId
+		a.DefaultTemplateId == b.DefaultTemplateId &&
+		a.DateRelationKey == b.DateRelationKey &&
+		a.EndDateRelationKey == b.EndDateRelationKey &&
+		a.DependencyRelationKey == b.DependencyRelationKey &&
+		a.Query == b.Query
 
 	if isEqual {
 		return nil
@@ -34,6 +38,10 @@ func diffViewFields(a, b *model.BlockContentDataviewView) *pb.EventBlockDataview
 		GroupBackgroundColors: b.GroupBackgroundColors,
 		PageLimit:             b.PageLimit,
 		DefaultTemplateId:     b.DefaultTemplateId,
+		DateRelationKey:       b.DateRelationKey,
+		EndDateRelationKey:    b.EndDateRelationKey,
+		DependencyRelationKey: b.DependencyRelationKey,
+		Query:                 b.Query,
 	}
 }
 
@@ -234,6 +242,10 @@ func (l *Dataview) ApplyViewUpdate(upd *pb.EventBlockDataviewViewUpdate) {
 		view.GroupBackgroundColors = f.GroupBackgroundColors
 		view.PageLimit = f.PageLimit
 		view.DefaultTemplateId = f.DefaultTemplateId
+		view.DateRelationKey = f.DateRelationKey
+		view.EndDateRelationKey = f.EndDateRelationKey
+		view.DependencyRelationKey = f.DependencyRelationKey
+		view.Query = f.Query
 	}
 
 	{
@@ -3,11 +3,12 @@ package dataview
 import (
 	"github.com/globalsign/mgo/bson"
 
+	"github.com/anyproto/anytype-heart/pkg/lib/database/filter"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/slice"
 )
 
-func (l *Dataview) AddFilter(viewID string, filter *model.BlockContentDataviewFilter) error {
+func (l *Dataview) AddFilter(viewID string, f *model.BlockContentDataviewFilter) error {
 	l.resetObjectOrderForView(viewID)
 
 	view, err := l.GetView(viewID)
@@ -15,13 +16,20 @@ func (l *Dataview) AddFilter(viewID string, filter *model.BlockContentDataviewFi
 		return err
 	}
 
-	if filter.Id == "" {
-		filter.Id = bson.NewObjectId().Hex()
-	}
-	view.Filters = append(view.Filters, filter)
+	fillFilterIds(f)
+	view.Filters = append(view.Filters, f)
 	return nil
 }
 
+// fillFilterIds generates missing ids of filters including nested filters of groups
+func fillFilterIds(filters ...*model.BlockContentDataviewFilter) {
+	filter.Walk(filters, func(f *model.BlockContentDataviewFilter) {
+		if f.Id == "" {
+			f.Id = bson.NewObjectId().Hex()
+		}
+	})
+}
+
 func (l *Dataview) RemoveFilters(viewID string, filterIDs []string) error {
 	l.resetObjectOrderForView(viewID)
 
@@ -36,7 +44,7 @@ func (l *Dataview) RemoveFilters(viewID string, filterIDs []string) error {
 	return nil
 }
 
-func (l *Dataview) ReplaceFilter(viewID string, filterID string, filter *model.BlockContentDataviewFilter) error {
+func (l *Dataview) ReplaceFilter(viewID string, filterID string, f *model.BlockContentDataviewFilter) error {
 	l.resetObjectOrderForView(viewID)
 
 	view, err := l.GetView(viewID)
@@ -48,11 +56,12 @@ func (l *Dataview) ReplaceFilter(viewID string, filterID string, filter *model.B
 		return f.Id == filterID
 	})
 	if idx < 0 {
-		return l.AddFilter(viewID, filter)
+		return l.AddFilter(viewID, f)
 	}
 
-	filter.Id = filterID
-	view.Filters[idx] = filter
+	f.Id = filterID
+	fillFilterIds(f)
+	view.Filters[idx] = f
 
 	return nil
 }
@@ -1,7 +1,9 @@
 package latex
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/base"
@@ -10,6 +12,11 @@ import (
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
+var labelRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9:._-]*$`)
+
+// ErrInvalidLabel is returned for labels not matching the label pattern or already used by another equation
+var ErrInvalidLabel = errors.New("invalid equation label")
+
 func init() {
 	simple.RegisterCreator(NewLatex)
 }
@@ -27,6 +34,8 @@ func NewLatex(m *model.Block) simple.Block {
 type Block interface {
 	simple.Block
 	SetText(text string)
+	Label() string
+	SetLabel(label string) error
 	ApplyEvent(e *pb.EventBlockSetLatex) error
 }
 
@@ -45,8 +54,10 @@ func (l *Latex) Copy() simple.Block {
 	}
 }
 
-// Validate TODO: add validation rules
 func (l *Latex) Validate() error {
+	if label := l.Label(); label != "" && !labelRe.MatchString(label) {
+		return fmt.Errorf("%w: %q", ErrInvalidLabel, label)
+	}
 	return nil
 }
 
@@ -68,6 +79,11 @@ func (l *Latex) Diff(b simple.Block) (msgs []simple.EventMessage, err error) {
 		changes.Text = &pb.EventBlockSetLatexText{Value: latex.content.Text}
 	}
 
+	if l.content.Label != latex.content.Label {
+		hasChanges = true
+		changes.Label = &pb.EventBlockSetLatexLabel{Value: latex.content.Label}
+	}
+
 	if hasChanges {
 		msgs = append(msgs, simple.EventMessage{Msg: &pb.EventMessage{Value: &pb.EventMessageValueOfBlockSetLatex{BlockSetLatex: changes}}})
 	}
@@ -78,9 +94,25 @@ func (r *Latex) SetText(text string) {
 	r.content.Text = text
 }
 
+// Label returns the equation label, empty label means the equation is not numbered
+func (l *Latex) Label() string {
+	return l.content.Label
+}
+
+func (l *Latex) SetLabel(label string) error {
+	if label != "" && !labelRe.MatchString(label) {
+		return fmt.Errorf("%w: %q", ErrInvalidLabel, label)
+	}
+	l.content.Label = label
+	return nil
+}
+
 func (l *Latex) ApplyEvent(e *pb.EventBlockSetLatex) error {
 	if e.Text != nil {
 		l.content.Text = e.Text.GetValue()
 	}
+	if e.Label != nil {
+		l.content.Label = e.Label.GetValue()
+	}
 	return nil
 }
@@ -3,6 +3,7 @@ package latex
 import (
 	"testing"
 
+	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/base"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -52,4 +53,86 @@ func TestLatex_Diff(t *testing.T) {
 		assert.NotNil(t, change.Text)
 		assert.Equal(t, "42", change.Text.Value)
 	})
+	t.Run("label diff", func(t *testing.T) {
+		b1 := testBlock()
+		b2 := testBlock()
+		b2.content.Label = "eq:1"
+
+		diff, err := b1.Diff(b2)
+		require.NoError(t, err)
+		require.Len(t, diff, 1)
+		change := diff[0].Msg.Value.(*pb.EventMessageValueOfBlockSetLatex).BlockSetLatex
+		assert.Nil(t, change.Text)
+		require.NotNil(t, change.Label)
+		assert.Equal(t, "eq:1", change.Label.Value)
+
+		require.NoError(t, b1.ApplyEvent(change))
+		assert.Equal(t, "eq:1", b1.Label())
+	})
+}
+
+func TestLatex_SetLabel(t *testing.T) {
+	l := NewLatex(&model.Block{
+		Content: &model.BlockContentOfLatex{Latex: &model.BlockContentLatex{}},
+	}).(*Latex)
+
+	require.NoError(t, l.SetLabel("eq:energy"))
+	assert.Equal(t, "eq:energy", l.Label())
+	assert.NoError(t, l.Validate())
+
+	assert.ErrorIs(t, l.SetLabel("1 bad label"), ErrInvalidLabel)
+	assert.Equal(t, "eq:energy", l.Label())
+
+	require.NoError(t, l.SetLabel(""))
+	assert.Empty(t, l.Label())
+}
+
+type blocksIterator []simple.Block
+
+func (bi blocksIterator) Iterate(f func(b simple.Block) (isContinue bool)) error {
+	for _, b := range bi {
+		if !f(b) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func TestNewNumbering(t *testing.T) {
+	newLatex := func(id, label string) simple.Block {
+		l := NewLatex(&model.Block{
+			Id:      id,
+			Content: &model.BlockContentOfLatex{Latex: &model.BlockContentLatex{}},
+		}).(*Latex)
+		require.NoError(t, l.SetLabel(label))
+		return l
+	}
+	n, err := NewNumbering(blocksIterator{
+		newLatex("1", "first"),
+		base.NewBase(&model.Block{Id: "text"}),
+		newLatex("2", ""),
+		newLatex("3", "second"),
+		newLatex("4", "first"),
+	})
+	require.NoError(t, err)
+
+	num, ok := n.Number("1")
+	assert.True(t, ok)
+	assert.Equal(t, 1, num)
+	_, ok = n.Number("2")
+	assert.False(t, ok)
+	num, ok = n.Number("3")
+	assert.True(t, ok)
+	assert.Equal(t, 2, num)
+	_, ok = n.Number("4")
+	assert.False(t, ok)
+
+	label, num, ok := n.Ref(RefParam("second"))
+	assert.True(t, ok)
+	assert.Equal(t, "second", label)
+	assert.Equal(t, 2, num)
+	_, _, ok = n.Ref(RefParam("unknown"))
+	assert.False(t, ok)
+	_, _, ok = n.Ref("https://second")
+	assert.False(t, ok)
 }
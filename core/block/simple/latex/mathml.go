@@ -0,0 +1,343 @@
+package latex
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+var mathIdentifiers = map[string]string{
+	"alpha": "α", "beta": "β", "gamma": "γ", "delta": "δ", "epsilon": "ϵ", "varepsilon": "ε",
+	"zeta": "ζ", "eta": "η", "theta": "θ", "vartheta": "ϑ", "iota": "ι", "kappa": "κ",
+	"lambda": "λ", "mu": "μ", "nu": "ν", "xi": "ξ", "pi": "π", "varpi": "ϖ", "rho": "ρ",
+	"varrho": "ϱ", "sigma": "σ", "varsigma": "ς", "tau": "τ", "upsilon": "υ", "phi": "ϕ",
+	"varphi": "φ", "chi": "χ", "psi": "ψ", "omega": "ω",
+	"Gamma": "Γ", "Delta": "Δ", "Theta": "Θ", "Lambda": "Λ", "Xi": "Ξ", "Pi": "Π",
+	"Sigma": "Σ", "Upsilon": "Υ", "Phi": "Φ", "Psi": "Ψ", "Omega": "Ω",
+	"infty": "∞", "partial": "∂", "nabla": "∇", "hbar": "ℏ", "ell": "ℓ", "emptyset": "∅",
+}
+
+var mathOperators = map[string]string{
+	"sum": "∑", "prod": "∏", "coprod": "∐", "int": "∫", "iint": "∬", "iiint": "∭", "oint": "∮",
+	"bigcup": "⋃", "bigcap": "⋂",
+	"pm": "±", "mp": "∓", "times": "×", "div": "÷", "cdot": "⋅", "ast": "∗", "circ": "∘",
+	"leq": "≤", "le": "≤", "geq": "≥", "ge": "≥", "neq": "≠", "ne": "≠", "approx": "≈",
+	"equiv": "≡", "sim": "∼", "simeq": "≃", "cong": "≅", "propto": "∝", "ll": "≪", "gg": "≫",
+	"in": "∈", "notin": "∉", "ni": "∋", "subset": "⊂", "supset": "⊃", "subseteq": "⊆",
+	"supseteq": "⊇", "cup": "∪", "cap": "∩", "setminus": "∖", "forall": "∀", "exists": "∃",
+	"neg": "¬", "land": "∧", "wedge": "∧", "lor": "∨", "vee": "∨", "oplus": "⊕", "otimes": "⊗",
+	"to": "→", "rightarrow": "→", "leftarrow": "←", "Rightarrow": "⇒", "Leftarrow": "⇐",
+	"leftrightarrow": "↔", "Leftrightarrow": "⇔", "mapsto": "↦", "implies": "⟹", "iff": "⟺",
+	"cdots": "⋯", "ldots": "…", "dots": "…", "vdots": "⋮", "ddots": "⋱",
+	"langle": "⟨", "rangle": "⟩", "lfloor": "⌊", "rfloor": "⌋", "lceil": "⌈", "rceil": "⌉",
+	"{": "{", "}": "}", "|": "‖", "mid": "∣", "perp": "⊥", "parallel": "∥", "angle": "∠",
+}
+
+var mathFunctions = map[string]bool{
+	"sin": true, "cos": true, "tan": true, "cot": true, "sec": true, "csc": true,
+	"arcsin": true, "arccos": true, "arctan": true, "sinh": true, "cosh": true, "tanh": true,
+	"log": true, "ln": true, "lg": true, "exp": true, "det": true, "dim": true, "ker": true,
+	"gcd": true, "deg": true, "arg": true, "max": true, "min": true, "sup": true, "inf": true,
+	"lim": true, "Pr": true,
+}
+
+// operators whose limits are placed under and over them in display mode
+var mathLimits = map[string]bool{
+	"sum": true, "prod": true, "coprod": true, "bigcup": true, "bigcap": true,
+	"lim": true, "max": true, "min": true, "sup": true, "inf": true,
+}
+
+var mathSpaces = map[string]string{
+	",": "0.167em", ":": "0.222em", ";": "0.278em", "!": "-0.167em", " ": "0.25em",
+	"quad": "1em", "qquad": "2em",
+}
+
+var mathAccents = map[string]string{
+	"hat": "^", "bar": "¯", "overline": "¯", "vec": "→", "tilde": "~", "dot": "˙", "ddot": "¨",
+}
+
+// ToMathML converts a LaTeX formula to a presentation MathML element.
+// Only the common subset of LaTeX math is supported, unknown commands are rendered as merror
+func ToMathML(text string, display bool) string {
+	p := &mathParser{src: []rune(text)}
+	var buf strings.Builder
+	buf.WriteString(`<math xmlns="http://www.w3.org/1998/Math/MathML"`)
+	if display {
+		buf.WriteString(` display="block"`)
+	}
+	buf.WriteString(`><mrow>`)
+	buf.WriteString(p.parseSeq(0))
+	buf.WriteString(`</mrow></math>`)
+	return buf.String()
+}
+
+type mathParser struct {
+	src       []rune
+	pos       int
+	leftDepth int
+}
+
+func (p *mathParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *mathParser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// parseSeq parses elements until the end of input or the closing rune
+func (p *mathParser) parseSeq(closing rune) string {
+	var buf strings.Builder
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return buf.String()
+		}
+		if closing != 0 && p.src[p.pos] == closing {
+			p.pos++
+			return buf.String()
+		}
+		if p.leftDepth > 0 && p.peekCommand("right") {
+			return buf.String()
+		}
+		buf.WriteString(p.parseScripts())
+	}
+}
+
+func (p *mathParser) peekCommand(name string) bool {
+	if p.eof() || p.src[p.pos] != '\\' {
+		return false
+	}
+	end := p.pos + 1 + len([]rune(name))
+	if end > len(p.src) || string(p.src[p.pos+1:end]) != name {
+		return false
+	}
+	return end == len(p.src) || !unicode.IsLetter(p.src[end])
+}
+
+// parseScripts parses an atom with optional subscript and superscript
+func (p *mathParser) parseScripts() string {
+	base, limits := p.parseAtom()
+	var sub, sup string
+	var hasSub, hasSup bool
+	for {
+		p.skipSpace()
+		if p.eof() {
+			break
+		}
+		switch p.src[p.pos] {
+		case '_':
+			p.pos++
+			sub, _ = p.parseArg()
+			hasSub = true
+			continue
+		case '^':
+			p.pos++
+			sup, _ = p.parseArg()
+			hasSup = true
+			continue
+		}
+		break
+	}
+	if base == "" {
+		if !hasSub && !hasSup {
+			return ""
+		}
+		base = "<mrow></mrow>"
+	}
+	under, over, both := "msub", "msup", "msubsup"
+	if limits {
+		under, over, both = "munder", "mover", "munderover"
+	}
+	switch {
+	case hasSub && hasSup:
+		return "<" + both + ">" + base + sub + sup + "</" + both + ">"
+	case hasSub:
+		return "<" + under + ">" + base + sub + "</" + under + ">"
+	case hasSup:
+		return "<" + over + ">" + base + sup + "</" + over + ">"
+	}
+	return base
+}
+
+// parseArg parses a single argument: a braced group or a single atom
+func (p *mathParser) parseArg() (string, bool) {
+	p.skipSpace()
+	if p.eof() {
+		return "<mrow></mrow>", false
+	}
+	if p.src[p.pos] == '{' {
+		p.pos++
+		return "<mrow>" + p.parseSeq('}') + "</mrow>", true
+	}
+	atom, _ := p.parseAtom()
+	return atom, true
+}
+
+// parseRawGroup returns the raw text of a braced group
+func (p *mathParser) parseRawGroup() string {
+	p.skipSpace()
+	if p.eof() || p.src[p.pos] != '{' {
+		return ""
+	}
+	p.pos++
+	start, depth := p.pos, 1
+	for !p.eof() {
+		switch p.src[p.pos] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				text := string(p.src[start:p.pos])
+				p.pos++
+				return text
+			}
+		}
+		p.pos++
+	}
+	return string(p.src[start:])
+}
+
+func (p *mathParser) parseAtom() (res string, limits bool) {
+	r := p.src[p.pos]
+	switch {
+	case r == '{':
+		p.pos++
+		return "<mrow>" + p.parseSeq('}') + "</mrow>", false
+	case r == '\\':
+		return p.parseCommand()
+	case unicode.IsDigit(r) || r == '.' && p.pos+1 < len(p.src) && unicode.IsDigit(p.src[p.pos+1]):
+		start := p.pos
+		for !p.eof() && (unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		return "<mn>" + string(p.src[start:p.pos]) + "</mn>", false
+	case unicode.IsLetter(r):
+		p.pos++
+		return "<mi>" + string(r) + "</mi>", false
+	case r == '}':
+		// unbalanced brace
+		p.pos++
+		return "", false
+	case r == '&':
+		p.pos++
+		return "<mspace width=\"1em\"/>", false
+	}
+	p.pos++
+	if r == '\'' {
+		return "<mo>′</mo>", false
+	}
+	return "<mo>" + html.EscapeString(string(r)) + "</mo>", false
+}
+
+func (p *mathParser) parseCommand() (res string, limits bool) {
+	p.pos++ // backslash
+	if p.eof() {
+		return "", false
+	}
+	start := p.pos
+	if unicode.IsLetter(p.src[p.pos]) {
+		for !p.eof() && unicode.IsLetter(p.src[p.pos]) {
+			p.pos++
+		}
+	} else {
+		p.pos++
+	}
+	name := string(p.src[start:p.pos])
+
+	if v, ok := mathIdentifiers[name]; ok {
+		return "<mi>" + v + "</mi>", false
+	}
+	if v, ok := mathOperators[name]; ok {
+		return "<mo>" + html.EscapeString(v) + "</mo>", mathLimits[name]
+	}
+	if mathFunctions[name] {
+		return "<mi>" + name + "</mi>", mathLimits[name]
+	}
+	if v, ok := mathSpaces[name]; ok {
+		return `<mspace width="` + v + `"/>`, false
+	}
+	if v, ok := mathAccents[name]; ok {
+		arg, _ := p.parseArg()
+		return `<mover accent="true">` + arg + "<mo>" + v + "</mo></mover>", false
+	}
+	switch name {
+	case "frac", "dfrac", "tfrac":
+		num, _ := p.parseArg()
+		den, _ := p.parseArg()
+		return "<mfrac>" + num + den + "</mfrac>", false
+	case "binom":
+		n, _ := p.parseArg()
+		k, _ := p.parseArg()
+		return `<mrow><mo>(</mo><mfrac linethickness="0">` + n + k + `</mfrac><mo>)</mo></mrow>`, false
+	case "sqrt":
+		p.skipSpace()
+		if !p.eof() && p.src[p.pos] == '[' {
+			p.pos++
+			index := p.parseSeq(']')
+			arg, _ := p.parseArg()
+			return "<mroot>" + arg + "<mrow>" + index + "</mrow></mroot>", false
+		}
+		arg, _ := p.parseArg()
+		return "<msqrt>" + arg + "</msqrt>", false
+	case "text", "textrm", "mathrm", "operatorname":
+		return "<mtext>" + html.EscapeString(p.parseRawGroup()) + "</mtext>", false
+	case "mathbf", "boldsymbol":
+		return `<mstyle mathvariant="bold">` + p.mustArg() + "</mstyle>", false
+	case "mathit":
+		return `<mstyle mathvariant="italic">` + p.mustArg() + "</mstyle>", false
+	case "mathbb":
+		return `<mstyle mathvariant="double-struck">` + p.mustArg() + "</mstyle>", false
+	case "mathcal":
+		return `<mstyle mathvariant="script">` + p.mustArg() + "</mstyle>", false
+	case "left":
+		open := p.parseDelimiter()
+		p.leftDepth++
+		inner := p.parseSeq(0)
+		p.leftDepth--
+		var closeDelim string
+		if p.peekCommand("right") {
+			p.pos += len("\\right")
+			closeDelim = p.parseDelimiter()
+		}
+		return "<mrow>" + open + inner + closeDelim + "</mrow>", false
+	case "right":
+		// unbalanced \right, consume the delimiter
+		return p.parseDelimiter(), false
+	case "label", "tag", "nonumber", "notag":
+		// numbering is handled by the block label
+		if name == "label" || name == "tag" {
+			p.parseRawGroup()
+		}
+		return "", false
+	case "\\":
+		return `<mspace linebreak="newline"/>`, false
+	}
+	return "<merror><mtext>\\" + html.EscapeString(name) + "</mtext></merror>", false
+}
+
+func (p *mathParser) mustArg() string {
+	arg, _ := p.parseArg()
+	return arg
+}
+
+func (p *mathParser) parseDelimiter() string {
+	p.skipSpace()
+	if p.eof() {
+		return ""
+	}
+	r := p.src[p.pos]
+	if r == '.' {
+		p.pos++
+		return ""
+	}
+	if r == '\\' {
+		res, _ := p.parseCommand()
+		return res
+	}
+	p.pos++
+	return "<mo>" + html.EscapeString(string(r)) + "</mo>"
+}
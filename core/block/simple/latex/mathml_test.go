@@ -0,0 +1,43 @@
+package latex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToMathML(t *testing.T) {
+	for _, tc := range []struct {
+		latex   string
+		display bool
+		mathml  string
+	}{
+		{
+			latex:  "E=mc^2",
+			mathml: `<math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><mi>E</mi><mo>=</mo><mi>m</mi><msup><mi>c</mi><mn>2</mn></msup></mrow></math>`,
+		},
+		{
+			latex:   `\frac{a}{b}`,
+			display: true,
+			mathml:  `<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><mrow><mfrac><mrow><mi>a</mi></mrow><mrow><mi>b</mi></mrow></mfrac></mrow></math>`,
+		},
+		{
+			latex:  `\sum_{i=1}^n x_i`,
+			mathml: `<math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><munderover><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>1</mn></mrow><mi>n</mi></munderover><msub><mi>x</mi><mi>i</mi></msub></mrow></math>`,
+		},
+		{
+			latex:  `\sqrt[3]{\alpha} < 1.5`,
+			mathml: `<math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><mroot><mrow><mi>α</mi></mrow><mrow><mn>3</mn></mrow></mroot><mo>&lt;</mo><mn>1.5</mn></mrow></math>`,
+		},
+		{
+			latex:  `\left( x \right) \label{eq:x}`,
+			mathml: `<math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><mrow><mo>(</mo><mi>x</mi><mo>)</mo></mrow></mrow></math>`,
+		},
+		{
+			latex:  `\text{if } \unknown`,
+			mathml: `<math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><mtext>if </mtext><merror><mtext>\unknown</mtext></merror></mrow></math>`,
+		},
+	} {
+		assert.Equal(t, tc.mathml, ToMathML(tc.latex, tc.display), tc.latex)
+	}
+}
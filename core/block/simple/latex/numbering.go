@@ -0,0 +1,86 @@
+package latex
+
+import (
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/simple"
+)
+
+// RefPrefix starts the param of a link mark that references a labeled equation, e.g. "#eq:energy"
+const RefPrefix = "#"
+
+// Iterator walks blocks in document order, *state.State satisfies it
+type Iterator interface {
+	Iterate(f func(b simple.Block) (isContinue bool)) (err error)
+}
+
+// Numbering holds sequential numbers of labeled equations within one object
+type Numbering struct {
+	byLabel map[string]int
+	byBlock map[string]int
+}
+
+// NewNumbering numbers labeled equations in document order starting from 1.
+// When the same label is used more than once only the first equation gets the number
+func NewNumbering(it Iterator) (*Numbering, error) {
+	n := &Numbering{
+		byLabel: map[string]int{},
+		byBlock: map[string]int{},
+	}
+	err := it.Iterate(func(b simple.Block) (isContinue bool) {
+		l, ok := b.(Block)
+		if !ok {
+			return true
+		}
+		label := l.Label()
+		if label == "" {
+			return true
+		}
+		if _, exists := n.byLabel[label]; exists {
+			return true
+		}
+		num := len(n.byLabel) + 1
+		n.byLabel[label] = num
+		n.byBlock[b.Model().Id] = num
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// Number returns the number of the equation block
+func (n *Numbering) Number(blockId string) (num int, ok bool) {
+	if n == nil {
+		return
+	}
+	num, ok = n.byBlock[blockId]
+	return
+}
+
+// NumberByLabel returns the number of the equation with the given label
+func (n *Numbering) NumberByLabel(label string) (num int, ok bool) {
+	if n == nil {
+		return
+	}
+	num, ok = n.byLabel[label]
+	return
+}
+
+// Ref resolves the param of a link mark to the referenced label and its number
+func (n *Numbering) Ref(param string) (label string, num int, ok bool) {
+	if !strings.HasPrefix(param, RefPrefix) {
+		return
+	}
+	label = strings.TrimPrefix(param, RefPrefix)
+	if num, ok = n.NumberByLabel(label); !ok {
+		return "", 0, false
+	}
+	return
+}
+
+// RefParam builds the link mark param referencing the equation label
+func RefParam(label string) string {
+	return RefPrefix + label
+}
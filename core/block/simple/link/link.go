@@ -2,6 +2,8 @@ package link
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/base"
 	"github.com/anyproto/anytype-heart/pb"
@@ -13,10 +15,29 @@ import (
 	"github.com/gogo/protobuf/types"
 )
 
+// BlockRefSeparator separates the object id and the block id in the param of a mention mark referencing a block, e.g. "objectId#blockId"
+const BlockRefSeparator = "#"
+
 func init() {
 	simple.RegisterCreator(NewLink)
 }
 
+// BlockRef builds the mention mark param referencing the block inside the object
+func BlockRef(objectId, blockId string) string {
+	if blockId == "" {
+		return objectId
+	}
+	return objectId + BlockRefSeparator + blockId
+}
+
+// ParseBlockRef splits the mention mark param to the object id and the optional block id
+func ParseBlockRef(param string) (objectId, blockId string) {
+	if i := strings.Index(param, BlockRefSeparator); i > 0 {
+		return param[:i], param[i+len(BlockRefSeparator):]
+	}
+	return param, ""
+}
+
 func NewLink(m *model.Block) simple.Block {
 	if link := m.GetLink(); link != nil {
 		return &Link{
@@ -34,6 +55,8 @@ type Block interface {
 	ApplyEvent(e *pb.EventBlockSetLink) error
 	ToText(targetDetails *types.Struct) simple.Block
 	SetAppearance(content *model.BlockContentLink) error
+	// TargetSubBlockId returns the id of the embedded block of the target object, non-empty for synced blocks
+	TargetSubBlockId() string
 }
 
 type Link struct {
@@ -106,6 +129,11 @@ func (l *Link) Diff(b simple.Block) (msgs []simple.EventMessage, err error) {
 		changes.Relations = &pb.EventBlockSetLinkRelations{Value: link.content.Relations}
 	}
 
+	if l.content.TargetSubBlockId != link.content.TargetSubBlockId {
+		hasChanges = true
+		changes.TargetSubBlockId = &pb.EventBlockSetLinkTargetSubBlockId{Value: link.content.TargetSubBlockId}
+	}
+
 	if hasChanges {
 		msgs = append(msgs, simple.EventMessage{Msg: &pb.EventMessage{Value: &pb.EventMessageValueOfBlockSetLink{BlockSetLink: changes}}})
 	}
@@ -147,9 +175,17 @@ func (l *Link) ApplyEvent(e *pb.EventBlockSetLink) error {
 		l.content.Relations = e.Relations.GetValue()
 	}
 
+	if e.TargetSubBlockId != nil {
+		l.content.TargetSubBlockId = e.TargetSubBlockId.GetValue()
+	}
+
 	return nil
 }
 
+func (l *Link) TargetSubBlockId() string {
+	return l.content.TargetSubBlockId
+}
+
 func (l *Link) ToText(targetDetails *types.Struct) simple.Block {
 	tb := &model.BlockContentText{}
 	if l.content.TargetBlockId != "" {
@@ -163,7 +199,7 @@ func (l *Link) ToText(targetDetails *types.Struct) simple.Block {
 				{
 					Range: &model.Range{0, int32(text.UTF16RuneCountString(name))},
 					Type:  model.BlockContentTextMark_Mention,
-					Param: l.content.TargetBlockId,
+					Param: BlockRef(l.content.TargetBlockId, l.content.TargetSubBlockId),
 				},
 			},
 		}
@@ -55,6 +55,21 @@ func TestLink_Diff(t *testing.T) {
 		assert.NotNil(t, change.TargetBlockId)
 		assert.NotNil(t, change.Style)
 	})
+	t.Run("sub block diff", func(t *testing.T) {
+		b1 := testBlock()
+		b2 := testBlock()
+		b2.content.TargetSubBlockId = "blockId"
+
+		diff, err := b1.Diff(b2)
+		require.NoError(t, err)
+		require.Len(t, diff, 1)
+		change := diff[0].Msg.Value.(*pb.EventMessageValueOfBlockSetLink).BlockSetLink
+		require.NotNil(t, change.TargetSubBlockId)
+		assert.Equal(t, "blockId", change.TargetSubBlockId.Value)
+
+		require.NoError(t, b1.ApplyEvent(change))
+		assert.Equal(t, "blockId", b1.TargetSubBlockId())
+	})
 }
 
 func TestLink_ToText(t *testing.T) {
@@ -73,7 +88,7 @@ func TestLink_ToText(t *testing.T) {
 		assert.Equal(t, "target name", textModel.Text)
 		require.Len(t, textModel.Marks.Marks, 1)
 		assert.Equal(t, "targetId", textModel.Marks.Marks[0].Param)
-		assert.Equal(t, &model.Range{0, 11}, textModel.Marks.Marks[0].Range)
+		assert.Equal(t, &model.Range{From: 0, To: 11}, textModel.Marks.Marks[0].Range)
 	})
 	t.Run("with empty name", func(t *testing.T) {
 		b := NewLink(&model.Block{
@@ -86,8 +101,29 @@ func TestLink_ToText(t *testing.T) {
 		assert.Equal(t, "Untitled", textModel.Text)
 		require.Len(t, textModel.Marks.Marks, 1)
 		assert.Equal(t, "targetId", textModel.Marks.Marks[0].Param)
-		assert.Equal(t, &model.Range{0, 8}, textModel.Marks.Marks[0].Range)
+		assert.Equal(t, &model.Range{From: 0, To: 8}, textModel.Marks.Marks[0].Range)
 	})
+	t.Run("synced block", func(t *testing.T) {
+		b := NewLink(&model.Block{
+			Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "targetId", TargetSubBlockId: "blockId"}},
+		}).(*Link)
+		tb := b.ToText(nil)
+		require.NotNil(t, tb)
+		textModel := tb.Model().GetText()
+		require.Len(t, textModel.Marks.Marks, 1)
+		assert.Equal(t, "targetId#blockId", textModel.Marks.Marks[0].Param)
+	})
+}
+
+func TestParseBlockRef(t *testing.T) {
+	objectId, blockId := ParseBlockRef(BlockRef("objectId", "blockId"))
+	assert.Equal(t, "objectId", objectId)
+	assert.Equal(t, "blockId", blockId)
+
+	objectId, blockId = ParseBlockRef("objectId")
+	assert.Equal(t, "objectId", objectId)
+	assert.Empty(t, blockId)
+	assert.Equal(t, "objectId", BlockRef("objectId", ""))
 }
 
 func TestLink_Validate(t *testing.T) {
@@ -0,0 +1,74 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/base"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func init() {
+	simple.RegisterCreator(NewColumnBlock)
+}
+
+func NewColumnBlock(b *model.Block) simple.Block {
+	if c := b.GetTableColumn(); c != nil {
+		return &columnBlock{
+			Base:    base.NewBase(b).(*base.Base),
+			content: c,
+		}
+	}
+	return nil
+}
+
+type ColumnBlock interface {
+	simple.Block
+	ApplyEvent(e *pb.EventBlockSetTableColumn) (err error)
+	Type() model.BlockContentTableColumnType
+	SetType(t model.BlockContentTableColumnType)
+}
+
+type columnBlock struct {
+	*base.Base
+	content *model.BlockContentTableColumn
+}
+
+func (b *columnBlock) Copy() simple.Block {
+	return NewColumnBlock(pbtypes.CopyBlock(b.Model()))
+}
+
+func (b *columnBlock) Type() model.BlockContentTableColumnType {
+	return b.content.Type
+}
+
+func (b *columnBlock) SetType(t model.BlockContentTableColumnType) {
+	b.content.Type = t
+}
+
+func (b *columnBlock) Diff(sb simple.Block) (msgs []simple.EventMessage, err error) {
+	other, ok := sb.(*columnBlock)
+	if !ok {
+		return nil, fmt.Errorf("can't make diff with different block type")
+	}
+	if msgs, err = b.Base.Diff(other); err != nil {
+		return
+	}
+	if b.content.Type != other.content.Type {
+		changes := &pb.EventBlockSetTableColumn{
+			Id:   other.Id,
+			Type: &pb.EventBlockSetTableColumnType{Value: other.content.Type},
+		}
+		msgs = append(msgs, simple.EventMessage{Msg: &pb.EventMessage{Value: &pb.EventMessageValueOfBlockSetTableColumn{BlockSetTableColumn: changes}}})
+	}
+	return
+}
+
+func (b *columnBlock) ApplyEvent(e *pb.EventBlockSetTableColumn) (err error) {
+	if e.Type != nil {
+		b.content.Type = e.Type.GetValue()
+	}
+	return
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/base"
+	"github.com/anyproto/anytype-heart/core/block/simple/link"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
@@ -641,7 +642,8 @@ func (t *Text) FillSmartIds(ids []string) []string {
 		for _, m := range t.content.Marks.Marks {
 			if (m.Type == model.BlockContentTextMark_Mention ||
 				m.Type == model.BlockContentTextMark_Object) && m.Param != "" {
-				ids = append(ids, m.Param)
+				objectId, _ := link.ParseBlockRef(m.Param)
+				ids = append(ids, objectId)
 			}
 		}
 	}
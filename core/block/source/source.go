@@ -230,6 +230,7 @@ func (s *source) PushChange(params PushChangeParams) (id string, err error) {
 	c := &pb.Change{
 		Timestamp: params.Time.Unix(),
 		Version:   params.State.MigrationVersion(),
+		DeviceId:  s.accountService.Account().PeerId,
 	}
 	if params.DoSnapshot || s.needSnapshot() || len(params.Changes) == 0 {
 		c.Snapshot = &pb.ChangeSnapshot{
@@ -327,6 +328,49 @@ func (s *source) iterate(startId string, iterFunc objecttree.ChangeIterateFunc)
 	return s.ObjectTree.IterateFrom(startId, unmarshall, iterFunc)
 }
 
+// ChangesAfter returns changes of the tree which are neither the heads nor their ancestors, in the order of the tree.
+// Models of the changes are *pb.Change. All changes of the tree are returned when heads are not set
+func ChangesAfter(ot objecttree.ReadableObjectTree, heads []string) ([]*objecttree.Change, error) {
+	unmarshall := func(decrypted []byte) (res any, err error) {
+		ch := &pb.Change{}
+		err = proto.Unmarshal(decrypted, ch)
+		res = ch
+		return
+	}
+	var (
+		changes []*objecttree.Change
+		byId    = map[string]*objecttree.Change{}
+	)
+	err := ot.IterateRoot(unmarshall, func(c *objecttree.Change) (isContinue bool) {
+		changes = append(changes, c)
+		byId[c.Id] = c
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	applied := map[string]struct{}{}
+	for queue := heads; len(queue) > 0; {
+		id := queue[0]
+		queue = queue[1:]
+		if _, ok := applied[id]; ok {
+			continue
+		}
+		applied[id] = struct{}{}
+		if c, ok := byId[id]; ok {
+			queue = append(queue, c.PreviousIds...)
+		}
+	}
+	res := changes[:0]
+	for _, c := range changes {
+		if _, ok := applied[c.Id]; !ok {
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
 func (s *source) getFileHashesForSnapshot(changeHashes []string) []*pb.ChangeFileKeys {
 	fileKeys := s.getFileKeysByHashes(changeHashes)
 	var uniqKeys = make(map[string]struct{})
@@ -0,0 +1,134 @@
+package block
+
+import (
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/syncedblock"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	syncedBlocksHookId       = "syncedBlocks"
+	syncedBlocksSourceHookId = "syncedBlocksSource"
+)
+
+// watchSyncedBlocks registers synced blocks of the opened object and returns their references.
+// Must be called under the object lock
+func (s *Service) watchSyncedBlocks(ob smartblock.SmartBlock) []syncedblock.Ref {
+	id := ob.Id()
+	ob.AddHookOnce(syncedBlocksHookId, func(info smartblock.ApplyInfo) error {
+		// references of closed objects are not tracked by the registry
+		if added := s.syncedBlocks.Set(id, syncedblock.CollectRefs(info.State)); len(added) > 0 {
+			go s.sendSyncedBlocks(id, added)
+		}
+		return nil
+	}, smartblock.HookAfterApply)
+	ob.AddHookOnce(syncedBlocksHookId+"Close", func(_ smartblock.ApplyInfo) error {
+		s.syncedBlocks.Remove(id)
+		return nil
+	}, smartblock.HookOnBlockClose, smartblock.HookOnClose)
+
+	refs := syncedblock.CollectRefs(ob.NewState())
+	s.syncedBlocks.Watch(id, refs)
+	return refs
+}
+
+// fetchSyncedBlocks collects referenced blocks from the source objects and starts watching changes of the sources.
+// Must not be called under the lock of the embedding object, because source objects could embed it as well
+func (s *Service) fetchSyncedBlocks(embedderId string, refs []syncedblock.Ref) (res []*model.ObjectViewSyncedBlocks) {
+	sourceIds, blockIds := syncedblock.GroupBySource(refs)
+	for _, sourceId := range sourceIds {
+		err := s.Do(sourceId, func(b smartblock.SmartBlock) error {
+			s.addSyncedBlocksSourceHook(b)
+			blocks := syncedblock.Blocks(b.NewState(), blockIds[sourceId])
+			s.syncedBlocks.SetBlocks(embedderId, sourceId, blocksIds(blocks))
+			res = append(res, &model.ObjectViewSyncedBlocks{
+				ObjectId: sourceId,
+				Blocks:   syncedblock.Namespace(sourceId, blocks),
+			})
+			return nil
+		})
+		if err != nil {
+			log.With("objectID", sourceId).Errorf("failed to get synced blocks: %v", err)
+		}
+	}
+	return
+}
+
+func (s *Service) sendSyncedBlocks(embedderId string, refs []syncedblock.Ref) {
+	for _, synced := range s.fetchSyncedBlocks(embedderId, refs) {
+		s.sendSyncedBlocksSet(embedderId, synced.ObjectId, synced.Blocks)
+	}
+}
+
+func (s *Service) sendSyncedBlocksSet(embedderId, sourceId string, blocks []*model.Block) {
+	s.sendEvent(&pb.Event{
+		ContextId: embedderId,
+		Messages: []*pb.EventMessage{{
+			Value: &pb.EventMessageValueOfObjectSyncedBlocksSet{
+				ObjectSyncedBlocksSet: &pb.EventObjectSyncedBlocksSet{
+					Id:       embedderId,
+					ObjectId: sourceId,
+					Blocks:   blocks,
+				},
+			},
+		}},
+	})
+}
+
+// SyncedBlockSource returns the id of the source object when all blocks are blocks of one source object shown by
+// synced blocks of the opened object, so edits of these blocks are applied to the source object. In this case block ids
+// are replaced with ids of the blocks in the source object. Otherwise, the id of the object itself is returned and
+// block ids are kept as is. Empty block ids aren't routed
+func (s *Service) SyncedBlockSource(contextId string, blockIds ...*string) string {
+	ids := make([]string, 0, len(blockIds))
+	for _, id := range blockIds {
+		ids = append(ids, *id)
+	}
+	sourceId, sourceBlockIds, ok := syncedblock.SourceBlockIds(ids)
+	if !ok || !s.syncedBlocks.Shows(contextId, sourceId, sourceBlockIds) {
+		return contextId
+	}
+	for i, id := range blockIds {
+		*id = sourceBlockIds[i]
+	}
+	return sourceId
+}
+
+// SyncedBlockId returns the id of the block created in the source object as it is shown in the embedding object
+func SyncedBlockId(contextId, sourceId, blockId string) string {
+	if contextId == sourceId || blockId == "" {
+		return blockId
+	}
+	return syncedblock.BlockId(sourceId, blockId)
+}
+
+// addSyncedBlocksSourceHook sends changes of the blocks embedded by opened objects to the embedding objects.
+// Changes of the blocks are sent with namespaced block ids, structure changes of the embedded blocks are sent
+// as the full set of the blocks
+func (s *Service) addSyncedBlocksSourceHook(sb smartblock.SmartBlock) {
+	sourceId := sb.Id()
+	sb.AddHookOnce(syncedBlocksSourceHookId, func(info smartblock.ApplyInfo) error {
+		for embedderId, blockIds := range s.syncedBlocks.Embedders(sourceId) {
+			blocks := syncedblock.Blocks(info.State, blockIds)
+			s.syncedBlocks.SetBlocks(embedderId, sourceId, blocksIds(blocks))
+			msgs, resync := syncedblock.FilterEvents(info.State, blockIds, info.Events)
+			if resync {
+				s.sendSyncedBlocksSet(embedderId, sourceId, syncedblock.Namespace(sourceId, blocks))
+				continue
+			}
+			if len(msgs) > 0 {
+				s.sendEvent(&pb.Event{ContextId: embedderId, Messages: msgs})
+			}
+		}
+		return nil
+	}, smartblock.HookAfterApply)
+}
+
+func blocksIds(blocks []*model.Block) []string {
+	ids := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		ids = append(ids, b.Id)
+	}
+	return ids
+}
@@ -0,0 +1,45 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/syncedblock"
+)
+
+func TestSyncedBlockSource(t *testing.T) {
+	s := &Service{syncedBlocks: syncedblock.NewRegistry()}
+	s.syncedBlocks.Watch("embedder", []syncedblock.Ref{{ObjectId: "source", BlockId: "b1"}})
+	s.syncedBlocks.SetBlocks("embedder", "source", []string{"b1", "title"})
+
+	t.Run("blocks with the same ids as blocks of the embedding object", func(t *testing.T) {
+		title := "title"
+		assert.Equal(t, "embedder", s.SyncedBlockSource("embedder", &title))
+		assert.Equal(t, "title", title)
+
+		title = syncedblock.BlockId("source", "title")
+		assert.Equal(t, "source", s.SyncedBlockSource("embedder", &title))
+		assert.Equal(t, "title", title)
+	})
+	t.Run("structure edits", func(t *testing.T) {
+		first, second := syncedblock.BlockId("source", "b1"), syncedblock.BlockId("source", "title")
+		assert.Equal(t, "source", s.SyncedBlockSource("embedder", &first, &second))
+		assert.Equal(t, []string{"b1", "title"}, []string{first, second})
+
+		first, second = syncedblock.BlockId("source", "b1"), "b2"
+		assert.Equal(t, "embedder", s.SyncedBlockSource("embedder", &first, &second))
+		assert.Equal(t, syncedblock.BlockId("source", "b1"), first, "ids aren't changed when blocks aren't routed")
+	})
+	t.Run("blocks not shown in the embedding object", func(t *testing.T) {
+		id := syncedblock.BlockId("source", "b2")
+		assert.Equal(t, "embedder", s.SyncedBlockSource("embedder", &id))
+		id = syncedblock.BlockId("source", "b1")
+		assert.Equal(t, "other", s.SyncedBlockSource("other", &id))
+	})
+	t.Run("created block id", func(t *testing.T) {
+		assert.Equal(t, syncedblock.BlockId("source", "new"), SyncedBlockId("embedder", "source", "new"))
+		assert.Equal(t, "new", SyncedBlockId("embedder", "embedder", "new"))
+		assert.Equal(t, "", SyncedBlockId("embedder", "source", ""))
+	})
+}
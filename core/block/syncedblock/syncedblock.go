@@ -0,0 +1,306 @@
+package syncedblock
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/link"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+// idSeparator separates the source object id from the block id in ids of the embedded blocks
+const idSeparator = "/"
+
+// Ref points to the block of the source object embedded by a synced block
+type Ref struct {
+	ObjectId string
+	BlockId  string
+}
+
+// CollectRefs returns references of all synced blocks of the state. References to the object itself are skipped
+func CollectRefs(s *state.State) (refs []Ref) {
+	s.Iterate(func(b simple.Block) (isContinue bool) {
+		l, ok := b.(link.Block)
+		if !ok || l.TargetSubBlockId() == "" {
+			return true
+		}
+		ref := Ref{ObjectId: b.Model().GetLink().TargetBlockId, BlockId: l.TargetSubBlockId()}
+		if ref.ObjectId == "" || ref.ObjectId == s.RootId() {
+			return true
+		}
+		for _, r := range refs {
+			if r == ref {
+				return true
+			}
+		}
+		refs = append(refs, ref)
+		return true
+	})
+	return
+}
+
+// Blocks returns copies of the referenced blocks with all their descendants, missing blocks are skipped
+func Blocks(s *state.State, blockIds []string) (blocks []*model.Block) {
+	for _, id := range blockIds {
+		b := s.Pick(id)
+		if b == nil {
+			continue
+		}
+		blocks = append(blocks, b.Copy().Model())
+		for _, d := range s.Descendants(id) {
+			blocks = append(blocks, d.Copy().Model())
+		}
+	}
+	return
+}
+
+// BlockId returns the id of the block of the source object shown in the embedding object. Ids are namespaced by
+// the source object id, because blocks with fixed ids, like title or header, exist in both objects
+func BlockId(sourceId, blockId string) string {
+	return sourceId + idSeparator + blockId
+}
+
+// SourceBlockIds returns the source object id and ids of its blocks when all ids are ids of the blocks
+// of one source object shown in the embedding object
+func SourceBlockIds(ids []string) (sourceId string, blockIds []string, ok bool) {
+	if len(ids) == 0 {
+		return "", nil, false
+	}
+	blockIds = make([]string, 0, len(ids))
+	for _, id := range ids {
+		objectId, blockId, found := strings.Cut(id, idSeparator)
+		if !found || objectId == "" || blockId == "" || (sourceId != "" && objectId != sourceId) {
+			return "", nil, false
+		}
+		sourceId = objectId
+		blockIds = append(blockIds, blockId)
+	}
+	return sourceId, blockIds, true
+}
+
+// Namespace replaces ids and children ids of the blocks of the source object with the ids shown in the embedding object
+func Namespace(sourceId string, blocks []*model.Block) []*model.Block {
+	for _, b := range blocks {
+		b.Id = BlockId(sourceId, b.Id)
+		for i, childId := range b.ChildrenIds {
+			b.ChildrenIds[i] = BlockId(sourceId, childId)
+		}
+	}
+	return blocks
+}
+
+// FilterEvents selects the events of the source object that should be sent to the embedding object.
+// Changes of the referenced blocks are returned as copies with namespaced block ids, structure changes inside
+// the referenced blocks are reported with the resync flag, in this case the blocks should be sent again with Blocks
+func FilterEvents(s *state.State, blockIds []string, msgs []simple.EventMessage) (filtered []*pb.EventMessage, resync bool) {
+	ids := make(map[string]struct{})
+	for _, b := range Blocks(s, blockIds) {
+		ids[b.Id] = struct{}{}
+	}
+	for _, msg := range msgs {
+		if msg.Virtual {
+			continue
+		}
+		switch v := msg.Msg.Value.(type) {
+		case *pb.EventMessageValueOfBlockSetChildrenIds:
+			if _, ok := ids[v.BlockSetChildrenIds.Id]; ok {
+				resync = true
+			}
+		case *pb.EventMessageValueOfBlockDelete:
+			for _, id := range v.BlockDelete.BlockIds {
+				if slice.FindPos(blockIds, id) != -1 {
+					resync = true
+				}
+			}
+		default:
+			if id := blockEventId(msg.Msg); id != nil {
+				if _, ok := ids[*id]; ok {
+					m := proto.Clone(msg.Msg).(*pb.EventMessage)
+					id = blockEventId(m)
+					*id = BlockId(s.RootId(), *id)
+					filtered = append(filtered, m)
+				}
+			}
+		}
+	}
+	return
+}
+
+func blockEventId(msg *pb.EventMessage) *string {
+	switch v := msg.Value.(type) {
+	case *pb.EventMessageValueOfBlockSetFields:
+		return &v.BlockSetFields.Id
+	case *pb.EventMessageValueOfBlockSetRestrictions:
+		return &v.BlockSetRestrictions.Id
+	case *pb.EventMessageValueOfBlockSetBackgroundColor:
+		return &v.BlockSetBackgroundColor.Id
+	case *pb.EventMessageValueOfBlockSetText:
+		return &v.BlockSetText.Id
+	case *pb.EventMessageValueOfBlockSetFile:
+		return &v.BlockSetFile.Id
+	case *pb.EventMessageValueOfBlockSetLink:
+		return &v.BlockSetLink.Id
+	case *pb.EventMessageValueOfBlockSetBookmark:
+		return &v.BlockSetBookmark.Id
+	case *pb.EventMessageValueOfBlockSetAlign:
+		return &v.BlockSetAlign.Id
+	case *pb.EventMessageValueOfBlockSetDiv:
+		return &v.BlockSetDiv.Id
+	case *pb.EventMessageValueOfBlockSetRelation:
+		return &v.BlockSetRelation.Id
+	case *pb.EventMessageValueOfBlockSetLatex:
+		return &v.BlockSetLatex.Id
+	case *pb.EventMessageValueOfBlockSetVerticalAlign:
+		return &v.BlockSetVerticalAlign.Id
+	case *pb.EventMessageValueOfBlockSetTableRow:
+		return &v.BlockSetTableRow.Id
+	case *pb.EventMessageValueOfBlockSetTableColumn:
+		return &v.BlockSetTableColumn.Id
+	case *pb.EventMessageValueOfBlockSetWidget:
+		return &v.BlockSetWidget.Id
+	}
+	return nil
+}
+
+// Registry tracks which opened objects embed blocks of which source objects
+type Registry struct {
+	mu         sync.Mutex
+	byEmbedder map[string]*embedder
+}
+
+type embedder struct {
+	refs []Ref
+	// ids of the embedded blocks with their descendants by the source object id
+	blocks map[string][]string
+}
+
+func NewRegistry() *Registry {
+	return &Registry{byEmbedder: map[string]*embedder{}}
+}
+
+// Watch starts tracking references of the opened embedding object
+func (r *Registry) Watch(embedderId string, refs []Ref) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byEmbedder[embedderId] = &embedder{refs: refs, blocks: map[string][]string{}}
+}
+
+// Set replaces references of the embedding object and returns references that were not registered before.
+// Objects that are not watched are ignored
+func (r *Registry) Set(embedderId string, refs []Ref) (added []Ref) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.byEmbedder[embedderId]
+	if !ok {
+		return nil
+	}
+	for _, ref := range refs {
+		if !containsRef(e.refs, ref) {
+			added = append(added, ref)
+		}
+	}
+	for sourceId := range e.blocks {
+		if !containsSource(refs, sourceId) {
+			delete(e.blocks, sourceId)
+		}
+	}
+	e.refs = refs
+	return
+}
+
+// SetBlocks remembers ids of the blocks of the source object shown in the embedding object
+func (r *Registry) SetBlocks(embedderId, sourceId string, blockIds []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.byEmbedder[embedderId]; ok && containsSource(e.refs, sourceId) {
+		e.blocks[sourceId] = blockIds
+	}
+}
+
+// Remove forgets all references of the embedding object
+func (r *Registry) Remove(embedderId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.byEmbedder, embedderId)
+}
+
+// Embedders returns ids of the referenced blocks of the source object grouped by the embedding object id
+func (r *Registry) Embedders(sourceId string) map[string][]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res map[string][]string
+	for embedderId, e := range r.byEmbedder {
+		for _, ref := range e.refs {
+			if ref.ObjectId != sourceId {
+				continue
+			}
+			if res == nil {
+				res = map[string][]string{}
+			}
+			res[embedderId] = append(res[embedderId], ref.BlockId)
+		}
+	}
+	return res
+}
+
+// IsEmbedded reports whether blocks of the object are embedded by any opened object
+func (r *Registry) IsEmbedded(sourceId string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.byEmbedder {
+		if containsSource(e.refs, sourceId) {
+			return true
+		}
+	}
+	return false
+}
+
+// Shows reports whether all blocks are blocks of the source object shown in the embedding object
+func (r *Registry) Shows(embedderId, sourceId string, blockIds []string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.byEmbedder[embedderId]
+	if !ok || len(blockIds) == 0 {
+		return false
+	}
+	ids, ok := e.blocks[sourceId]
+	return ok && lo.Every(ids, blockIds)
+}
+
+func containsRef(refs []Ref, ref Ref) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSource(refs []Ref, sourceId string) bool {
+	for _, r := range refs {
+		if r.ObjectId == sourceId {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupBySource groups referenced block ids by the source object id keeping the order of the first occurrence
+func GroupBySource(refs []Ref) (sourceIds []string, blockIds map[string][]string) {
+	blockIds = map[string][]string{}
+	for _, ref := range refs {
+		if _, ok := blockIds[ref.ObjectId]; !ok {
+			sourceIds = append(sourceIds, ref.ObjectId)
+		}
+		blockIds[ref.ObjectId] = append(blockIds[ref.ObjectId], ref.BlockId)
+	}
+	return
+}
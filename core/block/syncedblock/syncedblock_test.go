@@ -0,0 +1,147 @@
+package syncedblock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	_ "github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func newState(rootId string, bs ...*model.Block) *state.State {
+	blocks := map[string]simple.Block{}
+	for _, b := range bs {
+		blocks[b.Id] = simple.New(b)
+	}
+	return state.NewDoc(rootId, blocks).(*state.State)
+}
+
+func syncedLink(id, objectId, blockId string) *model.Block {
+	return &model.Block{Id: id, Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: objectId, TargetSubBlockId: blockId}}}
+}
+
+func textBlock(id string, childrenIds ...string) *model.Block {
+	return &model.Block{Id: id, ChildrenIds: childrenIds, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: id}}}
+}
+
+func TestCollectRefs(t *testing.T) {
+	s := newState("root",
+		&model.Block{Id: "root", ChildrenIds: []string{"l1", "l2", "l3", "l4", "l5"}},
+		syncedLink("l1", "source", "b1"),
+		syncedLink("l2", "source", "b1"),
+		syncedLink("l3", "root", "b2"),
+		&model.Block{Id: "l4", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "source"}}},
+		syncedLink("l5", "other", "b3"),
+	)
+	assert.Equal(t, []Ref{{ObjectId: "source", BlockId: "b1"}, {ObjectId: "other", BlockId: "b3"}}, CollectRefs(s))
+}
+
+func TestFilterEvents(t *testing.T) {
+	s := newState("source",
+		&model.Block{Id: "source", ChildrenIds: []string{"b1", "b2"}},
+		textBlock("b1", "b1.1"),
+		textBlock("b1.1"),
+		textBlock("b2"),
+	)
+	blocks := Blocks(s, []string{"b1"})
+	require.Len(t, blocks, 2)
+	assert.Equal(t, "b1", blocks[0].Id)
+	assert.Equal(t, "b1.1", blocks[1].Id)
+
+	setText := func(id string) simple.EventMessage {
+		return simple.EventMessage{Msg: &pb.EventMessage{Value: &pb.EventMessageValueOfBlockSetText{BlockSetText: &pb.EventBlockSetText{Id: id}}}}
+	}
+	t.Run("set events", func(t *testing.T) {
+		msgs, resync := FilterEvents(s, []string{"b1"}, []simple.EventMessage{setText("b1.1"), setText("b2"), setText("b1")})
+		assert.False(t, resync)
+		require.Len(t, msgs, 2)
+		assert.Equal(t, "source/b1.1", msgs[0].GetBlockSetText().Id)
+		assert.Equal(t, "source/b1", msgs[1].GetBlockSetText().Id)
+	})
+	t.Run("events of the source are not changed", func(t *testing.T) {
+		msg := setText("b1")
+		_, _ = FilterEvents(s, []string{"b1"}, []simple.EventMessage{msg})
+		assert.Equal(t, "b1", msg.Msg.GetBlockSetText().Id)
+	})
+	t.Run("structure change", func(t *testing.T) {
+		_, resync := FilterEvents(s, []string{"b1"}, []simple.EventMessage{{Msg: &pb.EventMessage{Value: &pb.EventMessageValueOfBlockSetChildrenIds{
+			BlockSetChildrenIds: &pb.EventBlockSetChildrenIds{Id: "b1"},
+		}}}})
+		assert.True(t, resync)
+		_, resync = FilterEvents(s, []string{"b1"}, []simple.EventMessage{{Msg: &pb.EventMessage{Value: &pb.EventMessageValueOfBlockSetChildrenIds{
+			BlockSetChildrenIds: &pb.EventBlockSetChildrenIds{Id: "source"},
+		}}}})
+		assert.False(t, resync)
+	})
+	t.Run("referenced block deleted", func(t *testing.T) {
+		_, resync := FilterEvents(s, []string{"b3"}, []simple.EventMessage{{Msg: &pb.EventMessage{Value: &pb.EventMessageValueOfBlockDelete{
+			BlockDelete: &pb.EventBlockDelete{BlockIds: []string{"b3"}},
+		}}}})
+		assert.True(t, resync)
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	assert.Nil(t, r.Set("closed", []Ref{{ObjectId: "source", BlockId: "b1"}}), "not watched objects are ignored")
+
+	r.Watch("embedder1", []Ref{{ObjectId: "source", BlockId: "b1"}})
+	r.Watch("embedder2", []Ref{{ObjectId: "source", BlockId: "b2"}, {ObjectId: "other", BlockId: "b3"}})
+	assert.False(t, r.IsEmbedded("closed"))
+	assert.True(t, r.IsEmbedded("other"))
+
+	added := r.Set("embedder1", []Ref{{ObjectId: "source", BlockId: "b1"}, {ObjectId: "source", BlockId: "b4"}})
+	assert.Equal(t, []Ref{{ObjectId: "source", BlockId: "b4"}}, added)
+
+	assert.Equal(t, map[string][]string{
+		"embedder1": {"b1", "b4"},
+		"embedder2": {"b2"},
+	}, r.Embedders("source"))
+
+	t.Run("shown blocks", func(t *testing.T) {
+		r.SetBlocks("embedder1", "source", []string{"b1", "b1.1", "b4"})
+		r.SetBlocks("embedder1", "other", []string{"b3"})
+
+		assert.True(t, r.Shows("embedder1", "source", []string{"b1.1", "b4"}))
+		assert.False(t, r.Shows("embedder1", "source", []string{"b1", "b3"}), "blocks of other objects")
+		assert.False(t, r.Shows("embedder1", "other", []string{"b3"}), "the object isn't referenced by the embedder")
+		assert.False(t, r.Shows("embedder1", "source", nil))
+
+		r.Set("embedder1", []Ref{{ObjectId: "other", BlockId: "b3"}})
+		assert.False(t, r.Shows("embedder1", "source", []string{"b1"}), "blocks of removed references are forgotten")
+	})
+
+	r.Remove("embedder2")
+	r.Remove("embedder1")
+	assert.Nil(t, r.Embedders("other"))
+	assert.False(t, r.IsEmbedded("other"))
+}
+
+func TestBlockIds(t *testing.T) {
+	t.Run("namespace", func(t *testing.T) {
+		blocks := Namespace("source", []*model.Block{textBlock("title"), textBlock("b1", "b1.1")})
+		assert.Equal(t, "source/title", blocks[0].Id)
+		assert.Equal(t, "source/b1", blocks[1].Id)
+		assert.Equal(t, []string{"source/b1.1"}, blocks[1].ChildrenIds)
+	})
+	t.Run("source block ids", func(t *testing.T) {
+		sourceId, blockIds, ok := SourceBlockIds([]string{BlockId("source", "title"), BlockId("source", "b1")})
+		assert.True(t, ok)
+		assert.Equal(t, "source", sourceId)
+		assert.Equal(t, []string{"title", "b1"}, blockIds)
+
+		_, _, ok = SourceBlockIds([]string{"title"})
+		assert.False(t, ok, "blocks of the embedding object")
+		_, _, ok = SourceBlockIds([]string{BlockId("source", "b1"), "b2"})
+		assert.False(t, ok, "blocks of different objects")
+		_, _, ok = SourceBlockIds([]string{BlockId("source", "b1"), BlockId("other", "b1")})
+		assert.False(t, ok, "blocks of different sources")
+		_, _, ok = SourceBlockIds(nil)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,177 @@
+package block
+
+import (
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/basic"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/schema"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// MoveInTimeline sets both dates of the timeline item in one change. With the cascade dependent items starting before
+// the end of the item they depend on are pushed forward, so the order of the plan is kept. Dates of all items are
+// planned before changing any of them. It returns ids of pushed dependents
+func (s *Service) MoveInTimeline(ctx *session.Context, req pb.RpcObjectTimelineMoveRequest) (movedIds []string, err error) {
+	return moveInTimeline(s, s.objectStore, ctx, req)
+}
+
+type dependentsQuerier interface {
+	Query(schema schema.Schema, q database.Query) (records []database.Record, total int, err error)
+}
+
+// timelineItem is the planned move of the timeline item
+type timelineItem struct {
+	id string
+	// start and end are planned dates, the end of the item without the end date is its start
+	start, end int64
+	// prevStart and prevEnd are stored dates, they are restored when the move fails
+	prevStart, prevEnd int64
+	// hasEnd is true when the end date is changed, otherwise the item lasts no time and only the start is changed
+	hasEnd bool
+	pushes int
+}
+
+// prevEffectiveEnd returns the stored end of the item or its start when the end is not set
+func (it *timelineItem) prevEffectiveEnd() int64 {
+	if it.prevEnd != 0 {
+		return it.prevEnd
+	}
+	return it.prevStart
+}
+
+func moveInTimeline(picker Picker, store dependentsQuerier, ctx *session.Context, req pb.RpcObjectTimelineMoveRequest) (movedIds []string, err error) {
+	if req.StartRelationKey == "" {
+		return nil, fmt.Errorf("start relation is not set")
+	}
+	if req.EndRelationKey != "" && req.End < req.Start {
+		return nil, fmt.Errorf("end is before start")
+	}
+	if req.Cascade && req.DependencyRelationKey == "" {
+		return nil, fmt.Errorf("dependency relation is not set")
+	}
+
+	item, err := readTimelineItem(picker, req.ContextId, req.StartRelationKey, req.EndRelationKey)
+	if err != nil {
+		return nil, err
+	}
+	item.start, item.end = req.Start, req.Start
+	if req.EndRelationKey != "" {
+		item.end, item.hasEnd = req.End, true
+	}
+	plan := []*timelineItem{item}
+	// dependents could be violated only when the end is moved later
+	if req.Cascade && item.end > item.prevEffectiveEnd() {
+		pushed, err := pushDependents(picker, store, req, item)
+		if err != nil {
+			return nil, err
+		}
+		plan = append(plan, pushed...)
+	}
+
+	for i, it := range plan {
+		if err = setItemDates(picker, ctx, it, it.start, it.end, req.StartRelationKey, req.EndRelationKey); err != nil {
+			// dates of already moved items are restored, so the plan is applied entirely or not at all
+			for _, moved := range plan[:i] {
+				if rerr := setItemDates(picker, nil, moved, moved.prevStart, moved.prevEnd, req.StartRelationKey, req.EndRelationKey); rerr != nil {
+					log.With("objectID", moved.id).Errorf("failed to restore timeline dates: %v", rerr)
+				}
+			}
+			return nil, fmt.Errorf("move %s: %w", it.id, err)
+		}
+		if i > 0 {
+			movedIds = append(movedIds, it.id)
+		}
+	}
+	return movedIds, nil
+}
+
+// pushDependents plans dates of items depending on the item transitively. A dependent is pushed only when it starts
+// before the end of the item it depends on, it's pushed to this end keeping its duration
+func pushDependents(picker Picker, store dependentsQuerier, req pb.RpcObjectTimelineMoveRequest, item *timelineItem) (pushed []*timelineItem, err error) {
+	var (
+		queue = []*timelineItem{item}
+		items = map[string]*timelineItem{item.id: item}
+	)
+	for len(queue) > 0 {
+		it := queue[0]
+		queue = queue[1:]
+		records, _, err := store.Query(nil, database.Query{
+			Filters: []*model.BlockContentDataviewFilter{
+				{
+					RelationKey: req.DependencyRelationKey,
+					Condition:   model.BlockContentDataviewFilter_In,
+					Value:       pbtypes.StringList([]string{it.id}),
+				},
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("query dependents of %s: %w", it.id, err)
+		}
+		for _, rec := range records {
+			depId := pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
+			dep, ok := items[depId]
+			if !ok {
+				if dep, err = readTimelineItem(picker, depId, req.StartRelationKey, req.EndRelationKey); err != nil {
+					return nil, fmt.Errorf("dependent %s: %w", depId, err)
+				}
+				dep.start, dep.end = dep.prevStart, dep.prevEffectiveEnd()
+				items[depId] = dep
+			}
+			// items without the start date are not planned yet
+			if dep.start == 0 || dep.start >= it.end {
+				continue
+			}
+			// the moved item or an item pushed more times than there are items depends on itself
+			if dep == item || dep.pushes >= len(items) {
+				return nil, fmt.Errorf("dependency cycle at %s", depId)
+			}
+			shift := it.end - dep.start
+			dep.start, dep.end = dep.start+shift, dep.end+shift
+			if dep.pushes == 0 {
+				pushed = append(pushed, dep)
+			}
+			dep.pushes++
+			queue = append(queue, dep)
+		}
+	}
+	return pushed, nil
+}
+
+// readTimelineItem reads current dates of the item and checks that they can be changed
+func readTimelineItem(picker Picker, id, startKey, endKey string) (it *timelineItem, err error) {
+	err = Do(picker, id, func(sb smartblock.SmartBlock) error {
+		if _, ok := sb.(basic.DetailsSettable); !ok {
+			return fmt.Errorf("details of object %s can't be set", id)
+		}
+		details := sb.CombinedDetails()
+		it = &timelineItem{id: id, prevStart: pbtypes.GetInt64(details, startKey)}
+		if endKey != "" {
+			it.prevEnd = pbtypes.GetInt64(details, endKey)
+			it.hasEnd = it.prevEnd != 0
+		}
+		return nil
+	})
+	return
+}
+
+func setItemDates(picker Picker, ctx *session.Context, it *timelineItem, start, end int64, startKey, endKey string) error {
+	return Do(picker, it.id, func(sb smartblock.SmartBlock) error {
+		b, ok := sb.(basic.DetailsSettable)
+		if !ok {
+			return fmt.Errorf("details of object %s can't be set", it.id)
+		}
+		newDetails := []*pb.RpcObjectSetDetailsDetail{
+			{Key: startKey, Value: pbtypes.Int64(start)},
+		}
+		if it.hasEnd {
+			newDetails = append(newDetails, &pb.RpcObjectSetDetailsDetail{Key: endKey, Value: pbtypes.Int64(end)})
+		}
+		return b.SetDetails(ctx, newDetails, true)
+	})
+}
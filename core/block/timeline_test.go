@@ -0,0 +1,186 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/schema"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	testStartKey      = "startDate"
+	testEndKey        = "endDate"
+	testDependencyKey = "dependsOn"
+)
+
+type testTimeline struct {
+	objects map[string]*smarttest.SmartTest
+	// dependencies are ids of items the item depends on by the item id
+	dependencies map[string][]string
+	queries      int
+	// failOnPick is the number of successful picks of the object before its pick fails
+	failOnPick map[string]int
+}
+
+func newTestTimeline() *testTimeline {
+	return &testTimeline{objects: map[string]*smarttest.SmartTest{}, dependencies: map[string][]string{}, failOnPick: map[string]int{}}
+}
+
+func (tl *testTimeline) add(t *testing.T, id string, start, end int64, dependsOn ...string) {
+	sb := smarttest.New(id)
+	require.NoError(t, sb.SetDetails(nil, []*pb.RpcObjectSetDetailsDetail{
+		{Key: testStartKey, Value: pbtypes.Int64(start)},
+		{Key: testEndKey, Value: pbtypes.Int64(end)},
+	}, false))
+	tl.objects[id] = sb
+	tl.dependencies[id] = dependsOn
+}
+
+func (tl *testTimeline) dates(id string) (start, end int64) {
+	details := tl.objects[id].CombinedDetails()
+	return pbtypes.GetInt64(details, testStartKey), pbtypes.GetInt64(details, testEndKey)
+}
+
+func (tl *testTimeline) PickBlock(ctx context.Context, id string) (smartblock.SmartBlock, error) {
+	if n, ok := tl.failOnPick[id]; ok {
+		if n == 0 {
+			return nil, fmt.Errorf("object %s can't be picked", id)
+		}
+		tl.failOnPick[id] = n - 1
+	}
+	return tl.objects[id], nil
+}
+
+func (tl *testTimeline) Query(_ schema.Schema, q database.Query) (records []database.Record, total int, err error) {
+	tl.queries++
+	target := pbtypes.GetStringListValue(q.Filters[0].Value)[0]
+	for id, dependsOn := range tl.dependencies {
+		for _, depId := range dependsOn {
+			if depId == target {
+				records = append(records, database.Record{Details: &types.Struct{Fields: map[string]*types.Value{
+					"id": pbtypes.String(id),
+				}}})
+			}
+		}
+	}
+	return records, len(records), nil
+}
+
+func TestMoveInTimeline(t *testing.T) {
+	move := func(tl *testTimeline, id string, start, end int64) ([]string, error) {
+		return moveInTimeline(tl, tl, nil, pb.RpcObjectTimelineMoveRequest{
+			ContextId:             id,
+			StartRelationKey:      testStartKey,
+			EndRelationKey:        testEndKey,
+			DependencyRelationKey: testDependencyKey,
+			Start:                 start,
+			End:                   end,
+			Cascade:               true,
+		})
+	}
+
+	t.Run("dependents are shifted transitively", func(t *testing.T) {
+		tl := newTestTimeline()
+		tl.add(t, "a", 1000, 2000)
+		tl.add(t, "b", 2000, 3000, "a")
+		tl.add(t, "c", 3000, 4000, "b")
+
+		movedIds, err := move(tl, "a", 1500, 2500)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b", "c"}, movedIds)
+		for id, dates := range map[string][2]int64{"a": {1500, 2500}, "b": {2500, 3500}, "c": {3500, 4500}} {
+			start, end := tl.dates(id)
+			assert.Equal(t, dates, [2]int64{start, end}, id)
+		}
+	})
+	t.Run("dependents are pushed only when they start before the end", func(t *testing.T) {
+		tl := newTestTimeline()
+		tl.add(t, "a", 1000, 2000)
+		tl.add(t, "b", 2600, 3000, "a")
+		tl.add(t, "c", 2200, 2400, "a")
+
+		movedIds, err := move(tl, "a", 1000, 2500)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c"}, movedIds)
+		for id, dates := range map[string][2]int64{"a": {1000, 2500}, "b": {2600, 3000}, "c": {2500, 2700}} {
+			start, end := tl.dates(id)
+			assert.Equal(t, dates, [2]int64{start, end}, id)
+		}
+	})
+	t.Run("dependent is pushed to the latest end of the items it depends on", func(t *testing.T) {
+		tl := newTestTimeline()
+		tl.add(t, "a", 1000, 2000)
+		tl.add(t, "b", 2000, 2500, "a")
+		tl.add(t, "c", 2500, 3000, "a", "b")
+
+		movedIds, err := move(tl, "a", 1000, 2600)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"b", "c"}, movedIds)
+		for id, dates := range map[string][2]int64{"a": {1000, 2600}, "b": {2600, 3100}, "c": {3100, 3600}} {
+			start, end := tl.dates(id)
+			assert.Equal(t, dates, [2]int64{start, end}, id)
+		}
+	})
+	t.Run("dependents are not pulled when the item is moved earlier", func(t *testing.T) {
+		tl := newTestTimeline()
+		tl.add(t, "a", 1000, 2000)
+		tl.add(t, "b", 2000, 3000, "a")
+
+		movedIds, err := move(tl, "a", 900, 1900)
+		require.NoError(t, err)
+		assert.Empty(t, movedIds)
+		assert.Zero(t, tl.queries)
+		start, end := tl.dates("b")
+		assert.Equal(t, [2]int64{2000, 3000}, [2]int64{start, end})
+	})
+	t.Run("cycle fails the move and nothing is changed", func(t *testing.T) {
+		tl := newTestTimeline()
+		tl.add(t, "a", 1000, 2000, "c")
+		tl.add(t, "b", 2000, 3000, "a")
+		tl.add(t, "c", 3000, 4000, "b")
+
+		_, err := move(tl, "a", 1500, 2500)
+		require.Error(t, err)
+		for id, dates := range map[string][2]int64{"a": {1000, 2000}, "b": {2000, 3000}, "c": {3000, 4000}} {
+			start, end := tl.dates(id)
+			assert.Equal(t, dates, [2]int64{start, end}, id)
+		}
+	})
+	t.Run("moved items are restored when a dependent can't be moved", func(t *testing.T) {
+		tl := newTestTimeline()
+		tl.add(t, "a", 1000, 2000)
+		tl.add(t, "b", 2000, 3000, "a")
+		tl.add(t, "c", 3000, 4000, "b")
+		// c is read by the plan and fails on the move
+		tl.failOnPick["c"] = 1
+
+		_, err := move(tl, "a", 1500, 2500)
+		require.Error(t, err)
+		for id, dates := range map[string][2]int64{"a": {1000, 2000}, "b": {2000, 3000}, "c": {3000, 4000}} {
+			start, end := tl.dates(id)
+			assert.Equal(t, dates, [2]int64{start, end}, id)
+		}
+	})
+	t.Run("no cascade when the end is not moved", func(t *testing.T) {
+		tl := newTestTimeline()
+		tl.add(t, "a", 1000, 2000)
+		tl.add(t, "b", 2000, 3000, "a")
+
+		movedIds, err := move(tl, "a", 1200, 2000)
+		require.NoError(t, err)
+		assert.Empty(t, movedIds)
+		assert.Zero(t, tl.queries)
+		start, end := tl.dates("b")
+		assert.Equal(t, [2]int64{2000, 3000}, [2]int64{start, end})
+	})
+}
@@ -2,9 +2,11 @@ package core
 
 import (
 	"context"
+	"errors"
 
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/database/query"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
@@ -146,6 +148,10 @@ func (mw *Middleware) BlockDataviewViewUpdate(cctx context.Context, req *pb.RpcB
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
 		return bs.UpdateDataviewView(ctx, *req)
 	})
+	var queryErr *query.Error
+	if errors.As(err, &queryErr) {
+		return response(pb.RpcBlockDataviewViewUpdateResponseError_BAD_INPUT, err)
+	}
 	if err != nil {
 		return response(pb.RpcBlockDataviewViewUpdateResponseError_UNKNOWN_ERROR, err)
 	}
@@ -168,6 +174,10 @@ func (mw *Middleware) BlockDataviewViewCreate(cctx context.Context, req *pb.RpcB
 		viewId, err = bs.CreateDataviewView(ctx, *req)
 		return err
 	})
+	var queryErr *query.Error
+	if errors.As(err, &queryErr) {
+		return response("", pb.RpcBlockDataviewViewCreateResponseError_BAD_INPUT, err)
+	}
 	if err != nil {
 		return response("", pb.RpcBlockDataviewViewCreateResponseError_UNKNOWN_ERROR, err)
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/editor/table"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/latex"
 	"github.com/anyproto/anytype-heart/core/files"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
@@ -69,6 +70,7 @@ type HTML struct {
 	s           *state.State
 	buf         *bytes.Buffer
 	fileService files.Service
+	equations   *latex.Numbering
 }
 
 func (h *HTML) Convert() (result string) {
@@ -116,6 +118,9 @@ func (h *HTML) render(rs *renderState, b *model.Block) {
 	case *model.BlockContentOfTable:
 		rs.Close()
 		h.renderTable(b)
+	case *model.BlockContentOfLatex:
+		rs.Close()
+		h.renderLatex(b)
 	default:
 		rs.Close()
 		h.renderLayout(b)
@@ -183,7 +188,11 @@ func (h *HTML) renderText(rs *renderState, b *model.Block) {
 			}
 		case model.BlockContentTextMark_Link:
 			if start {
-				fmt.Fprintf(h.buf, `<a href="%s">`, m.Param)
+				if label, num, ok := h.equationNumbering().Ref(m.Param); ok {
+					fmt.Fprintf(h.buf, `<a href="#%s" title="(%d)">`, html.EscapeString(label), num)
+				} else {
+					fmt.Fprintf(h.buf, `<a href="%s">`, m.Param)
+				}
 			} else {
 				h.buf.WriteString("</a>")
 			}
@@ -451,6 +460,27 @@ func (h *HTML) renderLink(b *model.Block) {
 	}
 }
 
+func (h *HTML) renderLatex(b *model.Block) {
+	l := b.GetLatex()
+	if l == nil {
+		return
+	}
+	if num, ok := h.equationNumbering().Number(b.Id); ok {
+		fmt.Fprintf(h.buf, `<div class="equation" id="%s" style="display: flex; align-items: center;"><div style="flex-grow: 1; text-align: center;">%s</div><span class="equation-number">(%d)</span></div>`,
+			html.EscapeString(l.Label), latex.ToMathML(l.Text, true), num)
+	} else {
+		fmt.Fprintf(h.buf, `<div class="equation" style="text-align: center;">%s</div>`, latex.ToMathML(l.Text, true))
+	}
+	h.renderChildren(b)
+}
+
+func (h *HTML) equationNumbering() *latex.Numbering {
+	if h.equations == nil {
+		h.equations, _ = latex.NewNumbering(h.s)
+	}
+	return h.equations
+}
+
 func (h *HTML) renderTable(b *model.Block) {
 	tb, err := table.NewTable(h.s, b.Id)
 	if err != nil {
@@ -119,6 +119,38 @@ func TestHTML_Convert(t *testing.T) {
 		exp := `<div class="row" style="display: flex"><div class="column" ><div style="font-size: 15px; line-height: 24px; letter-spacing: -0.08px; font-weight: 400; word-wrap: break-word;" class="paragraph" style="font-size: 15px; line-height: 24px; letter-spacing: -0.08px; font-weight: 400; word-wrap: break-word;">1</div></div><div class="column" ><div style="font-size: 15px; line-height: 24px; letter-spacing: -0.08px; font-weight: 400; word-wrap: break-word;" class="paragraph" style="font-size: 15px; line-height: 24px; letter-spacing: -0.08px; font-weight: 400; word-wrap: break-word;">2</div></div></div>`
 		assert.Equal(t, exp, res)
 	})
+
+	t.Run("latex", func(t *testing.T) {
+		s := state.NewDoc("root", map[string]simple.Block{
+			"root": simple.New(&model.Block{ChildrenIds: []string{"eq", "text"}}),
+			"eq": simple.New(&model.Block{
+				Id:      "eq",
+				Content: &model.BlockContentOfLatex{Latex: &model.BlockContentLatex{Text: "x^2", Label: "square"}},
+			}),
+			"text": simple.New(&model.Block{
+				Id: "text",
+				Content: &model.BlockContentOfText{
+					Text: &model.BlockContentText{
+						Text: "see eq",
+						Marks: &model.BlockContentTextMarks{
+							Marks: []*model.BlockContentTextMark{
+								{
+									Range: &model.Range{From: 4, To: 6},
+									Type:  model.BlockContentTextMark_Link,
+									Param: "#square",
+								},
+							},
+						},
+					},
+				},
+			}),
+		}).(*state.State)
+		res := convertHtml(s)
+		assert.Contains(t, res, `<div class="equation" id="square"`)
+		assert.Contains(t, res, `<msup><mi>x</mi><mn>2</mn></msup>`)
+		assert.Contains(t, res, `<span class="equation-number">(1)</span>`)
+		assert.Contains(t, res, `<a href="#square" title="(1)">eq</a>`)
+	})
 }
 
 func convertHtml(s *state.State) string {
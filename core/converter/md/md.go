@@ -15,6 +15,8 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/editor/table"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/latex"
+	"github.com/anyproto/anytype-heart/core/block/simple/link"
 	"github.com/anyproto/anytype-heart/core/converter"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
@@ -42,6 +44,8 @@ type MD struct {
 
 	mw *marksWriter
 	fn FileNamer
+
+	equations *latex.Numbering
 }
 
 func (h *MD) Convert(model.SmartBlockType) (result []byte) {
@@ -263,10 +267,21 @@ func (h *MD) renderLatex(buf writer, in *renderState, b *model.Block) {
 	l := b.GetLatex()
 	if l != nil {
 		buf.WriteString(in.indent)
+		if num, ok := h.equationNumbering().Number(b.Id); ok {
+			fmt.Fprintf(buf, "\n<a id=\"%s\"></a>\n$$\n%s \\tag{%d} \\label{%s}\n$$\n", l.Label, l.Text, num, l.Label)
+			return
+		}
 		fmt.Fprintf(buf, "\n$$\n%s\n$$\n", l.Text)
 	}
 }
 
+func (h *MD) equationNumbering() *latex.Numbering {
+	if h.equations == nil {
+		h.equations, _ = latex.NewNumbering(h.s)
+	}
+	return h.equations
+}
+
 func (h *MD) renderTable(buf writer, in *renderState, b *model.Block) {
 	if t := b.GetTable(); t == nil {
 		return
@@ -422,7 +437,8 @@ func (mw *marksWriter) writeMarks(buf writer, pos int) {
 				fmt.Fprintf(buf, "](%s)", urlS)
 			}
 		case model.BlockContentTextMark_Mention, model.BlockContentTextMark_Object:
-			_, filename, ok := mw.h.getLinkInfo(m.Param)
+			objectId, _ := link.ParseBlockRef(m.Param)
+			_, filename, ok := mw.h.getLinkInfo(objectId)
 			if ok {
 				if start {
 					buf.WriteString("[")
@@ -75,4 +75,17 @@ func TestMD_Convert(t *testing.T) {
 		exp := "***[some](http://golang.org)*** [t](http://golang.org) [e](http://golang.org)xt **wi~~th m~~**~~ar~~ks @mention   \n"
 		assert.Equal(t, exp, string(res))
 	})
+	t.Run("latex numbering", func(t *testing.T) {
+		s := newState(
+			&model.Block{Id: "eq1", Content: &model.BlockContentOfLatex{Latex: &model.BlockContentLatex{Text: "a^2+b^2=c^2", Label: "pythagoras"}}},
+			&model.Block{Id: "eq2", Content: &model.BlockContentOfLatex{Latex: &model.BlockContentLatex{Text: "x"}}},
+			&model.Block{Id: "eq3", Content: &model.BlockContentOfLatex{Latex: &model.BlockContentLatex{Text: "E=mc^2", Label: "energy"}}},
+		)
+		c := NewMDConverter(nil, s, nil)
+		res := c.Convert(0)
+		exp := "\n<a id=\"pythagoras\"></a>\n$$\na^2+b^2=c^2 \\tag{1} \\label{pythagoras}\n$$\n" +
+			"\n$$\nx\n$$\n" +
+			"\n<a id=\"energy\"></a>\n$$\nE=mc^2 \\tag{2} \\label{energy}\n$$\n"
+		assert.Equal(t, exp, string(res))
+	})
 }
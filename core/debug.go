@@ -2,9 +2,13 @@ package core
 
 import (
 	"context"
+	"errors"
+	"time"
+
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/debug"
 	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/core/syncstatus"
 	"github.com/anyproto/anytype-heart/pb"
 )
 
@@ -100,6 +104,69 @@ func (mw *Middleware) DebugSpaceSummary(cctx context.Context, req *pb.RpcDebugSp
 	return response(nil, spaceSummary)
 }
 
+func (mw *Middleware) DebugSpaceSyncReport(_ context.Context, req *pb.RpcDebugSpaceSyncReportRequest) *pb.RpcDebugSpaceSyncReportResponse {
+	response := func(report *syncstatus.SpaceSyncReport, code pb.RpcDebugSpaceSyncReportResponseErrorCode, err error) *pb.RpcDebugSpaceSyncReportResponse {
+		m := &pb.RpcDebugSpaceSyncReportResponse{Error: &pb.RpcDebugSpaceSyncReportResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+			return m
+		}
+		m.Report = syncReportToProto(report)
+		return m
+	}
+
+	a := mw.GetApp()
+	if a == nil {
+		return response(nil, pb.RpcDebugSpaceSyncReportResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	reporter, ok := a.MustComponent(syncstatus.CName).(syncstatus.Reporter)
+	if !ok {
+		return response(nil, pb.RpcDebugSpaceSyncReportResponseError_UNKNOWN_ERROR, errors.New("sync report is not supported"))
+	}
+	report, err := reporter.SpaceSyncReport(req.SpaceId)
+	if err != nil {
+		return response(nil, pb.RpcDebugSpaceSyncReportResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(report, pb.RpcDebugSpaceSyncReportResponseError_NULL, nil)
+}
+
+func syncReportToProto(report *syncstatus.SpaceSyncReport) *pb.RpcDebugSpaceSyncReportReport {
+	unix := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+	m := &pb.RpcDebugSpaceSyncReportReport{
+		SpaceId:       report.SpaceId,
+		TreesSynced:   int64(report.TreesSynced),
+		TreesPending:  int64(report.TreesPending),
+		TreesFailed:   int64(report.TreesFailed),
+		BytesSent:     report.BytesSent,
+		BytesReceived: report.BytesReceived,
+		FileQueue: &pb.RpcDebugSpaceSyncReportFileQueue{
+			Uploading: int64(report.FileQueue.Uploading),
+			Discarded: int64(report.FileQueue.Discarded),
+			Removing:  int64(report.FileQueue.Removing),
+		},
+	}
+	for _, p := range report.Peers {
+		m.Peers = append(m.Peers, &pb.RpcDebugSpaceSyncReportPeerSync{
+			PeerId:       p.PeerId,
+			LastSyncDate: unix(p.LastSyncAt),
+		})
+	}
+	for _, c := range report.Conflicts {
+		m.Conflicts = append(m.Conflicts, &pb.RpcDebugSpaceSyncReportConflict{
+			ObjectId:     c.ObjectId,
+			Heads:        c.Heads,
+			DetectedDate: unix(c.DetectedAt),
+			MergedDate:   unix(c.MergedAt),
+		})
+	}
+	return m
+}
+
 func (mw *Middleware) DebugExportLocalstore(cctx context.Context, req *pb.RpcDebugExportLocalstoreRequest) *pb.RpcDebugExportLocalstoreResponse {
 	response := func(path string, err error) (res *pb.RpcDebugExportLocalstoreResponse) {
 		res = &pb.RpcDebugExportLocalstoreResponse{
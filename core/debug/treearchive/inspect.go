@@ -0,0 +1,153 @@
+package treearchive
+
+import (
+	"sort"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// ReplayStep is the result of applying one change of the tree. Idx is the same index as in ChangeAt
+type ReplayStep struct {
+	Idx    int
+	Change IdChange
+	Events []simple.EventMessage
+	// Err is the error of applying the change, failed changes are applied ignoring errors to continue the replay
+	Err error
+}
+
+// StateDiff is the difference between two states of the object
+type StateDiff struct {
+	// Details contains changed and added details, removed details have nil values
+	Details *types.Struct
+	Added   []string
+	Removed []string
+	Changed []string
+}
+
+func (d StateDiff) IsEmpty() bool {
+	return d.Details == nil && len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Replay builds the state change by change from the root of the tree, f is called after every change with
+// the current state, the state must not be modified. The header root change has no model, f is called for it
+// with the empty state. The replay stops when f returns false
+func (t *treeImporter) Replay(f func(st *state.State, step ReplayStep) bool) error {
+	var (
+		st     *state.State
+		i      int
+		rootId = t.objectTree.Id()
+	)
+	return t.objectTree.IterateRoot(unmarshalChange, func(change *objecttree.Change) bool {
+		defer func() { i++ }()
+		if change.Id == rootId {
+			st = state.NewDoc(rootId, nil).(*state.State)
+			st.SetChangeId(change.Id)
+			return f(st, ReplayStep{Idx: i, Change: IdChange{Id: change.Id}})
+		}
+		model := change.Model.(*pb.Change)
+		step := ReplayStep{Idx: i, Change: IdChange{Model: model, Id: change.Id}}
+		if st == nil {
+			// the tree is built from the snapshot
+			st = state.NewDocFromSnapshot(rootId, model.Snapshot, state.WithChangeId(change.Id)).(*state.State)
+			return f(st, step)
+		}
+		s := st.NewState()
+		if step.Err = s.ApplyChange(model.Content...); step.Err != nil {
+			s = st.NewState()
+			s.ApplyChangeIgnoreErr(model.Content...)
+		}
+		s.SetChangeId(change.Id)
+		s.AddFileKeys(model.FileKeys...)
+		msgs, _, err := state.ApplyState(s, false)
+		if err != nil && step.Err == nil {
+			step.Err = err
+		}
+		step.Events = msgs
+		return f(st, step)
+	})
+}
+
+// StatesAt replays the tree and returns copies of states after changes with given indexes
+func (t *treeImporter) StatesAt(indexes ...int) (map[int]*state.State, error) {
+	states := map[int]*state.State{}
+	if len(indexes) == 0 {
+		return states, nil
+	}
+	need := map[int]struct{}{}
+	last := 0
+	for _, idx := range indexes {
+		need[idx] = struct{}{}
+		if idx > last {
+			last = idx
+		}
+	}
+	err := t.Replay(func(st *state.State, step ReplayStep) bool {
+		if _, ok := need[step.Idx]; ok {
+			states[step.Idx] = st.Copy()
+		}
+		return step.Idx < last
+	})
+	return states, err
+}
+
+func unmarshalChange(decrypted []byte) (any, error) {
+	ch := &pb.Change{}
+	if err := proto.Unmarshal(decrypted, ch); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// DiffStates compares details and blocks of the states
+func DiffStates(st1, st2 *state.State) StateDiff {
+	diff := StateDiff{
+		Details: pbtypes.StructDiff(st1.CombinedDetails(), st2.CombinedDetails()),
+	}
+	blocks1 := stateBlocks(st1)
+	blocks2 := stateBlocks(st2)
+	for id, b2 := range blocks2 {
+		b1, ok := blocks1[id]
+		if !ok {
+			diff.Added = append(diff.Added, id)
+		} else if !proto.Equal(b1, b2) {
+			diff.Changed = append(diff.Changed, id)
+		}
+	}
+	for id := range blocks1 {
+		if _, ok := blocks2[id]; !ok {
+			diff.Removed = append(diff.Removed, id)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+	return diff
+}
+
+func stateBlocks(st *state.State) map[string]*model.Block {
+	blocks := map[string]*model.Block{}
+	st.Iterate(func(b simple.Block) (isContinue bool) {
+		blocks[b.Model().Id] = b.Model()
+		return true
+	})
+	return blocks
+}
+
+// DiffLocalStore compares details of the state with details of the object in the local store of the archive.
+// Local and derived relations are skipped, because they are not stored in the tree
+func DiffLocalStore(st *state.State, info *model.ObjectInfo) *types.Struct {
+	skip := append(append([]string{}, bundle.LocalRelationsKeys...), bundle.DerivedRelationsKeys...)
+	return pbtypes.StructDiff(
+		pbtypes.StructCutKeys(info.GetDetails(), skip),
+		pbtypes.StructCutKeys(st.CombinedDetails(), skip),
+	)
+}
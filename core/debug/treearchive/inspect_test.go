@@ -0,0 +1,132 @@
+package treearchive
+
+import (
+	"testing"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree/mock_objecttree"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func newTestState(children []string, texts map[string]string, name string) *state.State {
+	blocks := map[string]simple.Block{
+		"root": simple.New(&model.Block{Id: "root", ChildrenIds: children}),
+	}
+	for id, text := range texts {
+		blocks[id] = simple.New(&model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text}}})
+	}
+	st := state.NewDoc("root", blocks).(*state.State)
+	st.SetDetails(&types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyName.String(): pbtypes.String(name),
+	}})
+	return st
+}
+
+func TestDiffStates(t *testing.T) {
+	st1 := newTestState([]string{"a", "b"}, map[string]string{"a": "a", "b": "b"}, "name")
+
+	t.Run("equal", func(t *testing.T) {
+		st2 := newTestState([]string{"a", "b"}, map[string]string{"a": "a", "b": "b"}, "name")
+		assert.True(t, DiffStates(st1, st2).IsEmpty())
+	})
+	t.Run("changed", func(t *testing.T) {
+		st2 := newTestState([]string{"a", "c"}, map[string]string{"a": "changed", "c": "c"}, "new name")
+		diff := DiffStates(st1, st2)
+		assert.Equal(t, []string{"c"}, diff.Added)
+		assert.Equal(t, []string{"b"}, diff.Removed)
+		assert.Equal(t, []string{"a", "root"}, diff.Changed)
+		assert.Equal(t, "new name", pbtypes.GetString(diff.Details, bundle.RelationKeyName.String()))
+	})
+}
+
+func TestDiffLocalStore(t *testing.T) {
+	st := newTestState(nil, nil, "name")
+	info := &model.ObjectInfo{Details: &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyName.String():           pbtypes.String("name"),
+		bundle.RelationKeyLastOpenedDate.String(): pbtypes.Int64(100),
+	}}}
+	assert.Nil(t, DiffLocalStore(st, info))
+
+	info.Details.Fields[bundle.RelationKeyName.String()] = pbtypes.String("old name")
+	diff := DiffLocalStore(st, info)
+	assert.Equal(t, "name", pbtypes.GetString(diff, bundle.RelationKeyName.String()))
+}
+
+func newTestReplayImporter(t *testing.T, names ...string) *treeImporter {
+	changes := []*objecttree.Change{{Id: "root"}}
+	for _, name := range names {
+		changes = append(changes, &objecttree.Change{Id: "change-" + name, Model: &pb.Change{
+			Content: []*pb.ChangeContent{{Value: &pb.ChangeContentValueOfDetailsSet{DetailsSet: &pb.ChangeDetailsSet{
+				Key:   bundle.RelationKeyName.String(),
+				Value: pbtypes.String(name),
+			}}}},
+		}})
+	}
+	tree := mock_objecttree.NewMockObjectTree(gomock.NewController(t))
+	tree.EXPECT().Id().Return("root").AnyTimes()
+	tree.EXPECT().IterateRoot(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ objecttree.ChangeConvertFunc, iterate objecttree.ChangeIterateFunc) error {
+			for _, ch := range changes {
+				if !iterate(ch) {
+					break
+				}
+			}
+			return nil
+		}).AnyTimes()
+	return &treeImporter{objectTree: tree}
+}
+
+func TestReplay(t *testing.T) {
+	t.Run("every change is replayed starting from the root", func(t *testing.T) {
+		importer := newTestReplayImporter(t, "first", "second")
+		var (
+			ids   []string
+			names []string
+		)
+		err := importer.Replay(func(st *state.State, step ReplayStep) bool {
+			assert.Len(t, ids, step.Idx)
+			assert.NoError(t, step.Err)
+			ids = append(ids, step.Change.Id)
+			names = append(names, pbtypes.GetString(st.CombinedDetails(), bundle.RelationKeyName.String()))
+			return true
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"root", "change-first", "change-second"}, ids)
+		assert.Equal(t, []string{"", "first", "second"}, names)
+	})
+	t.Run("replay stops", func(t *testing.T) {
+		importer := newTestReplayImporter(t, "first", "second")
+		var steps int
+		err := importer.Replay(func(st *state.State, step ReplayStep) bool {
+			steps++
+			return step.Idx < 1
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, steps)
+	})
+}
+
+func TestStatesAt(t *testing.T) {
+	importer := newTestReplayImporter(t, "first", "second", "third")
+
+	states, err := importer.StatesAt(0, 2)
+	require.NoError(t, err)
+	require.Len(t, states, 2)
+	require.NotNil(t, states[0], "state of the root change is returned")
+	assert.Empty(t, pbtypes.GetString(states[0].CombinedDetails(), bundle.RelationKeyName.String()))
+	assert.Equal(t, "second", pbtypes.GetString(states[2].CombinedDetails(), bundle.RelationKeyName.String()))
+
+	states, err = importer.StatesAt()
+	require.NoError(t, err)
+	assert.Empty(t, states)
+}
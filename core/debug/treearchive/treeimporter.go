@@ -8,7 +8,6 @@ import (
 	"github.com/anyproto/any-sync/commonspace/object/acl/liststorage"
 	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
 	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
-	"github.com/gogo/protobuf/proto"
 
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/source"
@@ -48,6 +47,9 @@ type TreeImporter interface {
 	Import(fromRoot bool, beforeId string) error
 	Json() (TreeJson, error)
 	ChangeAt(idx int) (IdChange, error)
+	// Replay applies changes one by one from the root of the tree
+	Replay(f func(st *state.State, step ReplayStep) bool) error
+	StatesAt(indexes ...int) (map[int]*state.State, error)
 }
 
 type treeImporter struct {
@@ -112,14 +114,7 @@ func (t *treeImporter) Json() (treeJson TreeJson, err error) {
 		Id: t.objectTree.Id(),
 	}
 	i := 0
-	err = t.objectTree.IterateRoot(func(decrypted []byte) (any, error) {
-		ch := &pb.Change{}
-		err := proto.Unmarshal(decrypted, ch)
-		if err != nil {
-			return nil, err
-		}
-		return ch, nil
-	}, func(change *objecttree.Change) bool {
+	err = t.objectTree.IterateRoot(unmarshalChange, func(change *objecttree.Change) bool {
 		defer func() { i++ }()
 		if change.Id == t.objectTree.Id() {
 			return true
@@ -142,14 +137,7 @@ func (t *treeImporter) ChangeAt(idx int) (idCh IdChange, err error) {
 		return
 	}
 	i := 0
-	err = t.objectTree.IterateRoot(func(decrypted []byte) (any, error) {
-		ch := &pb.Change{}
-		err := proto.Unmarshal(decrypted, ch)
-		if err != nil {
-			return nil, err
-		}
-		return ch, nil
-	}, func(change *objecttree.Change) bool {
+	err = t.objectTree.IterateRoot(unmarshalChange, func(change *objecttree.Change) bool {
 		defer func() { i++ }()
 		if change.Id == t.objectTree.Id() {
 			return true
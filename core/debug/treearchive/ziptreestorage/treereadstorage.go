@@ -32,7 +32,7 @@ func NewZipTreeReadStorage(id string, zr *zip.ReadCloser) (st treestorage.TreeSt
 			zrs.files[last] = f
 		}
 	}
-	data, ok := zrs.files["data.json"]
+	data, ok := zrs.files[DataEntry]
 	if !ok {
 		err = fmt.Errorf("no data.json in archive")
 		return
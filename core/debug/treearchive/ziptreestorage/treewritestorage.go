@@ -1,26 +1,34 @@
 package ziptreestorage
 
 import (
-	"archive/zip"
 	"context"
 	"encoding/json"
 	"github.com/anyproto/any-sync/commonspace/object/tree/treechangeproto"
 	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"io"
 	"strings"
 )
 
+// DataEntry is the name of the entry with heads of the tree
+const DataEntry = "data.json"
+
 type HeadsJsonEntry struct {
 	Heads  []string `json:"heads"`
 	RootId string   `json:"rootId"`
 }
 
+// EntryCreator creates entries of the archive, it's implemented by zip.Writer
+type EntryCreator interface {
+	Create(name string) (io.Writer, error)
+}
+
 type zipTreeWriteStorage struct {
 	id    string
 	heads []string
-	zw    *zip.Writer
+	zw    EntryCreator
 }
 
-func NewZipTreeWriteStorage(root *treechangeproto.RawTreeChangeWithId, zw *zip.Writer) (st treestorage.TreeStorage, err error) {
+func NewZipTreeWriteStorage(root *treechangeproto.RawTreeChangeWithId, zw EntryCreator) (st treestorage.TreeStorage, err error) {
 	z := &zipTreeWriteStorage{
 		id: root.Id,
 		zw: zw,
@@ -86,10 +94,10 @@ func (z *zipTreeWriteStorage) Delete() error {
 }
 
 func (z *zipTreeWriteStorage) FlushStorage() (err error) {
-	chw, err := z.zw.CreateHeader(&zip.FileHeader{
-		Name:   strings.Join([]string{z.id, "data.json"}, "/"),
-		Method: zip.Deflate,
-	})
+	chw, err := z.zw.Create(strings.Join([]string{z.id, DataEntry}, "/"))
+	if err != nil {
+		return
+	}
 	enc := json.NewEncoder(chw)
 	enc.SetIndent("", "\t")
 	err = enc.Encode(HeadsJsonEntry{
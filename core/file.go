@@ -2,12 +2,16 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/anyproto/any-sync/app"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/files/filecache"
+	"github.com/anyproto/anytype-heart/core/filestorage/bandwidth"
 	"github.com/anyproto/anytype-heart/pb"
 )
 
@@ -139,5 +143,156 @@ func (mw *Middleware) FileSpaceUsage(cctx context.Context, req *pb.RpcFileSpaceU
 	if err != nil {
 		return response(pb.RpcFileSpaceUsageResponseError_UNKNOWN_ERROR, err, nil)
 	}
+	cache := getService[filecache.Service](mw)
+	cacheUsage, err := cache.Usage(cctx)
+	if err != nil {
+		return response(pb.RpcFileSpaceUsageResponseError_UNKNOWN_ERROR, err, nil)
+	}
+	usage.LocalPinnedBytes = cacheUsage.Pinned
+	usage.LocalCachedBytes = cacheUsage.Cached
+	usage.LocalBytesLimit = uint64(cache.MaxSize())
 	return response(pb.RpcFileSpaceUsageResponseError_NULL, nil, usage)
 }
+
+func (mw *Middleware) FileSetBandwidth(cctx context.Context, req *pb.RpcFileSetBandwidthRequest) *pb.RpcFileSetBandwidthResponse {
+	response := func(code pb.RpcFileSetBandwidthResponseErrorCode, err error) *pb.RpcFileSetBandwidthResponse {
+		m := &pb.RpcFileSetBandwidthResponse{Error: &pb.RpcFileSetBandwidthResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	a := mw.GetApp()
+	if a == nil {
+		return response(pb.RpcFileSetBandwidthResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	if req.Bandwidth == nil {
+		return response(pb.RpcFileSetBandwidthResponseError_BAD_INPUT, fmt.Errorf("bandwidth is not set"))
+	}
+	err := app.MustComponent[bandwidth.Service](a).SetSettings(config.BandwidthConfig{
+		UploadLimit:        req.Bandwidth.UploadLimit,
+		DownloadLimit:      req.Bandwidth.DownloadLimit,
+		Metered:            req.Bandwidth.Metered,
+		MeteredMaxFileSize: req.Bandwidth.MeteredMaxFileSize,
+	})
+	if errors.Is(err, bandwidth.ErrInvalidSettings) {
+		return response(pb.RpcFileSetBandwidthResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcFileSetBandwidthResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcFileSetBandwidthResponseError_NULL, nil)
+}
+
+func (mw *Middleware) FileGetBandwidth(cctx context.Context, req *pb.RpcFileGetBandwidthRequest) *pb.RpcFileGetBandwidthResponse {
+	response := func(code pb.RpcFileGetBandwidthResponseErrorCode, err error, bw *pb.RpcFileBandwidth) *pb.RpcFileGetBandwidthResponse {
+		m := &pb.RpcFileGetBandwidthResponse{
+			Error:     &pb.RpcFileGetBandwidthResponseError{Code: code},
+			Bandwidth: bw,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	a := mw.GetApp()
+	if a == nil {
+		return response(pb.RpcFileGetBandwidthResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn, nil)
+	}
+	settings := app.MustComponent[bandwidth.Service](a).Settings()
+	return response(pb.RpcFileGetBandwidthResponseError_NULL, nil, &pb.RpcFileBandwidth{
+		UploadLimit:        settings.UploadLimit,
+		DownloadLimit:      settings.DownloadLimit,
+		Metered:            settings.Metered,
+		MeteredMaxFileSize: settings.MeteredMaxFileSize,
+	})
+}
+
+func (mw *Middleware) FilePin(cctx context.Context, req *pb.RpcFilePinRequest) *pb.RpcFilePinResponse {
+	response := func(code pb.RpcFilePinResponseErrorCode, err error) *pb.RpcFilePinResponse {
+		m := &pb.RpcFilePinResponse{Error: &pb.RpcFilePinResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	a := mw.GetApp()
+	if a == nil {
+		return response(pb.RpcFilePinResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	if len(req.Ids) == 0 {
+		return response(pb.RpcFilePinResponseError_BAD_INPUT, fmt.Errorf("ids are empty"))
+	}
+	if err := app.MustComponent[filecache.Service](a).Pin(req.Ids...); err != nil {
+		return response(pb.RpcFilePinResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcFilePinResponseError_NULL, nil)
+}
+
+func (mw *Middleware) FileUnpin(cctx context.Context, req *pb.RpcFileUnpinRequest) *pb.RpcFileUnpinResponse {
+	response := func(code pb.RpcFileUnpinResponseErrorCode, err error) *pb.RpcFileUnpinResponse {
+		m := &pb.RpcFileUnpinResponse{Error: &pb.RpcFileUnpinResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	a := mw.GetApp()
+	if a == nil {
+		return response(pb.RpcFileUnpinResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	if len(req.Ids) == 0 {
+		return response(pb.RpcFileUnpinResponseError_BAD_INPUT, fmt.Errorf("ids are empty"))
+	}
+	if err := app.MustComponent[filecache.Service](a).Unpin(req.Ids...); err != nil {
+		return response(pb.RpcFileUnpinResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcFileUnpinResponseError_NULL, nil)
+}
+
+func (mw *Middleware) FileListPinned(cctx context.Context, req *pb.RpcFileListPinnedRequest) *pb.RpcFileListPinnedResponse {
+	response := func(code pb.RpcFileListPinnedResponseErrorCode, err error, ids []string) *pb.RpcFileListPinnedResponse {
+		m := &pb.RpcFileListPinnedResponse{Error: &pb.RpcFileListPinnedResponseError{Code: code}, Ids: ids}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	a := mw.GetApp()
+	if a == nil {
+		return response(pb.RpcFileListPinnedResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn, nil)
+	}
+	ids, err := app.MustComponent[filecache.Service](a).ListPinned()
+	if err != nil {
+		return response(pb.RpcFileListPinnedResponseError_UNKNOWN_ERROR, err, nil)
+	}
+	return response(pb.RpcFileListPinnedResponseError_NULL, nil, ids)
+}
+
+func (mw *Middleware) FileSetCacheLimit(cctx context.Context, req *pb.RpcFileSetCacheLimitRequest) *pb.RpcFileSetCacheLimitResponse {
+	response := func(code pb.RpcFileSetCacheLimitResponseErrorCode, err error) *pb.RpcFileSetCacheLimitResponse {
+		m := &pb.RpcFileSetCacheLimitResponse{Error: &pb.RpcFileSetCacheLimitResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	a := mw.GetApp()
+	if a == nil {
+		return response(pb.RpcFileSetCacheLimitResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	err := app.MustComponent[filecache.Service](a).SetMaxSize(int64(req.Bytes))
+	if errors.Is(err, filecache.ErrInvalidMaxSize) {
+		return response(pb.RpcFileSetCacheLimitResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcFileSetCacheLimitResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcFileSetCacheLimitResponseError_NULL, nil)
+}
@@ -0,0 +1,74 @@
+package files
+
+import (
+	"strings"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/ipfs/go-cid"
+)
+
+// blockRefsPrefix is the prefix of references from local blocks to files: /files/block_refs/<cid>/<fileID>
+const blockRefsPrefix = "/files/block_refs/"
+
+// blockRefs keeps files referencing local blocks. Encrypted content defined chunks are convergent, so the same
+// chunks of different files are the same blocks. The block is removed only when no other file references it
+type blockRefs struct {
+	db *badger.DB
+}
+
+func blockRefKey(c cid.Cid, fileID string) []byte {
+	return []byte(blockRefsPrefix + c.String() + "/" + fileID)
+}
+
+func (r *blockRefs) add(fileID string, cids []cid.Cid) error {
+	wb := r.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, c := range cids {
+		if err := wb.Set(blockRefKey(c, fileID), nil); err != nil {
+			return err
+		}
+	}
+	return wb.Flush()
+}
+
+func (r *blockRefs) remove(fileID string, cids []cid.Cid) error {
+	wb := r.db.NewWriteBatch()
+	defer wb.Cancel()
+	for _, c := range cids {
+		if err := wb.Delete(blockRefKey(c, fileID)); err != nil {
+			return err
+		}
+	}
+	return wb.Flush()
+}
+
+// list returns files referencing the block
+func (r *blockRefs) list(c cid.Cid) (fileIDs []string, err error) {
+	prefix := blockRefsPrefix + c.String() + "/"
+	err = r.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{
+			PrefetchValues: false,
+			Prefix:         []byte(prefix),
+		})
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			fileIDs = append(fileIDs, strings.TrimPrefix(string(it.Item().Key()), prefix))
+		}
+		return nil
+	})
+	return
+}
+
+// referencedByOthers returns whether any file except the given one references the block
+func (r *blockRefs) referencedByOthers(c cid.Cid, fileID string) (bool, error) {
+	fileIDs, err := r.list(c)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range fileIDs {
+		if id != fileID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,98 @@
+package files
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	chunker "github.com/ipfs/go-ipfs-chunker"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ipfs/go-unixfs/importer/balanced"
+	ufshelpers "github.com/ipfs/go-unixfs/importer/helpers"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/crypto/symmetric"
+	"github.com/anyproto/anytype-heart/pkg/lib/crypto/symmetric/chunks"
+	cdc "github.com/anyproto/anytype-heart/pkg/lib/ipfs/chunker"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+)
+
+const chunksSecretInfo = "anytype.files.chunks"
+
+// addContentChunked adds the content split by FastCDC. When encrypt is set every chunk is encrypted with the key
+// derived from its content and the account key, so the same chunks of different files result in the same blocks
+func (s *service) addContentChunked(ctx context.Context, r io.Reader, encrypt bool) (ipld.Node, []*storage.FileInfoChunk, error) {
+	return s.addChunks(cdc.NewDefaultFastCDC(r), encrypt)
+}
+
+// addChunks adds chunks of the splitter as UnixFS leaves of the balanced DAG, they are not raw blocks
+func (s *service) addChunks(splitter chunker.Splitter, encrypt bool) (ipld.Node, []*storage.FileInfoChunk, error) {
+	var encSplitter *encryptingSplitter
+	if encrypt {
+		secret, err := s.chunksSecret()
+		if err != nil {
+			return nil, nil, err
+		}
+		encSplitter = &encryptingSplitter{Splitter: splitter, secret: secret}
+		splitter = encSplitter
+	}
+	dbp := ufshelpers.DagBuilderParams{
+		Dagserv:    s.dagService,
+		Maxlinks:   ufshelpers.DefaultLinksPerBlock,
+		CidBuilder: cidBuilder,
+	}
+	dbh, err := dbp.New(splitter)
+	if err != nil {
+		return nil, nil, err
+	}
+	node, err := balanced.Layout(dbh)
+	if err != nil {
+		return nil, nil, err
+	}
+	if encSplitter != nil {
+		return node, encSplitter.chunks, nil
+	}
+	return node, nil, nil
+}
+
+func (s *service) chunksSecret() ([]byte, error) {
+	raw, err := s.wallet.GetAccountPrivkey().Raw()
+	if err != nil {
+		return nil, fmt.Errorf("get account key: %w", err)
+	}
+	mac := hmac.New(sha256.New, raw)
+	mac.Write([]byte(chunksSecretInfo))
+	return mac.Sum(nil), nil
+}
+
+type encryptingSplitter struct {
+	chunker.Splitter
+	secret []byte
+	chunks []*storage.FileInfoChunk
+}
+
+func (s *encryptingSplitter) NextBytes() ([]byte, error) {
+	plaintext, err := s.Splitter.NextBytes()
+	if err != nil {
+		return nil, err
+	}
+	key, ciphertext, err := chunks.Encrypt(s.secret, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	s.chunks = append(s.chunks, &storage.FileInfoChunk{Key: key, Size_: int64(len(plaintext))})
+	return ciphertext, nil
+}
+
+func chunksDecryptReader(r io.ReadSeeker, fileChunks []*storage.FileInfoChunk) (symmetric.ReadSeekCloser, error) {
+	decChunks := make([]chunks.Chunk, 0, len(fileChunks))
+	for _, c := range fileChunks {
+		key, err := symmetric.FromBytes(c.Key)
+		if err != nil {
+			return nil, err
+		}
+		decChunks = append(decChunks, chunks.Chunk{Key: key, Size: c.Size_})
+	}
+	return chunks.NewDecryptReader(r, decChunks), nil
+}
@@ -0,0 +1,223 @@
+package files
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonfile/fileblockstore"
+	"github.com/anyproto/any-sync/commonfile/fileservice"
+	"github.com/dgraph-io/badger/v3"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	chunker "github.com/ipfs/go-ipfs-chunker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/wallet"
+	cdc "github.com/anyproto/anytype-heart/pkg/lib/ipfs/chunker"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/filestore"
+	"github.com/anyproto/anytype-heart/pkg/lib/mill"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+)
+
+type testBlockStore struct {
+	mu     sync.Mutex
+	blocks map[cid.Cid]blocks.Block
+}
+
+func (s *testBlockStore) Init(a *app.App) error { return nil }
+
+func (s *testBlockStore) Name() string { return fileblockstore.CName }
+
+func (s *testBlockStore) Get(ctx context.Context, k cid.Cid) (blocks.Block, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if b, ok := s.blocks[k]; ok {
+		return b, nil
+	}
+	return nil, fileblockstore.ErrCIDNotFound
+}
+
+func (s *testBlockStore) GetMany(ctx context.Context, ks []cid.Cid) <-chan blocks.Block {
+	ch := make(chan blocks.Block, len(ks))
+	defer close(ch)
+	for _, k := range ks {
+		if b, err := s.Get(ctx, k); err == nil {
+			ch <- b
+		}
+	}
+	return ch
+}
+
+func (s *testBlockStore) Add(ctx context.Context, bs []blocks.Block) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range bs {
+		s.blocks[b.Cid()] = b
+	}
+	return nil
+}
+
+func (s *testBlockStore) Delete(ctx context.Context, k cid.Cid) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.blocks, k)
+	return nil
+}
+
+func (s *testBlockStore) ExistsCids(ctx context.Context, ks []cid.Cid) (exists []cid.Cid, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, k := range ks {
+		if _, ok := s.blocks[k]; ok {
+			exists = append(exists, k)
+		}
+	}
+	return
+}
+
+func (s *testBlockStore) NotExistsBlocks(ctx context.Context, bs []blocks.Block) (notExists []blocks.Block, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range bs {
+		if _, ok := s.blocks[b.Cid()]; !ok {
+			notExists = append(notExists, b)
+		}
+	}
+	return
+}
+
+func (s *testBlockStore) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.blocks)
+}
+
+type testFileStore struct {
+	filestore.FileStore
+}
+
+func (s *testFileStore) GetBySource(mill string, source string, opts string) (*storage.FileInfo, error) {
+	return nil, localstore.ErrNotFound
+}
+
+func (s *testFileStore) GetByChecksum(mill string, checksum string) (*storage.FileInfo, error) {
+	return nil, localstore.ErrNotFound
+}
+
+func (s *testFileStore) Add(file *storage.FileInfo) error {
+	return nil
+}
+
+func newChunksFixture(t *testing.T) (*service, *testBlockStore) {
+	bs := &testBlockStore{blocks: map[cid.Cid]blocks.Block{}}
+	commonFile := fileservice.New()
+	w := wallet.NewWithRepoDirAndRandomKeys(t.TempDir())
+	a := new(app.App)
+	a.Register(bs).Register(commonFile).Register(w)
+	require.NoError(t, a.Start(context.Background()))
+	t.Cleanup(func() { _ = a.Close(context.Background()) })
+	db, err := badger.Open(badger.DefaultOptions(t.TempDir()).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return &service{
+		commonFile: commonFile,
+		dagService: commonFile.DAGService(),
+		fileStore:  &testFileStore{},
+		wallet:     w,
+		blockRefs:  &blockRefs{db: db},
+	}, bs
+}
+
+func testChunksData() []byte {
+	data := make([]byte, 4<<20)
+	rand.New(rand.NewSource(1)).Read(data)
+	return data
+}
+
+func addChunked(t *testing.T, s *service, data []byte) *storage.FileInfo {
+	info, err := s.fileAddWithConfig(context.Background(), &mill.Blob{}, AddOptions{
+		Reader:                 bytes.NewReader(data),
+		Name:                   "file.bin",
+		ContentDefinedChunking: true,
+	})
+	require.NoError(t, err)
+	return info
+}
+
+func readChunked(t *testing.T, s *service, info *storage.FileInfo) []byte {
+	r, err := s.getContentReader(context.Background(), info)
+	require.NoError(t, err)
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return content
+}
+
+func TestFileAddContentDefinedChunking(t *testing.T) {
+	ctx := context.Background()
+	s, bs := newChunksFixture(t)
+	data := testChunksData()
+	add := func(data []byte) *storage.FileInfo {
+		return addChunked(t, s, data)
+	}
+	read := func(info *storage.FileInfo) []byte {
+		return readChunked(t, s, info)
+	}
+
+	info := add(data)
+	assert.Equal(t, storage.FileInfo_AES_GCM_CHUNKS, info.EncMode)
+	assert.Greater(t, len(info.Chunks), 4)
+	assert.Equal(t, data, read(info))
+	blocksCount := bs.len()
+
+	t.Run("shifted content reuses blocks", func(t *testing.T) {
+		shifted := append([]byte("inserted at the beginning"), data...)
+		shiftedInfo := add(shifted)
+		assert.Equal(t, shifted, read(shiftedInfo))
+		// only the changed first chunk, the root and the meta are new
+		assert.LessOrEqual(t, bs.len()-blocksCount, 4)
+		assert.Greater(t, blocksCount, 4)
+	})
+	t.Run("max size leaf is not larger than the leaf of the fixed size splitter", func(t *testing.T) {
+		fixed, err := s.commonFile.AddFile(ctx, bytes.NewReader(data[:fileservice.ChunkSize]))
+		require.NoError(t, err)
+		chunked, _, err := s.addChunks(chunker.NewSizeSplitter(bytes.NewReader(data[:cdc.DefaultMaxSize]), cdc.DefaultMaxSize), true)
+		require.NoError(t, err)
+		assert.Equal(t, len(fixed.RawData()), len(chunked.RawData()))
+	})
+}
+
+func TestSharedChunksOffload(t *testing.T) {
+	s, _ := newChunksFixture(t)
+	data := testChunksData()
+	info := addChunked(t, s, data)
+	shifted := append([]byte("inserted at the beginning"), data...)
+	shiftedInfo := addChunked(t, s, shifted)
+	require.NoError(t, s.addBlockRefs(info.Hash))
+	require.NoError(t, s.addBlockRefs(shiftedInfo.Hash))
+
+	size, err := s.FileLocalSize(info.Hash)
+	require.NoError(t, err)
+	shiftedSize, err := s.FileLocalSize(shiftedInfo.Hash)
+	require.NoError(t, err)
+	total, err := s.FilesLocalSize([]string{info.Hash, shiftedInfo.Hash})
+	require.NoError(t, err)
+	assert.Greater(t, total, shiftedSize)
+	assert.Less(t, total, size+shiftedSize, "shared blocks are counted once")
+
+	removed, err := s.fileOffload(shiftedInfo.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, total-size, removed, "only blocks not shared with the other file are removed")
+	assert.Equal(t, data, readChunked(t, s, info))
+
+	removed, err = s.fileOffload(info.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, size, removed, "shared blocks are removed with the last file referencing them")
+}
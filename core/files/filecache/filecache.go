@@ -0,0 +1,385 @@
+package filecache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/app/logger"
+	"github.com/anyproto/any-sync/util/periodicsync"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/getblock"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/filestorage"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/filestore"
+)
+
+const CName = "files.cache"
+
+// evictPeriodSec is the period of saving access times and checking the cache size
+const evictPeriodSec = 60
+
+var log = logger.NewNamed(CName)
+
+var ErrInvalidMaxSize = errors.New("invalid max cache size")
+
+type Usage struct {
+	// Pinned is the size of local blocks of pinned files
+	Pinned uint64
+	// Cached is the size of all other local blocks, they can be evicted
+	Cached uint64
+}
+
+// Service keeps the local storage of files within the max size. Files are evicted starting from the least recently
+// opened, only files synced to the file node are evicted. Pinned files, files of pinned objects and files of objects
+// in pinned collections are always kept, including blocks they share with evicted files
+type Service interface {
+	Pin(ids ...string) error
+	Unpin(ids ...string) error
+	ListPinned() ([]string, error)
+	MaxSize() int64
+	// SetMaxSize sets the max size of the local file storage, zero means no limit
+	SetMaxSize(maxSize int64) error
+	Usage(ctx context.Context) (Usage, error)
+	app.ComponentRunnable
+}
+
+func New(picker getblock.Picker) Service {
+	return &service{
+		picker:   picker,
+		accessed: map[string]int64{},
+	}
+}
+
+type service struct {
+	picker      getblock.Picker
+	files       files.Service
+	localFiles  files.LocalFiles
+	fileStore   filestore.FileStore
+	fileStorage filestorage.FileStorage
+	dbProvider  datastore.Datastore
+	config      *config.Config
+	store       *store
+	periodic    periodicsync.PeriodicSync
+
+	mu       sync.Mutex
+	maxSize  int64
+	accessed map[string]int64
+	evictMu  sync.Mutex
+
+	// pinned is the resolved set of pinned files, nil when it should be resolved again. Files of pinned objects
+	// could change, so the set is resolved again every evict period
+	pinned   map[string]struct{}
+	pinnedMu sync.Mutex
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	evictWg   sync.WaitGroup
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.files = a.MustComponent(files.CName).(files.Service)
+	localFiles, ok := s.files.(files.LocalFiles)
+	if !ok {
+		return fmt.Errorf("files service doesn't support local files management")
+	}
+	s.localFiles = localFiles
+	s.fileStore = a.MustComponent(filestore.CName).(filestore.FileStore)
+	s.fileStorage = app.MustComponent[filestorage.FileStorage](a)
+	s.dbProvider = a.MustComponent(datastore.CName).(datastore.Datastore)
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.config = cfg
+		cacheCfg, cfgErr := cfg.FileCacheConfig()
+		if cfgErr != nil {
+			log.Error("failed to get file cache config", zap.Error(cfgErr))
+		}
+		s.maxSize = cacheCfg.MaxSize
+	}
+	s.localFiles.OnAccess(s.touch)
+	s.ctx, s.ctxCancel = context.WithCancel(context.Background())
+	s.periodic = periodicsync.NewPeriodicSync(evictPeriodSec, 0, s.checkSize, log)
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) Run(ctx context.Context) (err error) {
+	db, err := s.dbProvider.SpaceStorage()
+	if err != nil {
+		return
+	}
+	s.store = &store{db: db}
+	s.periodic.Run()
+	return
+}
+
+func (s *service) Close(ctx context.Context) (err error) {
+	if s.ctxCancel != nil {
+		s.mu.Lock()
+		s.ctxCancel()
+		s.mu.Unlock()
+	}
+	if s.periodic != nil {
+		s.periodic.Close()
+	}
+	s.evictWg.Wait()
+	if s.store != nil {
+		return s.flushAccessTimes()
+	}
+	return nil
+}
+
+func (s *service) touch(fileID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accessed[fileID] = time.Now().Unix()
+}
+
+func (s *service) flushAccessTimes() error {
+	s.mu.Lock()
+	accessed := s.accessed
+	s.accessed = map[string]int64{}
+	s.mu.Unlock()
+	if len(accessed) == 0 {
+		return nil
+	}
+	return s.store.setAccessTimes(accessed)
+}
+
+func (s *service) Pin(ids ...string) error {
+	if err := s.store.addPins(ids); err != nil {
+		return err
+	}
+	s.resetPinned()
+	return nil
+}
+
+func (s *service) Unpin(ids ...string) error {
+	if err := s.store.removePins(ids); err != nil {
+		return err
+	}
+	s.resetPinned()
+	s.evictAsync()
+	return nil
+}
+
+func (s *service) ListPinned() ([]string, error) {
+	return s.store.listPins()
+}
+
+func (s *service) MaxSize() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.maxSize
+}
+
+func (s *service) SetMaxSize(maxSize int64) error {
+	if maxSize < 0 {
+		return fmt.Errorf("%w: negative size", ErrInvalidMaxSize)
+	}
+	if s.config != nil {
+		if err := config.WriteJsonConfig(s.config.GetConfigPath(), config.ConfigRequired{FileCache: &config.FileCacheConfig{MaxSize: maxSize}}); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	s.maxSize = maxSize
+	s.mu.Unlock()
+	s.evictAsync()
+	return nil
+}
+
+func (s *service) Usage(ctx context.Context) (Usage, error) {
+	total, err := s.fileStorage.LocalDiskUsage(ctx)
+	if err != nil {
+		return Usage{}, err
+	}
+	pinned, err := s.pinnedFiles()
+	if err != nil {
+		return Usage{}, err
+	}
+	var usage Usage
+	// blocks shared by pinned files are counted once
+	usage.Pinned, err = s.localFiles.FilesLocalSize(lo.Keys(pinned))
+	if err != nil {
+		return Usage{}, err
+	}
+	if total > usage.Pinned {
+		usage.Cached = total - usage.Pinned
+	}
+	return usage, nil
+}
+
+func (s *service) checkSize(ctx context.Context) error {
+	if err := s.flushAccessTimes(); err != nil {
+		log.Error("failed to save file access times", zap.Error(err))
+	}
+	s.resetPinned()
+	s.evict(ctx)
+	return nil
+}
+
+// evictAsync starts the eviction in the background, it is canceled and waited for on Close
+func (s *service) evictAsync() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ctx.Err() != nil {
+		return
+	}
+	s.evictWg.Add(1)
+	go func() {
+		defer s.evictWg.Done()
+		s.evict(s.ctx)
+	}()
+}
+
+func (s *service) evict(ctx context.Context) {
+	s.evictMu.Lock()
+	defer s.evictMu.Unlock()
+	maxSize := uint64(s.MaxSize())
+	if maxSize == 0 {
+		return
+	}
+	usage, err := s.fileStorage.LocalDiskUsage(ctx)
+	if err != nil {
+		log.Error("failed to get local disk usage", zap.Error(err))
+		return
+	}
+	if usage <= maxSize {
+		return
+	}
+	candidates, pinned, err := s.evictionCandidates()
+	if err != nil {
+		log.Error("failed to list files to evict", zap.Error(err))
+		return
+	}
+	// blocks of pinned files are referenced, so blocks shared with evicted files are kept
+	if _, err = s.localFiles.FilesLocalSize(pinned); err != nil {
+		log.Error("failed to reference blocks of pinned files", zap.Error(err))
+		return
+	}
+	var evicted uint64
+	for _, fileID := range candidates {
+		if usage <= maxSize || ctx.Err() != nil {
+			break
+		}
+		// only synced files are offloaded
+		removed, _, err := s.files.FileListOffload([]string{fileID}, false)
+		if err != nil {
+			log.Warn("failed to evict file", zap.String("fileID", fileID), zap.Error(err))
+			continue
+		}
+		evicted += removed
+		if removed > usage {
+			removed = usage
+		}
+		usage -= removed
+	}
+	log.Info("files evicted", zap.Uint64("bytes", evicted), zap.Uint64("usage", usage), zap.Uint64("maxSize", maxSize))
+}
+
+// evictionCandidates returns files that can be evicted and pinned files
+func (s *service) evictionCandidates() (candidates, pinnedIDs []string, err error) {
+	targets, err := s.fileStore.ListTargets()
+	if err != nil {
+		return nil, nil, err
+	}
+	pinned, err := s.pinnedFiles()
+	if err != nil {
+		return nil, nil, err
+	}
+	accessed, err := s.store.accessTimes()
+	if err != nil {
+		return nil, nil, err
+	}
+	s.mu.Lock()
+	for fileID, ts := range s.accessed {
+		accessed[fileID] = ts
+	}
+	s.mu.Unlock()
+	return leastRecentlyUsed(targets, pinned, accessed), lo.Keys(pinned), nil
+}
+
+// leastRecentlyUsed returns not pinned files sorted from the least recently used, never opened files go first
+func leastRecentlyUsed(fileIDs []string, pinned map[string]struct{}, accessed map[string]int64) []string {
+	result := make([]string, 0, len(fileIDs))
+	for _, fileID := range fileIDs {
+		if _, ok := pinned[fileID]; !ok {
+			result = append(result, fileID)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return accessed[result[i]] < accessed[result[j]]
+	})
+	return result
+}
+
+// pinnedFiles returns the set of pinned files, it must not be modified. Pinned ids are resolved to files,
+// pinned collections are resolved to files of their objects
+func (s *service) pinnedFiles() (map[string]struct{}, error) {
+	s.pinnedMu.Lock()
+	defer s.pinnedMu.Unlock()
+	if s.pinned != nil {
+		return s.pinned, nil
+	}
+	pins, err := s.store.listPins()
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]struct{}{}
+	visited := map[string]struct{}{}
+	for _, id := range pins {
+		s.resolvePin(id, result, visited, true)
+	}
+	s.pinned = result
+	return result, nil
+}
+
+func (s *service) resetPinned() {
+	s.pinnedMu.Lock()
+	defer s.pinnedMu.Unlock()
+	s.pinned = nil
+}
+
+func (s *service) resolvePin(id string, result, visited map[string]struct{}, withCollection bool) {
+	if _, ok := visited[id]; ok {
+		return
+	}
+	visited[id] = struct{}{}
+	if roots, err := s.fileStore.ListByTarget(id); err == nil && len(roots) > 0 {
+		result[id] = struct{}{}
+		return
+	}
+	var fileIDs, objectIDs []string
+	err := getblock.Do(s.picker, id, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		fileIDs = st.GetAllFileHashes(sb.FileRelationKeys(st))
+		objectIDs = st.GetStoreSlice(template.CollectionStoreKey)
+		return nil
+	})
+	if err != nil {
+		log.Warn("failed to get files of pinned object", zap.String("objectID", id), zap.Error(err))
+		return
+	}
+	for _, fileID := range fileIDs {
+		result[fileID] = struct{}{}
+	}
+	if !withCollection {
+		return
+	}
+	for _, objectID := range objectIDs {
+		s.resolvePin(objectID, result, visited, false)
+	}
+}
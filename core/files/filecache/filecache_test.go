@@ -0,0 +1,329 @@
+package filecache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/filestorage"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/filestore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+)
+
+func TestLeastRecentlyUsed(t *testing.T) {
+	fileIDs := []string{"opened", "pinned", "neverOpened", "openedLater"}
+	pinned := map[string]struct{}{"pinned": {}}
+	accessed := map[string]int64{
+		"opened":      100,
+		"pinned":      50,
+		"openedLater": 200,
+	}
+	assert.Equal(t, []string{"neverOpened", "opened", "openedLater"}, leastRecentlyUsed(fileIDs, pinned, accessed))
+}
+
+func TestStore(t *testing.T) {
+	db, err := badger.Open(badger.DefaultOptions(t.TempDir()).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	defer db.Close()
+	s := &store{db: db}
+
+	t.Run("pins", func(t *testing.T) {
+		require.NoError(t, s.addPins([]string{"a", "b", "c"}))
+		require.NoError(t, s.removePins([]string{"b", "unknown"}))
+		pins, err := s.listPins()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "c"}, pins)
+	})
+	t.Run("access times", func(t *testing.T) {
+		require.NoError(t, s.setAccessTimes(map[string]int64{"a": 1, "b": 2}))
+		require.NoError(t, s.setAccessTimes(map[string]int64{"a": 3}))
+		times, err := s.accessTimes()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"a": 3, "b": 2}, times)
+	})
+}
+
+type testFiles struct {
+	files.Service
+	mu sync.Mutex
+	// blocks are local blocks of files, the same block can be shared by several files
+	blocks map[string][]string
+	// blockSizes are sizes of local blocks
+	blockSizes map[string]uint64
+	// refs are files referencing blocks, referenced blocks are kept when other files are offloaded
+	refs   map[string]map[string]struct{}
+	synced map[string]bool
+}
+
+// newTestFiles returns files each having its own block of the given size
+func newTestFiles(sizes map[string]uint64) *testFiles {
+	f := &testFiles{
+		blocks:     map[string][]string{},
+		blockSizes: map[string]uint64{},
+		refs:       map[string]map[string]struct{}{},
+		synced:     map[string]bool{},
+	}
+	for fileID, size := range sizes {
+		f.addBlock(fileID, fileID+"/block", size)
+	}
+	return f
+}
+
+func (f *testFiles) addBlock(fileID, block string, size uint64) {
+	f.blocks[fileID] = append(f.blocks[fileID], block)
+	f.blockSizes[block] = size
+}
+
+// sizes returns local sizes of files having local blocks
+func (f *testFiles) sizes() map[string]uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	sizes := map[string]uint64{}
+	for fileID := range f.blocks {
+		if size := f.localSize(fileID); size > 0 {
+			sizes[fileID] = size
+		}
+	}
+	return sizes
+}
+
+func (f *testFiles) localSize(fileID string) (size uint64) {
+	for _, block := range f.blocks[fileID] {
+		size += f.blockSizes[block]
+	}
+	return
+}
+
+func (f *testFiles) OnAccess(func(fileID string)) {}
+
+func (f *testFiles) FileLocalSize(fileID string) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.localSize(fileID), nil
+}
+
+func (f *testFiles) FilesLocalSize(fileIDs []string) (size uint64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	counted := map[string]struct{}{}
+	for _, fileID := range fileIDs {
+		for _, block := range f.blocks[fileID] {
+			if f.refs[block] == nil {
+				f.refs[block] = map[string]struct{}{}
+			}
+			f.refs[block][fileID] = struct{}{}
+			if _, ok := counted[block]; !ok {
+				counted[block] = struct{}{}
+				size += f.blockSizes[block]
+			}
+		}
+	}
+	return
+}
+
+func (f *testFiles) FileListOffload(fileIDs []string, _ bool) (totalBytesOffloaded uint64, totalFilesOffloaded uint64, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, fileID := range fileIDs {
+		if len(f.blocks[fileID]) == 0 || !f.synced[fileID] {
+			continue
+		}
+		for _, block := range f.blocks[fileID] {
+			delete(f.refs[block], fileID)
+			if len(f.refs[block]) > 0 {
+				continue
+			}
+			totalBytesOffloaded += f.blockSizes[block]
+			delete(f.blockSizes, block)
+		}
+		totalFilesOffloaded++
+	}
+	return
+}
+
+type testFileStorage struct {
+	filestorage.FileStorage
+	files *testFiles
+}
+
+func (s *testFileStorage) LocalDiskUsage(ctx context.Context) (usage uint64, err error) {
+	s.files.mu.Lock()
+	defer s.files.mu.Unlock()
+	for _, size := range s.files.blockSizes {
+		usage += size
+	}
+	return
+}
+
+type testFileStore struct {
+	filestore.FileStore
+	targets []string
+}
+
+func (s *testFileStore) ListTargets() ([]string, error) {
+	return s.targets, nil
+}
+
+func (s *testFileStore) ListByTarget(target string) ([]*storage.FileInfo, error) {
+	if slices.Contains(s.targets, target) {
+		return []*storage.FileInfo{{Hash: target}}, nil
+	}
+	return nil, nil
+}
+
+type testPicker struct {
+	objects map[string]smartblock.SmartBlock
+	picked  int
+}
+
+func (p *testPicker) PickBlock(ctx context.Context, id string) (smartblock.SmartBlock, error) {
+	p.picked++
+	if sb, ok := p.objects[id]; ok {
+		return sb, nil
+	}
+	return nil, fmt.Errorf("object %s not found", id)
+}
+
+type fixture struct {
+	*service
+	files  *testFiles
+	picker *testPicker
+}
+
+func newFixture(t *testing.T, sizes map[string]uint64) *fixture {
+	db, err := badger.Open(badger.DefaultOptions(t.TempDir()).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	fx := &fixture{
+		files:  newTestFiles(sizes),
+		picker: &testPicker{objects: map[string]smartblock.SmartBlock{}},
+	}
+	fx.service = New(fx.picker).(*service)
+	fx.service.files = fx.files
+	fx.localFiles = fx.files
+	fx.fileStorage = &testFileStorage{files: fx.files}
+	fx.fileStore = &testFileStore{targets: lo.Keys(sizes)}
+	fx.store = &store{db: db}
+	fx.ctx, fx.ctxCancel = context.WithCancel(context.Background())
+	return fx
+}
+
+func testFileID(name string) string {
+	return blocks.NewBlock([]byte(name)).Cid().String()
+}
+
+func objectWithFiles(id string, fileIDs ...string) *smarttest.SmartTest {
+	sb := smarttest.New(id)
+	root := &model.Block{Id: id}
+	for _, fileID := range fileIDs {
+		root.ChildrenIds = append(root.ChildrenIds, fileID)
+		sb.AddBlock(simple.New(&model.Block{
+			Id:      fileID,
+			Content: &model.BlockContentOfFile{File: &model.BlockContentFile{Hash: fileID}},
+		}))
+	}
+	return sb.AddBlock(simple.New(root))
+}
+
+func TestEvict(t *testing.T) {
+	var (
+		opened      = testFileID("opened")
+		openedLater = testFileID("openedLater")
+		pinned      = testFileID("pinned")
+		notSynced   = testFileID("notSynced")
+	)
+	fx := newFixture(t, map[string]uint64{opened: 10, openedLater: 10, pinned: 10, notSynced: 10})
+	fx.files.synced = map[string]bool{opened: true, openedLater: true, pinned: true}
+	fx.picker.objects["page"] = objectWithFiles("page", pinned)
+	require.NoError(t, fx.Pin("page"))
+	require.NoError(t, fx.store.setAccessTimes(map[string]int64{opened: 100}))
+	fx.touch(openedLater)
+
+	require.NoError(t, fx.SetMaxSize(25))
+	fx.evictWg.Wait()
+	require.NoError(t, fx.Close(context.Background()))
+
+	assert.Equal(t, map[string]uint64{pinned: 10, notSynced: 10}, fx.files.sizes())
+
+	t.Run("no eviction after close", func(t *testing.T) {
+		require.NoError(t, fx.Unpin("page"))
+		fx.evictWg.Wait()
+		assert.Equal(t, map[string]uint64{pinned: 10, notSynced: 10}, fx.files.sizes())
+	})
+}
+
+func TestEvictSharedChunks(t *testing.T) {
+	var (
+		pinned = testFileID("pinned")
+		cached = testFileID("cached")
+	)
+	fx := newFixture(t, map[string]uint64{pinned: 10, cached: 10})
+	fx.files.addBlock(pinned, "shared", 10)
+	fx.files.addBlock(cached, "shared", 10)
+	fx.files.synced = map[string]bool{pinned: true, cached: true}
+	require.NoError(t, fx.Pin(pinned))
+
+	require.NoError(t, fx.SetMaxSize(25))
+	fx.evictWg.Wait()
+	assert.Equal(t, map[string]uint64{pinned: 20, cached: 10}, fx.files.sizes(), "the shared block is kept")
+	usage, err := fx.Usage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Usage{Pinned: 20}, usage)
+
+	t.Run("shared blocks are counted once", func(t *testing.T) {
+		require.NoError(t, fx.Pin(cached))
+		usage, err := fx.Usage(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, Usage{Pinned: 20}, usage)
+	})
+}
+
+func TestUsage(t *testing.T) {
+	var (
+		pinnedFile    = testFileID("pinnedFile")
+		objectFile    = testFileID("objectFile")
+		collectedFile = testFileID("collectedFile")
+		cached        = testFileID("cached")
+	)
+	fx := newFixture(t, map[string]uint64{pinnedFile: 1, objectFile: 10, collectedFile: 100, cached: 1000})
+	collection := objectWithFiles("collection")
+	st := collection.NewState()
+	st.UpdateStoreSlice(template.CollectionStoreKey, []string{"page"})
+	require.NoError(t, collection.Apply(st))
+	fx.picker.objects["collection"] = collection
+	fx.picker.objects["page"] = objectWithFiles("page", collectedFile)
+	fx.picker.objects["object"] = objectWithFiles("object", objectFile)
+	require.NoError(t, fx.Pin(pinnedFile, "object", "collection"))
+
+	usage, err := fx.Usage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Usage{Pinned: 111, Cached: 1000}, usage)
+
+	t.Run("pinned files are cached", func(t *testing.T) {
+		picked := fx.picker.picked
+		_, err := fx.Usage(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, picked, fx.picker.picked)
+	})
+	t.Run("unpin", func(t *testing.T) {
+		require.NoError(t, fx.Unpin("collection"))
+		usage, err := fx.Usage(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, Usage{Pinned: 11, Cached: 1100}, usage)
+	})
+}
@@ -0,0 +1,99 @@
+package filecache
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/dgraph-io/badger/v3"
+
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+)
+
+const (
+	keyPrefix    = "/filecache/"
+	pinPrefix    = keyPrefix + "pin/"
+	accessPrefix = keyPrefix + "access/"
+)
+
+// store keeps pinned ids and the time of the last access to files
+type store struct {
+	db *badger.DB
+}
+
+func (s *store) updateTxn(f func(txn *badger.Txn) error) error {
+	return badgerhelper.RetryOnConflict(func() error {
+		return s.db.Update(f)
+	})
+}
+
+func (s *store) addPins(ids []string) error {
+	return s.updateTxn(func(txn *badger.Txn) error {
+		for _, id := range ids {
+			if err := txn.Set([]byte(pinPrefix+id), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *store) removePins(ids []string) error {
+	return s.updateTxn(func(txn *badger.Txn) error {
+		for _, id := range ids {
+			if err := txn.Delete([]byte(pinPrefix + id)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *store) listPins() (ids []string, err error) {
+	err = s.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{
+			PrefetchValues: false,
+			Prefix:         []byte(pinPrefix),
+		})
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			ids = append(ids, strings.TrimPrefix(string(it.Item().Key()), pinPrefix))
+		}
+		return nil
+	})
+	return
+}
+
+func (s *store) setAccessTimes(times map[string]int64) error {
+	return s.updateTxn(func(txn *badger.Txn) error {
+		for fileID, ts := range times {
+			if err := txn.Set([]byte(accessPrefix+fileID), binary.LittleEndian.AppendUint64(nil, uint64(ts))); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *store) accessTimes() (times map[string]int64, err error) {
+	times = map[string]int64{}
+	err = s.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{
+			PrefetchValues: true,
+			PrefetchSize:   100,
+			Prefix:         []byte(accessPrefix),
+		})
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			fileID := strings.TrimPrefix(string(item.Key()), accessPrefix)
+			if err := item.Value(func(val []byte) error {
+				times[fileID] = int64(binary.LittleEndian.Uint64(val))
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return
+}
@@ -26,11 +26,13 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/filestorage"
 	"github.com/anyproto/anytype-heart/core/filestorage/filesync"
+	"github.com/anyproto/anytype-heart/core/wallet"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/crypto/symmetric"
 	"github.com/anyproto/anytype-heart/pkg/lib/crypto/symmetric/cfb"
 	"github.com/anyproto/anytype-heart/pkg/lib/crypto/symmetric/gcm"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
 	"github.com/anyproto/anytype-heart/pkg/lib/ipfs/helpers"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/filestore"
@@ -79,6 +81,10 @@ type service struct {
 	fileStorage       filestorage.FileStorage
 	syncStatusWatcher SyncStatusWatcher
 	objectStore       objectstore.ObjectStore
+	wallet            wallet.Wallet
+	dbProvider        datastore.Datastore
+	blockRefs         *blockRefs
+	onAccess          func(fileID string)
 }
 
 func New(statusWatcher SyncStatusWatcher, objectStore objectstore.ObjectStore) Service {
@@ -95,6 +101,8 @@ func (s *service) Init(a *app.App) (err error) {
 	s.spaceService = a.MustComponent(space.CName).(space.Service)
 	s.dagService = s.commonFile.DAGService()
 	s.fileStorage = app.MustComponent[filestorage.FileStorage](a)
+	s.wallet = a.MustComponent(wallet.CName).(wallet.Wallet)
+	s.dbProvider = a.MustComponent(datastore.CName).(datastore.Datastore)
 	return nil
 }
 
@@ -102,6 +110,19 @@ func (s *service) Name() (name string) {
 	return CName
 }
 
+func (s *service) Run(ctx context.Context) (err error) {
+	db, err := s.dbProvider.SpaceStorage()
+	if err != nil {
+		return
+	}
+	s.blockRefs = &blockRefs{db: db}
+	return
+}
+
+func (s *service) Close(ctx context.Context) (err error) {
+	return nil
+}
+
 type FileKeys struct {
 	Hash string
 	Keys map[string]string
@@ -132,6 +153,9 @@ func (s *service) fileAdd(ctx context.Context, opts AddOptions) (string, *storag
 	if err = s.fileIndexData(ctx, node, nodeHash); err != nil {
 		return "", nil, err
 	}
+	if err = s.addBlockRefs(nodeHash); err != nil {
+		return "", nil, err
+	}
 
 	if err = s.fileStore.AddFileKeys(filestore.FileKeys{
 		Hash: nodeHash,
@@ -424,8 +448,10 @@ func (s *service) fileInfoFromPath(ctx context.Context, target string, path stri
 				}
 				continue
 			}
-			// save successful enc mode so it will be cached in the DB
-			file.EncMode = mode
+			// save successful enc mode so it will be cached in the DB, chunked content has its own mode
+			if file.EncMode != storage.FileInfo_AES_GCM_CHUNKS {
+				file.EncMode = mode
+			}
 			break
 		}
 	} else {
@@ -472,6 +498,10 @@ func (s *service) getContentReader(ctx context.Context, file *storage.FileInfo)
 		return fd, nil
 	}
 
+	if file.EncMode == storage.FileInfo_AES_GCM_CHUNKS {
+		return chunksDecryptReader(fd, file.Chunks)
+	}
+
 	key, err := symmetric.FromString(file.Key)
 	if err != nil {
 		return nil, err
@@ -557,28 +587,42 @@ func (s *service) fileAddWithConfig(ctx context.Context, mill m.Mill, conf AddOp
 	var (
 		contentReader io.Reader
 		encryptor     symmetric.EncryptorDecryptor
+		encrypt       = mill.Encrypt() && !conf.Plaintext
 	)
-	if mill.Encrypt() && !conf.Plaintext {
+	if encrypt {
 		key, err := symmetric.NewRandom()
 		if err != nil {
 			return nil, err
 		}
 		encryptor = cfb.New(key, [aes.BlockSize]byte{})
 
-		contentReader, err = encryptor.EncryptReader(res.File)
-		if err != nil {
-			return nil, err
-		}
-
 		fileInfo.Key = key.String()
 		fileInfo.EncMode = storage.FileInfo_AES_CFB
-	} else {
-		contentReader = res.File
 	}
 
-	contentNode, err := s.commonFile.AddFile(ctx, contentReader)
-	if err != nil {
-		return nil, err
+	var contentNode ipld.Node
+	if conf.ContentDefinedChunking {
+		// the key is still used for the meta
+		contentNode, fileInfo.Chunks, err = s.addContentChunked(ctx, res.File, encrypt)
+		if err != nil {
+			return nil, err
+		}
+		if encrypt {
+			fileInfo.EncMode = storage.FileInfo_AES_GCM_CHUNKS
+		}
+	} else {
+		if encryptor != nil {
+			contentReader, err = encryptor.EncryptReader(res.File)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			contentReader = res.File
+		}
+		contentNode, err = s.commonFile.AddFile(ctx, contentReader)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	fileInfo.Hash = contentNode.Cid().String()
@@ -855,6 +899,7 @@ func (s *service) StoreFileKeys(fileKeys ...FileKeys) error {
 }
 
 func (s *service) FileByHash(ctx context.Context, hash string) (File, error) {
+	s.fileAccessed(ctx, hash)
 	ok, err := s.isDeleted(hash)
 	if err != nil {
 		return nil, fmt.Errorf("check if file is deleted: %w", err)
@@ -11,6 +11,7 @@ import (
 )
 
 func (s *service) ImageByHash(ctx context.Context, hash string) (Image, error) {
+	s.fileAccessed(ctx, hash)
 	ok, err := s.isDeleted(hash)
 	if err != nil {
 		return nil, fmt.Errorf("check if file is deleted: %w", err)
@@ -101,6 +102,9 @@ func (s *service) imageAdd(ctx context.Context, opts AddOptions) (string, map[in
 	if err != nil {
 		return "", nil, err
 	}
+	if err = s.addBlockRefs(nodeHash); err != nil {
+		return "", nil, err
+	}
 
 	var variantsByWidth = make(map[int]*storage.FileInfo, len(dir.Files))
 	for _, f := range dir.Files {
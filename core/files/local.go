@@ -0,0 +1,73 @@
+package files
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+
+	"github.com/anyproto/anytype-heart/core/filestorage"
+)
+
+// LocalFiles is implemented by the files service to manage the local copies of files
+type LocalFiles interface {
+	// OnAccess sets the callback called when the file is requested by the user
+	OnAccess(func(fileID string))
+	// FileLocalSize returns the size of the file blocks stored locally
+	FileLocalSize(fileID string) (uint64, error)
+	// FilesLocalSize returns the size of blocks of the files stored locally, blocks shared by the files are counted
+	// once. Found blocks are referenced by the files, so they are kept when other files are offloaded.
+	// Files the blocks of which can't be listed are skipped
+	FilesLocalSize(fileIDs []string) (uint64, error)
+}
+
+var _ LocalFiles = (*service)(nil)
+
+func (s *service) OnAccess(callback func(fileID string)) {
+	s.onAccess = callback
+}
+
+func (s *service) FileLocalSize(fileID string) (size uint64, err error) {
+	fileBlocks, err := s.getAllExistingFileBlocks(fileID)
+	if err != nil {
+		return 0, err
+	}
+	for _, b := range fileBlocks {
+		size += b.size
+	}
+	return size, nil
+}
+
+func (s *service) FilesLocalSize(fileIDs []string) (size uint64, err error) {
+	counted := map[cid.Cid]struct{}{}
+	for _, fileID := range fileIDs {
+		fileBlocks, err := s.getAllExistingFileBlocks(fileID)
+		if err != nil {
+			log.With("fileID", fileID).Warnf("failed to get local blocks of file: %s", err)
+			continue
+		}
+		cids := make([]cid.Cid, 0, len(fileBlocks))
+		for _, b := range fileBlocks {
+			cids = append(cids, b.cid)
+			if _, ok := counted[b.cid]; ok {
+				continue
+			}
+			counted[b.cid] = struct{}{}
+			size += b.size
+		}
+		if err = s.blockRefs.add(fileID, cids); err != nil {
+			return 0, fmt.Errorf("add block references: %w", err)
+		}
+	}
+	return size, nil
+}
+
+// fileAccessed notifies about loads requested by the user, background loads like indexing are skipped
+func (s *service) fileAccessed(ctx context.Context, hash string) {
+	if s.onAccess == nil {
+		return
+	}
+	if requested, _ := ctx.Value(filestorage.CtxKeyRemoteLoadRequested).(bool); requested {
+		s.onAccess(hash)
+	}
+}
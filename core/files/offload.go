@@ -44,24 +44,54 @@ func (s *service) isFilePinned(fileID string) (bool, error) {
 	return stat.UploadedChunksCount == stat.TotalChunksCount, nil
 }
 
+// fileOffload removes local blocks of the file, blocks referenced by other files are kept
 func (s *service) fileOffload(hash string) (totalSize uint64, err error) {
 	log.With("fileID", hash).Info("offload file")
-	totalSize, cids, err := s.getAllExistingFileBlocksCids(hash)
+	fileBlocks, err := s.getAllExistingFileBlocks(hash)
 	if err != nil {
 		return 0, err
 	}
 
-	for _, c := range cids {
-		err = s.commonFile.DAGService().Remove(context.Background(), c)
+	cids := make([]cid.Cid, 0, len(fileBlocks))
+	for _, b := range fileBlocks {
+		cids = append(cids, b.cid)
+		shared, err := s.blockRefs.referencedByOthers(b.cid, hash)
+		if err != nil {
+			return 0, fmt.Errorf("get block references: %w", err)
+		}
+		if shared {
+			continue
+		}
+		err = s.commonFile.DAGService().Remove(context.Background(), b.cid)
 		if err != nil {
 			// no need to check for cid not exists
 			return 0, err
 		}
+		totalSize += b.size
 	}
 
+	if err = s.blockRefs.remove(hash, cids); err != nil {
+		return 0, fmt.Errorf("remove block references: %w", err)
+	}
 	return totalSize, nil
 }
 
+// addBlockRefs references local blocks of the file, so they are not removed when other files are offloaded
+func (s *service) addBlockRefs(hash string) error {
+	fileBlocks, err := s.getAllExistingFileBlocks(hash)
+	if err != nil {
+		return err
+	}
+	cids := make([]cid.Cid, 0, len(fileBlocks))
+	for _, b := range fileBlocks {
+		cids = append(cids, b.cid)
+	}
+	if err = s.blockRefs.add(hash, cids); err != nil {
+		return fmt.Errorf("add block references: %w", err)
+	}
+	return nil
+}
+
 func (s *service) FileListOffload(fileIDs []string, includeNotPinned bool) (totalBytesOffloaded uint64, totalFilesOffloaded uint64, err error) {
 	if len(fileIDs) == 0 {
 		fileIDs, err = s.fileStore.ListTargets()
@@ -122,7 +152,12 @@ func (s *service) keepOnlyPinnedOrDeleted(fileIDs []string) ([]string, error) {
 	return result, nil
 }
 
-func (s *service) getAllExistingFileBlocksCids(hash string) (totalSize uint64, cids []cid.Cid, err error) {
+type fileBlock struct {
+	cid  cid.Cid
+	size uint64
+}
+
+func (s *service) getAllExistingFileBlocks(hash string) (fileBlocks []fileBlock, err error) {
 	var getCidsLinksRecursively func(c cid.Cid) (err error)
 
 	var visitedMap = make(map[string]struct{})
@@ -134,20 +169,21 @@ func (s *service) getAllExistingFileBlocksCids(hash string) (totalSize uint64, c
 			// otherwise format.GetLinks will do bitswap
 			return nil
 		}
-		cids = append(cids, c)
+		b := fileBlock{cid: c}
 
 		// here we can be sure that the block is loaded to the blockstore, so 1s should be more than enough
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		ctx = context.WithValue(ctx, filestorage.CtxKeyRemoteLoadDisabled, true)
 		n, err := s.commonFile.DAGService().Get(ctx, c)
 		if err != nil {
-			log.Errorf("GetAllExistingFileBlocksCids: failed to get links: %s", err.Error())
+			log.Errorf("getAllExistingFileBlocks: failed to get links: %s", err.Error())
 		}
 		cancel()
 		if n != nil {
 			// use rawData because Size() includes size of inner links which may be not loaded
-			totalSize += uint64(len(n.RawData()))
+			b.size = uint64(len(n.RawData()))
 		}
+		fileBlocks = append(fileBlocks, b)
 		if n == nil || len(n.Links()) == 0 {
 			return nil
 		}
@@ -167,7 +203,7 @@ func (s *service) getAllExistingFileBlocksCids(hash string) (totalSize uint64, c
 
 	c, err := cid.Parse(hash)
 	if err != nil {
-		return 0, nil, err
+		return nil, err
 	}
 
 	err = getCidsLinksRecursively(c)
@@ -22,6 +22,9 @@ type AddOptions struct {
 	Name             string
 	LastModifiedDate int64
 	Plaintext        bool
+	// ContentDefinedChunking splits the content by its data instead of fixed size blocks, so blocks of the same
+	// parts of different file versions are stored only once
+	ContentDefinedChunking bool
 }
 
 func WithReader(r io.ReadSeeker) AddOption {
@@ -42,6 +45,12 @@ func WithLastModifiedDate(timestamp int64) AddOption {
 	}
 }
 
+func WithContentDefinedChunking() AddOption {
+	return func(args *AddOptions) {
+		args.ContentDefinedChunking = true
+	}
+}
+
 func (s *service) normalizeOptions(ctx context.Context, opts *AddOptions) error {
 	if opts.Use != "" {
 		ref, err := ipfspath.ParsePath(opts.Use)
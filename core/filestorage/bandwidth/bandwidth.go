@@ -0,0 +1,142 @@
+package bandwidth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/app/logger"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+)
+
+const CName = "filestorage.bandwidth"
+
+// defaultMeteredMaxFileSize is used when MeteredMaxFileSize is not set
+const defaultMeteredMaxFileSize = 1024 * 1024
+
+var log = logger.NewNamed(CName)
+
+var ErrInvalidSettings = errors.New("invalid bandwidth settings")
+
+// Service throttles the traffic of file sync and keeps the metered network mode
+type Service interface {
+	Settings() config.BandwidthConfig
+	// SetSettings applies the settings at runtime and saves them to the config
+	SetSettings(cfg config.BandwidthConfig) error
+	// WaitUpload blocks until n bytes can be sent
+	WaitUpload(ctx context.Context, n int) error
+	// WaitDownload blocks until n bytes can be received
+	WaitDownload(ctx context.Context, n int) error
+	IsMetered() bool
+	// DeferUpload reports whether the upload of the file of the given size should wait until the network is not metered
+	DeferUpload(size int) bool
+	// OnChange adds the callback called after the settings are changed
+	OnChange(func())
+	app.Component
+}
+
+func New() Service {
+	return &service{
+		upload:   newLimiter(0),
+		download: newLimiter(0),
+	}
+}
+
+type service struct {
+	config   *config.Config
+	upload   *limiter
+	download *limiter
+
+	mu        sync.Mutex
+	settings  config.BandwidthConfig
+	callbacks []func()
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	cfg, ok := a.Component(config.CName).(*config.Config)
+	if !ok {
+		return nil
+	}
+	s.config = cfg
+	settings, err := cfg.BandwidthConfig()
+	if err != nil {
+		log.Error("failed to get bandwidth config", zap.Error(err))
+		return nil
+	}
+	s.apply(settings)
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) Settings() config.BandwidthConfig {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.settings
+}
+
+func (s *service) SetSettings(cfg config.BandwidthConfig) error {
+	if cfg.UploadLimit < 0 || cfg.DownloadLimit < 0 || cfg.MeteredMaxFileSize < 0 {
+		return fmt.Errorf("%w: negative values are not allowed", ErrInvalidSettings)
+	}
+	if s.config != nil {
+		if err := config.WriteJsonConfig(s.config.GetConfigPath(), config.ConfigRequired{Bandwidth: &cfg}); err != nil {
+			return err
+		}
+	}
+	s.apply(cfg)
+	s.mu.Lock()
+	callbacks := s.callbacks
+	s.mu.Unlock()
+	for _, cb := range callbacks {
+		cb()
+	}
+	return nil
+}
+
+func (s *service) apply(cfg config.BandwidthConfig) {
+	s.mu.Lock()
+	s.settings = cfg
+	s.mu.Unlock()
+	s.upload.setRate(cfg.UploadLimit)
+	s.download.setRate(cfg.DownloadLimit)
+}
+
+func (s *service) WaitUpload(ctx context.Context, n int) error {
+	return s.upload.wait(ctx, n)
+}
+
+func (s *service) WaitDownload(ctx context.Context, n int) error {
+	return s.download.wait(ctx, n)
+}
+
+func (s *service) IsMetered() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.settings.Metered
+}
+
+func (s *service) DeferUpload(size int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.settings.Metered {
+		return false
+	}
+	maxSize := s.settings.MeteredMaxFileSize
+	if maxSize == 0 {
+		maxSize = defaultMeteredMaxFileSize
+	}
+	return int64(size) > maxSize
+}
+
+func (s *service) OnChange(cb func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.callbacks = append(s.callbacks, cb)
+}
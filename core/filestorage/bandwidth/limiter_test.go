@@ -0,0 +1,59 @@
+package bandwidth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	l := &limiter{now: func() time.Time { return now }}
+
+	t.Run("no limit", func(t *testing.T) {
+		l.setRate(0)
+		assert.Zero(t, l.reserve(1<<30))
+	})
+	t.Run("burst and debt", func(t *testing.T) {
+		l.setRate(100)
+		assert.Zero(t, l.reserve(60))
+		assert.Zero(t, l.reserve(40))
+		assert.Equal(t, 500*time.Millisecond, l.reserve(50))
+		now = now.Add(time.Second)
+		assert.Equal(t, 500*time.Millisecond, l.reserve(100))
+		now = now.Add(10 * time.Second)
+		assert.Zero(t, l.reserve(100))
+		assert.Equal(t, time.Second, l.reserve(100))
+	})
+	t.Run("canceled wait", func(t *testing.T) {
+		l.setRate(1)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, l.wait(ctx, 10), context.Canceled)
+	})
+}
+
+func TestService_DeferUpload(t *testing.T) {
+	s := New().(*service)
+	var changed int
+	s.OnChange(func() { changed++ })
+
+	assert.False(t, s.DeferUpload(10*defaultMeteredMaxFileSize))
+
+	require.NoError(t, s.SetSettings(config.BandwidthConfig{Metered: true}))
+	assert.True(t, s.IsMetered())
+	assert.False(t, s.DeferUpload(defaultMeteredMaxFileSize))
+	assert.True(t, s.DeferUpload(defaultMeteredMaxFileSize+1))
+
+	require.NoError(t, s.SetSettings(config.BandwidthConfig{Metered: true, MeteredMaxFileSize: 10}))
+	assert.True(t, s.DeferUpload(11))
+	assert.Equal(t, 2, changed)
+
+	assert.ErrorIs(t, s.SetSettings(config.BandwidthConfig{UploadLimit: -1}), ErrInvalidSettings)
+	assert.Equal(t, 2, changed)
+}
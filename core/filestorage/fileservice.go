@@ -19,6 +19,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/filestorage/bandwidth"
 	"github.com/anyproto/anytype-heart/core/filestorage/rpcstore"
 	"github.com/anyproto/anytype-heart/core/wallet"
 	"github.com/anyproto/anytype-heart/pb"
@@ -54,6 +55,7 @@ type fileStorage struct {
 
 	provider     datastore.Datastore
 	rpcStore     rpcstore.Service
+	bandwidth    bandwidth.Service
 	spaceService space.Service
 	spaceStorage storage.ClientStorage
 	sendEvent    func(event *pb.Event)
@@ -70,6 +72,7 @@ func (f *fileStorage) Init(a *app.App) (err error) {
 	}
 
 	f.rpcStore = a.MustComponent(rpcstore.CName).(rpcstore.Service)
+	f.bandwidth = a.MustComponent(bandwidth.CName).(bandwidth.Service)
 	f.spaceStorage = a.MustComponent(spacestorage.CName).(storage.ClientStorage)
 	f.handler = &rpcHandler{spaceStorage: f.spaceStorage}
 	f.spaceService = a.MustComponent(space.CName).(space.Service)
@@ -89,7 +92,12 @@ func (f *fileStorage) Name() (name string) {
 	return CName
 }
 
+// patchAccountIdCtx sets the space of the account unless the space is set explicitly, e.g. to load files shared
+// from other accounts
 func (f *fileStorage) patchAccountIdCtx(ctx context.Context) context.Context {
+	if fileblockstore.CtxGetSpaceId(ctx) != "" {
+		return ctx
+	}
 	return fileblockstore.CtxWithSpaceId(ctx, f.spaceService.AccountId())
 }
 
@@ -108,6 +116,7 @@ func (f *fileStorage) Run(ctx context.Context) (err error) {
 		localStore: localStore,
 		origin:     f.rpcStore.NewStore(),
 		oldStore:   oldStore,
+		bandwidth:  f.bandwidth,
 	}
 	f.proxy = ps
 	return
@@ -14,6 +14,8 @@ import (
 	ipld "github.com/ipfs/go-ipld-format"
 	"go.uber.org/zap"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/filestorage/bandwidth"
 	"github.com/anyproto/anytype-heart/core/filestorage/rpcstore"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
@@ -29,6 +31,8 @@ var loopTimeout = time.Minute
 
 var errReachedLimit = fmt.Errorf("file upload limit has been reached")
 
+var errUploadDeferred = fmt.Errorf("file upload is deferred in metered mode")
+
 //go:generate mockgen -package mock_filesync -destination ./mock_filesync/filesync_mock.go github.com/anyproto/anytype-heart/core/filestorage/filesync FileSync
 type FileSync interface {
 	AddFile(spaceId, fileId string, uploadedByUser bool) (err error)
@@ -68,6 +72,9 @@ type fileSync struct {
 	sendEvent    func(event *pb.Event)
 	onUpload     func(spaceID, fileID string) error
 	spaceService space.Service
+	bandwidth    bandwidth.Service
+	// localOnly disables uploading to file nodes, files are fetched by local peers directly from the local store
+	localOnly bool
 
 	spaceStatsLock sync.Mutex
 	spaceStats     map[string]SpaceStat
@@ -86,6 +93,10 @@ func (f *fileSync) Init(a *app.App) (err error) {
 	f.dagService = a.MustComponent(fileservice.CName).(fileservice.FileService).DAGService()
 	f.fileStore = app.MustComponent[filestore.FileStore](a)
 	f.spaceService = app.MustComponent[space.Service](a)
+	f.bandwidth = a.MustComponent(bandwidth.CName).(bandwidth.Service)
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		f.localOnly = cfg.LocalOnly
+	}
 	f.removePingCh = make(chan struct{})
 	f.uploadPingCh = make(chan struct{})
 	return
@@ -108,6 +119,11 @@ func (f *fileSync) Run(ctx context.Context) (err error) {
 	if err != nil {
 		return
 	}
+	if f.localOnly {
+		// there are no file nodes in local only mode, local peers fetch blocks from the local store
+		// via the file rpc handler. Files stay in the queue and will be uploaded when the account leaves local only mode
+		return
+	}
 
 	// TODO multi-spaces: init for each space: GO-1681
 	{
@@ -124,6 +140,13 @@ func (f *fileSync) Run(ctx context.Context) (err error) {
 		}
 	}
 
+	// deferred uploads are started as soon as the network is not metered
+	f.bandwidth.OnChange(func() {
+		select {
+		case f.uploadPingCh <- struct{}{}:
+		default:
+		}
+	})
 	f.loopCtx, f.loopCancel = context.WithCancel(context.Background())
 	go f.addLoop()
 	go f.removeLoop()
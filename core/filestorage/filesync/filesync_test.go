@@ -25,6 +25,8 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/filestorage/bandwidth"
 	"github.com/anyproto/anytype-heart/core/filestorage/rpcstore"
 	"github.com/anyproto/anytype-heart/core/filestorage/rpcstore/mock_rpcstore"
 	"github.com/anyproto/anytype-heart/pb"
@@ -69,6 +71,42 @@ func TestFileSync_AddFile(t *testing.T) {
 	fx.waitEmptyQueue(t, time.Second*5)
 }
 
+func TestFileSync_AddFileMetered(t *testing.T) {
+	fx := newFixture(t)
+	defer fx.Finish(t)
+	bw := fx.a.MustComponent(bandwidth.CName).(bandwidth.Service)
+	require.NoError(t, bw.SetSettings(config.BandwidthConfig{Metered: true, MeteredMaxFileSize: 1024}))
+	var buf = make([]byte, 1024*1024)
+	_, err := rand.Read(buf)
+	require.NoError(t, err)
+	n, err := fx.fileService.AddFile(ctx, bytes.NewReader(buf))
+	require.NoError(t, err)
+	fileId := n.Cid().String()
+	spaceId := "space1"
+
+	fx.fileStoreMock.EXPECT().GetSyncStatus(fileId).Return(int(syncstatus.StatusNotSynced), nil)
+	fx.fileStoreMock.EXPECT().ListByTarget(fileId).Return([]*storage.FileInfo{{}}, nil).AnyTimes()
+	require.NoError(t, fx.AddFile(spaceId, fileId, false))
+
+	// the file is deferred without any request to the node
+	time.Sleep(time.Millisecond * 100)
+	ss, err := fx.SyncStatus()
+	require.NoError(t, err)
+	require.Equal(t, 1, ss.QueueLen)
+
+	fx.rpcStore.EXPECT().CheckAvailability(gomock.Any(), spaceId, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, cids []cid.Cid) ([]*fileproto.BlockAvailability, error) {
+		return lo.Map(cids, func(c cid.Cid, _ int) *fileproto.BlockAvailability {
+			return &fileproto.BlockAvailability{
+				Cid:    c.Bytes(),
+				Status: fileproto.AvailabilityStatus_NotExists,
+			}
+		}), nil
+	})
+	fx.rpcStore.EXPECT().AddToFile(gomock.Any(), spaceId, fileId, gomock.Any()).AnyTimes()
+	require.NoError(t, bw.SetSettings(config.BandwidthConfig{}))
+	fx.waitEmptyQueue(t, time.Second*5)
+}
+
 func TestFileSync_RemoveFile(t *testing.T) {
 	t.Skip("https://linear.app/anytype/issue/GO-1229/fix-testfilesync-removefile")
 	return
@@ -125,7 +163,8 @@ func newFixture(t *testing.T) *fixture {
 		Register(mockRpcStoreService).
 		Register(fx.FileSync).
 		Register(fileStoreMock).
-		Register(spaceService)
+		Register(spaceService).
+		Register(bandwidth.New())
 	require.NoError(t, fx.a.Start(ctx))
 	return fx
 }
@@ -62,11 +62,20 @@ func (f *fileSync) addLoop() {
 }
 
 func (f *fileSync) addOperation() {
+	// deferred files are moved to the back of the queue, so meeting one again means there is nothing to upload now
+	deferred := map[string]struct{}{}
 	for {
-		fileID, err := f.tryToUpload()
+		fileID, err := f.tryToUpload(deferred)
 		if err == errQueueIsEmpty {
 			return
 		}
+		if errors.Is(err, errUploadDeferred) {
+			if _, ok := deferred[fileID]; ok {
+				return
+			}
+			deferred[fileID] = struct{}{}
+			continue
+		}
 		if err != nil {
 			log.Warn("can't upload file", zap.String("fileID", fileID), zap.Error(err))
 			return
@@ -82,12 +91,15 @@ func (f *fileSync) getUpload() (*QueueItem, error) {
 	return it, err
 }
 
-func (f *fileSync) tryToUpload() (string, error) {
+func (f *fileSync) tryToUpload(deferred map[string]struct{}) (string, error) {
 	it, err := f.getUpload()
 	if err != nil {
 		return "", err
 	}
 	spaceId, fileId := it.SpaceID, it.FileID
+	if _, ok := deferred[fileId]; ok {
+		return fileId, errUploadDeferred
+	}
 	ok, storeErr := f.hasFileInStore(fileId)
 	if storeErr != nil {
 		return fileId, fmt.Errorf("check if file is in store: %w", storeErr)
@@ -196,6 +208,13 @@ func (f *fileSync) prepareToUpload(ctx context.Context, spaceId string, fileId s
 	if err != nil {
 		return nil, fmt.Errorf("collect file blocks: %w", err)
 	}
+	var fileSize int
+	for _, b := range fileBlocks {
+		fileSize += len(b.RawData())
+	}
+	if f.bandwidth.DeferUpload(fileSize) {
+		return nil, errUploadDeferred
+	}
 
 	bytesToUpload, blocksToUpload, err := f.selectBlocksToUploadAndBindExisting(ctx, spaceId, fileId, fileBlocks)
 	if err != nil {
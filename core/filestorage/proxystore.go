@@ -12,11 +12,15 @@ import (
 	format "github.com/ipfs/go-ipld-format"
 	"go.uber.org/zap"
 
+	"github.com/anyproto/anytype-heart/core/filestorage/bandwidth"
 	"github.com/anyproto/anytype-heart/core/filestorage/rpcstore"
 )
 
 const CtxKeyRemoteLoadDisabled = "object_remote_load_disabled"
 
+// CtxKeyRemoteLoadRequested marks loads requested by the user, only they are allowed to fetch blocks in metered mode
+const CtxKeyRemoteLoadRequested = "object_remote_load_requested"
+
 var ErrRemoteLoadDisabled = fmt.Errorf("remote load disabled")
 
 type proxyStore struct {
@@ -24,6 +28,19 @@ type proxyStore struct {
 	origin     rpcstore.RpcStore
 
 	oldStore *badger.DB
+	// bandwidth is nil when metered mode is not supported
+	bandwidth bandwidth.Service
+}
+
+func (c *proxyStore) remoteLoadDisabled(ctx context.Context) bool {
+	if v, ok := ctx.Value(CtxKeyRemoteLoadDisabled).(bool); ok && v {
+		return true
+	}
+	if c.bandwidth == nil || !c.bandwidth.IsMetered() {
+		return false
+	}
+	requested, _ := ctx.Value(CtxKeyRemoteLoadRequested).(bool)
+	return !requested
 }
 
 func (c *proxyStore) Get(ctx context.Context, k cid.Cid) (b blocks.Block, err error) {
@@ -43,8 +60,7 @@ func (c *proxyStore) Get(ctx context.Context, k cid.Cid) (b blocks.Block, err er
 	} else {
 		return
 	}
-	v, ok := ctx.Value(CtxKeyRemoteLoadDisabled).(bool)
-	if ok && v {
+	if c.remoteLoadDisabled(ctx) {
 		return nil, ErrRemoteLoadDisabled
 	}
 	if b, err = c.origin.Get(ctx, k); err != nil {
@@ -100,6 +116,10 @@ func (c *proxyStore) GetMany(ctx context.Context, ks []cid.Cid) <-chan blocks.Bl
 		fromOrigin = ks
 	}
 	log.Debug("get many cids", zap.Int("cached", len(fromCache)), zap.Int("origin", len(fromOrigin)))
+	if len(fromOrigin) > 0 && c.remoteLoadDisabled(ctx) {
+		log.Debug("get many: remote load disabled", zap.Int("origin", len(fromOrigin)))
+		fromOrigin = nil
+	}
 	if len(fromOrigin) == 0 && gotFromOldStore == 0 {
 		return c.localStore.GetMany(ctx, fromCache)
 	}
@@ -16,6 +16,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/filestorage/bandwidth"
 	"github.com/anyproto/anytype-heart/pb"
 )
 
@@ -65,6 +67,23 @@ func TestCacheStore_Get(t *testing.T) {
 			assert.NotNil(t, lb)
 		}
 	})
+	t.Run("metered", func(t *testing.T) {
+		testBlocks := newTestBocks("1", "2")
+		cs := newPSFixture(t)
+		defer cs.Finish(t)
+		cs.bandwidth = bandwidth.New()
+		require.NoError(t, cs.bandwidth.SetSettings(config.BandwidthConfig{Metered: true}))
+		require.NoError(t, cs.localStore.Add(ctx, testBlocks[:1]))
+		require.NoError(t, cs.origin.Add(ctx, testBlocks))
+
+		_, err := cs.Get(ctx, testBlocks[0].Cid())
+		require.NoError(t, err)
+		_, err = cs.Get(ctx, testBlocks[1].Cid())
+		require.ErrorIs(t, err, ErrRemoteLoadDisabled)
+		gb, err := cs.Get(context.WithValue(ctx, CtxKeyRemoteLoadRequested, true), testBlocks[1].Cid())
+		require.NoError(t, err)
+		assert.NotNil(t, gb)
+	})
 }
 
 func TestCacheStore_GetMany(t *testing.T) {
@@ -0,0 +1,96 @@
+package filestorage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonfile/fileblockstore"
+	"github.com/anyproto/any-sync/commonfile/fileproto"
+	"github.com/anyproto/any-sync/net/rpc/rpctest"
+	"github.com/anyproto/any-sync/nodeconf"
+	"github.com/anyproto/any-sync/nodeconf/mock_nodeconf"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/filestorage/bandwidth"
+	"github.com/anyproto/anytype-heart/core/filestorage/rpcstore"
+	"github.com/anyproto/anytype-heart/core/wallet"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/space/peerstore"
+	"github.com/anyproto/anytype-heart/space/storage"
+)
+
+type testSpaceStorage struct {
+	storage.ClientStorage
+	spaceIds []string
+}
+
+func (s *testSpaceStorage) AllSpaceIds() ([]string, error) {
+	return s.spaceIds, nil
+}
+
+// TestLocalOnlyExchange runs two accounts in one process: the first one serves blocks of its local store
+// by the file rpc handler, the second one fetches them in local only mode from the first one as a local peer
+func TestLocalOnlyExchange(t *testing.T) {
+	const (
+		spaceId = "space1"
+		peerId  = "peer1"
+	)
+	testBlocks := newTestBocks("1", "2", "3")
+
+	// the serving account
+	servingStore, err := newFlatStore(t.TempDir(), func(event *pb.Event) {}, time.Second)
+	require.NoError(t, err)
+	require.NoError(t, servingStore.Add(ctx, testBlocks))
+	rserv := rpctest.NewTestServer()
+	require.NoError(t, fileproto.DRPCRegisterFile(rserv.Mux, &rpcHandler{
+		store:        servingStore,
+		spaceStorage: &testSpaceStorage{spaceIds: []string{spaceId}},
+	}))
+
+	// the fetching account
+	ctrl := gomock.NewController(t)
+	nodeConf := mock_nodeconf.NewMockService(ctrl)
+	nodeConf.EXPECT().Name().Return(nodeconf.CName).AnyTimes()
+	nodeConf.EXPECT().Init(gomock.Any()).AnyTimes()
+	nodeConf.EXPECT().Run(gomock.Any()).AnyTimes()
+	nodeConf.EXPECT().Close(gomock.Any()).AnyTimes()
+	ps := peerstore.New()
+	a := new(app.App)
+	a.Register(config.New(config.WithLocalOnly(true), config.DisableFileConfig(true))).
+		Register(wallet.NewWithRepoDirAndRandomKeys(t.TempDir())).
+		Register(rpcstore.New()).
+		Register(rpctest.NewTestPool().WithServer(rserv)).
+		Register(nodeConf).
+		Register(ps).
+		Register(bandwidth.New())
+	require.NoError(t, a.Start(ctx))
+	defer a.Close(ctx)
+	ps.UpdateLocalPeer(peerId, []string{spaceId})
+
+	origin := app.MustComponent[rpcstore.Service](a).NewStore()
+	defer origin.(interface{ Close() error }).Close()
+	localStore, err := newFlatStore(t.TempDir(), func(event *pb.Event) {}, time.Second)
+	require.NoError(t, err)
+	fetching := &proxyStore{localStore: localStore, origin: origin}
+
+	spaceCtx := fileblockstore.CtxWithSpaceId(ctx, spaceId)
+	for _, b := range testBlocks {
+		got, err := fetching.Get(spaceCtx, b.Cid())
+		require.NoError(t, err)
+		assert.Equal(t, b.RawData(), got.RawData())
+		local, err := localStore.Get(ctx, b.Cid())
+		require.NoError(t, err, "fetched block is stored locally")
+		assert.Equal(t, b.RawData(), local.RawData())
+	}
+
+	t.Run("local peers don't accept writes", func(t *testing.T) {
+		err := origin.AddToFile(spaceCtx, spaceId, "file1", testBlocks)
+		assert.ErrorIs(t, err, rpcstore.ErrUnsupported)
+		_, err = origin.SpaceInfo(spaceCtx, spaceId)
+		assert.ErrorIs(t, err, rpcstore.ErrUnsupported)
+	})
+}
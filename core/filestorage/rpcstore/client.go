@@ -96,6 +96,9 @@ func (c *client) put(ctx context.Context, spaceID string, fileID string, cd cid.
 	if err != nil {
 		return
 	}
+	if err = c.s.bandwidth.WaitUpload(ctx, len(data)); err != nil {
+		return
+	}
 	st := time.Now()
 	return p.DoDrpc(ctx, func(conn drpc.Conn) error {
 		if _, err = fileproto.NewDRPCFileClient(conn).BlockPush(ctx, &fileproto.BlockPushRequest{
@@ -135,6 +138,10 @@ func (c *client) get(ctx context.Context, spaceID string, cd cid.Cid) (data []by
 	if err != nil {
 		return
 	}
+	// the size is known only after the block is received, so the limit delays the next get
+	if err = c.s.bandwidth.WaitDownload(ctx, len(resp.Data)); err != nil {
+		return
+	}
 	return resp.Data, nil
 }
 
@@ -0,0 +1,40 @@
+package rpcstore
+
+import (
+	"context"
+
+	"github.com/anyproto/any-sync/commonfile/fileproto"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+)
+
+// localStore is the store of local only mode. Blocks are fetched from peers of the local network, which serve
+// them from their local stores. Local peers don't accept writes, so the blocks of the files stay in the local
+// store of the account until peers request them
+type localStore struct {
+	*store
+}
+
+func (s *localStore) AddToFile(ctx context.Context, spaceId string, fileId string, bs []blocks.Block) error {
+	return ErrUnsupported
+}
+
+func (s *localStore) CheckAvailability(ctx context.Context, spaceID string, cids []cid.Cid) ([]*fileproto.BlockAvailability, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *localStore) BindCids(ctx context.Context, spaceID string, fileID string, cids []cid.Cid) error {
+	return ErrUnsupported
+}
+
+func (s *localStore) DeleteFiles(ctx context.Context, spaceId string, fileIds ...string) error {
+	return ErrUnsupported
+}
+
+func (s *localStore) SpaceInfo(ctx context.Context, spaceId string) (*fileproto.SpaceInfoResponse, error) {
+	return nil, ErrUnsupported
+}
+
+func (s *localStore) FilesInfo(ctx context.Context, spaceId string, fileIds ...string) ([]*fileproto.FileInfo, error) {
+	return nil, ErrUnsupported
+}
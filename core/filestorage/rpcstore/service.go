@@ -9,6 +9,8 @@ import (
 	"github.com/anyproto/any-sync/net/pool"
 	"github.com/anyproto/any-sync/nodeconf"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/filestorage/bandwidth"
 	"github.com/anyproto/anytype-heart/space/peerstore"
 )
 
@@ -29,14 +31,21 @@ type service struct {
 	pool         pool.Pool
 	nodeconf     nodeconf.Service
 	peerStore    peerstore.PeerStore
+	bandwidth    bandwidth.Service
 	mx           sync.Mutex
 	peerUpdateCh chan struct{}
+	// localOnly disables file nodes, blocks are fetched only from peers of the local network
+	localOnly bool
 }
 
 func (s *service) Init(a *app.App) (err error) {
 	s.pool = a.MustComponent(pool.CName).(pool.Pool)
 	s.nodeconf = a.MustComponent(nodeconf.CName).(nodeconf.Service)
 	s.peerStore = a.MustComponent(peerstore.CName).(peerstore.PeerStore)
+	s.bandwidth = a.MustComponent(bandwidth.CName).(bandwidth.Service)
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.localOnly = cfg.LocalOnly
+	}
 	s.peerStore.AddObserver(func(peerId string, spaceIds []string) {
 		select {
 		case s.peerUpdateCh <- struct{}{}:
@@ -52,13 +61,20 @@ func (s *service) Name() (name string) {
 
 func (s *service) NewStore() RpcStore {
 	cm := newClientManager(s, s.peerUpdateCh)
-	return &store{
+	st := &store{
 		s:  s,
 		cm: cm,
 	}
+	if s.localOnly {
+		return &localStore{store: st}
+	}
+	return st
 }
 
 func (s *service) fileNodePeers() []string {
+	if s.localOnly {
+		return nil
+	}
 	return s.peerStore.ResponsibleFilePeers()
 }
 
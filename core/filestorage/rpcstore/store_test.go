@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/anyproto/anytype-heart/core/filestorage/bandwidth"
 	"github.com/anyproto/anytype-heart/space/peerstore"
 )
 
@@ -154,7 +155,8 @@ func newFixture(t *testing.T) *fixture {
 		Register(mock_accountservice.NewAccountServiceWithAccount(fx.ctrl, &accountdata.AccountKeys{})).
 		Register(rpctest.NewTestPool().WithServer(rserv)).
 		Register(fx.nodeConf).
-		Register(peerstore.New())
+		Register(peerstore.New()).
+		Register(bandwidth.New())
 	require.NoError(t, fx.a.Start(ctx))
 	fx.store = fx.s.NewStore().(*store)
 	return fx
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/history"
@@ -92,3 +93,43 @@ func (mw *Middleware) HistorySetVersion(cctx context.Context, req *pb.RpcHistory
 		return hs.SetVersion(req.ObjectId, req.VersionId)
 	}))
 }
+
+func (mw *Middleware) HistoryRelationHistory(cctx context.Context, req *pb.RpcHistoryRelationHistoryRequest) *pb.RpcHistoryRelationHistoryResponse {
+	response := func(changes []*model.RelationValueChange, code pb.RpcHistoryRelationHistoryResponseErrorCode, err error) *pb.RpcHistoryRelationHistoryResponse {
+		m := &pb.RpcHistoryRelationHistoryResponse{Error: &pb.RpcHistoryRelationHistoryResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Changes = changes
+		}
+		return m
+	}
+	if req.ObjectId == "" || req.RelationKey == "" {
+		return response(nil, pb.RpcHistoryRelationHistoryResponseError_BAD_INPUT, fmt.Errorf("object id and relation key are required"))
+	}
+	changes, err := getService[history.History](mw).RelationHistory(req.ObjectId, req.RelationKey)
+	if err != nil {
+		return response(nil, pb.RpcHistoryRelationHistoryResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(changes, pb.RpcHistoryRelationHistoryResponseError_NULL, nil)
+}
+
+func (mw *Middleware) HistoryRelationChanges(cctx context.Context, req *pb.RpcHistoryRelationChangesRequest) *pb.RpcHistoryRelationChangesResponse {
+	response := func(changes []*model.RelationValueChange, code pb.RpcHistoryRelationChangesResponseErrorCode, err error) *pb.RpcHistoryRelationChangesResponse {
+		m := &pb.RpcHistoryRelationChangesResponse{Error: &pb.RpcHistoryRelationChangesResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Changes = changes
+		}
+		return m
+	}
+	if req.From < 0 || (req.To != 0 && req.To < req.From) {
+		return response(nil, pb.RpcHistoryRelationChangesResponseError_BAD_INPUT, fmt.Errorf("invalid time range"))
+	}
+	changes, err := getService[history.History](mw).RelationChanges(req.RelationKey, req.From, req.To, int(req.Limit))
+	if err != nil {
+		return response(nil, pb.RpcHistoryRelationChangesResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(changes, pb.RpcHistoryRelationChangesResponseError_NULL, nil)
+}
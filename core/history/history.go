@@ -2,12 +2,16 @@ package history
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/anyproto/any-sync/accountservice"
 	"github.com/anyproto/any-sync/app"
 	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
 	"github.com/anyproto/any-sync/commonspace/objecttreebuilder"
+	"github.com/dgraph-io/badger/v3"
 	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
 
 	"github.com/anyproto/anytype-heart/core/block"
 	smartblock2 "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
@@ -20,6 +24,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
 	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -42,6 +47,10 @@ type History interface {
 	Show(pageId, versionId string) (bs *model.ObjectView, ver *pb.RpcHistoryVersion, err error)
 	Versions(pageId, lastVersionId string, limit int) (resp []*pb.RpcHistoryVersion, err error)
 	SetVersion(pageId, versionId string) (err error)
+	RelationHistory(objectId, relationKey string) ([]*model.RelationValueChange, error)
+	RelationChanges(relationKey string, from, to int64, limit int) ([]*model.RelationValueChange, error)
+	IndexRelationChanges(info smartblock2.DocInfo, oldDetails, newDetails *types.Struct) error
+	PruneRelationChanges(objectId string) error
 	app.Component
 }
 
@@ -51,6 +60,8 @@ type history struct {
 	objectStore     objectstore.ObjectStore
 	relationService relation.Service
 	spaceService    space.Service
+	accountService  accountservice.Service
+	db              *badger.DB
 }
 
 func (h *history) Init(a *app.App) (err error) {
@@ -59,6 +70,11 @@ func (h *history) Init(a *app.App) (err error) {
 	h.objectStore = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
 	h.relationService = a.MustComponent(relation.CName).(relation.Service)
 	h.spaceService = a.MustComponent(space.CName).(space.Service)
+	h.accountService = a.MustComponent(accountservice.CName).(accountservice.Service)
+	h.db, err = a.MustComponent(datastore.CName).(datastore.Datastore).LocalstoreBadger()
+	if err != nil {
+		return fmt.Errorf("get badger: %w", err)
+	}
 	return
 }
 
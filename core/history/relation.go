@@ -0,0 +1,153 @@
+package history
+
+import (
+	"fmt"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// RelationHistory returns every value the relation had in the object, the oldest first. Values are collected
+// from snapshots and details changes of the change tree of the object
+func (h *history) RelationHistory(objectId, relationKey string) ([]*model.RelationValueChange, error) {
+	if relationKey == "" {
+		return nil, fmt.Errorf("relation key is empty")
+	}
+	changes, err := h.allChanges(objectId)
+	if err != nil {
+		return nil, err
+	}
+	return h.relationValues(objectId, relationKey, changes), nil
+}
+
+// relationValues returns values of the relation set by the changes
+func (h *history) relationValues(objectId, relationKey string, changes []*objecttree.Change) []*model.RelationValueChange {
+	var (
+		resp []*model.RelationValueChange
+		prev *types.Value
+	)
+	for _, c := range changes {
+		ch, ok := c.Model.(*pb.Change)
+		if !ok {
+			continue
+		}
+		value, changed := relationValue(ch, relationKey, prev)
+		if !changed {
+			continue
+		}
+		var identity string
+		if c.Identity != nil {
+			identity = c.Identity.Account()
+		}
+		resp = append(resp, &model.RelationValueChange{
+			ObjectId:    objectId,
+			RelationKey: relationKey,
+			Value:       valueOrNull(value),
+			PrevValue:   valueOrNull(prev),
+			AuthorId:    h.authorId(identity),
+			Identity:    identity,
+			DeviceId:    ch.DeviceId,
+			ChangeId:    c.Id,
+			Time:        c.Timestamp,
+		})
+		prev = value
+	}
+	return resp
+}
+
+// authorId returns the profile id of the account with the identity. Profiles of other accounts are not known,
+// so it's empty for their changes
+func (h *history) authorId(identity string) string {
+	if identity == "" || identity != h.accountService.Account().SignKey.GetPublic().Account() {
+		return ""
+	}
+	return h.a.ProfileID()
+}
+
+// relationValue applies the change to the previous value of the relation and reports whether the value is changed
+func relationValue(ch *pb.Change, relationKey string, prev *types.Value) (value *types.Value, changed bool) {
+	value = prev
+	if snapshot := ch.GetSnapshot(); snapshot != nil {
+		value = pbtypes.Get(snapshot.GetData().GetDetails(), relationKey)
+	}
+	for _, ct := range ch.GetContent() {
+		if set := ct.GetDetailsSet(); set != nil && set.Key == relationKey {
+			value = set.Value
+		}
+		if unset := ct.GetDetailsUnset(); unset != nil && unset.Key == relationKey {
+			value = nil
+		}
+	}
+	if _, isNull := value.GetKind().(*types.Value_NullValue); isNull {
+		value = nil
+	}
+	if value == nil || prev == nil {
+		return value, value != prev
+	}
+	return value, !value.Equal(prev)
+}
+
+// allChanges returns changes of the object from the first one
+func (h *history) allChanges(objectId string) ([]*objecttree.Change, error) {
+	return changesFromFirst(func(beforeId string, includeBefore bool) (objecttree.ReadableObjectTree, error) {
+		tree, _, err := h.treeWithId(objectId, beforeId, includeBefore)
+		return tree, err
+	})
+}
+
+// historyTreeBuilder builds the history tree ending with the change or before it, the tree ends with the heads
+// when the change is not set
+type historyTreeBuilder func(beforeId string, includeBefore bool) (objecttree.ReadableObjectTree, error)
+
+// changesFromFirst returns changes from the first one. The history tree is built from the last snapshot,
+// so trees before the snapshot are built one by one the same way as for versions
+func changesFromFirst(buildTree historyTreeBuilder) ([]*objecttree.Change, error) {
+	var (
+		changes       []*objecttree.Change
+		beforeId      string
+		includeBefore = true
+	)
+	convert := func(decrypted []byte) (any, error) {
+		ch := &pb.Change{}
+		if err := proto.Unmarshal(decrypted, ch); err != nil {
+			return nil, err
+		}
+		return ch, nil
+	}
+	for {
+		tree, err := buildTree(beforeId, includeBefore)
+		if err != nil {
+			return nil, err
+		}
+		var data []*objecttree.Change
+		err = tree.IterateFrom(tree.Root().Id, convert, func(c *objecttree.Change) (isContinue bool) {
+			data = append(data, c)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(data) == 0 {
+			break
+		}
+		changes = append(data, changes...)
+		if len(data[0].PreviousIds) == 0 {
+			break
+		}
+		beforeId = tree.Root().Id
+		includeBefore = false
+	}
+	return changes, nil
+}
+
+func valueOrNull(v *types.Value) *types.Value {
+	if v == nil {
+		return pbtypes.Null()
+	}
+	return v
+}
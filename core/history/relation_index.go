@@ -0,0 +1,361 @@
+package history
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+
+	smartblock2 "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/source"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+// relationChangesPrefix/<relationKey>/<time>/<objectId>/<changeId> -> model.RelationValueChange
+const relationChangesPrefix = "/relationchanges/"
+
+// relationChangesObjectPrefix/<objectId>/<relationKey>/<time>/<changeId> -> nil, keys of changes of the object
+const relationChangesObjectPrefix = "/relationchanges_obj/"
+
+// relationChangesHeadsPrefix/<objectId> -> heads of the object joined by commas, changes after them aren't indexed yet
+const relationChangesHeadsPrefix = "/relationchanges_heads/"
+
+const relationChangesDefaultLimit = 1000
+
+// relationChangesSkipKeys are changed by the middleware on every change of the object
+var relationChangesSkipKeys = []string{
+	bundle.RelationKeyLastModifiedDate.String(),
+	bundle.RelationKeyLastModifiedBy.String(),
+	bundle.RelationKeyLastOpenedDate.String(),
+}
+
+// IndexRelationChanges is called by the indexer with details of the object before and after the indexing.
+// Changes of the tree applied after the heads of the previous call are replayed over the old details, so every
+// applied change of a relation value is indexed with its id, author and time. Objects indexed the first time are
+// skipped, so the index has only changes made after the object has been indexed on this device
+func (h *history) IndexRelationChanges(info smartblock2.DocInfo, oldDetails, newDetails *types.Struct) error {
+	prevHeads, err := h.indexedHeads(info.Id)
+	if err != nil {
+		return fmt.Errorf("get indexed heads: %w", err)
+	}
+	var changes []*model.RelationValueChange
+	if len(oldDetails.GetFields()) > 0 {
+		var applied []*objecttree.Change
+		if info.Tree != nil {
+			if applied, err = source.ChangesAfter(info.Tree, prevHeads); err != nil {
+				return fmt.Errorf("get applied changes: %w", err)
+			}
+			// heads of the old details are not known, so only the last change is replayed
+			if len(prevHeads) == 0 && len(applied) > 0 {
+				applied = applied[len(applied)-1:]
+			}
+		}
+		changes = h.appliedRelationChanges(info, applied, oldDetails, newDetails)
+	}
+
+	return badgerhelper.RetryOnConflict(func() error {
+		return h.db.Update(func(txn *badger.Txn) error {
+			for _, ch := range changes {
+				data, err := ch.Marshal()
+				if err != nil {
+					return err
+				}
+				if err = txn.Set(relationChangeKey(ch), data); err != nil {
+					return err
+				}
+				if err = txn.Set(relationChangeObjectKey(ch), nil); err != nil {
+					return err
+				}
+			}
+			if len(info.Heads) == 0 {
+				return nil
+			}
+			return txn.Set([]byte(relationChangesHeadsPrefix+info.Id), []byte(strings.Join(info.Heads, ",")))
+		})
+	})
+}
+
+// appliedRelationChanges replays changes of the tree over the old details and returns changes of relation values
+// made by them. Values changed without a change of the tree, e.g. by the middleware, are attributed to the last
+// applied change or to the first head when there are no applied changes
+func (h *history) appliedRelationChanges(info smartblock2.DocInfo, applied []*objecttree.Change, oldDetails, newDetails *types.Struct) []*model.RelationValueChange {
+	var (
+		changes []*model.RelationValueChange
+		values  = map[string]*types.Value{}
+	)
+	for key, value := range oldDetails.GetFields() {
+		values[key] = value
+	}
+	newChange := func(c *objecttree.Change, key string, value, prev *types.Value) *model.RelationValueChange {
+		ch := &model.RelationValueChange{
+			ObjectId:    info.Id,
+			RelationKey: key,
+			Value:       valueOrNull(value),
+			PrevValue:   valueOrNull(prev),
+		}
+		if c == nil {
+			if len(info.Heads) > 0 {
+				ch.ChangeId = info.Heads[0]
+			}
+			ch.Time = pbtypes.GetInt64(newDetails, bundle.RelationKeyLastModifiedDate.String())
+			if ch.Time == 0 {
+				ch.Time = time.Now().Unix()
+			}
+			return ch
+		}
+		ch.ChangeId = c.Id
+		ch.Time = c.Timestamp
+		if c.Identity != nil {
+			ch.Identity = c.Identity.Account()
+		}
+		ch.AuthorId = h.authorId(ch.Identity)
+		if model, ok := c.Model.(*pb.Change); ok {
+			ch.DeviceId = model.DeviceId
+		}
+		return ch
+	}
+
+	var last *objecttree.Change
+	for _, c := range applied {
+		ch, ok := c.Model.(*pb.Change)
+		if !ok {
+			continue
+		}
+		last = c
+		for _, key := range changedKeys(ch, values) {
+			if skipRelationChange(key) {
+				continue
+			}
+			value, changed := relationValue(ch, key, values[key])
+			if !changed {
+				continue
+			}
+			changes = append(changes, newChange(c, key, value, values[key]))
+			values[key] = value
+		}
+	}
+	for _, diff := range diffRelationValues(oldDetails, newDetails) {
+		prev, value := values[diff.RelationKey], pbtypes.Get(newDetails, diff.RelationKey)
+		if prev == value || prev != nil && prev.Equal(value) {
+			continue
+		}
+		changes = append(changes, newChange(last, diff.RelationKey, value, prev))
+	}
+	return changes
+}
+
+// changedKeys returns keys of relations which can be changed by the change. A snapshot changes all relations
+func changedKeys(ch *pb.Change, values map[string]*types.Value) []string {
+	var keys []string
+	if snapshot := ch.GetSnapshot(); snapshot != nil {
+		for key := range snapshot.GetData().GetDetails().GetFields() {
+			keys = append(keys, key)
+		}
+		for key := range values {
+			keys = append(keys, key)
+		}
+	}
+	for _, ct := range ch.GetContent() {
+		if set := ct.GetDetailsSet(); set != nil {
+			keys = append(keys, set.Key)
+		}
+		if unset := ct.GetDetailsUnset(); unset != nil {
+			keys = append(keys, unset.Key)
+		}
+	}
+	keys = lo.Uniq(keys)
+	sort.Strings(keys)
+	return keys
+}
+
+func (h *history) indexedHeads(objectId string) (heads []string, err error) {
+	err = h.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(relationChangesHeadsPrefix + objectId))
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			heads = strings.Split(string(val), ",")
+			return nil
+		})
+	})
+	return
+}
+
+// RelationChanges returns indexed changes of the relation in the time range, the newest first.
+// All relations are returned when the relation key is empty
+func (h *history) RelationChanges(relationKey string, from, to int64, limit int) ([]*model.RelationValueChange, error) {
+	if to == 0 {
+		to = time.Now().Unix()
+	}
+	if from < 0 || to < from {
+		return nil, fmt.Errorf("invalid time range")
+	}
+	if limit <= 0 {
+		limit = relationChangesDefaultLimit
+	}
+	prefix := relationChangesPrefix
+	if relationKey != "" {
+		prefix += relationKey + "/"
+	}
+
+	// keys of the relation are sorted by the time, so the range is seeked directly
+	start := []byte(prefix)
+	if relationKey != "" {
+		start = []byte(fmt.Sprintf("%s%020d", prefix, from))
+	}
+	var changes []*model.RelationValueChange
+	err := h.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{
+			PrefetchValues: true,
+			PrefetchSize:   100,
+			Prefix:         []byte(prefix),
+		})
+		defer it.Close()
+		for it.Seek(start); it.Valid(); it.Next() {
+			item := it.Item()
+			ts, err := relationChangeTime(string(item.Key()))
+			if err != nil {
+				log.Warnf("invalid key of relation change %s: %v", item.Key(), err)
+				continue
+			}
+			if ts > to && relationKey != "" {
+				break
+			}
+			if ts < from || ts > to {
+				continue
+			}
+			ch := &model.RelationValueChange{}
+			if err = item.Value(ch.Unmarshal); err != nil {
+				return err
+			}
+			changes = append(changes, ch)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Time > changes[j].Time
+	})
+	if len(changes) > limit {
+		changes = changes[:limit]
+	}
+	return changes, nil
+}
+
+// PruneRelationChanges removes indexed changes of the deleted object
+func (h *history) PruneRelationChanges(objectId string) error {
+	prefix := relationChangesObjectPrefix + objectId + "/"
+	toDelete := [][]byte{[]byte(relationChangesHeadsPrefix + objectId)}
+	err := h.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{
+			PrefetchValues: false,
+			Prefix:         []byte(prefix),
+		})
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			key := it.Item().KeyCopy(nil)
+			parts := strings.SplitN(strings.TrimPrefix(string(key), prefix), "/", 3)
+			if len(parts) != 3 {
+				log.Warnf("invalid key of relation change of the object %s", key)
+				continue
+			}
+			toDelete = append(toDelete, key, []byte(fmt.Sprintf("%s%s/%s/%s/%s", relationChangesPrefix, parts[0], parts[1], objectId, parts[2])))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("iterate keys: %w", err)
+	}
+
+	txn := h.db.NewTransaction(true)
+	defer func() {
+		txn.Discard()
+	}()
+	for _, key := range toDelete {
+		err = txn.Delete(key)
+		if err == badger.ErrTxnTooBig {
+			if err = txn.Commit(); err != nil {
+				return fmt.Errorf("commit big transaction: %w", err)
+			}
+			txn = h.db.NewTransaction(true)
+			err = txn.Delete(key)
+		}
+		if err != nil {
+			return fmt.Errorf("delete key %s: %w", key, err)
+		}
+	}
+	return txn.Commit()
+}
+
+func diffRelationValues(oldDetails, newDetails *types.Struct) []*model.RelationValueChange {
+	var changes []*model.RelationValueChange
+	for key, value := range newDetails.GetFields() {
+		if skipRelationChange(key) {
+			continue
+		}
+		prev := pbtypes.Get(oldDetails, key)
+		if prev != nil && prev.Equal(value) {
+			continue
+		}
+		changes = append(changes, &model.RelationValueChange{
+			RelationKey: key,
+			Value:       value,
+			PrevValue:   valueOrNull(prev),
+		})
+	}
+	for key, prev := range oldDetails.GetFields() {
+		if skipRelationChange(key) || pbtypes.Get(newDetails, key) != nil {
+			continue
+		}
+		changes = append(changes, &model.RelationValueChange{
+			RelationKey: key,
+			Value:       pbtypes.Null(),
+			PrevValue:   prev,
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].RelationKey < changes[j].RelationKey
+	})
+	return changes
+}
+
+// skipRelationChange reports whether changes of the relation are not indexed
+func skipRelationChange(key string) bool {
+	return slice.FindPos(relationChangesSkipKeys, key) != -1 ||
+		slice.FindPos(bundle.LocalRelationsKeys, key) != -1 ||
+		slice.FindPos(bundle.DerivedRelationsKeys, key) != -1
+}
+
+func relationChangeKey(ch *model.RelationValueChange) []byte {
+	// the time is padded, so keys of the relation are sorted by the time
+	return []byte(fmt.Sprintf("%s%s/%020d/%s/%s", relationChangesPrefix, ch.RelationKey, ch.Time, ch.ObjectId, ch.ChangeId))
+}
+
+func relationChangeObjectKey(ch *model.RelationValueChange) []byte {
+	return []byte(fmt.Sprintf("%s%s/%s/%020d/%s", relationChangesObjectPrefix, ch.ObjectId, ch.RelationKey, ch.Time, ch.ChangeId))
+}
+
+func relationChangeTime(key string) (int64, error) {
+	parts := strings.Split(strings.TrimPrefix(key, relationChangesPrefix), "/")
+	if len(parts) < 2 {
+		return 0, fmt.Errorf("too few parts")
+	}
+	return strconv.ParseInt(parts[1], 10, 64)
+}
@@ -0,0 +1,247 @@
+package history
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/anyproto/any-sync/accountservice"
+	"github.com/anyproto/any-sync/commonspace/object/accountdata"
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"github.com/anyproto/any-sync/util/crypto"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestRelationValue(t *testing.T) {
+	snapshot := &pb.Change{Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+		Details: &types.Struct{Fields: map[string]*types.Value{"status": pbtypes.String("todo")}},
+	}}}
+
+	value, changed := relationValue(snapshot, "status", nil)
+	assert.True(t, changed)
+	assert.Equal(t, "todo", value.GetStringValue())
+
+	_, changed = relationValue(snapshot, "status", pbtypes.String("todo"))
+	assert.False(t, changed)
+
+	_, changed = relationValue(&pb.Change{Content: []*pb.ChangeContent{detailsSet("name", pbtypes.String("n"))}}, "status", value)
+	assert.False(t, changed)
+
+	value, changed = relationValue(&pb.Change{Content: []*pb.ChangeContent{detailsSet("status", pbtypes.String("done"))}}, "status", value)
+	assert.True(t, changed)
+	assert.Equal(t, "done", value.GetStringValue())
+
+	value, changed = relationValue(&pb.Change{Content: []*pb.ChangeContent{
+		{Value: &pb.ChangeContentValueOfDetailsUnset{DetailsUnset: &pb.ChangeDetailsUnset{Key: "status"}}},
+	}}, "status", value)
+	assert.True(t, changed)
+	assert.Nil(t, value)
+}
+
+func TestRelationChanges(t *testing.T) {
+	db, err := badger.Open(badger.DefaultOptions(t.TempDir()).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	defer db.Close()
+	accountKeys, err := accountdata.NewRandom()
+	require.NoError(t, err)
+	anotherKeys, err := accountdata.NewRandom()
+	require.NoError(t, err)
+	identity, another := accountKeys.SignKey.GetPublic(), anotherKeys.SignKey.GetPublic()
+	h := &history{db: db, a: testCore{profileId: "profile1"}, accountService: testAccountService{keys: accountKeys}}
+
+	details := func(modified int64, fields map[string]*types.Value) *types.Struct {
+		fields["lastModifiedDate"] = pbtypes.Int64(modified)
+		return &types.Struct{Fields: fields}
+	}
+	tree := &testTree{changes: []*objecttree.Change{
+		testChange("c1", 100, identity, &pb.Change{Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Details: &types.Struct{Fields: map[string]*types.Value{"status": pbtypes.String("todo"), "name": pbtypes.String("task")}},
+		}}}),
+	}}
+	// the first indexing of the object isn't a change
+	require.NoError(t, h.IndexRelationChanges(smartblock.DocInfo{Id: "obj1", Heads: []string{"c1"}, Tree: tree}, nil, details(100, map[string]*types.Value{
+		"status": pbtypes.String("todo"),
+		"name":   pbtypes.String("task"),
+	})))
+	// every applied change is indexed with its author and time
+	tree.changes = append(tree.changes,
+		testChange("c2", 150, another, &pb.Change{Content: []*pb.ChangeContent{detailsSet("status", pbtypes.String("in progress"))}}),
+		testChange("c3", 200, identity, &pb.Change{DeviceId: "device1", Content: []*pb.ChangeContent{
+			detailsSet("status", pbtypes.String("done")),
+			{Value: &pb.ChangeContentValueOfDetailsUnset{DetailsUnset: &pb.ChangeDetailsUnset{Key: "name"}}},
+		}}),
+	)
+	require.NoError(t, h.IndexRelationChanges(smartblock.DocInfo{Id: "obj1", Heads: []string{"c3"}, Tree: tree}, details(100, map[string]*types.Value{
+		"status": pbtypes.String("todo"),
+		"name":   pbtypes.String("task"),
+	}), details(200, map[string]*types.Value{
+		"status": pbtypes.String("done"),
+	})))
+	// objects without the tree are attributed to the head
+	require.NoError(t, h.IndexRelationChanges(smartblock.DocInfo{Id: "obj2", Heads: []string{"h3"}}, details(100, map[string]*types.Value{
+		"status": pbtypes.String("todo"),
+	}), details(300, map[string]*types.Value{
+		"status": pbtypes.String("in progress"),
+	})))
+
+	changes, err := h.RelationChanges("status", 150, 1000, 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+	assert.Equal(t, "obj2", changes[0].ObjectId)
+	assert.Equal(t, "h3", changes[0].ChangeId)
+	assert.Equal(t, "in progress", changes[0].Value.GetStringValue())
+	assert.Empty(t, changes[0].Identity)
+
+	assert.Equal(t, "obj1", changes[1].ObjectId)
+	assert.Equal(t, "c3", changes[1].ChangeId)
+	assert.Equal(t, "in progress", changes[1].PrevValue.GetStringValue())
+	assert.Equal(t, "done", changes[1].Value.GetStringValue())
+	assert.Equal(t, "profile1", changes[1].AuthorId)
+	assert.Equal(t, identity.Account(), changes[1].Identity)
+	assert.Equal(t, "device1", changes[1].DeviceId)
+	assert.Equal(t, int64(200), changes[1].Time)
+
+	assert.Equal(t, "c2", changes[2].ChangeId)
+	assert.Equal(t, "todo", changes[2].PrevValue.GetStringValue())
+	assert.Equal(t, "in progress", changes[2].Value.GetStringValue())
+	assert.Empty(t, changes[2].AuthorId, "profiles of other accounts are not known")
+	assert.Equal(t, another.Account(), changes[2].Identity)
+	assert.Equal(t, int64(150), changes[2].Time)
+
+	changes, err = h.RelationChanges("status", 150, 180, 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "c2", changes[0].ChangeId)
+
+	changes, err = h.RelationChanges("", 0, 1000, 0)
+	require.NoError(t, err)
+	var keys []string
+	for _, ch := range changes {
+		keys = append(keys, ch.RelationKey)
+	}
+	assert.Equal(t, []string{"status", "name", "status", "status"}, keys)
+	assert.Equal(t, types.NullValue_NULL_VALUE, changes[1].Value.GetNullValue())
+
+	require.NoError(t, h.PruneRelationChanges("obj1"))
+	changes, err = h.RelationChanges("", 0, 1000, 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "obj2", changes[0].ObjectId)
+	heads, err := h.indexedHeads("obj1")
+	require.NoError(t, err)
+	assert.Empty(t, heads)
+}
+
+func TestRelationHistory(t *testing.T) {
+	accountKeys, err := accountdata.NewRandom()
+	require.NoError(t, err)
+	identity := accountKeys.SignKey.GetPublic()
+	h := &history{a: testCore{profileId: "profile1"}, accountService: testAccountService{keys: accountKeys}}
+
+	snapshot := func(fields map[string]*types.Value) *pb.Change {
+		return &pb.Change{Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{Details: &types.Struct{Fields: fields}}}}
+	}
+	// the history tree is built from the snapshot in the middle, so the tree before it is built separately
+	changes, err := changesFromFirst(testHistoryTrees([]*objecttree.Change{
+		testChange("c1", 100, identity, snapshot(map[string]*types.Value{"status": pbtypes.String("todo")})),
+		testChange("c2", 200, identity, &pb.Change{Content: []*pb.ChangeContent{detailsSet("status", pbtypes.String("done"))}}),
+		testChange("c3", 300, identity, &pb.Change{Content: []*pb.ChangeContent{detailsSet("name", pbtypes.String("task"))}}),
+		testChange("c4", 400, identity, snapshot(map[string]*types.Value{"status": pbtypes.String("done"), "name": pbtypes.String("task")})),
+		testChange("c5", 500, identity, &pb.Change{Content: []*pb.ChangeContent{
+			{Value: &pb.ChangeContentValueOfDetailsUnset{DetailsUnset: &pb.ChangeDetailsUnset{Key: "status"}}},
+		}}),
+	}))
+	require.NoError(t, err)
+	require.Len(t, changes, 5)
+
+	values := h.relationValues("obj1", "status", changes)
+	var got []string
+	for _, v := range values {
+		assert.Equal(t, "profile1", v.AuthorId)
+		got = append(got, fmt.Sprintf("%s:%s->%s", v.ChangeId, v.PrevValue.GetStringValue(), v.Value.GetStringValue()))
+	}
+	assert.Equal(t, []string{"c1:->todo", "c2:todo->done", "c5:done->"}, got)
+}
+
+func detailsSet(key string, value *types.Value) *pb.ChangeContent {
+	return &pb.ChangeContent{Value: &pb.ChangeContentValueOfDetailsSet{DetailsSet: &pb.ChangeDetailsSet{Key: key, Value: value}}}
+}
+
+func testChange(id string, timestamp int64, identity crypto.PubKey, ch *pb.Change) *objecttree.Change {
+	return &objecttree.Change{Id: id, Timestamp: timestamp, Identity: identity, Model: ch, IsSnapshot: ch.Snapshot != nil}
+}
+
+// testTree is a linear tree of changes
+type testTree struct {
+	objecttree.ReadableObjectTree
+	changes []*objecttree.Change
+}
+
+func (t *testTree) Root() *objecttree.Change {
+	return t.changes[0]
+}
+
+func (t *testTree) IterateRoot(convert objecttree.ChangeConvertFunc, iterate objecttree.ChangeIterateFunc) error {
+	return t.IterateFrom(t.changes[0].Id, convert, iterate)
+}
+
+func (t *testTree) IterateFrom(id string, _ objecttree.ChangeConvertFunc, iterate objecttree.ChangeIterateFunc) error {
+	var started bool
+	for _, c := range t.changes {
+		if started = started || c.Id == id; started && !iterate(c) {
+			break
+		}
+	}
+	return nil
+}
+
+// testHistoryTrees builds trees of the linear history from the last snapshot before the change
+func testHistoryTrees(changes []*objecttree.Change) historyTreeBuilder {
+	for i := 1; i < len(changes); i++ {
+		changes[i].PreviousIds = []string{changes[i-1].Id}
+	}
+	return func(beforeId string, includeBefore bool) (objecttree.ReadableObjectTree, error) {
+		end := len(changes)
+		for i, c := range changes {
+			if c.Id == beforeId {
+				end = i
+				if includeBefore {
+					end++
+				}
+			}
+		}
+		var start int
+		for i := 0; i < end; i++ {
+			if changes[i].IsSnapshot {
+				start = i
+			}
+		}
+		return &testTree{changes: changes[start:end]}, nil
+	}
+}
+
+type testCore struct {
+	core.Service
+	profileId string
+}
+
+func (c testCore) ProfileID() string {
+	return c.profileId
+}
+
+type testAccountService struct {
+	accountservice.Service
+	keys *accountdata.AccountKeys
+}
+
+func (a testAccountService) Account() *accountdata.AccountKeys {
+	return a.keys
+}
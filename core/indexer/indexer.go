@@ -52,7 +52,7 @@ const (
 	ForceBundledObjectsReindexCounter int32 = 5 // reindex objects like anytypeProfile
 	// ForceIdxRebuildCounter erases localstore indexes and reindex all type of objects
 	// (no need to increase ForceThreadsObjectsReindexCounter & ForceFilesReindexCounter)
-	ForceIdxRebuildCounter int32 = 47
+	ForceIdxRebuildCounter int32 = 48
 	// ForceFulltextIndexCounter  performs fulltext indexing for all type of objects (useful when we change fulltext config)
 	ForceFulltextIndexCounter int32 = 5
 	// ForceFilestoreKeysReindexCounter reindex filestore keys in all objects
@@ -97,6 +97,10 @@ type syncStarter interface {
 	StartSync()
 }
 
+type relationChangesIndexer interface {
+	IndexRelationChanges(info smartblock2.DocInfo, oldDetails, newDetails *types.Struct) error
+}
+
 type indexer struct {
 	store            objectstore.ObjectStore
 	fileStore        filestore.FileStore
@@ -107,6 +111,7 @@ type indexer struct {
 	subObjectCreator subObjectCreator
 	syncStarter      syncStarter
 	fileService      files.Service
+	relationChanges  relationChangesIndexer
 
 	quit       chan struct{}
 	mu         sync.Mutex
@@ -132,6 +137,7 @@ func (i *indexer) Init(a *app.App) (err error) {
 	i.ftsearch = app.MustComponent[ftsearch.FTSearch](a)
 	i.subObjectCreator = app.MustComponent[subObjectCreator](a)
 	i.syncStarter = app.MustComponent[syncStarter](a)
+	i.relationChanges = app.MustComponent[relationChangesIndexer](a)
 	i.quit = make(chan struct{})
 	i.forceFt = make(chan struct{})
 	return
@@ -241,6 +247,10 @@ func (i *indexer) Index(ctx context.Context, info smartblock2.DocInfo, options .
 
 	indexLinksTime := time.Now()
 	if indexDetails {
+		oldDetails, err := i.store.GetDetails(info.Id)
+		if err != nil {
+			log.With("objectID", info.Id).Errorf("failed to get indexed details: %v", err)
+		}
 		if err := i.store.UpdateObjectDetails(info.Id, details); err != nil {
 			if errors.Is(err, objectstore.ErrDetailsNotChanged) {
 				metrics.ObjectDetailsHeadsNotChangedCounter.Add(1)
@@ -250,6 +260,9 @@ func (i *indexer) Index(ctx context.Context, info smartblock2.DocInfo, options .
 				log.With("objectID", info.Id).Errorf("can't update object store: %v", err)
 			}
 		} else {
+			if err = i.relationChanges.IndexRelationChanges(info, oldDetails.GetDetails(), details); err != nil {
+				log.With("objectID", info.Id).Errorf("failed to index relation changes: %v", err)
+			}
 			// todo: remove temp log
 			if lastIndexedHash == headHashToIndex {
 				l := log.With("objectID", info.Id).
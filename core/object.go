@@ -13,15 +13,19 @@ import (
 	"github.com/gogo/protobuf/types"
 
 	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/block/bulkedit"
 	importer "github.com/anyproto/anytype-heart/core/block/import"
 	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/object/backlinks"
 	"github.com/anyproto/anytype-heart/core/block/object/objectgraph"
 	"github.com/anyproto/anytype-heart/core/indexer"
+	"github.com/anyproto/anytype-heart/core/relation"
 	"github.com/anyproto/anytype-heart/core/subscription"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/database/filter"
+	"github.com/anyproto/anytype-heart/pkg/lib/database/query"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/builtinobjects"
@@ -44,6 +48,12 @@ func (mw *Middleware) ObjectSetDetails(cctx context.Context, req *pb.RpcObjectSe
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
 		return bs.SetDetails(ctx, *req)
 	})
+	var validationErr *relation.ValidationError
+	if errors.As(err, &validationErr) {
+		m := response(pb.RpcObjectSetDetailsResponseError_VALIDATION_FAILED, err)
+		m.Violations = validationErr.Violations
+		return m
+	}
 	if err != nil {
 		return response(pb.RpcObjectSetDetailsResponseError_UNKNOWN_ERROR, err)
 	}
@@ -110,11 +120,25 @@ func (mw *Middleware) ObjectSearch(cctx context.Context, req *pb.RpcObjectSearch
 		return response(pb.RpcObjectSearchResponseError_BAD_INPUT, nil, fmt.Errorf("account must be started"))
 	}
 
+	ds := mw.app.MustComponent(objectstore.CName).(objectstore.ObjectStore)
+	if req.Query != "" {
+		parsed, err := query.Parse(req.Query, ds)
+		if err != nil {
+			r := response(pb.RpcObjectSearchResponseError_BAD_INPUT, nil, err)
+			var queryErr *query.Error
+			if errors.As(err, &queryErr) {
+				r.Error.Position = int32(queryErr.Pos)
+			}
+			return r
+		}
+		req.Filters = append(append([]*model.BlockContentDataviewFilter{}, req.Filters...), parsed.Filters...)
+		req.FullText = strings.TrimSpace(req.FullText + " " + parsed.FullText)
+	}
+
 	if req.FullText != "" {
 		mw.app.MustComponent(indexer.CName).(indexer.Indexer).ForceFTIndex()
 	}
 
-	ds := mw.app.MustComponent(objectstore.CName).(objectstore.ObjectStore)
 	records, _, err := ds.Query(nil, database.Query{
 		Filters:  req.Filters,
 		Sorts:    req.Sorts,
@@ -283,12 +307,56 @@ func (mw *Middleware) ObjectSearchSubscribe(cctx context.Context, req *pb.RpcObj
 
 	resp, err := subService.Search(*req)
 	if err != nil {
-		return errResponse(err)
+		r := errResponse(err)
+		var queryErr *query.Error
+		if errors.As(err, &queryErr) {
+			r.Error.Code = pb.RpcObjectSearchSubscribeResponseError_BAD_INPUT
+			r.Error.Position = int32(queryErr.Pos)
+		} else if errors.Is(err, filter.ErrInvalidRegex) || errors.Is(err, filter.ErrRegexTooComplex) {
+			r.Error.Code = pb.RpcObjectSearchSubscribeResponseError_BAD_INPUT
+		}
+		return r
 	}
 
 	return resp
 }
 
+func (mw *Middleware) ObjectQueryParse(cctx context.Context, req *pb.RpcObjectQueryParseRequest) *pb.RpcObjectQueryParseResponse {
+	response := func(res *query.Result, code pb.RpcObjectQueryParseResponseErrorCode, err error) *pb.RpcObjectQueryParseResponse {
+		m := &pb.RpcObjectQueryParseResponse{Error: &pb.RpcObjectQueryParseResponseError{Code: code}}
+		if res != nil {
+			m.Filters = res.Filters
+			m.FullText = res.FullText
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+			var queryErr *query.Error
+			if errors.As(err, &queryErr) {
+				m.Error.Position = int32(queryErr.Pos)
+			}
+		}
+
+		return m
+	}
+
+	mw.m.RLock()
+	defer mw.m.RUnlock()
+
+	if mw.app == nil {
+		return response(nil, pb.RpcObjectQueryParseResponseError_BAD_INPUT, fmt.Errorf("account must be started"))
+	}
+
+	res, err := query.Parse(req.Query, mw.app.MustComponent(objectstore.CName).(objectstore.ObjectStore))
+	var queryErr *query.Error
+	if errors.As(err, &queryErr) {
+		return response(nil, pb.RpcObjectQueryParseResponseError_INVALID_QUERY, err)
+	}
+	if err != nil {
+		return response(nil, pb.RpcObjectQueryParseResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(res, pb.RpcObjectQueryParseResponseError_NULL, nil)
+}
+
 func (mw *Middleware) ObjectGroupsSubscribe(_ context.Context, req *pb.RpcObjectGroupsSubscribeRequest) *pb.RpcObjectGroupsSubscribeResponse {
 	errResponse := func(err error) *pb.RpcObjectGroupsSubscribeResponse {
 		r := &pb.RpcObjectGroupsSubscribeResponse{
@@ -319,6 +387,186 @@ func (mw *Middleware) ObjectGroupsSubscribe(_ context.Context, req *pb.RpcObject
 	return resp
 }
 
+func (mw *Middleware) ObjectCalendarSubscribe(_ context.Context, req *pb.RpcObjectCalendarSubscribeRequest) *pb.RpcObjectCalendarSubscribeResponse {
+	errResponse := func(code pb.RpcObjectCalendarSubscribeResponseErrorCode, err error) *pb.RpcObjectCalendarSubscribeResponse {
+		r := &pb.RpcObjectCalendarSubscribeResponse{
+			Error: &pb.RpcObjectCalendarSubscribeResponseError{
+				Code: code,
+			},
+		}
+		if err != nil {
+			r.Error.Description = err.Error()
+		}
+		return r
+	}
+
+	mw.m.RLock()
+	defer mw.m.RUnlock()
+
+	if mw.app == nil {
+		return errResponse(pb.RpcObjectCalendarSubscribeResponseError_UNKNOWN_ERROR, errors.New("app must be started"))
+	}
+
+	subService := mw.app.MustComponent(subscription.CName).(subscription.Service)
+
+	resp, err := subService.SubscribeCalendar(*req)
+	if errors.Is(err, subscription.ErrBadInput) {
+		return errResponse(pb.RpcObjectCalendarSubscribeResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return errResponse(pb.RpcObjectCalendarSubscribeResponseError_UNKNOWN_ERROR, err)
+	}
+
+	return resp
+}
+
+func (mw *Middleware) ObjectCalendarMove(cctx context.Context, req *pb.RpcObjectCalendarMoveRequest) *pb.RpcObjectCalendarMoveResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcObjectCalendarMoveResponseErrorCode, err error) *pb.RpcObjectCalendarMoveResponse {
+		m := &pb.RpcObjectCalendarMoveResponse{Error: &pb.RpcObjectCalendarMoveResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	if req.DateRelationKey == "" {
+		return response(pb.RpcObjectCalendarMoveResponseError_BAD_INPUT, errors.New("date relation is not set"))
+	}
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		return bs.MoveInCalendar(ctx, *req)
+	})
+	if err != nil {
+		return response(pb.RpcObjectCalendarMoveResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcObjectCalendarMoveResponseError_NULL, nil)
+}
+
+func (mw *Middleware) ObjectTimelineSubscribe(_ context.Context, req *pb.RpcObjectTimelineSubscribeRequest) *pb.RpcObjectTimelineSubscribeResponse {
+	errResponse := func(code pb.RpcObjectTimelineSubscribeResponseErrorCode, err error) *pb.RpcObjectTimelineSubscribeResponse {
+		r := &pb.RpcObjectTimelineSubscribeResponse{
+			Error: &pb.RpcObjectTimelineSubscribeResponseError{
+				Code: code,
+			},
+		}
+		if err != nil {
+			r.Error.Description = err.Error()
+		}
+		return r
+	}
+
+	mw.m.RLock()
+	defer mw.m.RUnlock()
+
+	if mw.app == nil {
+		return errResponse(pb.RpcObjectTimelineSubscribeResponseError_UNKNOWN_ERROR, errors.New("app must be started"))
+	}
+
+	subService := mw.app.MustComponent(subscription.CName).(subscription.Service)
+
+	resp, err := subService.SubscribeTimeline(*req)
+	if errors.Is(err, subscription.ErrBadInput) {
+		return errResponse(pb.RpcObjectTimelineSubscribeResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return errResponse(pb.RpcObjectTimelineSubscribeResponseError_UNKNOWN_ERROR, err)
+	}
+
+	return resp
+}
+
+func (mw *Middleware) ObjectTimelineMove(cctx context.Context, req *pb.RpcObjectTimelineMoveRequest) *pb.RpcObjectTimelineMoveResponse {
+	ctx := mw.newContext(cctx)
+	response := func(movedIds []string, code pb.RpcObjectTimelineMoveResponseErrorCode, err error) *pb.RpcObjectTimelineMoveResponse {
+		m := &pb.RpcObjectTimelineMoveResponse{Error: &pb.RpcObjectTimelineMoveResponseError{Code: code}, MovedIds: movedIds}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	if req.StartRelationKey == "" || (req.Cascade && req.DependencyRelationKey == "") {
+		return response(nil, pb.RpcObjectTimelineMoveResponseError_BAD_INPUT, errors.New("start and dependency relations must be set"))
+	}
+	if req.EndRelationKey != "" && req.End < req.Start {
+		return response(nil, pb.RpcObjectTimelineMoveResponseError_BAD_INPUT, errors.New("end is before start"))
+	}
+	var movedIds []string
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		movedIds, err = bs.MoveInTimeline(ctx, *req)
+		return
+	})
+	if err != nil {
+		return response(movedIds, pb.RpcObjectTimelineMoveResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(movedIds, pb.RpcObjectTimelineMoveResponseError_NULL, nil)
+}
+
+func (mw *Middleware) ObjectBulkEdit(cctx context.Context, req *pb.RpcObjectBulkEditRequest) *pb.RpcObjectBulkEditResponse {
+	response := func(res *block.BulkEditResult, code pb.RpcObjectBulkEditResponseErrorCode, err error) *pb.RpcObjectBulkEditResponse {
+		m := &pb.RpcObjectBulkEditResponse{Error: &pb.RpcObjectBulkEditResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		if res != nil {
+			m.ObjectIds = res.ObjectIds
+			m.Failures = res.Failures
+			m.UndoToken = res.UndoToken
+		}
+		return m
+	}
+	if err := bulkedit.ValidateOperations(req.Operations); err != nil {
+		return response(nil, pb.RpcObjectBulkEditResponseError_BAD_INPUT, err)
+	}
+	if len(req.ObjectIds) == 0 && len(req.Filters) == 0 {
+		return response(nil, pb.RpcObjectBulkEditResponseError_BAD_INPUT, errors.New("object ids or filters must be set"))
+	}
+	var res *block.BulkEditResult
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		res, err = bs.BulkEdit(*req)
+		return
+	})
+	switch {
+	case errors.Is(err, block.ErrBulkEditCanceled):
+		return response(res, pb.RpcObjectBulkEditResponseError_CANCELED, err)
+	case errors.Is(err, filter.ErrInvalidRegex), errors.Is(err, filter.ErrRegexTooComplex):
+		return response(nil, pb.RpcObjectBulkEditResponseError_BAD_INPUT, err)
+	case err != nil:
+		return response(nil, pb.RpcObjectBulkEditResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(res, pb.RpcObjectBulkEditResponseError_NULL, nil)
+}
+
+func (mw *Middleware) ObjectBulkEditUndo(cctx context.Context, req *pb.RpcObjectBulkEditUndoRequest) *pb.RpcObjectBulkEditUndoResponse {
+	response := func(failures []*pb.RpcObjectBulkEditFailure, code pb.RpcObjectBulkEditUndoResponseErrorCode, err error) *pb.RpcObjectBulkEditUndoResponse {
+		m := &pb.RpcObjectBulkEditUndoResponse{Error: &pb.RpcObjectBulkEditUndoResponseError{Code: code}, Failures: failures}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if req.UndoToken == "" {
+		return response(nil, pb.RpcObjectBulkEditUndoResponseError_BAD_INPUT, errors.New("undo token is empty"))
+	}
+	var (
+		failures []*pb.RpcObjectBulkEditFailure
+		found    bool
+	)
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		failures, found, err = bs.BulkEditUndo(req.UndoToken)
+		return
+	})
+	if err != nil {
+		return response(nil, pb.RpcObjectBulkEditUndoResponseError_UNKNOWN_ERROR, err)
+	}
+	if !found {
+		return response(nil, pb.RpcObjectBulkEditUndoResponseError_NOT_FOUND, errors.New("undo token is not found"))
+	}
+	return response(failures, pb.RpcObjectBulkEditUndoResponseError_NULL, nil)
+}
+
 func (mw *Middleware) ObjectSubscribeIds(_ context.Context, req *pb.RpcObjectSubscribeIdsRequest) *pb.RpcObjectSubscribeIdsResponse {
 	errResponse := func(err error) *pb.RpcObjectSubscribeIdsResponse {
 		r := &pb.RpcObjectSubscribeIdsResponse{
@@ -387,34 +635,35 @@ func (mw *Middleware) ObjectGraph(cctx context.Context, req *pb.RpcObjectGraphRe
 		return objectResponse(
 			pb.RpcObjectGraphResponseError_BAD_INPUT,
 			nil,
-			nil,
 			fmt.Errorf("account must be started"),
 		)
 	}
 
-	nodes, edges, err := getService[objectgraph.Service](mw).ObjectGraph(req)
+	graph, err := getService[objectgraph.Service](mw).ObjectGraph(req)
 	if err != nil {
 		return unknownError(err)
 	}
-	return objectResponse(pb.RpcObjectGraphResponseError_NULL, nodes, edges, nil)
+	return objectResponse(pb.RpcObjectGraphResponseError_NULL, graph, nil)
 }
 
 func unknownError(err error) *pb.RpcObjectGraphResponse {
-	return objectResponse(pb.RpcObjectGraphResponseError_UNKNOWN_ERROR, nil, nil, err)
+	return objectResponse(pb.RpcObjectGraphResponseError_UNKNOWN_ERROR, nil, err)
 }
 
 func objectResponse(
 	code pb.RpcObjectGraphResponseErrorCode,
-	nodes []*types.Struct,
-	edges []*pb.RpcObjectGraphEdge,
+	graph *objectgraph.Graph,
 	err error,
 ) *pb.RpcObjectGraphResponse {
 	response := &pb.RpcObjectGraphResponse{
 		Error: &pb.RpcObjectGraphResponseError{
 			Code: code,
 		},
-		Nodes: nodes,
-		Edges: edges,
+	}
+	if graph != nil {
+		response.Nodes = graph.Nodes
+		response.Edges = graph.Edges
+		response.NodesInfo = graph.NodesInfo
 	}
 
 	if err != nil {
@@ -871,3 +1120,42 @@ func (mw *Middleware) ObjectImportUseCase(cctx context.Context, req *pb.RpcObjec
 	objCreator := getService[builtinobjects.BuiltinObjects](mw)
 	return response(objCreator.CreateObjectsForUseCase(ctx, req.UseCase))
 }
+
+func (mw *Middleware) ObjectBacklinksList(cctx context.Context, req *pb.RpcObjectBacklinksListRequest) *pb.RpcObjectBacklinksListResponse {
+	response := func(code pb.RpcObjectBacklinksListResponseErrorCode, backlinks, unlinked []*pb.RpcObjectBacklinksBacklink, err error) *pb.RpcObjectBacklinksListResponse {
+		m := &pb.RpcObjectBacklinksListResponse{Error: &pb.RpcObjectBacklinksListResponseError{Code: code}, Backlinks: backlinks, UnlinkedMentions: unlinked}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if req.ContextId == "" {
+		return response(pb.RpcObjectBacklinksListResponseError_BAD_INPUT, nil, nil, fmt.Errorf("contextId is empty"))
+	}
+	links, unlinked, err := getService[backlinks.Service](mw).List(req)
+	if err != nil {
+		return response(pb.RpcObjectBacklinksListResponseError_UNKNOWN_ERROR, nil, nil, err)
+	}
+	return response(pb.RpcObjectBacklinksListResponseError_NULL, links, unlinked, nil)
+}
+
+func (mw *Middleware) ObjectBacklinksLinkMention(cctx context.Context, req *pb.RpcObjectBacklinksLinkMentionRequest) *pb.RpcObjectBacklinksLinkMentionResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcObjectBacklinksLinkMentionResponseErrorCode, err error) *pb.RpcObjectBacklinksLinkMentionResponse {
+		m := &pb.RpcObjectBacklinksLinkMentionResponse{Error: &pb.RpcObjectBacklinksLinkMentionResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	err := getService[backlinks.Service](mw).LinkMention(ctx, req)
+	if errors.Is(err, backlinks.ErrMentionChanged) {
+		return response(pb.RpcObjectBacklinksLinkMentionResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcObjectBacklinksLinkMentionResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcObjectBacklinksLinkMentionResponseError_NULL, nil)
+}
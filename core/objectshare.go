@@ -2,8 +2,11 @@ package core
 
 import (
 	"context"
+	"errors"
 
-	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/any-sync/app"
+
+	"github.com/anyproto/anytype-heart/core/objectshare"
 	"github.com/anyproto/anytype-heart/pb"
 )
 
@@ -17,13 +20,97 @@ func (mw *Middleware) ObjectShareByLink(cctx context.Context, req *pb.RpcObjectS
 		return m
 	}
 
-	var link string
-	err := mw.doBlockService(func(bs *block.Service) (err error) {
-		link, err = bs.ObjectShareByLink(req)
-		return
-	})
-	if err != nil {
+	a := mw.GetApp()
+	if a == nil {
+		return response("", pb.RpcObjectShareByLinkResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	link, err := app.MustComponent[objectshare.Service](a).Share(cctx, req.ObjectId, req.IncludeLinked, req.IncludeFiles)
+	if errors.Is(err, objectshare.ErrEmptyExport) {
 		return response("", pb.RpcObjectShareByLinkResponseError_BAD_INPUT, err)
 	}
+	if err != nil {
+		return response("", pb.RpcObjectShareByLinkResponseError_UNKNOWN_ERROR, err)
+	}
 	return response(link, pb.RpcObjectShareByLinkResponseError_NULL, nil)
 }
+
+func (mw *Middleware) ObjectShareRevoke(cctx context.Context, req *pb.RpcObjectShareRevokeRequest) *pb.RpcObjectShareRevokeResponse {
+	response := func(code pb.RpcObjectShareRevokeResponseErrorCode, err error) *pb.RpcObjectShareRevokeResponse {
+		m := &pb.RpcObjectShareRevokeResponse{Error: &pb.RpcObjectShareRevokeResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+
+		return m
+	}
+
+	a := mw.GetApp()
+	if a == nil {
+		return response(pb.RpcObjectShareRevokeResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	err := app.MustComponent[objectshare.Service](a).Revoke(cctx, req.ObjectId)
+	if errors.Is(err, objectshare.ErrNotShared) {
+		return response(pb.RpcObjectShareRevokeResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcObjectShareRevokeResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcObjectShareRevokeResponseError_NULL, nil)
+}
+
+func (mw *Middleware) ObjectShareList(cctx context.Context, req *pb.RpcObjectShareListRequest) *pb.RpcObjectShareListResponse {
+	response := func(objects []*pb.RpcObjectShareListShared, code pb.RpcObjectShareListResponseErrorCode, err error) *pb.RpcObjectShareListResponse {
+		m := &pb.RpcObjectShareListResponse{Objects: objects, Error: &pb.RpcObjectShareListResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+
+		return m
+	}
+
+	a := mw.GetApp()
+	if a == nil {
+		return response(nil, pb.RpcObjectShareListResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	shareService := app.MustComponent[objectshare.Service](a)
+	list, err := shareService.List()
+	if err != nil {
+		return response(nil, pb.RpcObjectShareListResponseError_UNKNOWN_ERROR, err)
+	}
+	objects := make([]*pb.RpcObjectShareListShared, 0, len(list))
+	for _, shared := range list {
+		objects = append(objects, &pb.RpcObjectShareListShared{
+			ObjectId:      shared.ObjectId,
+			Link:          shareService.Link(shared),
+			PublishedAt:   shared.PublishedAt,
+			IncludeLinked: shared.IncludeLinked,
+			IncludeFiles:  shared.IncludeFiles,
+		})
+	}
+	return response(objects, pb.RpcObjectShareListResponseError_NULL, nil)
+}
+
+func (mw *Middleware) ObjectShareImport(cctx context.Context, req *pb.RpcObjectShareImportRequest) *pb.RpcObjectShareImportResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcObjectShareImportResponseErrorCode, err error) *pb.RpcObjectShareImportResponse {
+		m := &pb.RpcObjectShareImportResponse{Error: &pb.RpcObjectShareImportResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+
+		return m
+	}
+
+	a := mw.GetApp()
+	if a == nil {
+		return response(pb.RpcObjectShareImportResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	err := app.MustComponent[objectshare.Service](a).Import(ctx, req.Link)
+	if errors.Is(err, objectshare.ErrInvalidLink) {
+		return response(pb.RpcObjectShareImportResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcObjectShareImportResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcObjectShareImportResponseError_NULL, nil)
+}
@@ -0,0 +1,445 @@
+package objectshare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/app/logger"
+	"github.com/anyproto/any-sync/commonfile/fileblockstore"
+	"github.com/anyproto/any-sync/commonfile/fileservice"
+	"github.com/anyproto/any-sync/util/crypto"
+	"github.com/anyproto/any-sync/util/periodicsync"
+	"github.com/globalsign/mgo/bson"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+
+	"github.com/anyproto/anytype-heart/core/block/export"
+	importer "github.com/anyproto/anytype-heart/core/block/import"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/filestorage"
+	"github.com/anyproto/anytype-heart/core/filestorage/filesync"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/space"
+)
+
+const CName = "objectshare"
+
+const (
+	linkPrefix   = "anytype://object/share?"
+	fetchTimeout = time.Minute
+	// pointerUpdatePeriodSec is the period of checking whether new snapshots are uploaded
+	pointerUpdatePeriodSec = 60
+)
+
+var log = logging.Logger("anytype-mw-objectshare")
+
+var (
+	ErrInvalidLink = errors.New("invalid share link")
+	ErrNotShared   = errors.New("object is not shared")
+	ErrEmptyExport = errors.New("nothing to share")
+)
+
+// Service publishes end-to-end encrypted snapshots of objects. The snapshot is the protobuf export of the object,
+// it is encrypted with the random key of the object and uploaded to the file node. The key never leaves
+// the fragment of the link, so the node stores only the ciphertext.
+// The share has the stable id, which is kept when the object is republished. The share points to the uploaded
+// snapshot, the pointer is moved to the new snapshot when it is uploaded. Previous snapshots are kept until
+// the share is revoked, so links sent before keep working. The file node stores only content addressed blocks,
+// so recipients resolve the share to the newest snapshot they have received the link to
+type Service interface {
+	// Share publishes the snapshot of the object. Sharing the already shared object republishes it with the same key
+	// and the same share id
+	Share(ctx context.Context, objectId string, includeLinked, includeFiles bool) (link string, err error)
+	// Revoke removes the snapshot of the object from the file node
+	Revoke(ctx context.Context, objectId string) error
+	List() ([]*Shared, error)
+	// Link returns the link to the latest snapshot of the shared object
+	Link(shared *Shared) string
+	// Import downloads the snapshot by the link and imports it as new objects. Links of own shares are resolved
+	// to the latest snapshot, links of other shares are resolved to the newest received snapshot of the share
+	Import(ctx *session.Context, link string) error
+	app.ComponentRunnable
+}
+
+func New(tempDirProvider core.TempDirProvider) Service {
+	return &service{tempDirProvider: tempDirProvider}
+}
+
+type service struct {
+	tempDirProvider core.TempDirProvider
+	exporter        export.Export
+	importer        importer.Importer
+	commonFile      fileservice.FileService
+	fileSync        filesync.FileSync
+	spaceService    space.Service
+	dbProvider      datastore.Datastore
+	store           *store
+	periodic        periodicsync.PeriodicSync
+	// mu guards updates of share records
+	mu sync.Mutex
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.exporter = a.MustComponent(export.CName).(export.Export)
+	s.importer = a.MustComponent(importer.CName).(importer.Importer)
+	s.commonFile = a.MustComponent(fileservice.CName).(fileservice.FileService)
+	s.fileSync = a.MustComponent(filesync.CName).(filesync.FileSync)
+	s.spaceService = a.MustComponent(space.CName).(space.Service)
+	s.dbProvider = a.MustComponent(datastore.CName).(datastore.Datastore)
+	s.periodic = periodicsync.NewPeriodicSync(pointerUpdatePeriodSec, 0, s.updatePointers, logger.CtxLogger{Logger: log.Desugar()})
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) Run(ctx context.Context) (err error) {
+	db, err := s.dbProvider.SpaceStorage()
+	if err != nil {
+		return
+	}
+	s.store = &store{db: db}
+	s.periodic.Run()
+	return nil
+}
+
+func (s *service) Close(ctx context.Context) (err error) {
+	if s.periodic != nil {
+		s.periodic.Close()
+	}
+	return nil
+}
+
+func (s *service) Share(ctx context.Context, objectId string, includeLinked, includeFiles bool) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prev, err := s.store.get(objectId)
+	if err != nil {
+		return "", err
+	}
+	var key *crypto.AESKey
+	if prev != nil {
+		key, err = crypto.UnmarshallAESKey(prev.Key)
+	} else {
+		prev = &Shared{ObjectId: objectId, ShareId: bson.NewObjectId().Hex()}
+		key, err = crypto.NewRandomAES()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	snapshot, err := s.exportSnapshot(objectId, includeLinked, includeFiles)
+	if err != nil {
+		return "", err
+	}
+	encrypted, err := key.Encrypt(snapshot)
+	if err != nil {
+		return "", err
+	}
+	node, err := s.commonFile.AddFile(ctx, bytes.NewReader(encrypted))
+	if err != nil {
+		return "", fmt.Errorf("add snapshot: %w", err)
+	}
+	spaceId := s.spaceService.AccountId()
+	if err = s.fileSync.AddFile(spaceId, node.Cid().String(), true); err != nil {
+		return "", fmt.Errorf("upload snapshot: %w", err)
+	}
+
+	shared := &Shared{
+		ObjectId:      objectId,
+		ShareId:       prev.ShareId,
+		SpaceId:       spaceId,
+		FileId:        prev.FileId,
+		PendingFileId: node.Cid().String(),
+		Key:           key.Bytes(),
+		IncludeLinked: includeLinked,
+		IncludeFiles:  includeFiles,
+		PublishedAt:   time.Now().Unix(),
+		Version:       prev.Version + 1,
+	}
+	if shared.PendingFileId == shared.FileId {
+		shared.PendingFileId = ""
+	}
+	if err = s.store.set(shared); err != nil {
+		return "", err
+	}
+	// the replaced pending snapshot has never been pointed to by the share
+	if prev.PendingFileId != "" && prev.PendingFileId != shared.PendingFileId && prev.PendingFileId != shared.FileId {
+		s.removeSnapshot(ctx, prev.SpaceId, prev.PendingFileId)
+	}
+	if err = s.updatePointer(ctx, shared); err != nil {
+		log.With("objectId", objectId).Warnf("failed to update share pointer: %s", err)
+	}
+	return s.Link(shared), nil
+}
+
+// updatePointers moves pointers of shares to their new snapshots that are uploaded
+func (s *service) updatePointers(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list, err := s.store.list()
+	if err != nil {
+		return err
+	}
+	for _, shared := range list {
+		if err = s.updatePointer(ctx, shared); err != nil {
+			log.With("objectId", shared.ObjectId).Warnf("failed to update share pointer: %s", err)
+		}
+	}
+	return nil
+}
+
+// updatePointer points the share to the pending snapshot when it is uploaded to the file node,
+// the previous snapshot is kept for links sent before. Must be called under the lock
+func (s *service) updatePointer(ctx context.Context, shared *Shared) error {
+	if shared.PendingFileId == "" {
+		return nil
+	}
+	stat, err := s.fileSync.FileStat(ctx, shared.SpaceId, shared.PendingFileId)
+	if errors.Is(err, domain.ErrFileNotFound) || err == nil && (stat.TotalChunksCount == 0 || !stat.IsPinned()) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get snapshot upload status: %w", err)
+	}
+	if shared.FileId != "" && shared.FileId != shared.PendingFileId {
+		shared.PreviousFileIds = append(shared.PreviousFileIds, shared.FileId)
+	}
+	shared.FileId, shared.PendingFileId = shared.PendingFileId, ""
+	return s.store.set(shared)
+}
+
+func (s *service) exportSnapshot(objectId string, includeLinked, includeFiles bool) ([]byte, error) {
+	dir, err := os.MkdirTemp(s.tempDirProvider.TempDir(), "share")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	path, succeed, err := s.exporter.Export(pb.RpcObjectListExportRequest{
+		Path:          dir,
+		ObjectIds:     []string{objectId},
+		Format:        pb.RpcObjectListExport_Protobuf,
+		Zip:           true,
+		IncludeNested: includeLinked,
+		IncludeFiles:  includeFiles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export: %w", err)
+	}
+	if succeed == 0 || path == "" {
+		return nil, ErrEmptyExport
+	}
+	return os.ReadFile(path)
+}
+
+func (s *service) Revoke(ctx context.Context, objectId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	shared, err := s.store.get(objectId)
+	if err != nil {
+		return err
+	}
+	if shared == nil {
+		return ErrNotShared
+	}
+	if err = s.store.delete(objectId); err != nil {
+		return err
+	}
+	for _, fileId := range append([]string{shared.FileId, shared.PendingFileId}, shared.PreviousFileIds...) {
+		if fileId != "" {
+			s.removeSnapshot(ctx, shared.SpaceId, fileId)
+		}
+	}
+	return nil
+}
+
+func (s *service) removeSnapshot(ctx context.Context, spaceId, fileId string) {
+	if err := s.fileSync.RemoveFile(spaceId, fileId); err != nil {
+		log.With("fileId", fileId).Errorf("failed to remove snapshot from the file node: %s", err)
+	}
+	if err := s.removeLocal(ctx, fileId); err != nil {
+		log.With("fileId", fileId).Errorf("failed to remove local snapshot: %s", err)
+	}
+}
+
+func (s *service) List() ([]*Shared, error) {
+	return s.store.list()
+}
+
+func (s *service) Link(shared *Shared) string {
+	params := url.Values{}
+	params.Add("spaceId", shared.SpaceId)
+	params.Add("shareId", shared.ShareId)
+	params.Add("fileId", shared.LatestFileId())
+	params.Add("version", strconv.Itoa(shared.Version))
+	key, err := crypto.UnmarshallAESKey(shared.Key)
+	if err != nil {
+		return ""
+	}
+	return linkPrefix + params.Encode() + "#" + key.String()
+}
+
+type shareLink struct {
+	spaceId string
+	shareId string
+	fileId  cid.Cid
+	version int
+	key     *crypto.AESKey
+}
+
+func parseLink(link string) (res shareLink, err error) {
+	u, err := url.Parse(link)
+	if err != nil || u.Scheme+"://"+u.Host+u.Path+"?" != linkPrefix {
+		return res, ErrInvalidLink
+	}
+	// links published before share ids were added have no share id
+	res.spaceId, res.shareId = u.Query().Get("spaceId"), u.Query().Get("shareId")
+	if res.spaceId == "" {
+		return res, ErrInvalidLink
+	}
+	if res.fileId, err = cid.Parse(u.Query().Get("fileId")); err != nil {
+		return res, fmt.Errorf("%w: %s", ErrInvalidLink, err)
+	}
+	// links published before versions were added are older than any other link of the share
+	if version := u.Query().Get("version"); version != "" {
+		if res.version, err = strconv.Atoi(version); err != nil {
+			return res, fmt.Errorf("%w: %s", ErrInvalidLink, err)
+		}
+	}
+	if res.key, err = crypto.UnmarshallAESKeyString(u.Fragment); err != nil {
+		return res, fmt.Errorf("%w: %s", ErrInvalidLink, err)
+	}
+	return
+}
+
+func (s *service) Import(ctx *session.Context, link string) error {
+	l, err := parseLink(link)
+	if err != nil {
+		return err
+	}
+	fileId, err := s.resolveLatest(l)
+	if err != nil {
+		return err
+	}
+	snapshot, err := s.fetchSnapshot(l.spaceId, fileId)
+	if err != nil {
+		return err
+	}
+	snapshot, err = l.key.Decrypt(snapshot)
+	if err != nil {
+		return fmt.Errorf("decrypt snapshot: %w", err)
+	}
+
+	dir, err := os.MkdirTemp(s.tempDirProvider.TempDir(), "share")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "shared.zip")
+	if err = os.WriteFile(path, snapshot, 0600); err != nil {
+		return err
+	}
+	return s.importer.Import(ctx, &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfPbParams{
+			PbParams: &pb.RpcObjectImportRequestPbParams{Path: []string{path}},
+		},
+		Type: pb.RpcObjectImportRequest_Pb,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	})
+}
+
+// resolveLatest returns the latest snapshot of own shares. Snapshots of other shares are resolved to the newest
+// snapshot of the share the link of which has been received, the link is saved if it is the newest one
+func (s *service) resolveLatest(l shareLink) (cid.Cid, error) {
+	if l.shareId == "" {
+		return l.fileId, nil
+	}
+	if l.spaceId == s.spaceService.AccountId() {
+		shared, err := s.store.getByShareId(l.shareId)
+		if err != nil || shared == nil {
+			return l.fileId, err
+		}
+		return cid.Parse(shared.LatestFileId())
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	received, err := s.store.getReceived(l.shareId)
+	if err != nil {
+		return l.fileId, err
+	}
+	if received != nil && received.SpaceId == l.spaceId && received.Version > l.version {
+		return cid.Parse(received.FileId)
+	}
+	return l.fileId, s.store.setReceived(&Received{
+		ShareId: l.shareId,
+		SpaceId: l.spaceId,
+		FileId:  l.fileId.String(),
+		Version: l.version,
+	})
+}
+
+// fetchSnapshot downloads the snapshot from the space of the sharer, local blocks are removed after reading
+func (s *service) fetchSnapshot(spaceId string, fileId cid.Cid) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+	ctx = fileblockstore.CtxWithSpaceId(ctx, spaceId)
+	ctx = context.WithValue(ctx, filestorage.CtxKeyRemoteLoadRequested, true)
+	r, err := s.commonFile.GetFile(ctx, fileId)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshot: %w", err)
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot: %w", err)
+	}
+	// own snapshots are kept until they are revoked
+	if spaceId != s.spaceService.AccountId() {
+		if err = s.removeLocal(ctx, fileId.String()); err != nil {
+			log.Errorf("failed to remove local blocks of shared snapshot: %s", err)
+		}
+	}
+	return data, nil
+}
+
+func (s *service) removeLocal(ctx context.Context, fileId string) error {
+	c, err := cid.Parse(fileId)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, filestorage.CtxKeyRemoteLoadDisabled, true)
+	var cids []cid.Cid
+	if err = collectCids(ctx, s.commonFile.DAGService(), c, &cids); err != nil {
+		return err
+	}
+	return s.commonFile.DAGService().RemoveMany(ctx, cids)
+}
+
+func collectCids(ctx context.Context, getter ipld.NodeGetter, c cid.Cid, cids *[]cid.Cid) error {
+	node, err := getter.Get(ctx, c)
+	if err != nil {
+		return err
+	}
+	*cids = append(*cids, c)
+	for _, l := range node.Links() {
+		if err = collectCids(ctx, getter, l.Cid, cids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,358 @@
+package objectshare
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonfile/fileblockstore"
+	"github.com/anyproto/any-sync/commonfile/fileservice"
+	"github.com/anyproto/any-sync/util/crypto"
+	"github.com/dgraph-io/badger/v3"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/export"
+	importer "github.com/anyproto/anytype-heart/core/block/import"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/filestorage/filesync"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/space"
+)
+
+const testSpaceId = "account.space"
+
+type testBlockStore struct {
+	mu     sync.Mutex
+	blocks map[cid.Cid]blocks.Block
+	// remote is the store of the file node, blocks missing locally are taken from it
+	remote *testBlockStore
+}
+
+func (s *testBlockStore) Init(a *app.App) error { return nil }
+
+func (s *testBlockStore) Name() string { return fileblockstore.CName }
+
+func (s *testBlockStore) Get(ctx context.Context, k cid.Cid) (blocks.Block, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if b, ok := s.blocks[k]; ok {
+		return b, nil
+	}
+	if s.remote != nil {
+		return s.remote.Get(ctx, k)
+	}
+	return nil, fileblockstore.ErrCIDNotFound
+}
+
+func (s *testBlockStore) GetMany(ctx context.Context, ks []cid.Cid) <-chan blocks.Block {
+	ch := make(chan blocks.Block, len(ks))
+	defer close(ch)
+	for _, k := range ks {
+		if b, err := s.Get(ctx, k); err == nil {
+			ch <- b
+		}
+	}
+	return ch
+}
+
+func (s *testBlockStore) Add(ctx context.Context, bs []blocks.Block) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range bs {
+		s.blocks[b.Cid()] = b
+	}
+	return nil
+}
+
+func (s *testBlockStore) Delete(ctx context.Context, k cid.Cid) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.blocks, k)
+	return nil
+}
+
+func (s *testBlockStore) ExistsCids(ctx context.Context, ks []cid.Cid) (exists []cid.Cid, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, k := range ks {
+		if _, ok := s.blocks[k]; ok {
+			exists = append(exists, k)
+		}
+	}
+	return
+}
+
+func (s *testBlockStore) NotExistsBlocks(ctx context.Context, bs []blocks.Block) (notExists []blocks.Block, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range bs {
+		if _, ok := s.blocks[b.Cid()]; !ok {
+			notExists = append(notExists, b)
+		}
+	}
+	return
+}
+
+type testFileSync struct {
+	filesync.FileSync
+	uploaded map[string]bool
+	removed  []string
+}
+
+func (s *testFileSync) AddFile(spaceId, fileId string, uploadedByUser bool) error {
+	s.uploaded[fileId] = false
+	return nil
+}
+
+func (s *testFileSync) RemoveFile(spaceId, fileId string) error {
+	s.removed = append(s.removed, fileId)
+	return nil
+}
+
+func (s *testFileSync) FileStat(ctx context.Context, spaceId, fileId string) (filesync.FileStat, error) {
+	uploaded, ok := s.uploaded[fileId]
+	if !ok {
+		return filesync.FileStat{}, domain.ErrFileNotFound
+	}
+	stat := filesync.FileStat{SpaceId: spaceId, FileId: fileId, TotalChunksCount: 1}
+	if uploaded {
+		stat.UploadedChunksCount = 1
+	}
+	return stat, nil
+}
+
+type testSpaceService struct {
+	space.Service
+	accountId string
+}
+
+func (s *testSpaceService) AccountId() string {
+	return s.accountId
+}
+
+// testExporter exports the current content of the object as the snapshot
+type testExporter struct {
+	content string
+}
+
+func (e *testExporter) Init(a *app.App) error { return nil }
+
+func (e *testExporter) Name() string { return export.CName }
+
+func (e *testExporter) Export(req pb.RpcObjectListExportRequest) (string, int, error) {
+	path := filepath.Join(req.Path, "export.zip")
+	return path, 1, os.WriteFile(path, []byte(e.content), 0600)
+}
+
+type testImporter struct {
+	importer.Importer
+	imported []string
+}
+
+func (i *testImporter) Import(ctx *session.Context, req *pb.RpcObjectImportRequest) error {
+	data, err := os.ReadFile(req.GetPbParams().Path[0])
+	i.imported = append(i.imported, string(data))
+	return err
+}
+
+type testTempDirProvider struct {
+	dir string
+}
+
+func (p *testTempDirProvider) TempDir() string {
+	return p.dir
+}
+
+type fixture struct {
+	*service
+	blockStore *testBlockStore
+	exporter   *testExporter
+	importer   *testImporter
+	fileSync   *testFileSync
+}
+
+func newFixture(t *testing.T) *fixture {
+	return newAccountFixture(t, testSpaceId, nil)
+}
+
+// newRecipientFixture returns the fixture of another account, the block store of the sharer plays the file node
+func newRecipientFixture(t *testing.T, sharer *fixture) *fixture {
+	return newAccountFixture(t, "recipient.space", sharer.blockStore)
+}
+
+func newAccountFixture(t *testing.T, accountId string, remote *testBlockStore) *fixture {
+	blockStore := &testBlockStore{blocks: map[cid.Cid]blocks.Block{}, remote: remote}
+	commonFile := fileservice.New()
+	a := new(app.App)
+	a.Register(blockStore).Register(commonFile)
+	require.NoError(t, a.Start(context.Background()))
+	t.Cleanup(func() { _ = a.Close(context.Background()) })
+	db, err := badger.Open(badger.DefaultOptions(t.TempDir()).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	fx := &fixture{
+		blockStore: blockStore,
+		exporter:   &testExporter{},
+		importer:   &testImporter{},
+		fileSync:   &testFileSync{uploaded: map[string]bool{}},
+	}
+	fx.service = &service{
+		tempDirProvider: &testTempDirProvider{dir: t.TempDir()},
+		exporter:        fx.exporter,
+		importer:        fx.importer,
+		commonFile:      commonFile,
+		fileSync:        fx.fileSync,
+		spaceService:    &testSpaceService{accountId: accountId},
+		store:           &store{db: db},
+	}
+	return fx
+}
+
+// TestShareImport republishes the shared object and checks that the link sent before resolves to the latest
+// snapshot and previous snapshots are kept until the share is revoked
+func TestShareImport(t *testing.T) {
+	ctx := context.Background()
+	fx := newFixture(t)
+	upload := func(link string) {
+		l, err := parseLink(link)
+		require.NoError(t, err)
+		fx.fileSync.uploaded[l.fileId.String()] = true
+		require.NoError(t, fx.updatePointers(ctx))
+	}
+	importLink := func(link string) string {
+		require.NoError(t, fx.Import(nil, link))
+		return fx.importer.imported[len(fx.importer.imported)-1]
+	}
+
+	fx.exporter.content = "v1"
+	link1, err := fx.Share(ctx, "object", false, false)
+	require.NoError(t, err)
+	upload(link1)
+	assert.Equal(t, "v1", importLink(link1))
+	l1, err := parseLink(link1)
+	require.NoError(t, err)
+
+	fx.exporter.content = "v2"
+	link2, err := fx.Share(ctx, "object", false, false)
+	require.NoError(t, err)
+	l2, err := parseLink(link2)
+	require.NoError(t, err)
+	assert.Equal(t, l1.shareId, l2.shareId)
+	assert.NotEqual(t, l1.fileId, l2.fileId)
+	assert.True(t, l1.key.Equals(l2.key))
+
+	shared, err := fx.store.get("object")
+	require.NoError(t, err)
+	assert.Equal(t, l1.fileId.String(), shared.FileId, "share points to the uploaded snapshot")
+	assert.Empty(t, fx.fileSync.removed, "previous snapshot is kept until the new one is uploaded")
+	assert.Equal(t, "v2", importLink(link1))
+
+	upload(link2)
+	shared, err = fx.store.get("object")
+	require.NoError(t, err)
+	assert.Equal(t, l2.fileId.String(), shared.FileId)
+	assert.Empty(t, shared.PendingFileId)
+	assert.Equal(t, []string{l1.fileId.String()}, shared.PreviousFileIds)
+	assert.Empty(t, fx.fileSync.removed, "previous snapshot is kept for links sent before")
+	assert.Equal(t, "v2", importLink(link1))
+	assert.Equal(t, "v2", importLink(link2))
+
+	require.NoError(t, fx.Revoke(ctx, "object"))
+	assert.ElementsMatch(t, []string{l1.fileId.String(), l2.fileId.String()}, fx.fileSync.removed)
+}
+
+// TestRecipientImport imports links of the republished object by another account
+func TestRecipientImport(t *testing.T) {
+	ctx := context.Background()
+	sharer := newFixture(t)
+	recipient := newRecipientFixture(t, sharer)
+	share := func(content string) string {
+		sharer.exporter.content = content
+		link, err := sharer.Share(ctx, "object", false, false)
+		require.NoError(t, err)
+		l, err := parseLink(link)
+		require.NoError(t, err)
+		sharer.fileSync.uploaded[l.fileId.String()] = true
+		require.NoError(t, sharer.updatePointers(ctx))
+		return link
+	}
+	importLink := func(link string) string {
+		require.NoError(t, recipient.Import(nil, link))
+		return recipient.importer.imported[len(recipient.importer.imported)-1]
+	}
+
+	link1 := share("v1")
+	link2 := share("v2")
+	assert.Equal(t, "v1", importLink(link1), "snapshot of the link sent before is not removed")
+	assert.Equal(t, "v2", importLink(link2))
+	assert.Equal(t, "v2", importLink(link1), "link sent before is resolved to the newest received snapshot")
+
+	link3 := share("v3")
+	assert.Equal(t, "v3", importLink(link3))
+	assert.Equal(t, "v3", importLink(link2))
+}
+
+func TestLink(t *testing.T) {
+	key, err := crypto.NewRandomAES()
+	require.NoError(t, err)
+	shared := &Shared{
+		ObjectId: "object",
+		ShareId:  "share",
+		Version:  2,
+		SpaceId:  "space.id",
+		FileId:   "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+		Key:      key.Bytes(),
+	}
+	s := &service{}
+
+	l, err := parseLink(s.Link(shared))
+	require.NoError(t, err)
+	assert.Equal(t, shared.SpaceId, l.spaceId)
+	assert.Equal(t, shared.ShareId, l.shareId)
+	assert.Equal(t, shared.FileId, l.fileId.String())
+	assert.Equal(t, shared.Version, l.version)
+	assert.True(t, key.Equals(l.key))
+
+	for _, link := range []string{
+		"anytype://object/other?spaceId=space&fileId=" + shared.FileId + "#" + key.String(),
+		"anytype://object/share?fileId=" + shared.FileId + "#" + key.String(),
+		"anytype://object/share?spaceId=space&fileId=" + shared.FileId,
+	} {
+		_, err = parseLink(link)
+		assert.ErrorIs(t, err, ErrInvalidLink, link)
+	}
+}
+
+func TestStore(t *testing.T) {
+	db, err := badger.Open(badger.DefaultOptions(t.TempDir()).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	defer db.Close()
+	s := &store{db: db}
+
+	shared, err := s.get("object")
+	require.NoError(t, err)
+	assert.Nil(t, shared)
+
+	require.NoError(t, s.set(&Shared{ObjectId: "object", FileId: "file1"}))
+	require.NoError(t, s.set(&Shared{ObjectId: "object", FileId: "file2"}))
+	shared, err = s.get("object")
+	require.NoError(t, err)
+	assert.Equal(t, "file2", shared.FileId)
+
+	list, err := s.list()
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	require.NoError(t, s.delete("object"))
+	list, err = s.list()
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
@@ -0,0 +1,161 @@
+package objectshare
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/dgraph-io/badger/v3"
+
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+)
+
+const (
+	keyPrefix         = "/objectshare/"
+	receivedKeyPrefix = "/objectshare_received/"
+)
+
+// Shared is the published snapshot of the object
+type Shared struct {
+	ObjectId string
+	// ShareId is kept when the object is republished
+	ShareId string
+	SpaceId string
+	// FileId is the snapshot the share points to, it is uploaded to the file node
+	FileId string
+	// PendingFileId is the new snapshot, the share is pointed to it when it is uploaded
+	PendingFileId string `json:",omitempty"`
+	// PreviousFileIds are snapshots the share pointed to before, they are removed when the share is revoked
+	PreviousFileIds []string `json:",omitempty"`
+	Key             []byte
+	IncludeLinked   bool
+	IncludeFiles    bool
+	PublishedAt     int64
+	// Version is increased every time the object is republished
+	Version int
+}
+
+// LatestFileId returns the id of the latest published snapshot
+func (s *Shared) LatestFileId() string {
+	if s.PendingFileId != "" {
+		return s.PendingFileId
+	}
+	return s.FileId
+}
+
+// Received is the newest snapshot of the share of another account the link of which has been imported
+type Received struct {
+	ShareId string
+	SpaceId string
+	FileId  string
+	Version int
+}
+
+type store struct {
+	db *badger.DB
+}
+
+func (s *store) get(objectId string) (*Shared, error) {
+	var shared *Shared
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(keyPrefix + objectId))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			shared = &Shared{}
+			return json.Unmarshal(val, shared)
+		})
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, nil
+	}
+	return shared, err
+}
+
+func (s *store) set(shared *Shared) error {
+	val, err := json.Marshal(shared)
+	if err != nil {
+		return err
+	}
+	return badgerhelper.RetryOnConflict(func() error {
+		return s.db.Update(func(txn *badger.Txn) error {
+			return txn.Set([]byte(keyPrefix+shared.ObjectId), val)
+		})
+	})
+}
+
+func (s *store) delete(objectId string) error {
+	return badgerhelper.RetryOnConflict(func() error {
+		return s.db.Update(func(txn *badger.Txn) error {
+			return txn.Delete([]byte(keyPrefix + objectId))
+		})
+	})
+}
+
+func (s *store) getByShareId(shareId string) (*Shared, error) {
+	list, err := s.list()
+	if err != nil {
+		return nil, err
+	}
+	for _, shared := range list {
+		if shared.ShareId == shareId {
+			return shared, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *store) list() (list []*Shared, err error) {
+	err = s.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{
+			PrefetchValues: true,
+			PrefetchSize:   100,
+			Prefix:         []byte(keyPrefix),
+		})
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			if err := it.Item().Value(func(val []byte) error {
+				shared := &Shared{}
+				if err := json.Unmarshal(val, shared); err != nil {
+					return err
+				}
+				list = append(list, shared)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return
+}
+
+func (s *store) getReceived(shareId string) (*Received, error) {
+	var received *Received
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(receivedKeyPrefix + shareId))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			received = &Received{}
+			return json.Unmarshal(val, received)
+		})
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, nil
+	}
+	return received, err
+}
+
+func (s *store) setReceived(received *Received) error {
+	val, err := json.Marshal(received)
+	if err != nil {
+		return err
+	}
+	return badgerhelper.RetryOnConflict(func() error {
+		return s.db.Update(func(txn *badger.Txn) error {
+			return txn.Set([]byte(receivedKeyPrefix+received.ShareId), val)
+		})
+	})
+}
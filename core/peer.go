@@ -0,0 +1,105 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/space/localdiscovery"
+	"github.com/anyproto/anytype-heart/space/peerstore"
+)
+
+func (mw *Middleware) PeerAdd(cctx context.Context, req *pb.RpcPeerAddRequest) *pb.RpcPeerAddResponse {
+	response := func(peer *peerstore.ManualPeer, code pb.RpcPeerAddResponseErrorCode, err error) *pb.RpcPeerAddResponse {
+		m := &pb.RpcPeerAddResponse{Error: &pb.RpcPeerAddResponseError{Code: code}}
+		if peer != nil {
+			m.Peer = peerInfo(*peer)
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	a := mw.GetApp()
+	if a == nil {
+		return response(nil, pb.RpcPeerAddResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	peer := peerstore.ManualPeer{PeerId: req.PeerId, Addrs: req.Addrs}
+	if req.Payload != "" {
+		var err error
+		if peer, err = peerstore.DecodePairingPayload(req.Payload); err != nil {
+			return response(nil, pb.RpcPeerAddResponseError_BAD_INPUT, err)
+		}
+	}
+	err := a.MustComponent(localdiscovery.ManualCName).(localdiscovery.ManualDiscovery).AddPeer(peer)
+	switch {
+	case errors.Is(err, peerstore.ErrInvalidPeer):
+		return response(nil, pb.RpcPeerAddResponseError_BAD_INPUT, err)
+	case err != nil:
+		return response(nil, pb.RpcPeerAddResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(&peer, pb.RpcPeerAddResponseError_NULL, nil)
+}
+
+func (mw *Middleware) PeerRemove(cctx context.Context, req *pb.RpcPeerRemoveRequest) *pb.RpcPeerRemoveResponse {
+	response := func(code pb.RpcPeerRemoveResponseErrorCode, err error) *pb.RpcPeerRemoveResponse {
+		m := &pb.RpcPeerRemoveResponse{Error: &pb.RpcPeerRemoveResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if req.PeerId == "" {
+		return response(pb.RpcPeerRemoveResponseError_BAD_INPUT, errors.New("empty peer id"))
+	}
+	a := mw.GetApp()
+	if a == nil {
+		return response(pb.RpcPeerRemoveResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	if err := a.MustComponent(localdiscovery.ManualCName).(localdiscovery.ManualDiscovery).RemovePeer(req.PeerId); err != nil {
+		return response(pb.RpcPeerRemoveResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcPeerRemoveResponseError_NULL, nil)
+}
+
+func (mw *Middleware) PeerList(cctx context.Context, req *pb.RpcPeerListRequest) *pb.RpcPeerListResponse {
+	response := func(peers []*pb.RpcPeerInfo, code pb.RpcPeerListResponseErrorCode, err error) *pb.RpcPeerListResponse {
+		m := &pb.RpcPeerListResponse{Peers: peers, Error: &pb.RpcPeerListResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	a := mw.GetApp()
+	if a == nil {
+		return response(nil, pb.RpcPeerListResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	var peers []*pb.RpcPeerInfo
+	for _, peer := range a.MustComponent(localdiscovery.ManualCName).(localdiscovery.ManualDiscovery).Peers() {
+		peers = append(peers, peerInfo(peer))
+	}
+	return response(peers, pb.RpcPeerListResponseError_NULL, nil)
+}
+
+func (mw *Middleware) PeerGetPairingPayload(cctx context.Context, req *pb.RpcPeerGetPairingPayloadRequest) *pb.RpcPeerGetPairingPayloadResponse {
+	response := func(payload string, code pb.RpcPeerGetPairingPayloadResponseErrorCode, err error) *pb.RpcPeerGetPairingPayloadResponse {
+		m := &pb.RpcPeerGetPairingPayloadResponse{Payload: payload, Error: &pb.RpcPeerGetPairingPayloadResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	a := mw.GetApp()
+	if a == nil {
+		return response("", pb.RpcPeerGetPairingPayloadResponseError_ACCOUNT_IS_NOT_RUNNING, ErrNotLoggedIn)
+	}
+	payload, err := a.MustComponent(localdiscovery.ManualCName).(localdiscovery.ManualDiscovery).PairingPayload()
+	if err != nil {
+		return response("", pb.RpcPeerGetPairingPayloadResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(payload, pb.RpcPeerGetPairingPayloadResponseError_NULL, nil)
+}
+
+func peerInfo(peer peerstore.ManualPeer) *pb.RpcPeerInfo {
+	return &pb.RpcPeerInfo{PeerId: peer.PeerId, Addrs: peer.Addrs}
+}
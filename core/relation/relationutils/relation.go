@@ -31,10 +31,37 @@ func RelationFromStruct(st *types.Struct) *Relation {
 			Description:      pbtypes.GetString(st, bundle.RelationKeyDescription.String()),
 			Scope:            model.RelationScope(pbtypes.GetFloat64(st, bundle.RelationKeyScope.String())),
 			Creator:          pbtypes.GetString(st, bundle.RelationKeyCreator.String()),
+			Rules:            rulesFromStruct(st),
 		},
 	}
 }
 
+func rulesFromStruct(st *types.Struct) *model.RelationRules {
+	rules := &model.RelationRules{
+		Required: pbtypes.GetBool(st, bundle.RelationKeyRelationRequired.String()),
+		Unique:   pbtypes.GetBool(st, bundle.RelationKeyRelationUnique.String()),
+		MinValue: numberOrNil(pbtypes.Get(st, bundle.RelationKeyRelationMinValue.String())),
+		MaxValue: numberOrNil(pbtypes.Get(st, bundle.RelationKeyRelationMaxValue.String())),
+		Regex:    pbtypes.GetString(st, bundle.RelationKeyRelationRegex.String()),
+	}
+	if !HasRules(rules) {
+		return nil
+	}
+	return rules
+}
+
+func numberOrNil(v *types.Value) *types.Value {
+	if _, ok := v.GetKind().(*types.Value_NumberValue); ok {
+		return v
+	}
+	return nil
+}
+
+// HasRules reports whether any rule of the relation is set
+func HasRules(rules *model.RelationRules) bool {
+	return rules != nil && (rules.Required || rules.Unique || rules.MinValue != nil || rules.MaxValue != nil || rules.Regex != "")
+}
+
 type Relation struct {
 	*model.Relation
 }
@@ -47,7 +74,7 @@ func (r *Relation) RelationLink() *model.RelationLink {
 }
 
 func (r *Relation) ToStruct() *types.Struct {
-	return &types.Struct{
+	st := &types.Struct{
 		Fields: map[string]*types.Value{
 			bundle.RelationKeyId.String():                        pbtypes.String(r.Id),
 			bundle.RelationKeyRelationKey.String():               pbtypes.String(r.GetKey()),
@@ -65,6 +92,14 @@ func (r *Relation) ToStruct() *types.Struct {
 			bundle.RelationKeyCreator.String():                   pbtypes.String(r.GetCreator()),
 		},
 	}
+	if rules := r.GetRules(); HasRules(rules) {
+		st.Fields[bundle.RelationKeyRelationRequired.String()] = pbtypes.Bool(rules.Required)
+		st.Fields[bundle.RelationKeyRelationUnique.String()] = pbtypes.Bool(rules.Unique)
+		st.Fields[bundle.RelationKeyRelationMinValue.String()] = pbtypes.NilToNullWrapper(rules.MinValue)
+		st.Fields[bundle.RelationKeyRelationMaxValue.String()] = pbtypes.NilToNullWrapper(rules.MaxValue)
+		st.Fields[bundle.RelationKeyRelationRegex.String()] = pbtypes.String(rules.Regex)
+	}
+	return st
 }
 
 type Relations []*Relation
@@ -0,0 +1,395 @@
+package relation
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/types"
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/anyproto/anytype-heart/core/relation/relationutils"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/database/filter"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+// ValidationError is returned when values of relations violate rules of relations
+type ValidationError struct {
+	Violations []*model.RelationViolation
+}
+
+func (e *ValidationError) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		descriptions = append(descriptions, v.Description)
+	}
+	return "relation rules are violated: " + strings.Join(descriptions, "; ")
+}
+
+// ValidateRules checks the value of the relation in details of the object against rules of the relation
+func ValidateRules(store objectstore.ObjectStore, rel *relationutils.Relation, objectId string, details *types.Struct) ([]*model.RelationViolation, error) {
+	violations, err := checkValue(store, rel, objectId, details)
+	if err != nil {
+		return nil, err
+	}
+	if rel.GetRules().GetUnique() {
+		v, err := checkUnique(store, rel, objectId, details)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			violations = append(violations, v)
+		}
+	}
+	return violations, nil
+}
+
+// ValidateDetails checks values of the keys in details of the object against rules of their relations.
+// It returns *ValidationError if any rule is violated. Values of unique relations are locked by the relation
+// and the object type until unlock is called, so it must be called after the details are applied and indexed.
+// Unlock is not nil even when the error is returned
+func ValidateDetails(s Service, store objectstore.ObjectStore, objectId string, details *types.Struct, keys []string) (unlock func(), err error) {
+	var rels []*relationutils.Relation
+	for _, key := range keys {
+		rel, err := s.FetchKey(key)
+		if err != nil {
+			continue
+		}
+		if relationutils.HasRules(rel.Rules) || len(rel.ObjectTypes) > 0 {
+			rels = append(rels, rel)
+		}
+	}
+	unlock = lockUnique(rels, details)
+
+	var violations []*model.RelationViolation
+	for _, rel := range rels {
+		v, err := ValidateRules(store, rel, objectId, details)
+		if err != nil {
+			unlock()
+			return func() {}, fmt.Errorf("validate rules of relation %s: %w", rel.Key, err)
+		}
+		violations = append(violations, v...)
+	}
+	if len(violations) > 0 {
+		unlock()
+		return func() {}, &ValidationError{Violations: violations}
+	}
+	return unlock, nil
+}
+
+// uniqueLocks serialize checks and changes of values of unique relations by the relation and the object type,
+// otherwise objects changed at the same time could get the same value, as they are checked before any of them is indexed
+var uniqueLocks = struct {
+	sync.Mutex
+	byKey map[string]*uniqueLock
+}{byKey: map[string]*uniqueLock{}}
+
+type uniqueLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lockUnique locks values of unique relations of the object type in the order of keys, so objects with several
+// unique relations don't deadlock
+func lockUnique(rels []*relationutils.Relation, details *types.Struct) (unlock func()) {
+	objectType := pbtypes.GetString(details, bundle.RelationKeyType.String())
+	var keys []string
+	for _, rel := range rels {
+		if rel.GetRules().GetUnique() && objectType != "" {
+			keys = append(keys, rel.Key+"/"+objectType)
+		}
+	}
+	sort.Strings(keys)
+
+	locks := make([]*uniqueLock, 0, len(keys))
+	for _, key := range keys {
+		uniqueLocks.Lock()
+		l, ok := uniqueLocks.byKey[key]
+		if !ok {
+			l = &uniqueLock{}
+			uniqueLocks.byKey[key] = l
+		}
+		l.refs++
+		uniqueLocks.Unlock()
+		l.Lock()
+		locks = append(locks, l)
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			for i, l := range locks {
+				l.Unlock()
+				uniqueLocks.Lock()
+				if l.refs--; l.refs == 0 {
+					delete(uniqueLocks.byKey, keys[i])
+				}
+				uniqueLocks.Unlock()
+			}
+		})
+	}
+}
+
+// ListViolations checks existing objects against rules of relations. Relations without rules are skipped
+func ListViolations(store objectstore.ObjectStore, rels relationutils.Relations) ([]*model.RelationViolation, error) {
+	var violations []*model.RelationViolation
+	for _, rel := range rels {
+		if !relationutils.HasRules(rel.GetRules()) && len(rel.ObjectTypes) == 0 {
+			continue
+		}
+		records, err := objectsToCheck(store, rel)
+		if err != nil {
+			return nil, err
+		}
+		// objects of the same type with the same value violate the unique rule
+		byValue := map[string][]string{}
+		groupKey := func(details *types.Struct) string {
+			return pbtypes.GetString(details, bundle.RelationKeyType.String()) + "/" + pbtypes.Get(details, rel.Key).String()
+		}
+		for _, rec := range records {
+			id := pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
+			v, err := checkValue(store, rel, id, rec.Details)
+			if err != nil {
+				return nil, err
+			}
+			violations = append(violations, v...)
+			if rel.GetRules().GetUnique() && !isEmpty(pbtypes.Get(rec.Details, rel.Key)) {
+				byValue[groupKey(rec.Details)] = append(byValue[groupKey(rec.Details)], id)
+			}
+		}
+		for _, ids := range byValue {
+			if len(ids) < 2 {
+				continue
+			}
+			for _, id := range ids {
+				duplicateIds := slice.Remove(append([]string{}, ids...), id)
+				violations = append(violations, uniqueViolation(rel, id, duplicateIds))
+			}
+		}
+	}
+	return violations, nil
+}
+
+// objectsToCheck returns objects with the value of the relation and, for required relations, objects of types
+// which recommend the relation
+func objectsToCheck(store objectstore.ObjectStore, rel *relationutils.Relation) ([]database.Record, error) {
+	records, _, err := store.Query(nil, database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: rel.Key,
+				Condition:   model.BlockContentDataviewFilter_NotEmpty,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query objects with relation %s: %w", rel.Key, err)
+	}
+	if !rel.GetRules().GetRequired() {
+		return records, nil
+	}
+
+	objectTypes, _, err := store.Query(nil, database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyType.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(bundle.TypeKeyObjectType.URL()),
+			},
+			{
+				RelationKey: bundle.RelationKeyRecommendedRelations.String(),
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       pbtypes.StringList([]string{addr.RelationKeyToIdPrefix + rel.Key}),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query types with relation %s: %w", rel.Key, err)
+	}
+	if len(objectTypes) == 0 {
+		return records, nil
+	}
+	typeIds := make([]string, 0, len(objectTypes))
+	for _, rec := range objectTypes {
+		typeIds = append(typeIds, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+	}
+	empty, _, err := store.Query(nil, database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyType.String(),
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       pbtypes.StringList(typeIds),
+			},
+			{
+				RelationKey: rel.Key,
+				Condition:   model.BlockContentDataviewFilter_Empty,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query objects without relation %s: %w", rel.Key, err)
+	}
+	return append(records, empty...), nil
+}
+
+func checkValue(store objectstore.ObjectStore, rel *relationutils.Relation, objectId string, details *types.Struct) ([]*model.RelationViolation, error) {
+	var (
+		rules      = rel.GetRules()
+		value      = pbtypes.Get(details, rel.Key)
+		violations []*model.RelationViolation
+	)
+	violate := func(rule model.RelationViolationRule, format string, args ...interface{}) {
+		violations = append(violations, &model.RelationViolation{
+			ObjectId:    objectId,
+			RelationKey: rel.Key,
+			Rule:        rule,
+			Description: fmt.Sprintf("%s: ", rel.Name) + fmt.Sprintf(format, args...),
+		})
+	}
+	if isEmpty(value) {
+		if rules.GetRequired() {
+			violate(model.RelationViolation_Required, "value is required")
+		}
+		return violations, nil
+	}
+
+	switch rel.Format {
+	case model.RelationFormat_number, model.RelationFormat_date:
+		if _, ok := value.Kind.(*types.Value_NumberValue); !ok {
+			break
+		}
+		if min := rules.GetMinValue(); min != nil && value.GetNumberValue() < min.GetNumberValue() {
+			violate(model.RelationViolation_MinValue, "value is less than %v", min.GetNumberValue())
+		}
+		if max := rules.GetMaxValue(); max != nil && value.GetNumberValue() > max.GetNumberValue() {
+			violate(model.RelationViolation_MaxValue, "value is greater than %v", max.GetNumberValue())
+		}
+	case model.RelationFormat_longtext, model.RelationFormat_shorttext, model.RelationFormat_url,
+		model.RelationFormat_email, model.RelationFormat_phone:
+		if rules.GetRegex() == "" {
+			break
+		}
+		re, err := compileRulePattern(rules.GetRegex())
+		if err != nil {
+			log.Warnf("invalid pattern of relation %s: %v", rel.Key, err)
+			break
+		}
+		if !re.MatchString(value.GetStringValue()) {
+			violate(model.RelationViolation_Regex, "value doesn't match the pattern %s", rules.GetRegex())
+		}
+	case model.RelationFormat_object:
+		// values of readonly relations are managed by the middleware
+		if len(rel.ObjectTypes) == 0 || rel.ReadOnly {
+			break
+		}
+		// bundled types of objects like profiles are allowed by the ids of installed types and vice versa
+		allowedTypes, _ := relationutils.MigrateObjectTypeIds(rel.ObjectTypes)
+		for _, id := range pbtypes.GetStringListValue(value) {
+			linked, err := store.GetDetails(id)
+			if err != nil {
+				return nil, fmt.Errorf("get details of %s: %w", id, err)
+			}
+			objectType, _ := relationutils.MigrateObjectTypeId(pbtypes.GetString(linked.GetDetails(), bundle.RelationKeyType.String()))
+			if objectType != "" && slice.FindPos(allowedTypes, objectType) == -1 {
+				violate(model.RelationViolation_ObjectTypes, "object %s has the type not allowed for the relation", id)
+			}
+		}
+	}
+	return violations, nil
+}
+
+// checkUnique looks for other objects of the same type with the same value
+func checkUnique(store objectstore.ObjectStore, rel *relationutils.Relation, objectId string, details *types.Struct) (*model.RelationViolation, error) {
+	value := pbtypes.Get(details, rel.Key)
+	objectType := pbtypes.GetString(details, bundle.RelationKeyType.String())
+	if isEmpty(value) || objectType == "" {
+		return nil, nil
+	}
+	records, _, err := store.Query(nil, database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyType.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(objectType),
+			},
+			{
+				RelationKey: rel.Key,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       value,
+			},
+			{
+				RelationKey: bundle.RelationKeyId.String(),
+				Condition:   model.BlockContentDataviewFilter_NotEqual,
+				Value:       pbtypes.String(objectId),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query objects with the same %s: %w", rel.Key, err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(records))
+	for _, rec := range records {
+		ids = append(ids, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+	}
+	return uniqueViolation(rel, objectId, ids), nil
+}
+
+// rulePatternsLimit limits the number of cached patterns, patterns of removed or changed rules are evicted
+const rulePatternsLimit = 256
+
+// rulePatterns caches compiled regex rules by the pattern, as rules are checked on every change of details
+var rulePatterns = mustLRU(rulePatternsLimit)
+
+func mustLRU(size int) *lru.Cache {
+	c, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func compileRulePattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := rulePatterns.Get(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	// the whole value must match the pattern
+	re, err := filter.CompileRegex("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, err
+	}
+	rulePatterns.Add(pattern, re)
+	return re, nil
+}
+
+func uniqueViolation(rel *relationutils.Relation, objectId string, duplicateIds []string) *model.RelationViolation {
+	return &model.RelationViolation{
+		ObjectId:    objectId,
+		RelationKey: rel.Key,
+		Rule:        model.RelationViolation_Unique,
+		Description: fmt.Sprintf("%s: value is already used by %s", rel.Name, strings.Join(duplicateIds, ", ")),
+	}
+}
+
+func isEmpty(v *types.Value) bool {
+	if v == nil {
+		return true
+	}
+	switch k := v.Kind.(type) {
+	case *types.Value_NullValue:
+		return true
+	case *types.Value_StringValue:
+		return k.StringValue == ""
+	case *types.Value_ListValue:
+		return k.ListValue == nil || len(k.ListValue.Values) == 0
+	}
+	return false
+}
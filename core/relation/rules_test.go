@@ -0,0 +1,155 @@
+package relation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/relation/relationutils"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/testMock"
+)
+
+func TestValidateRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := testMock.NewMockObjectStore(ctrl)
+
+	validate := func(rel *model.Relation, value *types.Value) []model.RelationViolationRule {
+		details := &types.Struct{Fields: map[string]*types.Value{"type": pbtypes.String("invoice")}}
+		if value != nil {
+			details.Fields[rel.Key] = value
+		}
+		violations, err := ValidateRules(store, &relationutils.Relation{Relation: rel}, "obj1", details)
+		require.NoError(t, err)
+		var rules []model.RelationViolationRule
+		for _, v := range violations {
+			assert.Equal(t, "obj1", v.ObjectId)
+			rules = append(rules, v.Rule)
+		}
+		return rules
+	}
+
+	t.Run("required", func(t *testing.T) {
+		rel := &model.Relation{Key: "number", Format: model.RelationFormat_shorttext, Rules: &model.RelationRules{Required: true}}
+		assert.Equal(t, []model.RelationViolationRule{model.RelationViolation_Required}, validate(rel, nil))
+		assert.Equal(t, []model.RelationViolationRule{model.RelationViolation_Required}, validate(rel, pbtypes.String("")))
+		assert.Empty(t, validate(rel, pbtypes.String("INV-1")))
+	})
+	t.Run("min and max", func(t *testing.T) {
+		rel := &model.Relation{Key: "amount", Format: model.RelationFormat_number, Rules: &model.RelationRules{
+			MinValue: pbtypes.Float64(0),
+			MaxValue: pbtypes.Float64(100),
+		}}
+		assert.Equal(t, []model.RelationViolationRule{model.RelationViolation_MinValue}, validate(rel, pbtypes.Float64(-1)))
+		assert.Equal(t, []model.RelationViolationRule{model.RelationViolation_MaxValue}, validate(rel, pbtypes.Float64(101)))
+		assert.Empty(t, validate(rel, pbtypes.Float64(100)))
+	})
+	t.Run("regex", func(t *testing.T) {
+		rel := &model.Relation{Key: "number", Format: model.RelationFormat_shorttext, Rules: &model.RelationRules{Regex: `INV-\d+`}}
+		assert.Equal(t, []model.RelationViolationRule{model.RelationViolation_Regex}, validate(rel, pbtypes.String("draft INV-1")))
+		assert.Empty(t, validate(rel, pbtypes.String("INV-42")))
+	})
+	t.Run("object types", func(t *testing.T) {
+		rel := &model.Relation{Key: "customer", Format: model.RelationFormat_object, ObjectTypes: []string{"company"}}
+		store.EXPECT().GetDetails("person1").Return(&model.ObjectDetails{Details: &types.Struct{Fields: map[string]*types.Value{
+			"type": pbtypes.String("person"),
+		}}}, nil)
+		assert.Equal(t, []model.RelationViolationRule{model.RelationViolation_ObjectTypes}, validate(rel, pbtypes.StringList([]string{"person1"})))
+	})
+	t.Run("bundled object types", func(t *testing.T) {
+		rel := &model.Relation{Key: "assignee", Format: model.RelationFormat_object, ObjectTypes: []string{bundle.TypeKeyProfile.URL()}}
+		store.EXPECT().GetDetails("profile1").Return(&model.ObjectDetails{Details: &types.Struct{Fields: map[string]*types.Value{
+			"type": pbtypes.String(bundle.TypeKeyProfile.BundledURL()),
+		}}}, nil)
+		assert.Empty(t, validate(rel, pbtypes.StringList([]string{"profile1"})))
+	})
+	t.Run("unique", func(t *testing.T) {
+		rel := &model.Relation{Key: "number", Format: model.RelationFormat_shorttext, Rules: &model.RelationRules{Unique: true}}
+		store.EXPECT().Query(nil, gomock.Any()).Return([]database.Record{
+			{Details: &types.Struct{Fields: map[string]*types.Value{"id": pbtypes.String("obj2")}}},
+		}, 1, nil)
+		assert.Equal(t, []model.RelationViolationRule{model.RelationViolation_Unique}, validate(rel, pbtypes.String("INV-1")))
+	})
+}
+
+func TestListViolations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := testMock.NewMockObjectStore(ctrl)
+
+	object := func(id, objectType, number string) database.Record {
+		return database.Record{Details: &types.Struct{Fields: map[string]*types.Value{
+			"id":     pbtypes.String(id),
+			"type":   pbtypes.String(objectType),
+			"number": pbtypes.String(number),
+		}}}
+	}
+	store.EXPECT().Query(nil, gomock.Any()).Return([]database.Record{
+		object("obj1", "invoice", "INV-1"),
+		object("obj2", "invoice", "INV-1"),
+		object("obj3", "invoice", "INV-2"),
+		object("obj4", "bill", "INV-1"),
+	}, 4, nil)
+
+	violations, err := ListViolations(store, relationutils.Relations{
+		{Relation: &model.Relation{Key: "number", Format: model.RelationFormat_shorttext, Rules: &model.RelationRules{Unique: true}}},
+		{Relation: &model.Relation{Key: "name", Format: model.RelationFormat_shorttext}},
+	})
+	require.NoError(t, err)
+	var ids []string
+	for _, v := range violations {
+		assert.Equal(t, model.RelationViolation_Unique, v.Rule)
+		ids = append(ids, v.ObjectId)
+	}
+	assert.ElementsMatch(t, []string{"obj1", "obj2"}, ids)
+}
+
+func TestLockUnique(t *testing.T) {
+	unique := &relationutils.Relation{Relation: &model.Relation{Key: "number", Rules: &model.RelationRules{Unique: true}}}
+	ofType := func(objectType string) *types.Struct {
+		return &types.Struct{Fields: map[string]*types.Value{"type": pbtypes.String(objectType)}}
+	}
+
+	unlock := lockUnique(relationutils.Relations{unique}, ofType("invoice"))
+	locked := make(chan struct{})
+	go func() {
+		defer close(locked)
+		lockUnique(relationutils.Relations{unique}, ofType("invoice"))()
+	}()
+
+	// values of other types are not locked
+	lockUnique(relationutils.Relations{unique}, ofType("bill"))()
+	select {
+	case <-locked:
+		t.Fatal("the value is checked before the previous change is applied")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	// repeated unlock doesn't release locks taken by others
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("the value is not unlocked")
+	}
+	uniqueLocks.Lock()
+	assert.Empty(t, uniqueLocks.byKey)
+	uniqueLocks.Unlock()
+}
+
+func TestCompileRulePattern(t *testing.T) {
+	for i := 0; i < rulePatternsLimit+10; i++ {
+		_, err := compileRulePattern(fmt.Sprintf(`INV-%d`, i))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, rulePatternsLimit, rulePatterns.Len())
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/gogo/protobuf/types"
 
 	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/relation"
+	"github.com/anyproto/anytype-heart/core/relation/relationutils"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
@@ -299,6 +301,43 @@ func (mw *Middleware) RelationListRemoveOption(cctx context.Context, request *pb
 	return response(pb.RpcRelationListRemoveOptionResponseError_NULL, nil)
 }
 
+func (mw *Middleware) RelationListViolations(cctx context.Context, req *pb.RpcRelationListViolationsRequest) *pb.RpcRelationListViolationsResponse {
+	response := func(violations []*model.RelationViolation, code pb.RpcRelationListViolationsResponseErrorCode, err error) *pb.RpcRelationListViolationsResponse {
+		m := &pb.RpcRelationListViolationsResponse{Violations: violations, Error: &pb.RpcRelationListViolationsResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	mw.m.RLock()
+	defer mw.m.RUnlock()
+
+	if mw.app == nil {
+		return response(nil, pb.RpcRelationListViolationsResponseError_BAD_INPUT, fmt.Errorf("account must be started"))
+	}
+	relationService := mw.app.MustComponent(relation.CName).(relation.Service)
+	store := mw.app.MustComponent(objectstore.CName).(objectstore.ObjectStore)
+
+	var (
+		rels relationutils.Relations
+		err  error
+	)
+	if len(req.RelationKeys) > 0 {
+		rels, err = relationService.FetchKeys(req.RelationKeys...)
+	} else {
+		rels, err = relationService.ListAll()
+	}
+	if err != nil {
+		return response(nil, pb.RpcRelationListViolationsResponseError_UNKNOWN_ERROR, err)
+	}
+	violations, err := relation.ListViolations(store, rels)
+	if err != nil {
+		return response(nil, pb.RpcRelationListViolationsResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(violations, pb.RpcRelationListViolationsResponseError_NULL, nil)
+}
+
 func (mw *Middleware) RelationOptions(cctx context.Context, request *pb.RpcRelationOptionsRequest) *pb.RpcRelationOptionsResponse {
 	// TODO implement me
 	panic("implement me")
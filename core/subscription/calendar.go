@@ -0,0 +1,197 @@
+package subscription
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// calendarMaxDays limits the range of the calendar subscription, a year view is the largest one
+const calendarMaxDays = 366
+
+// ErrBadInput is returned for calendar and timeline requests without the date relation or with the invalid range
+var ErrBadInput = errors.New("bad input")
+
+// SubscribeCalendar subscribes for objects with dates in the range and buckets them by days
+func (s *service) SubscribeCalendar(req pb.RpcObjectCalendarSubscribeRequest) (*pb.RpcObjectCalendarSubscribeResponse, error) {
+	if err := validateRange(req.DateRelationKey, req.From, req.To, calendarMaxDays); err != nil {
+		return nil, err
+	}
+	from, to := time.Unix(req.From, 0), time.Unix(req.To, 0)
+	loc := s.location()
+
+	keys := append([]string{bundle.RelationKeyId.String(), req.DateRelationKey}, req.Keys...)
+	if req.EndDateRelationKey != "" {
+		keys = append(keys, req.EndDateRelationKey)
+	}
+	resp, err := s.Search(pb.RpcObjectSearchSubscribeRequest{
+		SubId:        req.SubId,
+		Filters:      append(rangeFilters(req.DateRelationKey, req.EndDateRelationKey, dayStart(from, loc).Unix(), req.To), req.Filters...),
+		Sorts:        req.Sorts,
+		Source:       req.Source,
+		CollectionId: req.CollectionId,
+		Keys:         lo.Uniq(keys),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c := &calendar{dateKey: req.DateRelationKey, endDateKey: req.EndDateRelationKey, from: from, to: to, loc: loc}
+	s.m.Lock()
+	// days are bucketed under the lock, so further changes of the subscription are sent as changed days
+	if sub, ok := s.subscriptions[resp.SubId]; ok {
+		c.days = c.buckets(sub.getActiveRecords())
+		s.calendars[resp.SubId] = c
+	} else {
+		c.days = c.buckets(resp.Records)
+	}
+	s.m.Unlock()
+	return &pb.RpcObjectCalendarSubscribeResponse{
+		Days:         c.responseDays(),
+		Records:      resp.Records,
+		Dependencies: resp.Dependencies,
+		SubId:        resp.SubId,
+	}, nil
+}
+
+func validateRange(dateKey string, from, to int64, maxDays int) error {
+	if dateKey == "" {
+		return fmt.Errorf("%w: date relation is not set", ErrBadInput)
+	}
+	if to < from {
+		return fmt.Errorf("%w: end of the range is before its start", ErrBadInput)
+	}
+	if time.Unix(to, 0).Sub(time.Unix(from, 0)) > time.Duration(maxDays)*24*time.Hour {
+		return fmt.Errorf("%w: range is longer than %d days", ErrBadInput, maxDays)
+	}
+	return nil
+}
+
+// rangeFilters selects objects which dates or spans from the start to the end date intersect the range
+func rangeFilters(startKey, endKey string, from, to int64) []*model.BlockContentDataviewFilter {
+	startsBeforeEnd := &model.BlockContentDataviewFilter{
+		RelationKey: startKey,
+		Condition:   model.BlockContentDataviewFilter_LessOrEqual,
+		Value:       pbtypes.Int64(to),
+	}
+	endsAfterStart := &model.BlockContentDataviewFilter{
+		RelationKey: startKey,
+		Condition:   model.BlockContentDataviewFilter_GreaterOrEqual,
+		Value:       pbtypes.Int64(from),
+	}
+	if endKey != "" {
+		endsAfterStart = &model.BlockContentDataviewFilter{
+			Operator: model.BlockContentDataviewFilter_Or,
+			NestedFilters: []*model.BlockContentDataviewFilter{
+				endsAfterStart,
+				{
+					RelationKey: endKey,
+					Condition:   model.BlockContentDataviewFilter_GreaterOrEqual,
+					Value:       pbtypes.Int64(from),
+				},
+			},
+		}
+	}
+	return []*model.BlockContentDataviewFilter{
+		{
+			RelationKey: startKey,
+			Condition:   model.BlockContentDataviewFilter_NotEmpty,
+		},
+		startsBeforeEnd,
+		endsAfterStart,
+	}
+}
+
+// calendar keeps day buckets of the calendar subscription to send changes of days
+type calendar struct {
+	dateKey, endDateKey string
+	from, to            time.Time
+	loc                 *time.Location
+	// days are ids of objects by the start of the day
+	days map[int64][]string
+}
+
+// buckets puts records into days of the range in the time zone of the calendar. Records with the end date later than
+// the date are put into every day of the span within the range
+func (c *calendar) buckets(records []*types.Struct) map[int64][]string {
+	var (
+		days     = map[int64][]string{}
+		from     = dayStart(c.from, c.loc)
+		rangeEnd = dayStart(c.to, c.loc)
+	)
+	for _, rec := range records {
+		start := dayStart(time.Unix(pbtypes.GetInt64(rec, c.dateKey), 0), c.loc)
+		end := start
+		if c.endDateKey != "" {
+			if endDate := dayStart(time.Unix(pbtypes.GetInt64(rec, c.endDateKey), 0), c.loc); endDate.After(start) {
+				end = endDate
+			}
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(rangeEnd) {
+			end = rangeEnd
+		}
+		id := pbtypes.GetString(rec, bundle.RelationKeyId.String())
+		for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+			days[day.Unix()] = append(days[day.Unix()], id)
+		}
+	}
+	return days
+}
+
+func (c *calendar) responseDays() []*pb.RpcObjectCalendarSubscribeResponseDay {
+	res := make([]*pb.RpcObjectCalendarSubscribeResponseDay, 0, len(c.days))
+	for date, ids := range c.days {
+		res = append(res, &pb.RpcObjectCalendarSubscribeResponseDay{Date: date, ObjectIds: ids})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Date < res[j].Date
+	})
+	return res
+}
+
+// onChange rebuckets records of the subscription and returns days which objects are changed, removed days are
+// returned with empty object ids
+func (c *calendar) onChange(subId string, records []*types.Struct) (changed []opCalendarDay) {
+	days := c.buckets(records)
+	for date, ids := range days {
+		if !slices.Equal(c.days[date], ids) {
+			changed = append(changed, opCalendarDay{subId: subId, date: date, objectIds: ids})
+		}
+	}
+	for date := range c.days {
+		if _, ok := days[date]; !ok {
+			changed = append(changed, opCalendarDay{subId: subId, date: date})
+		}
+	}
+	sort.Slice(changed, func(i, j int) bool {
+		return changed[i].date < changed[j].date
+	})
+	c.days = days
+	return
+}
+
+// calendarsOnChange collects changed days of calendar subscriptions. Must be called under the lock after
+// subscriptions handled the changes
+func (s *service) calendarsOnChange(ctx *opCtx) {
+	for subId, c := range s.calendars {
+		sub, ok := s.subscriptions[subId]
+		if !ok {
+			delete(s.calendars, subId)
+			continue
+		}
+		ctx.calendarDays = append(ctx.calendarDays, c.onChange(subId, sub.getActiveRecords())...)
+	}
+}
@@ -0,0 +1,134 @@
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestCalendarDays(t *testing.T) {
+	// days start in the time zone of the account, which differs from the local one
+	loc := time.FixedZone("UTC+14", 14*60*60)
+	day := func(d int) time.Time {
+		return time.Date(2026, time.October, d, 0, 0, 0, 0, loc)
+	}
+	object := func(id string, start, end time.Time) *types.Struct {
+		details := &types.Struct{Fields: map[string]*types.Value{
+			"id":      pbtypes.String(id),
+			"dueDate": pbtypes.Int64(start.Unix()),
+		}}
+		if !end.IsZero() {
+			details.Fields["endDate"] = pbtypes.Int64(end.Unix())
+		}
+		return details
+	}
+
+	c := &calendar{dateKey: "dueDate", endDateKey: "endDate", from: day(2), to: day(11).Add(-time.Second), loc: loc}
+	c.days = c.buckets([]*types.Struct{
+		object("single", day(5).Add(15*time.Hour), time.Time{}),
+		object("span", day(6).Add(9*time.Hour), day(8).Add(18*time.Hour)),
+		object("before", day(1), day(3)),
+		object("after", day(10), day(20)),
+		object("endBeforeStart", day(7), day(6)),
+	})
+	days := c.responseDays()
+
+	var got []string
+	for _, d := range days {
+		for _, id := range d.ObjectIds {
+			got = append(got, time.Unix(d.Date, 0).In(loc).Format("02")+":"+id)
+		}
+	}
+	require.Len(t, days, 7)
+	assert.Equal(t, []string{
+		"02:before", "03:before",
+		"05:single",
+		"06:span",
+		"07:span", "07:endBeforeStart",
+		"08:span",
+		"10:after",
+	}, got)
+}
+
+func TestCalendarSubscription(t *testing.T) {
+	fx := newFixture(t)
+	defer fx.a.Close(context.Background())
+	defer fx.ctrl.Finish()
+
+	loc := time.FixedZone("UTC+14", 14*60*60)
+	day := func(d int) time.Time {
+		return time.Date(2026, time.October, d, 0, 0, 0, 0, loc)
+	}
+	s := fx.Service.(*service)
+	s.location = func() *time.Location { return loc }
+	object := func(id string, date time.Time) *types.Struct {
+		return &types.Struct{Fields: map[string]*types.Value{
+			"id":      pbtypes.String(id),
+			"dueDate": pbtypes.Int64(date.Unix()),
+		}}
+	}
+
+	fx.store.EXPECT().QueryRaw(gomock.Any(), 0, 0).Return([]database.Record{
+		{Details: object("first", day(5).Add(time.Hour))},
+		{Details: object("second", day(6).Add(time.Hour))},
+	}, nil)
+	fx.store.EXPECT().QueryByID(gomock.Any()).Return(nil, nil).AnyTimes()
+	fx.store.EXPECT().GetRelationByKey(gomock.Any()).Return(&model.Relation{Format: model.RelationFormat_date}, nil).AnyTimes()
+
+	resp, err := fx.SubscribeCalendar(pb.RpcObjectCalendarSubscribeRequest{
+		SubId:           "subId",
+		DateRelationKey: "dueDate",
+		From:            day(1).Unix(),
+		To:              day(31).Unix(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Days, 2)
+
+	calendarDays := func() map[int64][]string {
+		days := map[int64][]string{}
+		for _, e := range fx.events {
+			for _, msg := range e.Messages {
+				if d := msg.GetSubscriptionCalendarDay(); d != nil {
+					assert.Equal(t, "subId", d.SubId)
+					days[d.Date] = d.ObjectIds
+				}
+			}
+		}
+		fx.events = nil
+		return days
+	}
+
+	t.Run("object is moved to another day", func(t *testing.T) {
+		s.onChange([]*entry{{id: "first", data: object("first", day(6).Add(2*time.Hour))}})
+		assert.Equal(t, map[int64][]string{
+			day(5).Unix(): nil,
+			day(6).Unix(): {"first", "second"},
+		}, calendarDays())
+	})
+	t.Run("object is added", func(t *testing.T) {
+		s.onChange([]*entry{{id: "third", data: object("third", day(7))}})
+		assert.Equal(t, map[int64][]string{day(7).Unix(): {"third"}}, calendarDays())
+	})
+	t.Run("object leaves the range", func(t *testing.T) {
+		s.onChange([]*entry{{id: "third", data: object("third", day(31).Add(time.Hour))}})
+		assert.Equal(t, map[int64][]string{day(7).Unix(): nil}, calendarDays())
+	})
+	t.Run("not changed days are not sent", func(t *testing.T) {
+		s.onChange([]*entry{{id: "second", data: object("second", day(6).Add(time.Minute))}})
+		assert.Empty(t, calendarDays())
+	})
+	t.Run("days are not tracked after unsubscribe", func(t *testing.T) {
+		require.NoError(t, fx.Unsubscribe("subId"))
+		assert.Empty(t, s.calendars)
+	})
+}
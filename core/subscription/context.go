@@ -41,6 +41,12 @@ type opGroup struct {
 	remove bool
 }
 
+type opCalendarDay struct {
+	subId     string
+	date      int64
+	objectIds []string
+}
+
 type opCtx struct {
 	// subIds for remove
 	remove   []opRemove
@@ -49,6 +55,8 @@ type opCtx struct {
 	counters []opCounter
 	entries  []*entry
 	groups   []opGroup
+	// calendarDays are changed days of calendar subscriptions
+	calendarDays []opCalendarDay
 
 	keysBuf []struct {
 		id     string
@@ -143,6 +151,18 @@ func (ctx *opCtx) apply() (event *pb.Event) {
 		})
 	}
 
+	for _, day := range ctx.calendarDays {
+		subMsgs = append(subMsgs, &pb.EventMessage{
+			Value: &pb.EventMessageValueOfSubscriptionCalendarDay{
+				SubscriptionCalendarDay: &pb.EventObjectSubscriptionCalendarDay{
+					SubId:     day.subId,
+					Date:      day.date,
+					ObjectIds: day.objectIds,
+				},
+			},
+		})
+	}
+
 	return &pb.Event{
 		Messages: append(eventMsgs, subMsgs...),
 	}
@@ -234,4 +254,5 @@ func (ctx *opCtx) reset() {
 	ctx.keysBuf = ctx.keysBuf[:0]
 	ctx.entries = ctx.entries[:0]
 	ctx.groups = ctx.groups[:0]
+	ctx.calendarDays = ctx.calendarDays[:0]
 }
@@ -1,6 +1,8 @@
 package subscription
 
 import (
+	"github.com/gogo/protobuf/types"
+
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
@@ -18,6 +20,10 @@ type dependencyService struct {
 	s *service
 
 	isRelationObjMap map[string]bool
+
+	// pathValues are values of relations of linked objects checked by addLinkingEntries by object id
+	pathValues       map[string]*types.Struct
+	pathPropertyKeys []string
 }
 
 func (ds *dependencyService) makeSubscriptionByEntries(subId string, allEntries, activeEntries []*entry, keys, depKeys, filterDepIds []string) *simpleSub {
@@ -103,6 +109,101 @@ func (ds *dependencyService) depEntriesByEntries(ctx *opCtx, depIds []string) (d
 	return
 }
 
+// addLinkingEntries adds to the context entries of objects that link to the changed entries by one of link keys.
+// Filters and sorts by relations of linked objects depend on linked objects, so linking objects have to be re-evaluated.
+// Objects are found by the inbound links index, so only relations included into links are tracked. Links are looked up
+// only for entries with changed values of relations used by the paths
+func (ds *dependencyService) addLinkingEntries(ctx *opCtx, paths relationPaths) {
+	if !slice.UnsortedEquals(ds.pathPropertyKeys, paths.propertyKeys) {
+		ds.resetPathValues()
+		ds.pathPropertyKeys = paths.propertyKeys
+	}
+	inCtx := make(map[string]struct{}, len(ctx.entries))
+	changed := make(map[string]struct{}, len(ctx.entries))
+	var linkingIds []string
+	for _, e := range ctx.entries {
+		inCtx[e.id] = struct{}{}
+		if !ds.pathValuesChanged(e, paths.propertyKeys) {
+			continue
+		}
+		changed[e.id] = struct{}{}
+		inboundIds, err := ds.s.objectStore.GetInboundLinksByID(e.id)
+		if err != nil {
+			log.Errorf("can't get inbound links of %s: %v", e.id, err)
+			continue
+		}
+		for _, id := range inboundIds {
+			if slice.FindPos(linkingIds, id) == -1 {
+				linkingIds = append(linkingIds, id)
+			}
+		}
+	}
+	var depIds []string
+	for _, id := range linkingIds {
+		if _, ok := inCtx[id]; !ok {
+			depIds = append(depIds, id)
+		}
+	}
+	for _, e := range ds.depEntriesByEntries(&opCtx{}, depIds) {
+		if linksByKeys(e, paths.linkKeys, changed) {
+			ctx.entries = append(ctx.entries, e)
+		}
+	}
+}
+
+// pathValuesChanged remembers values of the relations of the entry and reports whether they differ
+// from the values of the previous check. Entries checked for the first time are reported as changed
+func (ds *dependencyService) pathValuesChanged(e *entry, keys []string) bool {
+	values := pbtypes.StructFilterKeys(e.data, keys)
+	prev, ok := ds.pathValues[e.id]
+	if ds.pathValues == nil {
+		ds.pathValues = map[string]*types.Struct{}
+	}
+	ds.pathValues[e.id] = values
+	return !ok || !prev.Equal(values)
+}
+
+// prunePathValues forgets values of objects which are neither in subscriptions nor linked by subscribed objects
+// by link keys, so values of objects leaving subscriptions are not kept. Forgotten objects are reported as changed
+// on the next check
+func (ds *dependencyService) prunePathValues(linkKeys []string) {
+	if len(ds.pathValues) == 0 {
+		return
+	}
+	linked := make(map[string]struct{}, len(ds.pathValues))
+	for _, e := range ds.s.cache.entries {
+		for _, k := range linkKeys {
+			for _, id := range pbtypes.GetStringList(e.data, k) {
+				linked[id] = struct{}{}
+			}
+		}
+	}
+	for id := range ds.pathValues {
+		if _, ok := linked[id]; ok {
+			continue
+		}
+		if ds.s.cache.Get(id) == nil {
+			delete(ds.pathValues, id)
+		}
+	}
+}
+
+func (ds *dependencyService) resetPathValues() {
+	ds.pathValues = nil
+	ds.pathPropertyKeys = nil
+}
+
+func linksByKeys(e *entry, keys []string, ids map[string]struct{}) bool {
+	for _, k := range keys {
+		for _, id := range pbtypes.GetStringList(e.data, k) {
+			if _, ok := ids[id]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (ds *dependencyService) isRelationObject(key string) bool {
 	if isObj, ok := ds.isRelationObjMap[key]; ok {
 		return isObj
@@ -122,8 +223,8 @@ func (ds *dependencyService) depKeys(keys []string) (depKeys []string) {
 		if key == bundle.RelationKeyId.String() {
 			continue
 		}
-		if key == bundle.RelationKeyLinks.String() {
-			// skip links because it's aggregated from other relations and blocks
+		if key == bundle.RelationKeyLinks.String() || key == bundle.RelationKeyMentions.String() || key == bundle.RelationKeyBlockLinks.String() {
+			// skip links, mentions and block links because they are aggregated from other relations and blocks
 			continue
 		}
 		if ds.isRelationObject(key) {
@@ -0,0 +1,132 @@
+package subscription
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/database/filter"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	timeutil "github.com/anyproto/anytype-heart/util/time"
+)
+
+// relativeDatesCheckInterval is the interval of checking the change of the day. The ticker is used instead of the timer
+// until the midnight, because the monotonic clock stops when the device sleeps
+const relativeDatesCheckInterval = time.Minute
+
+// hasRelativeDates reports whether filters contain dates relative to the current day, e.g. Today or CurrentWeek
+func hasRelativeDates(filters []*model.BlockContentDataviewFilter) (has bool) {
+	filter.Walk(filters, func(f *model.BlockContentDataviewFilter) {
+		if f.QuickOption > model.BlockContentDataviewFilter_ExactDate {
+			has = true
+		}
+	})
+	return
+}
+
+func (s *service) setRelativeDates(req pb.RpcObjectSearchSubscribeRequest) {
+	if hasRelativeDates(req.Filters) {
+		s.relativeDates[req.SubId] = req
+	} else {
+		delete(s.relativeDates, req.SubId)
+	}
+}
+
+// dayStart returns the start of the day of the time in the given time zone
+func dayStart(t time.Time, loc *time.Location) time.Time {
+	calendar := timeutil.NewCalendar(t.In(loc), loc)
+	return calendar.DayNumStart(0)
+}
+
+// relativeDatesLoop refreshes subscriptions with relative dates when the day changes in the time zone of the account.
+// Week and month boundaries are day boundaries too, the change of the time zone is the change of the day
+func (s *service) relativeDatesLoop(day time.Time) {
+	ticker := time.NewTicker(relativeDatesCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closing:
+			return
+		case <-ticker.C:
+			if today := dayStart(s.now(), s.location()); !today.Equal(day) {
+				day = today
+				s.refreshRelativeDates()
+			}
+		}
+	}
+}
+
+func (s *service) refreshRelativeDates() {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for subId, req := range s.relativeDates {
+		sub, ok := s.subscriptions[subId]
+		if !ok {
+			delete(s.relativeDates, subId)
+			continue
+		}
+		if err := s.refreshFilter(sub, req); err != nil {
+			log.Errorf("can't refresh relative dates of subscription %s: %v", subId, err)
+		}
+	}
+}
+
+// refreshFilter rebuilds the filter of the subscription for the current time and sends events about added and removed objects
+func (s *service) refreshFilter(sub subscription, req pb.RpcObjectSearchSubscribeRequest) error {
+	f, err := s.makeFilters(req)
+	if err != nil {
+		return err
+	}
+	var (
+		ssub *sortedSub
+		// candidates are objects which can enter the subscription
+		candidates []*entry
+	)
+	switch v := sub.(type) {
+	case *sortedSub:
+		v.filter = f.FilterObj
+		ssub = v
+		records, err := s.objectStore.QueryRaw(f, 0, 0)
+		if err != nil {
+			return fmt.Errorf("objectStore query error: %w", err)
+		}
+		for _, r := range records {
+			candidates = append(candidates, &entry{id: pbtypes.GetString(r.Details, "id"), data: r.Details})
+		}
+	case *collectionSub:
+		v.sortedSub.filter = filter.AndFilters{v.observer, f.FilterObj}
+		ssub = v.sortedSub
+		// only objects of the collection can enter the subscription, so the store is not queried
+		candidates = v.observer.listEntries()
+	default:
+		return nil
+	}
+
+	// re-evaluate current objects of the subscription and objects matching the new filter
+	s.ctxBuf.reset()
+	var (
+		ids    []string
+		idsSet = map[string]struct{}{}
+	)
+	addId := func(id string) {
+		if _, ok := idsSet[id]; !ok {
+			idsSet[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	for el := ssub.skl.Front(); el != nil; el = el.Next() {
+		addId(el.Key().(*entry).id)
+	}
+	for _, e := range candidates {
+		if _, ok := idsSet[e.id]; !ok && s.cache.Get(e.id) == nil {
+			s.ctxBuf.entries = append(s.ctxBuf.entries, e)
+		}
+		addId(e.id)
+	}
+	s.ds.depEntriesByEntries(s.ctxBuf, ids)
+	sub.onChange(s.ctxBuf)
+	s.calendarsOnChange(s.ctxBuf)
+	s.sendEvent(s.ctxBuf.apply())
+	return nil
+}
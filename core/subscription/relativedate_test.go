@@ -0,0 +1,155 @@
+package subscription
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestHasRelativeDates(t *testing.T) {
+	today := &model.BlockContentDataviewFilter{
+		RelationKey: "dueDate",
+		Condition:   model.BlockContentDataviewFilter_Equal,
+		QuickOption: model.BlockContentDataviewFilter_Today,
+	}
+	exactDate := &model.BlockContentDataviewFilter{
+		RelationKey: "dueDate",
+		Condition:   model.BlockContentDataviewFilter_Equal,
+		QuickOption: model.BlockContentDataviewFilter_ExactDate,
+		Value:       pbtypes.Int64(1),
+	}
+	group := func(filters ...*model.BlockContentDataviewFilter) *model.BlockContentDataviewFilter {
+		return &model.BlockContentDataviewFilter{Operator: model.BlockContentDataviewFilter_Or, NestedFilters: filters}
+	}
+
+	assert.True(t, hasRelativeDates([]*model.BlockContentDataviewFilter{today}))
+	assert.True(t, hasRelativeDates([]*model.BlockContentDataviewFilter{exactDate, group(exactDate, group(today))}))
+	assert.False(t, hasRelativeDates([]*model.BlockContentDataviewFilter{exactDate, group(exactDate, group(exactDate))}))
+	assert.False(t, hasRelativeDates(nil))
+}
+
+func TestRefreshRelativeDates(t *testing.T) {
+	fx := newFixture(t)
+	defer fx.a.Close(context.Background())
+	defer fx.ctrl.Finish()
+
+	// days start in the time zone of the account, objects are due on different days in UTC
+	loc := time.FixedZone("UTC+14", 14*60*60)
+	day := func(d int) time.Time {
+		return time.Date(2026, time.October, d, 0, 0, 0, 0, loc)
+	}
+	now := day(19).Add(12 * time.Hour)
+	s := fx.Service.(*service)
+	s.now = func() time.Time { return now.UTC() }
+	s.location = func() *time.Location { return loc }
+
+	objects := []*types.Struct{
+		{Fields: map[string]*types.Value{"id": pbtypes.String("first"), "dueDate": pbtypes.Int64(day(19).Add(15 * time.Hour).Unix())}},
+		{Fields: map[string]*types.Value{"id": pbtypes.String("second"), "dueDate": pbtypes.Int64(day(20).Add(15 * time.Hour).Unix())}},
+	}
+	fx.store.EXPECT().QueryRaw(gomock.Any(), 0, 0).DoAndReturn(func(f *database.Filters, limit, offset int) ([]database.Record, error) {
+		var records []database.Record
+		for _, details := range objects {
+			if f.FilterObj.FilterObject(pbtypes.ValueGetter(details)) {
+				records = append(records, database.Record{Details: details})
+			}
+		}
+		return records, nil
+	}).AnyTimes()
+	fx.store.EXPECT().QueryByID(gomock.Any()).Return(nil, nil).AnyTimes()
+	fx.store.EXPECT().GetRelationByKey(gomock.Any()).Return(&model.Relation{Format: model.RelationFormat_date}, nil).AnyTimes()
+
+	resp, err := fx.Search(pb.RpcObjectSearchSubscribeRequest{
+		SubId:             "subId",
+		Keys:              []string{bundle.RelationKeyId.String()},
+		NoDepSubscription: true,
+		Filters: []*model.BlockContentDataviewFilter{{
+			RelationKey: "dueDate",
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			QuickOption: model.BlockContentDataviewFilter_Today,
+			Format:      model.RelationFormat_date,
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	assert.Equal(t, "first", pbtypes.GetString(resp.Records[0], "id"))
+
+	now = day(20).Add(time.Hour)
+	s.refreshRelativeDates()
+
+	require.Len(t, fx.events, 1)
+	var added, removed []string
+	for _, msg := range fx.events[0].Messages {
+		if add := msg.GetSubscriptionAdd(); add != nil {
+			added = append(added, add.Id)
+		}
+		if rem := msg.GetSubscriptionRemove(); rem != nil {
+			removed = append(removed, rem.Id)
+		}
+	}
+	assert.Equal(t, []string{"second"}, added)
+	assert.Equal(t, []string{"first"}, removed)
+}
+
+func TestRefreshRelativeDatesCollection(t *testing.T) {
+	fx := newFixture(t)
+	defer fx.a.Close(context.Background())
+	defer fx.ctrl.Finish()
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.Local)
+	s := fx.Service.(*service)
+	s.now = func() time.Time { return now }
+	s.collectionService.(*collectionServiceMock).ids = []string{"first", "second"}
+
+	objects := []database.Record{
+		{Details: &types.Struct{Fields: map[string]*types.Value{"id": pbtypes.String("first"), "dueDate": pbtypes.Int64(now.Unix())}}},
+		{Details: &types.Struct{Fields: map[string]*types.Value{"id": pbtypes.String("second"), "dueDate": pbtypes.Int64(now.AddDate(0, 0, 1).Unix())}}},
+	}
+	// objects of the collection are fetched by ids, the store is not queried by the filter
+	fx.store.EXPECT().QueryByID(gomock.Any()).DoAndReturn(func(ids []string) ([]database.Record, error) {
+		return lo.Filter(objects, func(r database.Record, _ int) bool {
+			return lo.Contains(ids, pbtypes.GetString(r.Details, "id"))
+		}), nil
+	}).AnyTimes()
+	fx.store.EXPECT().GetRelationByKey(gomock.Any()).Return(&model.Relation{Format: model.RelationFormat_date}, nil).AnyTimes()
+
+	resp, err := fx.Search(pb.RpcObjectSearchSubscribeRequest{
+		SubId:             "subId",
+		CollectionId:      "collection",
+		Keys:              []string{bundle.RelationKeyId.String()},
+		NoDepSubscription: true,
+		Filters: []*model.BlockContentDataviewFilter{{
+			RelationKey: "dueDate",
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			QuickOption: model.BlockContentDataviewFilter_Today,
+			Format:      model.RelationFormat_date,
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	assert.Equal(t, "first", pbtypes.GetString(resp.Records[0], "id"))
+
+	now = now.AddDate(0, 0, 1)
+	s.refreshRelativeDates()
+
+	require.Len(t, fx.events, 1)
+	var added []string
+	for _, msg := range fx.events[0].Messages {
+		if add := msg.GetSubscriptionAdd(); add != nil {
+			added = append(added, add.Id)
+		}
+	}
+	assert.Equal(t, []string{"second"}, added)
+}
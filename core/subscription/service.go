@@ -13,6 +13,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/samber/lo"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/event"
 	"github.com/anyproto/anytype-heart/core/kanban"
 	"github.com/anyproto/anytype-heart/pb"
@@ -20,6 +21,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/database/filter"
+	"github.com/anyproto/anytype-heart/pkg/lib/database/query"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
@@ -39,6 +41,8 @@ func New(collectionService CollectionService, sbtProvider typeprovider.SmartBloc
 	return &service{
 		collectionService: collectionService,
 		sbtProvider:       sbtProvider,
+		now:               time.Now,
+		location:          func() *time.Location { return time.Local },
 	}
 }
 
@@ -47,6 +51,8 @@ type Service interface {
 	SubscribeIdsReq(req pb.RpcObjectSubscribeIdsRequest) (resp *pb.RpcObjectSubscribeIdsResponse, err error)
 	SubscribeIds(subId string, ids []string) (records []*types.Struct, err error)
 	SubscribeGroups(req pb.RpcObjectGroupsSubscribeRequest) (*pb.RpcObjectGroupsSubscribeResponse, error)
+	SubscribeCalendar(req pb.RpcObjectCalendarSubscribeRequest) (*pb.RpcObjectCalendarSubscribeResponse, error)
+	SubscribeTimeline(req pb.RpcObjectTimelineSubscribeRequest) (*pb.RpcObjectTimelineSubscribeResponse, error)
 	Unsubscribe(subIds ...string) (err error)
 	UnsubscribeAll() (err error)
 	SubscriptionIDs() []string
@@ -72,13 +78,24 @@ type service struct {
 	cache         *cache
 	ds            *dependencyService
 	subscriptions map[string]subscription
+	// paths are relations of filters and sorts by relations of linked objects by subscription id
+	paths map[string]relationPaths
+	// relativeDates are requests of subscriptions with filters by dates relative to the current day
+	relativeDates map[string]pb.RpcObjectSearchSubscribeRequest
+	// calendars are day buckets of calendar subscriptions by subscription id
+	calendars map[string]*calendar
 	recBatch      *mb.MB
+	closing       chan struct{}
 
 	objectStore       objectstore.ObjectStore
 	kanban            kanban.Service
 	collectionService CollectionService
 	sbtProvider       typeprovider.SmartBlockTypeProvider
 	sendEvent         func(e *pb.Event)
+	// now is the clock of dates relative to the current day
+	now func() time.Time
+	// location is the time zone of the account, days of relative dates and calendars start in it
+	location func() *time.Location
 
 	m      sync.Mutex
 	ctxBuf *opCtx
@@ -88,11 +105,18 @@ func (s *service) Init(a *app.App) (err error) {
 	s.cache = newCache()
 	s.ds = newDependencyService(s)
 	s.subscriptions = make(map[string]subscription)
+	s.paths = make(map[string]relationPaths)
+	s.relativeDates = make(map[string]pb.RpcObjectSearchSubscribeRequest)
+	s.calendars = make(map[string]*calendar)
+	s.closing = make(chan struct{})
 	s.objectStore = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
 	s.kanban = a.MustComponent(kanban.CName).(kanban.Service)
 	s.recBatch = mb.New(0)
 	s.sendEvent = a.MustComponent(event.CName).(event.Sender).Send
 	s.ctxBuf = &opCtx{c: s.cache}
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.location = cfg.Location
+	}
 	return
 }
 
@@ -105,6 +129,7 @@ func (s *service) Run(context.Context) (err error) {
 		s.recBatch.Add(rec)
 	})
 	go s.recordsHandler()
+	go s.relativeDatesLoop(dayStart(s.now(), s.location()))
 	return
 }
 
@@ -113,23 +138,20 @@ func (s *service) Search(req pb.RpcObjectSearchSubscribeRequest) (*pb.RpcObjectS
 		req.SubId = bson.NewObjectId().Hex()
 	}
 
-	q := database.Query{
-		Filters: req.Filters,
-		Sorts:   req.Sorts,
-		Limit:   int(req.Limit),
+	if req.Query != "" {
+		parsed, err := query.Parse(req.Query, s.objectStore)
+		if err != nil {
+			return nil, err
+		}
+		req.Filters = append(append([]*model.BlockContentDataviewFilter{}, req.Filters...), parsed.Filters...)
+		if parsed.FullText != "" {
+			req.Filters = append(req.Filters, query.FullTextFilter(parsed.FullText))
+		}
 	}
 
-	f, err := database.NewFilters(q, nil, s.objectStore)
+	f, err := s.makeFilters(req)
 	if err != nil {
-		return nil, fmt.Errorf("new database filters: %w", err)
-	}
-
-	if len(req.Source) > 0 {
-		sourceFilter, err := s.filtersFromSource(req.Source)
-		if err != nil {
-			return nil, fmt.Errorf("can't make filter from source: %v", err)
-		}
-		f.FilterObj = filter.AndFilters{f.FilterObj, sourceFilter}
+		return nil, err
 	}
 
 	s.m.Lock()
@@ -140,6 +162,8 @@ func (s *service) Search(req pb.RpcObjectSearchSubscribeRequest) (*pb.RpcObjectS
 		delete(s.subscriptions, req.SubId)
 		exists.close()
 	}
+	s.setPaths(req.SubId, req.Filters, req.Sorts)
+	s.setRelativeDates(req)
 	if req.Offset < 0 {
 		req.Offset = 0
 	}
@@ -153,6 +177,29 @@ func (s *service) Search(req pb.RpcObjectSearchSubscribeRequest) (*pb.RpcObjectS
 	return s.subscribeForQuery(req, f, filterDepIds)
 }
 
+func (s *service) makeFilters(req pb.RpcObjectSearchSubscribeRequest) (*database.Filters, error) {
+	q := database.Query{
+		Filters: req.Filters,
+		Sorts:   req.Sorts,
+		Limit:   int(req.Limit),
+		Now:     s.now().In(s.location()),
+	}
+
+	f, err := database.NewFilters(q, nil, s.objectStore)
+	if err != nil {
+		return nil, fmt.Errorf("new database filters: %w", err)
+	}
+
+	if len(req.Source) > 0 {
+		sourceFilter, err := s.filtersFromSource(req.Source)
+		if err != nil {
+			return nil, fmt.Errorf("can't make filter from source: %v", err)
+		}
+		f.FilterObj = filter.AndFilters{f.FilterObj, sourceFilter}
+	}
+	return f, nil
+}
+
 func (s *service) subscribeForQuery(req pb.RpcObjectSearchSubscribeRequest, f *database.Filters, filterDepIds []string) (*pb.RpcObjectSearchSubscribeResponse, error) {
 	sub := s.newSortedSub(req.SubId, req.Keys, f.FilterObj, f.Order, int(req.Limit), int(req.Offset))
 	if req.NoDepSubscription {
@@ -356,6 +403,7 @@ func (s *service) SubscribeGroups(req pb.RpcObjectGroupsSubscribeRequest) (*pb.R
 			return nil, err
 		}
 		s.subscriptions[subId] = sub
+		s.setPaths(subId, req.Filters, nil)
 	} else if colObserver != nil {
 		colObserver.close()
 	}
@@ -378,6 +426,9 @@ func (s *service) Unsubscribe(subIds ...string) (err error) {
 		if sub, ok := s.subscriptions[subId]; ok {
 			sub.close()
 			delete(s.subscriptions, subId)
+			delete(s.paths, subId)
+			delete(s.relativeDates, subId)
+			delete(s.calendars, subId)
 		}
 	}
 	return
@@ -390,6 +441,9 @@ func (s *service) UnsubscribeAll() (err error) {
 		sub.close()
 	}
 	s.subscriptions = make(map[string]subscription)
+	s.paths = make(map[string]relationPaths)
+	s.relativeDates = make(map[string]pb.RpcObjectSearchSubscribeRequest)
+	s.calendars = make(map[string]*calendar)
 	return
 }
 
@@ -445,6 +499,12 @@ func (s *service) onChange(entries []*entry) time.Duration {
 	st := time.Now()
 	s.ctxBuf.reset()
 	s.ctxBuf.entries = entries
+	paths := s.activePaths()
+	if len(paths.linkKeys) > 0 {
+		s.ds.addLinkingEntries(s.ctxBuf, paths)
+	} else {
+		s.ds.resetPathValues()
+	}
 	for _, sub := range s.subscriptions {
 		sub.onChange(s.ctxBuf)
 		subCount++
@@ -452,8 +512,10 @@ func (s *service) onChange(entries []*entry) time.Duration {
 			depCount++
 		}
 	}
+	s.calendarsOnChange(s.ctxBuf)
 	handleTime := time.Since(st)
 	event := s.ctxBuf.apply()
+	s.ds.prunePathValues(paths.linkKeys)
 	dur := time.Since(st)
 
 	log.Debugf("handle %d entries; %v(handle:%v;genEvents:%v); cacheSize: %d; subCount:%d; subDepCount:%d", len(entries), dur, handleTime, dur-handleTime, len(s.cache.entries), subCount, depCount)
@@ -506,14 +568,73 @@ func (s *service) filtersFromSource(sources []string) (filter.Filter, error) {
 }
 
 func (s *service) depIdsFromFilter(filters []*model.BlockContentDataviewFilter) (depIds []string) {
-	for _, f := range filters {
-		if s.ds.isRelationObject(f.RelationKey) {
+	filter.Walk(filters, func(f *model.BlockContentDataviewFilter) {
+		key := f.RelationKey
+		if f.RelationProperty != "" {
+			key = f.RelationProperty
+		}
+		if s.ds.isRelationObject(key) {
 			for _, id := range pbtypes.GetStringListValue(f.Value) {
 				if slice.FindPos(depIds, id) == -1 {
 					depIds = append(depIds, id)
 				}
 			}
 		}
+	})
+	return
+}
+
+// relationPaths are relations of filters and sorts by relations of linked objects
+type relationPaths struct {
+	// linkKeys are relations linking objects
+	linkKeys []string
+	// propertyKeys are relations of linked objects
+	propertyKeys []string
+}
+
+func (p *relationPaths) add(linkKey, propertyKey string) {
+	if slice.FindPos(p.linkKeys, linkKey) == -1 {
+		p.linkKeys = append(p.linkKeys, linkKey)
+	}
+	if slice.FindPos(p.propertyKeys, propertyKey) == -1 {
+		p.propertyKeys = append(p.propertyKeys, propertyKey)
+	}
+}
+
+func (s *service) setPaths(subId string, filters []*model.BlockContentDataviewFilter, sorts []*model.BlockContentDataviewSort) {
+	var paths relationPaths
+	filter.Walk(filters, func(f *model.BlockContentDataviewFilter) {
+		if f.RelationProperty != "" {
+			paths.add(f.RelationKey, f.RelationProperty)
+		}
+	})
+	for _, sort := range sorts {
+		if sort.RelationProperty != "" {
+			paths.add(sort.RelationKey, sort.RelationProperty)
+		}
+	}
+	if len(paths.linkKeys) == 0 {
+		delete(s.paths, subId)
+		return
+	}
+	s.paths[subId] = paths
+}
+
+func (s *service) activePaths() (paths relationPaths) {
+	for subId, subPaths := range s.paths {
+		if _, ok := s.subscriptions[subId]; !ok {
+			continue
+		}
+		for _, key := range subPaths.linkKeys {
+			if slice.FindPos(paths.linkKeys, key) == -1 {
+				paths.linkKeys = append(paths.linkKeys, key)
+			}
+		}
+		for _, key := range subPaths.propertyKeys {
+			if slice.FindPos(paths.propertyKeys, key) == -1 {
+				paths.propertyKeys = append(paths.propertyKeys, key)
+			}
+		}
 	}
 	return
 }
@@ -522,6 +643,7 @@ func (s *service) Close(ctx context.Context) (err error) {
 	s.m.Lock()
 	defer s.m.Unlock()
 	s.recBatch.Close()
+	close(s.closing)
 	for _, sub := range s.subscriptions {
 		sub.close()
 	}
@@ -172,6 +172,87 @@ func TestService_Search(t *testing.T) {
 		assert.Len(t, resp.Dependencies, 2)
 
 	})
+	t.Run("relation path filter", func(t *testing.T) {
+		fx := newFixture(t)
+		defer fx.a.Close(context.Background())
+		defer fx.ctrl.Finish()
+
+		fx.store.EXPECT().QueryRaw(gomock.Any(), 0, 0).Return(
+			[]database.Record{
+				{Details: &types.Struct{Fields: map[string]*types.Value{
+					"id":     pbtypes.String("1"),
+					"name":   pbtypes.String("one"),
+					"author": pbtypes.StringList([]string{"author1"}),
+				}}},
+			},
+			nil,
+		)
+		fx.store.EXPECT().GetRelationByKey(bundle.RelationKeyName.String()).Return(&model.Relation{
+			Key:    bundle.RelationKeyName.String(),
+			Format: model.RelationFormat_shorttext,
+		}, nil).AnyTimes()
+
+		resp, err := fx.Search(pb.RpcObjectSearchSubscribeRequest{
+			SubId:             "subId",
+			Keys:              []string{bundle.RelationKeyName.String()},
+			NoDepSubscription: true,
+			Filters: []*model.BlockContentDataviewFilter{
+				{
+					RelationKey:      bundle.RelationKeyAuthor.String(),
+					RelationProperty: bundle.RelationKeyName.String(),
+					Condition:        model.BlockContentDataviewFilter_Equal,
+					Value:            pbtypes.String("Alice"),
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Records, 1)
+
+		// the first change of the linked author needs the lookup of linking objects
+		fx.store.EXPECT().GetInboundLinksByID("author1").Return([]string{"1"}, nil)
+		fx.store.EXPECT().GetDetails("author1").Return(&model.ObjectDetails{Details: &types.Struct{Fields: map[string]*types.Value{
+			"id":   pbtypes.String("author1"),
+			"name": pbtypes.String("Alice"),
+		}}}, nil)
+		fx.Service.(*service).onChange([]*entry{
+			{id: "author1", data: &types.Struct{Fields: map[string]*types.Value{
+				"id":   pbtypes.String("author1"),
+				"name": pbtypes.String("Alice"),
+			}}},
+		})
+		// changes of other relations of the author don't need the lookup of linking objects
+		fx.Service.(*service).onChange([]*entry{
+			{id: "author1", data: &types.Struct{Fields: map[string]*types.Value{
+				"id":          pbtypes.String("author1"),
+				"name":        pbtypes.String("Alice"),
+				"description": pbtypes.String("writer"),
+			}}},
+		})
+
+		// the linked author is renamed, so the object doesn't match anymore
+		fx.store.EXPECT().GetInboundLinksByID("author1").Return([]string{"1"}, nil)
+		fx.store.EXPECT().GetDetails("author1").Return(&model.ObjectDetails{Details: &types.Struct{Fields: map[string]*types.Value{
+			"id":   pbtypes.String("author1"),
+			"name": pbtypes.String("Bob"),
+		}}}, nil)
+		fx.Service.(*service).onChange([]*entry{
+			{id: "author1", data: &types.Struct{Fields: map[string]*types.Value{
+				"id":   pbtypes.String("author1"),
+				"name": pbtypes.String("Bob"),
+			}}},
+		})
+		assert.Len(t, fx.Service.(*service).cache.entries, 0)
+		require.NotEmpty(t, fx.events)
+		var removed []string
+		for _, msg := range fx.events[len(fx.events)-1].Messages {
+			if rem := msg.GetSubscriptionRemove(); rem != nil {
+				removed = append(removed, rem.Id)
+			}
+		}
+		assert.Equal(t, []string{"1"}, removed)
+		// the author isn't linked by subscribed objects anymore, so its values are not kept
+		assert.Empty(t, fx.Service.(*service).ds.pathValues)
+	})
 	t.Run("add with limit", func(t *testing.T) {
 		fx := newFixture(t)
 		defer fx.a.Close(context.Background())
@@ -304,10 +385,11 @@ func TestService_Search(t *testing.T) {
 
 type collectionServiceMock struct {
 	updateCh chan []string
+	ids      []string
 }
 
 func (c *collectionServiceMock) SubscribeForCollection(collectionID string, subscriptionID string) ([]string, <-chan []string, error) {
-	return nil, c.updateCh, nil
+	return c.ids, c.updateCh, nil
 }
 
 func (c *collectionServiceMock) UnsubscribeFromCollection(collectionID string, subscriptionID string) {
@@ -197,6 +197,7 @@ func (s *sortedSub) onEntryChange(ctx *opCtx, e *entry) (noChange bool) {
 	// remove
 	if curInSet && !newInSet {
 		s.skl.Remove(curr)
+		filter.ForgetLinked(s.order, e.id)
 		e.RemoveSubId(s.id)
 		return
 	}
@@ -208,7 +209,9 @@ func (s *sortedSub) onEntryChange(ctx *opCtx, e *entry) (noChange bool) {
 	}
 	// change
 	if curInSet && newInSet {
+		// the current entry is found by the linked objects it was sorted with
 		s.skl.Remove(curr)
+		filter.ForgetLinked(s.order, e.id)
 		s.skl.Set(e, nil)
 		e.SetSub(s.id, false, false)
 		return
@@ -0,0 +1,74 @@
+package subscription
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// timelineMaxDays limits the window of the timeline subscription
+const timelineMaxDays = 5 * 366
+
+// SubscribeTimeline subscribes for objects which spans overlap the time window
+func (s *service) SubscribeTimeline(req pb.RpcObjectTimelineSubscribeRequest) (*pb.RpcObjectTimelineSubscribeResponse, error) {
+	if err := validateRange(req.StartRelationKey, req.From, req.To, timelineMaxDays); err != nil {
+		return nil, err
+	}
+
+	keys := append([]string{bundle.RelationKeyId.String(), req.StartRelationKey}, req.Keys...)
+	for _, key := range []string{req.EndRelationKey, req.DependencyRelationKey} {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	resp, err := s.Search(pb.RpcObjectSearchSubscribeRequest{
+		SubId:        req.SubId,
+		Filters:      append(rangeFilters(req.StartRelationKey, req.EndRelationKey, req.From, req.To), req.Filters...),
+		Sorts:        req.Sorts,
+		Source:       req.Source,
+		CollectionId: req.CollectionId,
+		Keys:         lo.Uniq(keys),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RpcObjectTimelineSubscribeResponse{
+		Items:        timelineItems(resp.Records, req.StartRelationKey, req.EndRelationKey, req.DependencyRelationKey),
+		Records:      resp.Records,
+		Dependencies: resp.Dependencies,
+		SubId:        resp.SubId,
+	}, nil
+}
+
+// timelineItems makes items of records, dependencies are limited to items of the window
+func timelineItems(records []*types.Struct, startKey, endKey, dependencyKey string) []*pb.RpcObjectTimelineSubscribeResponseItem {
+	ids := make(map[string]struct{}, len(records))
+	for _, rec := range records {
+		ids[pbtypes.GetString(rec, bundle.RelationKeyId.String())] = struct{}{}
+	}
+	items := make([]*pb.RpcObjectTimelineSubscribeResponseItem, 0, len(records))
+	for _, rec := range records {
+		item := &pb.RpcObjectTimelineSubscribeResponseItem{
+			Id:    pbtypes.GetString(rec, bundle.RelationKeyId.String()),
+			Start: pbtypes.GetInt64(rec, startKey),
+		}
+		item.End = item.Start
+		if endKey != "" {
+			if end := pbtypes.GetInt64(rec, endKey); end > item.Start {
+				item.End = end
+			}
+		}
+		if dependencyKey != "" {
+			for _, id := range pbtypes.GetStringList(rec, dependencyKey) {
+				if _, ok := ids[id]; ok {
+					item.DependsOn = append(item.DependsOn, id)
+				}
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,38 @@
+package subscription
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestTimelineItems(t *testing.T) {
+	items := timelineItems([]*types.Struct{
+		{Fields: map[string]*types.Value{
+			"id":        pbtypes.String("design"),
+			"startDate": pbtypes.Int64(100),
+			"endDate":   pbtypes.Int64(200),
+		}},
+		{Fields: map[string]*types.Value{
+			"id":        pbtypes.String("build"),
+			"startDate": pbtypes.Int64(200),
+			"endDate":   pbtypes.Int64(400),
+			"dependsOn": pbtypes.StringList([]string{"design", "outOfWindow"}),
+		}},
+		{Fields: map[string]*types.Value{
+			"id":        pbtypes.String("release"),
+			"startDate": pbtypes.Int64(400),
+			"dependsOn": pbtypes.StringList([]string{"build"}),
+		}},
+	}, "startDate", "endDate", "dependsOn")
+
+	assert.Equal(t, []*pb.RpcObjectTimelineSubscribeResponseItem{
+		{Id: "design", Start: 100, End: 200},
+		{Id: "build", Start: 200, End: 400, DependsOn: []string{"design"}},
+		{Id: "release", Start: 400, End: 400, DependsOn: []string{"build"}},
+	}, items)
+}
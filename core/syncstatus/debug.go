@@ -10,6 +10,8 @@ import (
 
 func (s *service) DebugRouter(r chi.Router) {
 	r.Get("/file_watchers", debug.JSONHandler(s.listFileWatchers))
+	r.Get("/sync_report", debug.JSONHandler(s.debugSyncReport))
+	r.Get("/sync_report/{spaceId}", debug.JSONHandler(s.debugSyncReport))
 }
 
 type fileWatcherDebugInfo struct {
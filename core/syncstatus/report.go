@@ -0,0 +1,62 @@
+package syncstatus
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/anyproto/anytype-heart/core/filestorage/filesync"
+	"github.com/anyproto/anytype-heart/space/syncstats"
+)
+
+// Reporter builds the sync report of the space
+type Reporter interface {
+	SpaceSyncReport(spaceId string) (*SpaceSyncReport, error)
+}
+
+var _ Reporter = (*service)(nil)
+
+type FileQueue struct {
+	Uploading int
+	Discarded int
+	Removing  int
+}
+
+type SpaceSyncReport struct {
+	SpaceId string
+	syncstats.Report
+	FileQueue FileQueue
+}
+
+// SpaceSyncReport returns the report of the space, the account space is used when spaceId is empty
+func (s *service) SpaceSyncReport(spaceId string) (*SpaceSyncReport, error) {
+	if spaceId == "" {
+		spaceId = s.spaceService.AccountId()
+	}
+	queue, err := s.fileSync.DebugQueue(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SpaceSyncReport{
+		SpaceId: spaceId,
+		Report:  s.syncStats.Space(spaceId).Report(),
+		FileQueue: FileQueue{
+			Uploading: countSpaceItems(queue.UploadingQueue, spaceId),
+			Discarded: countSpaceItems(queue.DiscardedQueue, spaceId),
+			Removing:  countSpaceItems(queue.RemovingQueue, spaceId),
+		},
+	}, nil
+}
+
+func countSpaceItems(items []*filesync.QueueItem, spaceId string) (n int) {
+	for _, it := range items {
+		if it.SpaceID == spaceId {
+			n++
+		}
+	}
+	return
+}
+
+func (s *service) debugSyncReport(req *http.Request) (*SpaceSyncReport, error) {
+	return s.SpaceSyncReport(chi.URLParam(req, "spaceId"))
+}
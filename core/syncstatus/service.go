@@ -19,6 +19,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/filestore"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/space"
+	"github.com/anyproto/anytype-heart/space/syncstats"
 	"github.com/anyproto/anytype-heart/space/typeprovider"
 )
 
@@ -40,6 +41,8 @@ type service struct {
 	spaceService space.Service
 
 	coreService core.Service
+	fileSync    filesync.FileSync
+	syncStats   syncstats.Collector
 
 	fileWatcher        *fileWatcher
 	objectWatcher      *objectWatcher
@@ -71,6 +74,7 @@ func New(
 		spaceService:       spaceService,
 		typeProvider:       typeProvider,
 		coreService:        coreService,
+		fileSync:           fileSyncService,
 		fileWatcher:        fileWatcher,
 		objectWatcher:      objectWatcher,
 		subObjectsWatcher:  subObjectsWatcher,
@@ -80,6 +84,7 @@ func New(
 }
 
 func (s *service) Init(a *app.App) (err error) {
+	s.syncStats = a.MustComponent(syncstats.CName).(syncstats.Collector)
 	return s.fileWatcher.init()
 }
 
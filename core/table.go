@@ -2,8 +2,10 @@ package core
 
 import (
 	"context"
+	"errors"
 
 	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
 	"github.com/anyproto/anytype-heart/pb"
 )
 
@@ -314,3 +316,46 @@ func (mw *Middleware) BlockTableRowSetHeader(cctx context.Context, req *pb.RpcBl
 	}
 	return response(pb.RpcBlockTableRowSetHeaderResponseError_NULL, id, nil)
 }
+
+func (mw *Middleware) BlockTableColumnSetType(cctx context.Context, req *pb.RpcBlockTableColumnSetTypeRequest) *pb.RpcBlockTableColumnSetTypeResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcBlockTableColumnSetTypeResponseErrorCode, err error) *pb.RpcBlockTableColumnSetTypeResponse {
+		m := &pb.RpcBlockTableColumnSetTypeResponse{Error: &pb.RpcBlockTableColumnSetTypeResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		return bs.TableColumnSetType(ctx, *req)
+	})
+	if err != nil {
+		return response(pb.RpcBlockTableColumnSetTypeResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcBlockTableColumnSetTypeResponseError_NULL, nil)
+}
+
+func (mw *Middleware) BlockTableCellSetFormula(cctx context.Context, req *pb.RpcBlockTableCellSetFormulaRequest) *pb.RpcBlockTableCellSetFormulaResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcBlockTableCellSetFormulaResponseErrorCode, err error) *pb.RpcBlockTableCellSetFormulaResponse {
+		m := &pb.RpcBlockTableCellSetFormulaResponse{Error: &pb.RpcBlockTableCellSetFormulaResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = ctx.GetResponseEvent()
+		}
+		return m
+	}
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		return bs.TableCellSetFormula(ctx, *req)
+	})
+	if errors.Is(err, table.ErrFormulaSyntax) {
+		return response(pb.RpcBlockTableCellSetFormulaResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcBlockTableCellSetFormulaResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcBlockTableCellSetFormulaResponseError_NULL, nil)
+}
@@ -42,6 +42,8 @@ type Change struct {
 	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	// version of business logic
 	Version uint32 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// peer id of the device the change is made on
+	DeviceId string `protobuf:"bytes,9,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
 }
 
 func (m *Change) Reset()         { *m = Change{} }
@@ -133,6 +135,13 @@ func (m *Change) GetVersion() uint32 {
 	return 0
 }
 
+func (m *Change) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
 type ChangeSnapshot struct {
 	// logId -> lastChangeId
 	LogHeads map[string]string `protobuf:"bytes,1,rep,name=logHeads,proto3" json:"logHeads,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -1773,92 +1782,93 @@ func init() {
 func init() { proto.RegisterFile("pb/protos/changes.proto", fileDescriptor_2b02bba284ea1e46) }
 
 var fileDescriptor_2b02bba284ea1e46 = []byte{
-	// 1359 bytes of a gzipped FileDescriptorProto
+	// 1370 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x16, 0x25, 0x59, 0x1f, 0x43, 0xdb, 0x71, 0x16, 0x41, 0xc2, 0x97, 0x71, 0x14, 0x25, 0x71,
-	0xde, 0x0a, 0x6d, 0x40, 0x21, 0x4a, 0xd1, 0x7c, 0x16, 0x45, 0xe4, 0x24, 0x55, 0x90, 0xb8, 0x0e,
-	0x56, 0x4d, 0x0f, 0xbd, 0x18, 0x2b, 0x71, 0x2d, 0x33, 0xa6, 0x48, 0x82, 0x5c, 0x19, 0xd0, 0xaf,
-	0x68, 0xd1, 0xbf, 0xd4, 0x4b, 0x8f, 0xb9, 0xb5, 0xc7, 0x22, 0xb9, 0xf7, 0x17, 0x14, 0x68, 0xb1,
-	0xcb, 0x25, 0xb9, 0x64, 0xa8, 0xb8, 0x3e, 0xb4, 0x17, 0x5b, 0xb3, 0xfb, 0x3c, 0xcf, 0xce, 0xcc,
-	0x0e, 0x67, 0x16, 0x2e, 0x05, 0x93, 0x7e, 0x10, 0xfa, 0xcc, 0x8f, 0xfa, 0xd3, 0x23, 0xe2, 0xcd,
-	0x68, 0x64, 0x09, 0x13, 0x35, 0x89, 0xb7, 0x64, 0xcb, 0x80, 0x9a, 0x3b, 0xc1, 0xf1, 0xac, 0xef,
-	0x3a, 0x93, 0x7e, 0x30, 0xe9, 0xcf, 0x7d, 0x9b, 0xba, 0x09, 0x5e, 0x18, 0x12, 0x6e, 0x5e, 0xcc,
-	0x74, 0xe8, 0x09, 0xf5, 0x58, 0xb2, 0xbe, 0x3d, 0xf3, 0xfd, 0x99, 0x4b, 0xe3, 0xbd, 0xc9, 0xe2,
-	0xb0, 0x1f, 0xb1, 0x70, 0x31, 0x65, 0xf1, 0xee, 0xf5, 0xbf, 0xae, 0x40, 0x63, 0x57, 0x1c, 0x8b,
-	0xae, 0xc1, 0x7a, 0x10, 0xd2, 0x13, 0xc7, 0x5f, 0x44, 0x07, 0x8e, 0x1d, 0x19, 0x5a, 0xb7, 0xd6,
-	0x6b, 0x63, 0x3d, 0x59, 0x7b, 0x6e, 0x47, 0xa8, 0x07, 0x5b, 0x2e, 0x89, 0xd8, 0x41, 0xe4, 0x91,
-	0x20, 0x3a, 0xf2, 0xd9, 0x81, 0x63, 0x1b, 0xd5, 0xae, 0xd6, 0x6b, 0xe3, 0x4d, 0xbe, 0x3e, 0x96,
-	0xcb, 0xcf, 0x6d, 0xf4, 0x29, 0x9c, 0x4f, 0xc5, 0xe6, 0x94, 0x11, 0xa1, 0xb8, 0x26, 0x14, 0xcf,
-	0x25, 0x1b, 0x7b, 0x94, 0x11, 0xae, 0x7a, 0x1b, 0x9a, 0x53, 0xdf, 0x63, 0xd4, 0x63, 0x46, 0xad,
-	0x5b, 0xeb, 0xe9, 0x83, 0x4b, 0x96, 0x0c, 0xdd, 0x8a, 0x5d, 0xb3, 0x76, 0xe3, 0x6d, 0x9c, 0xe0,
-	0xd0, 0xe7, 0xd0, 0x4a, 0x7c, 0x30, 0xea, 0x5d, 0xad, 0xa7, 0x0f, 0x8c, 0x22, 0x27, 0x71, 0x06,
-	0xa7, 0x48, 0xce, 0x3a, 0x74, 0x5c, 0xfa, 0x82, 0x2e, 0x23, 0xa3, 0x21, 0x4e, 0xfa, 0x80, 0xf5,
-	0x4c, 0xee, 0xe3, 0x14, 0x89, 0xb6, 0xa1, 0xcd, 0x9c, 0x39, 0x8d, 0x18, 0x99, 0x07, 0x46, 0xb3,
-	0xab, 0xf5, 0x6a, 0x38, 0x5b, 0x40, 0x06, 0x34, 0x4f, 0x68, 0x18, 0x39, 0xbe, 0x67, 0xb4, 0xba,
-	0x5a, 0x6f, 0x03, 0x27, 0xa6, 0xf9, 0xa7, 0x06, 0xad, 0xc4, 0x09, 0x34, 0x84, 0x96, 0xeb, 0xcf,
-	0x46, 0x94, 0xc8, 0xc4, 0xea, 0x83, 0xff, 0xaf, 0x72, 0xd8, 0x7a, 0x29, 0x81, 0x4f, 0x3d, 0x16,
-	0x2e, 0x71, 0xca, 0x43, 0xf7, 0xa1, 0x6e, 0x13, 0x46, 0x44, 0xc6, 0xf5, 0xc1, 0xcd, 0x94, 0x2f,
-	0xca, 0xc0, 0x1a, 0xcf, 0x49, 0xc8, 0x86, 0xae, 0x3f, 0x3d, 0x4e, 0x84, 0x86, 0x24, 0xa2, 0x58,
-	0x50, 0x72, 0x91, 0xd7, 0xfe, 0x69, 0xe4, 0xe6, 0x43, 0xd8, 0xc8, 0xf9, 0x82, 0xb6, 0xa0, 0x76,
-	0x4c, 0x97, 0x86, 0x26, 0xae, 0x9c, 0xff, 0x44, 0x17, 0x60, 0xed, 0x84, 0xb8, 0x0b, 0x2a, 0xcb,
-	0x20, 0x36, 0x1e, 0x54, 0xef, 0x69, 0xe6, 0x0f, 0x1a, 0xb4, 0x12, 0x4d, 0x84, 0xa0, 0x7e, 0x44,
-	0xa2, 0x23, 0xc9, 0x14, 0xbf, 0xd1, 0x17, 0x50, 0x3f, 0xe6, 0xfe, 0x54, 0x85, 0x3f, 0xd7, 0x57,
-	0xf9, 0x63, 0xf1, 0x3f, 0x71, 0x2a, 0x04, 0xde, 0xbc, 0x0b, 0xed, 0x74, 0xe9, 0x4c, 0x1e, 0xfd,
-	0xdc, 0x86, 0xa6, 0xac, 0x24, 0xf4, 0x15, 0xe8, 0x13, 0x9e, 0xab, 0xdd, 0x90, 0x12, 0x46, 0x05,
-	0x5f, 0x1f, 0x5c, 0x2e, 0xfa, 0x30, 0xcc, 0x20, 0xa3, 0x0a, 0x56, 0x19, 0xa9, 0xc0, 0xeb, 0xc0,
-	0xe6, 0x02, 0xd5, 0x8f, 0x08, 0xc4, 0x90, 0x54, 0x20, 0x36, 0x53, 0x01, 0x4c, 0xe7, 0xfe, 0x09,
-	0x35, 0x6a, 0x1f, 0x11, 0x88, 0x21, 0xa9, 0x40, 0x6c, 0xa2, 0xfb, 0xd0, 0x16, 0xe6, 0x1e, 0xa7,
-	0xc7, 0x1f, 0xc1, 0xff, 0x4a, 0xe9, 0x7b, 0x31, 0x39, 0x43, 0xa3, 0x11, 0x6c, 0x0a, 0xe3, 0xc9,
-	0x22, 0x70, 0x9d, 0x29, 0xf7, 0x7f, 0x4d, 0xf0, 0x3b, 0xa5, 0xfc, 0x14, 0x35, 0xaa, 0xe0, 0x02,
-	0x8f, 0x47, 0x11, 0x52, 0x97, 0x30, 0xc7, 0xf7, 0x1e, 0xdb, 0xb6, 0x31, 0x28, 0x8f, 0x02, 0x67,
-	0x10, 0x1e, 0x85, 0xc2, 0xe0, 0xae, 0x24, 0xa6, 0xcc, 0xc4, 0x9d, 0x72, 0x57, 0x70, 0x0e, 0xc5,
-	0x5d, 0xc9, 0xf3, 0xd0, 0x23, 0x00, 0x9b, 0x32, 0xe2, 0xb8, 0xd1, 0x98, 0x32, 0xc3, 0x16, 0x2a,
-	0x66, 0x51, 0xe5, 0x49, 0x8a, 0x18, 0x55, 0xb0, 0x82, 0x47, 0x43, 0x58, 0x97, 0xd6, 0x6b, 0x2f,
-	0xa2, 0xcc, 0xa0, 0x82, 0xbf, 0xbd, 0x82, 0x2f, 0x30, 0xa3, 0x0a, 0xce, 0x71, 0xd0, 0xd7, 0x70,
-	0xce, 0x77, 0xed, 0x03, 0x35, 0x21, 0x87, 0xe5, 0x32, 0x07, 0xf9, 0x8c, 0x6c, 0xfa, 0xae, 0xad,
-	0xac, 0xa0, 0x57, 0x80, 0x54, 0x21, 0x99, 0x98, 0x99, 0xd0, 0xba, 0xba, 0x52, 0x2b, 0xcd, 0xcc,
-	0x79, 0x45, 0x4e, 0x26, 0xa7, 0xa0, 0x28, 0xab, 0xf6, 0xe8, 0x14, 0xc5, 0xb4, 0x72, 0x55, 0x45,
-	0x59, 0xbf, 0x4f, 0x61, 0xc3, 0x9f, 0xbc, 0xa1, 0x53, 0xf6, 0xed, 0x32, 0xa0, 0x3c, 0x54, 0x47,
-	0x88, 0x5d, 0x29, 0x8a, 0xed, 0xab, 0xa0, 0x51, 0x05, 0xe7, 0x59, 0xe8, 0x1b, 0xd8, 0xca, 0x16,
-	0x64, 0xa0, 0x6f, 0x84, 0x52, 0x77, 0xb5, 0x52, 0x1a, 0xe9, 0x07, 0x5c, 0x5e, 0x90, 0x11, 0xf3,
-	0x43, 0xde, 0x3a, 0x78, 0x19, 0x1c, 0x97, 0x17, 0xe4, 0x38, 0x83, 0xf0, 0x82, 0x54, 0x18, 0x3c,
-	0xae, 0xc4, 0x8c, 0x2b, 0xc1, 0x2d, 0x8f, 0x6b, 0xac, 0x82, 0x78, 0x5c, 0x39, 0x16, 0x8f, 0x4b,
-	0x2c, 0x8c, 0x5d, 0x67, 0x4a, 0x65, 0xba, 0xe7, 0xe5, 0x71, 0x8d, 0x0b, 0x38, 0x1e, 0x57, 0x91,
-	0x3b, 0x6c, 0xca, 0xb6, 0x66, 0xfe, 0xa4, 0x81, 0xae, 0xf4, 0x25, 0x64, 0x42, 0x8b, 0x91, 0x70,
-	0x46, 0xd9, 0x73, 0x5b, 0xb6, 0xc1, 0xd4, 0x46, 0xf7, 0xa1, 0x15, 0xf8, 0x91, 0xc3, 0x6f, 0x4d,
-	0x74, 0xa8, 0x4d, 0x25, 0x8c, 0x78, 0x6a, 0x08, 0x25, 0xeb, 0x95, 0x04, 0xe1, 0x14, 0x8e, 0x6e,
-	0x41, 0x43, 0x7c, 0xea, 0xc9, 0xbc, 0xb8, 0x50, 0x46, 0xc4, 0x12, 0x63, 0x7e, 0x29, 0x7d, 0x92,
-	0xb5, 0x61, 0x41, 0x23, 0x7e, 0x83, 0xc8, 0xe6, 0x7e, 0x31, 0x25, 0x3f, 0xe5, 0xcb, 0xd6, 0x1e,
-	0x8d, 0x22, 0x32, 0xa3, 0x58, 0xa2, 0xcc, 0xab, 0x92, 0x2e, 0xef, 0x70, 0x0b, 0x6a, 0xd9, 0x03,
-	0x84, 0xff, 0x34, 0x19, 0xb4, 0xd3, 0x56, 0xf6, 0x6f, 0x45, 0x2c, 0x4f, 0xad, 0x65, 0xa7, 0x2e,
-	0x61, 0x33, 0xdf, 0x00, 0xff, 0xbb, 0xa3, 0x5f, 0x02, 0x64, 0xad, 0xaa, 0x64, 0xca, 0xdd, 0x52,
-	0xa7, 0x1c, 0x4f, 0x70, 0xfc, 0xca, 0xb3, 0x92, 0x57, 0x9e, 0xf5, 0x1d, 0xdf, 0x95, 0xd3, 0xcf,
-	0xec, 0xc2, 0xba, 0xda, 0xb8, 0x3e, 0xd4, 0x33, 0x5f, 0x81, 0xae, 0x36, 0xa0, 0xc7, 0xb0, 0x91,
-	0xb4, 0x8a, 0x97, 0x8e, 0x77, 0x9c, 0xbc, 0x59, 0x2e, 0x17, 0x02, 0xc2, 0x0a, 0x06, 0xe7, 0x19,
-	0xe6, 0x00, 0x36, 0x0b, 0x3d, 0xa8, 0x9b, 0xcd, 0x8a, 0x17, 0xe2, 0x74, 0xf1, 0xbe, 0x54, 0x96,
-	0xcc, 0x5d, 0x58, 0x57, 0x3b, 0x23, 0xba, 0x03, 0xad, 0x64, 0x5b, 0x06, 0x7a, 0x69, 0x85, 0x07,
-	0x38, 0x05, 0x9a, 0xbf, 0xd6, 0xe0, 0x5c, 0xa1, 0x83, 0x95, 0x24, 0xf0, 0x2e, 0x34, 0x0e, 0xfd,
-	0x70, 0x4e, 0xd8, 0x8a, 0xbb, 0x4a, 0x04, 0x9e, 0x09, 0xd0, 0xa8, 0x82, 0x25, 0x1c, 0x5d, 0x80,
-	0xba, 0x47, 0xe6, 0xf1, 0xc0, 0x6e, 0x8f, 0x2a, 0x58, 0x58, 0xe8, 0x11, 0x1f, 0x1f, 0x87, 0x64,
-	0xe1, 0x32, 0x91, 0x78, 0x39, 0x8f, 0x57, 0x5c, 0x4b, 0x3c, 0x38, 0x32, 0x34, 0xda, 0x07, 0x3d,
-	0x6b, 0x64, 0x91, 0x1c, 0xc6, 0x9f, 0x9d, 0xd2, 0x96, 0x95, 0x7e, 0x18, 0xf1, 0x26, 0xa6, 0x28,
-	0xa0, 0x8b, 0xb0, 0x36, 0x5f, 0xb8, 0xcc, 0x31, 0x1a, 0x5d, 0xad, 0xd7, 0x1a, 0x55, 0x70, 0x6c,
-	0xa2, 0x67, 0x00, 0x11, 0x75, 0xe9, 0x94, 0x3d, 0x71, 0xa6, 0x4c, 0x3c, 0x66, 0xf5, 0xc1, 0xce,
-	0x69, 0xe7, 0x70, 0x2c, 0x9f, 0x96, 0x19, 0xd3, 0x7c, 0x00, 0x75, 0xfe, 0x1f, 0x0d, 0xa0, 0x6e,
-	0x73, 0xa5, 0xf8, 0x33, 0xef, 0xac, 0xc8, 0xa1, 0xb5, 0x1f, 0x88, 0x3b, 0x12, 0x58, 0xb3, 0x0f,
-	0xba, 0xe2, 0x39, 0xaf, 0x0a, 0x35, 0x76, 0x59, 0x15, 0xca, 0x52, 0xd6, 0xfa, 0x6e, 0x28, 0x17,
-	0x9b, 0xb5, 0x8a, 0x42, 0x25, 0x5f, 0x83, 0x8d, 0xdc, 0xc8, 0xe1, 0x90, 0x45, 0xe8, 0x26, 0x90,
-	0x45, 0xe8, 0x9a, 0x3b, 0xb0, 0x55, 0x9c, 0x25, 0x25, 0xa8, 0x7d, 0xd0, 0x95, 0x31, 0xc1, 0x9f,
-	0xb0, 0x01, 0x61, 0x47, 0xd2, 0x41, 0xf1, 0xfb, 0x8c, 0x5f, 0xe1, 0x0d, 0xd8, 0xc8, 0x0d, 0x8d,
-	0x32, 0x49, 0xf3, 0x8f, 0x2a, 0x6c, 0x15, 0x07, 0x42, 0x49, 0xf9, 0xde, 0x83, 0x1a, 0xb1, 0x6d,
-	0x79, 0xee, 0xce, 0x69, 0x13, 0xc5, 0x8a, 0x47, 0x2f, 0xa7, 0xa0, 0xc7, 0xd0, 0x08, 0xd5, 0x27,
-	0xe7, 0x27, 0xa7, 0x92, 0xd3, 0x69, 0x2b, 0x89, 0xe8, 0x21, 0xd4, 0xe7, 0xd9, 0xa3, 0xf3, 0xe6,
-	0xa9, 0x02, 0xf2, 0x01, 0x2a, 0x48, 0xe6, 0x6d, 0xa8, 0xf1, 0x5b, 0x31, 0xa0, 0x49, 0x0e, 0x19,
-	0x0d, 0xd3, 0x46, 0x9a, 0x98, 0x49, 0x33, 0xac, 0x66, 0xcd, 0xd0, 0x84, 0xc6, 0xca, 0xc9, 0x30,
-	0x80, 0xba, 0x18, 0x0a, 0x67, 0xd0, 0x1b, 0xea, 0xd0, 0xf6, 0x03, 0x1a, 0x8a, 0x3a, 0x1a, 0x6e,
-	0xff, 0xf2, 0xae, 0xa3, 0xbd, 0x7d, 0xd7, 0xd1, 0x7e, 0x7f, 0xd7, 0xd1, 0x7e, 0x7c, 0xdf, 0xa9,
-	0xbc, 0x7d, 0xdf, 0xa9, 0xfc, 0xf6, 0xbe, 0x53, 0xf9, 0xbe, 0x1a, 0x4c, 0x26, 0x0d, 0x71, 0x95,
-	0x77, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x01, 0xe9, 0x68, 0x3d, 0xa6, 0x0f, 0x00, 0x00,
+	0x13, 0x16, 0x45, 0x59, 0x1f, 0x43, 0xdb, 0x71, 0x16, 0x41, 0xc2, 0x97, 0x71, 0x14, 0xe5, 0xeb,
+	0xad, 0xd0, 0x06, 0x14, 0xa2, 0x14, 0xcd, 0x67, 0x51, 0x44, 0x4e, 0x52, 0x19, 0x89, 0xeb, 0x60,
+	0xd5, 0xf4, 0xd0, 0x8b, 0xb1, 0x12, 0xd7, 0x32, 0x63, 0x4a, 0x24, 0xc8, 0x95, 0x01, 0xfd, 0x8a,
+	0x16, 0xbd, 0xf6, 0xe7, 0xf4, 0xd2, 0x63, 0x6e, 0xed, 0xb1, 0x48, 0xee, 0xfd, 0x05, 0x3d, 0x14,
+	0xbb, 0x5c, 0x92, 0x4b, 0x86, 0x8a, 0xeb, 0x43, 0x7b, 0xb1, 0x35, 0xbb, 0xcf, 0xf3, 0xec, 0xcc,
+	0xec, 0x70, 0x66, 0xe1, 0x52, 0x30, 0xee, 0x05, 0xa1, 0xcf, 0xfc, 0xa8, 0x37, 0x39, 0x22, 0xf3,
+	0x29, 0x8d, 0x6c, 0x61, 0xa2, 0x06, 0x99, 0x2f, 0xd9, 0x32, 0xa0, 0xd6, 0xcd, 0xe0, 0x78, 0xda,
+	0xf3, 0xdc, 0x71, 0x2f, 0x18, 0xf7, 0x66, 0xbe, 0x43, 0xbd, 0x04, 0x2f, 0x0c, 0x09, 0xb7, 0x2e,
+	0x66, 0x3a, 0xf4, 0x84, 0xce, 0x59, 0xb2, 0xbe, 0x3d, 0xf5, 0xfd, 0xa9, 0x47, 0xe3, 0xbd, 0xf1,
+	0xe2, 0xb0, 0x17, 0xb1, 0x70, 0x31, 0x61, 0xf1, 0xee, 0xf5, 0x9f, 0xdb, 0x50, 0xdf, 0x11, 0xc7,
+	0xa2, 0x6b, 0xb0, 0x1e, 0x84, 0xf4, 0xc4, 0xf5, 0x17, 0xd1, 0x81, 0xeb, 0x44, 0xa6, 0xd6, 0xd1,
+	0xbb, 0x2d, 0x6c, 0x24, 0x6b, 0xbb, 0x4e, 0x84, 0xba, 0xb0, 0xe5, 0x91, 0x88, 0x1d, 0x44, 0x73,
+	0x12, 0x44, 0x47, 0x3e, 0x3b, 0x70, 0x1d, 0xb3, 0xda, 0xd1, 0xba, 0x2d, 0xbc, 0xc9, 0xd7, 0x47,
+	0x72, 0x79, 0xd7, 0x41, 0x9f, 0xc2, 0xf9, 0x54, 0x6c, 0x46, 0x19, 0x11, 0x8a, 0x6b, 0x42, 0xf1,
+	0x5c, 0xb2, 0xb1, 0x47, 0x19, 0xe1, 0xaa, 0x77, 0xa0, 0x31, 0xf1, 0xe7, 0x8c, 0xce, 0x99, 0xa9,
+	0x77, 0xf4, 0xae, 0xd1, 0xbf, 0x64, 0xcb, 0xd0, 0xed, 0xd8, 0x35, 0x7b, 0x27, 0xde, 0xc6, 0x09,
+	0x0e, 0x7d, 0x0e, 0xcd, 0xc4, 0x07, 0xb3, 0xd6, 0xd1, 0xba, 0x46, 0xdf, 0x2c, 0x72, 0x12, 0x67,
+	0x70, 0x8a, 0xe4, 0xac, 0x43, 0xd7, 0xa3, 0x2f, 0xe8, 0x32, 0x32, 0xeb, 0x1d, 0xbd, 0x8c, 0xf5,
+	0x5c, 0xee, 0xe3, 0x14, 0x89, 0xb6, 0xa1, 0xc5, 0xdc, 0x19, 0x8d, 0x18, 0x99, 0x05, 0x66, 0xa3,
+	0xa3, 0x75, 0x75, 0x9c, 0x2d, 0x20, 0x13, 0x1a, 0x27, 0x34, 0x8c, 0x5c, 0x7f, 0x6e, 0x36, 0x3b,
+	0x5a, 0x77, 0x03, 0x27, 0x26, 0xb2, 0xa0, 0xe9, 0xd0, 0x13, 0x77, 0x42, 0x77, 0x1d, 0xb3, 0x25,
+	0x92, 0x94, 0xda, 0xd6, 0x5f, 0x1a, 0x34, 0x13, 0x07, 0xd1, 0x00, 0x9a, 0x9e, 0x3f, 0x1d, 0x52,
+	0x22, 0x93, 0x6e, 0xf4, 0xff, 0xbf, 0x2a, 0x18, 0xfb, 0xa5, 0x04, 0x3e, 0x9b, 0xb3, 0x70, 0x89,
+	0x53, 0x1e, 0x7a, 0x00, 0x35, 0x87, 0x30, 0x22, 0x6e, 0xc3, 0xe8, 0xdf, 0x4a, 0xf9, 0xa2, 0x44,
+	0xec, 0xd1, 0x8c, 0x84, 0x6c, 0xe0, 0xf9, 0x93, 0xe3, 0x44, 0x68, 0x40, 0x22, 0x8a, 0x05, 0x25,
+	0x97, 0x15, 0xfd, 0x9f, 0x66, 0xc5, 0x7a, 0x04, 0x1b, 0x39, 0x5f, 0xd0, 0x16, 0xe8, 0xc7, 0x74,
+	0x69, 0x6a, 0x22, 0x52, 0xfe, 0x13, 0x5d, 0x80, 0xb5, 0x13, 0xe2, 0x2d, 0xa8, 0x2c, 0x91, 0xd8,
+	0x78, 0x58, 0xbd, 0xaf, 0x59, 0x3f, 0x68, 0xd0, 0x4c, 0x34, 0x11, 0x82, 0xda, 0x11, 0x89, 0x8e,
+	0x24, 0x53, 0xfc, 0x46, 0x5f, 0x40, 0xed, 0x98, 0xfb, 0x53, 0x15, 0xfe, 0x5c, 0x5f, 0xe5, 0x8f,
+	0xcd, 0xff, 0xc4, 0xa9, 0x10, 0x78, 0xeb, 0x1e, 0xb4, 0xd2, 0xa5, 0x33, 0x79, 0xf4, 0x4b, 0x0b,
+	0x1a, 0xb2, 0xca, 0xd0, 0x57, 0x60, 0x8c, 0x79, 0xae, 0x76, 0x42, 0x4a, 0x18, 0x15, 0x7c, 0xa3,
+	0x7f, 0xb9, 0xe8, 0xc3, 0x20, 0x83, 0x0c, 0x2b, 0x58, 0x65, 0xa4, 0x02, 0xaf, 0x03, 0x87, 0xb0,
+	0xf8, 0xb0, 0x55, 0x02, 0x31, 0x24, 0x15, 0x88, 0xcd, 0x54, 0x00, 0xd3, 0x99, 0x7f, 0x42, 0x4d,
+	0xfd, 0x23, 0x02, 0x31, 0x24, 0x15, 0x88, 0x4d, 0xf4, 0x00, 0x5a, 0xc2, 0xdc, 0xe3, 0xf4, 0xf8,
+	0x03, 0xf9, 0x5f, 0x29, 0x7d, 0x2f, 0x26, 0x67, 0x68, 0x34, 0x84, 0x4d, 0x61, 0x3c, 0x5d, 0x04,
+	0x9e, 0x3b, 0xe1, 0xfe, 0xaf, 0x09, 0x7e, 0xbb, 0x94, 0x9f, 0xa2, 0x86, 0x15, 0x5c, 0xe0, 0xf1,
+	0x28, 0x42, 0xea, 0x11, 0xe6, 0xfa, 0xf3, 0x27, 0x8e, 0x63, 0xf6, 0xcb, 0xa3, 0xc0, 0x19, 0x84,
+	0x47, 0xa1, 0x30, 0xb8, 0x2b, 0x89, 0x29, 0x33, 0x71, 0xb7, 0xdc, 0x15, 0x9c, 0x43, 0x71, 0x57,
+	0xf2, 0x3c, 0xf4, 0x18, 0xc0, 0xa1, 0x8c, 0xb8, 0x5e, 0x34, 0xa2, 0xcc, 0x74, 0x84, 0x8a, 0x55,
+	0x54, 0x79, 0x9a, 0x22, 0x86, 0x15, 0xac, 0xe0, 0xd1, 0x00, 0xd6, 0xa5, 0xf5, 0x7a, 0x1e, 0x51,
+	0x66, 0x52, 0xc1, 0xdf, 0x5e, 0xc1, 0x17, 0x98, 0x61, 0x05, 0xe7, 0x38, 0xe8, 0x6b, 0x38, 0xe7,
+	0x7b, 0xce, 0x81, 0x9a, 0x90, 0xc3, 0x72, 0x99, 0x83, 0x7c, 0x46, 0x36, 0x7d, 0xcf, 0x51, 0x56,
+	0xd0, 0x2b, 0x40, 0xaa, 0x90, 0x4c, 0xcc, 0x54, 0x68, 0x5d, 0x5d, 0xa9, 0x95, 0x66, 0xe6, 0xbc,
+	0x22, 0x27, 0x93, 0x53, 0x50, 0x94, 0x55, 0x7b, 0x74, 0x8a, 0x62, 0x5a, 0xb9, 0xaa, 0xa2, 0xac,
+	0xdf, 0x67, 0xb0, 0xe1, 0x8f, 0xdf, 0xd0, 0x09, 0xfb, 0x76, 0x19, 0x50, 0x1e, 0xaa, 0x2b, 0xc4,
+	0xae, 0x14, 0xc5, 0xf6, 0x55, 0xd0, 0xb0, 0x82, 0xf3, 0x2c, 0xf4, 0x0d, 0x6c, 0x65, 0x0b, 0x32,
+	0xd0, 0x37, 0x42, 0xa9, 0xb3, 0x5a, 0x29, 0x8d, 0xf4, 0x03, 0x2e, 0x2f, 0xc8, 0x88, 0xf9, 0x21,
+	0x6f, 0x1d, 0xbc, 0x0c, 0x8e, 0xcb, 0x0b, 0x72, 0x94, 0x41, 0x78, 0x41, 0x2a, 0x0c, 0x1e, 0x57,
+	0x62, 0xc6, 0x95, 0xe0, 0x95, 0xc7, 0x35, 0x52, 0x41, 0x3c, 0xae, 0x1c, 0x8b, 0xc7, 0x25, 0x16,
+	0x46, 0x9e, 0x3b, 0xa1, 0x32, 0xdd, 0xb3, 0xf2, 0xb8, 0x46, 0x05, 0x1c, 0x8f, 0xab, 0xc8, 0x1d,
+	0x34, 0x64, 0x5b, 0xb3, 0x7e, 0xd2, 0xc0, 0x50, 0xfa, 0x12, 0x1f, 0x41, 0x8c, 0x84, 0x53, 0xca,
+	0x76, 0x1d, 0xd9, 0x06, 0x53, 0x1b, 0x3d, 0x80, 0x66, 0xe0, 0x47, 0x2e, 0xbf, 0x35, 0xd1, 0xa1,
+	0x36, 0xfb, 0x57, 0x0a, 0x53, 0x43, 0x28, 0xd9, 0xaf, 0x24, 0x08, 0xa7, 0x70, 0x74, 0x1b, 0xea,
+	0xe2, 0x53, 0x4f, 0xe6, 0xc5, 0x85, 0x32, 0x22, 0x96, 0x18, 0xeb, 0x4b, 0xe9, 0x93, 0xac, 0x0d,
+	0x1b, 0xea, 0xf1, 0xfb, 0x44, 0x36, 0xf7, 0x8b, 0x29, 0xf9, 0x19, 0x5f, 0xb6, 0xf7, 0x68, 0x14,
+	0x91, 0x29, 0xc5, 0x12, 0x65, 0x5d, 0x95, 0x74, 0x79, 0x87, 0x5b, 0xa0, 0x67, 0x8f, 0x13, 0xfe,
+	0xd3, 0x62, 0xd0, 0x4a, 0x5b, 0xd9, 0xbf, 0x15, 0xb1, 0x3c, 0x55, 0xcf, 0x4e, 0x5d, 0xc2, 0x66,
+	0xbe, 0x01, 0xfe, 0x77, 0x47, 0xbf, 0x04, 0xc8, 0x5a, 0x55, 0xc9, 0x94, 0xbb, 0xad, 0x4e, 0x39,
+	0x9e, 0xe0, 0xf8, 0x05, 0x68, 0x27, 0x2f, 0x40, 0xfb, 0x3b, 0xbe, 0x2b, 0xa7, 0x9f, 0xd5, 0x81,
+	0x75, 0xb5, 0x71, 0x7d, 0xa8, 0x67, 0xbd, 0x02, 0x43, 0x6d, 0x40, 0x4f, 0x60, 0x23, 0x69, 0x15,
+	0x2f, 0xdd, 0xf9, 0x71, 0xf2, 0x66, 0xb9, 0x5c, 0x08, 0x08, 0x2b, 0x18, 0x9c, 0x67, 0x58, 0x7d,
+	0xd8, 0x2c, 0xf4, 0xa0, 0x4e, 0x36, 0x2b, 0x5e, 0x88, 0xd3, 0xc5, 0xdb, 0x53, 0x59, 0xb2, 0x76,
+	0x60, 0x5d, 0xed, 0x8c, 0xe8, 0x2e, 0x34, 0x93, 0x6d, 0x19, 0xe8, 0xa5, 0x15, 0x1e, 0xe0, 0x14,
+	0x68, 0xfd, 0xa6, 0xc3, 0xb9, 0x42, 0x07, 0x2b, 0x49, 0xe0, 0x3d, 0xa8, 0x1f, 0xfa, 0xe1, 0x8c,
+	0xb0, 0x15, 0x77, 0x95, 0x08, 0x3c, 0x17, 0xa0, 0x61, 0x05, 0x4b, 0x38, 0xba, 0x00, 0xb5, 0x39,
+	0x99, 0xc5, 0x03, 0xbb, 0x35, 0xac, 0x60, 0x61, 0xa1, 0xc7, 0x7c, 0x7c, 0x1c, 0x92, 0x85, 0xc7,
+	0x44, 0xe2, 0xcd, 0xda, 0xc7, 0xae, 0x25, 0x1e, 0x1c, 0x19, 0x1a, 0xed, 0x83, 0x91, 0x35, 0xb2,
+	0x48, 0x0e, 0xe3, 0xcf, 0x4e, 0x69, 0xcb, 0x4a, 0x3f, 0x8c, 0x78, 0x13, 0x53, 0x14, 0xd0, 0x45,
+	0x58, 0x9b, 0x2d, 0x3c, 0xe6, 0x9a, 0xf5, 0x8e, 0xd6, 0x6d, 0x0e, 0x2b, 0x38, 0x36, 0xd1, 0x73,
+	0x80, 0x88, 0x7a, 0x74, 0xc2, 0x9e, 0xba, 0x13, 0x26, 0x1e, 0xba, 0x46, 0xff, 0xe6, 0x69, 0xe7,
+	0x70, 0x2c, 0x9f, 0x96, 0x19, 0xd3, 0x7a, 0x08, 0x35, 0xfe, 0x1f, 0xf5, 0xa1, 0xe6, 0x70, 0xa5,
+	0xf8, 0x33, 0x6f, 0xaf, 0xc8, 0xa1, 0xbd, 0x1f, 0xf0, 0x7f, 0x58, 0x60, 0xad, 0x1e, 0x18, 0x8a,
+	0xe7, 0xbc, 0x2a, 0xd4, 0xd8, 0x65, 0x55, 0x28, 0x4b, 0x59, 0xeb, 0xbb, 0xa1, 0x5c, 0x6c, 0xd6,
+	0x2a, 0x0a, 0x95, 0x7c, 0x0d, 0x36, 0x72, 0x23, 0x87, 0x43, 0x16, 0xa1, 0x97, 0x40, 0x16, 0xa1,
+	0x67, 0xdd, 0x84, 0xad, 0xe2, 0x2c, 0x29, 0x41, 0xed, 0x83, 0xa1, 0x8c, 0x09, 0xfe, 0x84, 0x0d,
+	0x08, 0x3b, 0x92, 0x0e, 0x8a, 0xdf, 0x67, 0xfc, 0x0a, 0x6f, 0xc0, 0x46, 0x6e, 0x68, 0x94, 0x49,
+	0x5a, 0x7f, 0x56, 0x61, 0xab, 0x38, 0x10, 0x4a, 0xca, 0xf7, 0x3e, 0xe8, 0xc4, 0x71, 0xcc, 0x6a,
+	0xf9, 0x0d, 0x16, 0x05, 0xec, 0x78, 0xf4, 0x72, 0x0a, 0x7a, 0x02, 0xf5, 0x50, 0x7d, 0x72, 0x7e,
+	0x72, 0x2a, 0x39, 0x9d, 0xb6, 0x92, 0x88, 0x1e, 0x41, 0x6d, 0x96, 0x3d, 0x3a, 0x6f, 0x9d, 0x2a,
+	0x20, 0x1f, 0xa0, 0x82, 0x64, 0xdd, 0x01, 0x9d, 0xdf, 0x8a, 0x09, 0x0d, 0x72, 0xc8, 0x68, 0x98,
+	0x36, 0xd2, 0xc4, 0x4c, 0x9a, 0x61, 0x35, 0x6b, 0x86, 0x16, 0xd4, 0x57, 0x4e, 0x86, 0x3e, 0xd4,
+	0xc4, 0x50, 0x38, 0x83, 0xde, 0xc0, 0x80, 0x96, 0x1f, 0xd0, 0x50, 0xd4, 0xd1, 0x60, 0xfb, 0xd7,
+	0x77, 0x6d, 0xed, 0xed, 0xbb, 0xb6, 0xf6, 0xc7, 0xbb, 0xb6, 0xf6, 0xe3, 0xfb, 0x76, 0xe5, 0xed,
+	0xfb, 0x76, 0xe5, 0xf7, 0xf7, 0xed, 0xca, 0xf7, 0xd5, 0x60, 0x3c, 0xae, 0x8b, 0xab, 0xbc, 0xfb,
+	0xf7, 0x00, 0x96, 0x03, 0xe5, 0xcc, 0xc2, 0x0f, 0x00, 0x00,
 }
 
 func (m *Change) Marshal() (dAtA []byte, err error) {
@@ -1881,6 +1891,13 @@ func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintChanges(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Version != 0 {
 		i = encodeVarintChanges(dAtA, i, uint64(m.Version))
 		i--
@@ -3518,6 +3535,10 @@ func (m *Change) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovChanges(uint64(m.Version))
 	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovChanges(uint64(l))
+	}
 	return n
 }
 
@@ -4515,6 +4536,38 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChanges
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChanges
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChanges
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChanges(dAtA[iNdEx:])
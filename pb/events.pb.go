@@ -104,6 +104,8 @@ const (
 	ModelProcess_SaveFile       ModelProcessType = 3
 	ModelProcess_RecoverAccount ModelProcessType = 4
 	ModelProcess_Migration      ModelProcessType = 5
+	ModelProcess_Backup         ModelProcessType = 6
+	ModelProcess_BulkEdit       ModelProcessType = 7
 )
 
 var ModelProcessType_name = map[int32]string{
@@ -113,6 +115,8 @@ var ModelProcessType_name = map[int32]string{
 	3: "SaveFile",
 	4: "RecoverAccount",
 	5: "Migration",
+	6: "Backup",
+	7: "BulkEdit",
 }
 
 var ModelProcessType_value = map[string]int32{
@@ -122,6 +126,8 @@ var ModelProcessType_value = map[string]int32{
 	"SaveFile":       3,
 	"RecoverAccount": 4,
 	"Migration":      5,
+	"Backup":         6,
+	"BulkEdit":       7,
 }
 
 func (x ModelProcessType) String() string {
@@ -248,11 +254,13 @@ type EventMessage struct {
 	//	*EventMessageValueOfObjectRelationsRemove
 	//	*EventMessageValueOfObjectRemove
 	//	*EventMessageValueOfObjectRestrictionsSet
+	//	*EventMessageValueOfObjectSyncedBlocksSet
 	//	*EventMessageValueOfSubscriptionAdd
 	//	*EventMessageValueOfSubscriptionRemove
 	//	*EventMessageValueOfSubscriptionPosition
 	//	*EventMessageValueOfSubscriptionCounters
 	//	*EventMessageValueOfSubscriptionGroups
+	//	*EventMessageValueOfSubscriptionCalendarDay
 	//	*EventMessageValueOfBlockAdd
 	//	*EventMessageValueOfBlockDelete
 	//	*EventMessageValueOfFilesUpload
@@ -271,6 +279,7 @@ type EventMessage struct {
 	//	*EventMessageValueOfBlockSetLatex
 	//	*EventMessageValueOfBlockSetVerticalAlign
 	//	*EventMessageValueOfBlockSetTableRow
+	//	*EventMessageValueOfBlockSetTableColumn
 	//	*EventMessageValueOfBlockSetWidget
 	//	*EventMessageValueOfBlockDataviewViewSet
 	//	*EventMessageValueOfBlockDataviewViewDelete
@@ -372,6 +381,9 @@ type EventMessageValueOfObjectRemove struct {
 type EventMessageValueOfObjectRestrictionsSet struct {
 	ObjectRestrictionsSet *EventObjectRestrictionsSet `protobuf:"bytes,55,opt,name=objectRestrictionsSet,proto3,oneof" json:"objectRestrictionsSet,omitempty"`
 }
+type EventMessageValueOfObjectSyncedBlocksSet struct {
+	ObjectSyncedBlocksSet *EventObjectSyncedBlocksSet `protobuf:"bytes,56,opt,name=objectSyncedBlocksSet,proto3,oneof" json:"objectSyncedBlocksSet,omitempty"`
+}
 type EventMessageValueOfSubscriptionAdd struct {
 	SubscriptionAdd *EventObjectSubscriptionAdd `protobuf:"bytes,60,opt,name=subscriptionAdd,proto3,oneof" json:"subscriptionAdd,omitempty"`
 }
@@ -387,6 +399,9 @@ type EventMessageValueOfSubscriptionCounters struct {
 type EventMessageValueOfSubscriptionGroups struct {
 	SubscriptionGroups *EventObjectSubscriptionGroups `protobuf:"bytes,64,opt,name=subscriptionGroups,proto3,oneof" json:"subscriptionGroups,omitempty"`
 }
+type EventMessageValueOfSubscriptionCalendarDay struct {
+	SubscriptionCalendarDay *EventObjectSubscriptionCalendarDay `protobuf:"bytes,65,opt,name=subscriptionCalendarDay,proto3,oneof" json:"subscriptionCalendarDay,omitempty"`
+}
 type EventMessageValueOfBlockAdd struct {
 	BlockAdd *EventBlockAdd `protobuf:"bytes,2,opt,name=blockAdd,proto3,oneof" json:"blockAdd,omitempty"`
 }
@@ -441,6 +456,9 @@ type EventMessageValueOfBlockSetVerticalAlign struct {
 type EventMessageValueOfBlockSetTableRow struct {
 	BlockSetTableRow *EventBlockSetTableRow `protobuf:"bytes,37,opt,name=blockSetTableRow,proto3,oneof" json:"blockSetTableRow,omitempty"`
 }
+type EventMessageValueOfBlockSetTableColumn struct {
+	BlockSetTableColumn *EventBlockSetTableColumn `protobuf:"bytes,41,opt,name=blockSetTableColumn,proto3,oneof" json:"blockSetTableColumn,omitempty"`
+}
 type EventMessageValueOfBlockSetWidget struct {
 	BlockSetWidget *EventBlockSetWidget `protobuf:"bytes,40,opt,name=blockSetWidget,proto3,oneof" json:"blockSetWidget,omitempty"`
 }
@@ -531,11 +549,13 @@ func (*EventMessageValueOfObjectRelationsAmend) IsEventMessageValue()
 func (*EventMessageValueOfObjectRelationsRemove) IsEventMessageValue()          {}
 func (*EventMessageValueOfObjectRemove) IsEventMessageValue()                   {}
 func (*EventMessageValueOfObjectRestrictionsSet) IsEventMessageValue()          {}
+func (*EventMessageValueOfObjectSyncedBlocksSet) IsEventMessageValue()          {}
 func (*EventMessageValueOfSubscriptionAdd) IsEventMessageValue()                {}
 func (*EventMessageValueOfSubscriptionRemove) IsEventMessageValue()             {}
 func (*EventMessageValueOfSubscriptionPosition) IsEventMessageValue()           {}
 func (*EventMessageValueOfSubscriptionCounters) IsEventMessageValue()           {}
 func (*EventMessageValueOfSubscriptionGroups) IsEventMessageValue()             {}
+func (*EventMessageValueOfSubscriptionCalendarDay) IsEventMessageValue()        {}
 func (*EventMessageValueOfBlockAdd) IsEventMessageValue()                       {}
 func (*EventMessageValueOfBlockDelete) IsEventMessageValue()                    {}
 func (*EventMessageValueOfFilesUpload) IsEventMessageValue()                    {}
@@ -554,6 +574,7 @@ func (*EventMessageValueOfBlockSetRelation) IsEventMessageValue()
 func (*EventMessageValueOfBlockSetLatex) IsEventMessageValue()                  {}
 func (*EventMessageValueOfBlockSetVerticalAlign) IsEventMessageValue()          {}
 func (*EventMessageValueOfBlockSetTableRow) IsEventMessageValue()               {}
+func (*EventMessageValueOfBlockSetTableColumn) IsEventMessageValue()            {}
 func (*EventMessageValueOfBlockSetWidget) IsEventMessageValue()                 {}
 func (*EventMessageValueOfBlockDataviewViewSet) IsEventMessageValue()           {}
 func (*EventMessageValueOfBlockDataviewViewDelete) IsEventMessageValue()        {}
@@ -665,6 +686,13 @@ func (m *EventMessage) GetObjectRestrictionsSet() *EventObjectRestrictionsSet {
 	return nil
 }
 
+func (m *EventMessage) GetObjectSyncedBlocksSet() *EventObjectSyncedBlocksSet {
+	if x, ok := m.GetValue().(*EventMessageValueOfObjectSyncedBlocksSet); ok {
+		return x.ObjectSyncedBlocksSet
+	}
+	return nil
+}
+
 func (m *EventMessage) GetSubscriptionAdd() *EventObjectSubscriptionAdd {
 	if x, ok := m.GetValue().(*EventMessageValueOfSubscriptionAdd); ok {
 		return x.SubscriptionAdd
@@ -700,6 +728,13 @@ func (m *EventMessage) GetSubscriptionGroups() *EventObjectSubscriptionGroups {
 	return nil
 }
 
+func (m *EventMessage) GetSubscriptionCalendarDay() *EventObjectSubscriptionCalendarDay {
+	if x, ok := m.GetValue().(*EventMessageValueOfSubscriptionCalendarDay); ok {
+		return x.SubscriptionCalendarDay
+	}
+	return nil
+}
+
 func (m *EventMessage) GetBlockAdd() *EventBlockAdd {
 	if x, ok := m.GetValue().(*EventMessageValueOfBlockAdd); ok {
 		return x.BlockAdd
@@ -826,6 +861,13 @@ func (m *EventMessage) GetBlockSetTableRow() *EventBlockSetTableRow {
 	return nil
 }
 
+func (m *EventMessage) GetBlockSetTableColumn() *EventBlockSetTableColumn {
+	if x, ok := m.GetValue().(*EventMessageValueOfBlockSetTableColumn); ok {
+		return x.BlockSetTableColumn
+	}
+	return nil
+}
+
 func (m *EventMessage) GetBlockSetWidget() *EventBlockSetWidget {
 	if x, ok := m.GetValue().(*EventMessageValueOfBlockSetWidget); ok {
 		return x.BlockSetWidget
@@ -1022,11 +1064,13 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessageValueOfObjectRelationsRemove)(nil),
 		(*EventMessageValueOfObjectRemove)(nil),
 		(*EventMessageValueOfObjectRestrictionsSet)(nil),
+		(*EventMessageValueOfObjectSyncedBlocksSet)(nil),
 		(*EventMessageValueOfSubscriptionAdd)(nil),
 		(*EventMessageValueOfSubscriptionRemove)(nil),
 		(*EventMessageValueOfSubscriptionPosition)(nil),
 		(*EventMessageValueOfSubscriptionCounters)(nil),
 		(*EventMessageValueOfSubscriptionGroups)(nil),
+		(*EventMessageValueOfSubscriptionCalendarDay)(nil),
 		(*EventMessageValueOfBlockAdd)(nil),
 		(*EventMessageValueOfBlockDelete)(nil),
 		(*EventMessageValueOfFilesUpload)(nil),
@@ -1045,6 +1089,7 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessageValueOfBlockSetLatex)(nil),
 		(*EventMessageValueOfBlockSetVerticalAlign)(nil),
 		(*EventMessageValueOfBlockSetTableRow)(nil),
+		(*EventMessageValueOfBlockSetTableColumn)(nil),
 		(*EventMessageValueOfBlockSetWidget)(nil),
 		(*EventMessageValueOfBlockDataviewViewSet)(nil),
 		(*EventMessageValueOfBlockDataviewViewDelete)(nil),
@@ -2005,6 +2050,67 @@ func (m *EventObjectSubscriptionGroups) GetRemove() bool {
 	return false
 }
 
+// Replaces objects of the day of the calendar subscription, the day without objects is removed
+type EventObjectSubscriptionCalendarDay struct {
+	SubId     string   `protobuf:"bytes,1,opt,name=subId,proto3" json:"subId,omitempty"`
+	Date      int64    `protobuf:"varint,2,opt,name=date,proto3" json:"date,omitempty"`
+	ObjectIds []string `protobuf:"bytes,3,rep,name=objectIds,proto3" json:"objectIds,omitempty"`
+}
+
+func (m *EventObjectSubscriptionCalendarDay) Reset()         { *m = EventObjectSubscriptionCalendarDay{} }
+func (m *EventObjectSubscriptionCalendarDay) String() string { return proto.CompactTextString(m) }
+func (*EventObjectSubscriptionCalendarDay) ProtoMessage()    {}
+func (*EventObjectSubscriptionCalendarDay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 1, 5}
+}
+func (m *EventObjectSubscriptionCalendarDay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObjectSubscriptionCalendarDay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObjectSubscriptionCalendarDay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObjectSubscriptionCalendarDay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObjectSubscriptionCalendarDay.Merge(m, src)
+}
+func (m *EventObjectSubscriptionCalendarDay) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObjectSubscriptionCalendarDay) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObjectSubscriptionCalendarDay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObjectSubscriptionCalendarDay proto.InternalMessageInfo
+
+func (m *EventObjectSubscriptionCalendarDay) GetSubId() string {
+	if m != nil {
+		return m.SubId
+	}
+	return ""
+}
+
+func (m *EventObjectSubscriptionCalendarDay) GetDate() int64 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+func (m *EventObjectSubscriptionCalendarDay) GetObjectIds() []string {
+	if m != nil {
+		return m.ObjectIds
+	}
+	return nil
+}
+
 type EventObjectRelations struct {
 }
 
@@ -2278,6 +2384,105 @@ func (m *EventObjectRestrictionsSet) GetRestrictions() *model.Restrictions {
 	return nil
 }
 
+type EventObjectSyncedBlocks struct {
+}
+
+func (m *EventObjectSyncedBlocks) Reset()         { *m = EventObjectSyncedBlocks{} }
+func (m *EventObjectSyncedBlocks) String() string { return proto.CompactTextString(m) }
+func (*EventObjectSyncedBlocks) ProtoMessage()    {}
+func (*EventObjectSyncedBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 5}
+}
+func (m *EventObjectSyncedBlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObjectSyncedBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObjectSyncedBlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObjectSyncedBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObjectSyncedBlocks.Merge(m, src)
+}
+func (m *EventObjectSyncedBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObjectSyncedBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObjectSyncedBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObjectSyncedBlocks proto.InternalMessageInfo
+
+// Replaces the blocks of the source object embedded by synced blocks. Block ids are namespaced as "<objectId>/<blockId>",
+// further changes of these blocks are sent as usual block events with the context of the embedding object and namespaced ids.
+// Edits of the embedded blocks made with the context of the embedding object and namespaced ids are applied to the source object
+type EventObjectSyncedBlocksSet struct {
+	Id       string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ObjectId string         `protobuf:"bytes,2,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	Blocks   []*model.Block `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *EventObjectSyncedBlocksSet) Reset()         { *m = EventObjectSyncedBlocksSet{} }
+func (m *EventObjectSyncedBlocksSet) String() string { return proto.CompactTextString(m) }
+func (*EventObjectSyncedBlocksSet) ProtoMessage()    {}
+func (*EventObjectSyncedBlocksSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 5, 0}
+}
+func (m *EventObjectSyncedBlocksSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObjectSyncedBlocksSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObjectSyncedBlocksSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObjectSyncedBlocksSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObjectSyncedBlocksSet.Merge(m, src)
+}
+func (m *EventObjectSyncedBlocksSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObjectSyncedBlocksSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObjectSyncedBlocksSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObjectSyncedBlocksSet proto.InternalMessageInfo
+
+func (m *EventObjectSyncedBlocksSet) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventObjectSyncedBlocksSet) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *EventObjectSyncedBlocksSet) GetBlocks() []*model.Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
 type EventBlock struct {
 }
 
@@ -3366,8 +3571,9 @@ func (m *EventBlockSetTextIconImage) GetValue() string {
 }
 
 type EventBlockSetLatex struct {
-	Id   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text *EventBlockSetLatexText `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Id    string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text  *EventBlockSetLatexText  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Label *EventBlockSetLatexLabel `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
 }
 
 func (m *EventBlockSetLatex) Reset()         { *m = EventBlockSetLatex{} }
@@ -3417,6 +3623,13 @@ func (m *EventBlockSetLatex) GetText() *EventBlockSetLatexText {
 	return nil
 }
 
+func (m *EventBlockSetLatex) GetLabel() *EventBlockSetLatexLabel {
+	if m != nil {
+		return m.Label
+	}
+	return nil
+}
+
 type EventBlockSetLatexText struct {
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -3461,6 +3674,50 @@ func (m *EventBlockSetLatexText) GetValue() string {
 	return ""
 }
 
+type EventBlockSetLatexLabel struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventBlockSetLatexLabel) Reset()         { *m = EventBlockSetLatexLabel{} }
+func (m *EventBlockSetLatexLabel) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetLatexLabel) ProtoMessage()    {}
+func (*EventBlockSetLatexLabel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 8, 1}
+}
+func (m *EventBlockSetLatexLabel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetLatexLabel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetLatexLabel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetLatexLabel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetLatexLabel.Merge(m, src)
+}
+func (m *EventBlockSetLatexLabel) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetLatexLabel) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetLatexLabel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetLatexLabel proto.InternalMessageInfo
+
+func (m *EventBlockSetLatexLabel) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type EventBlockSetDiv struct {
 	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Style *EventBlockSetDivStyle `protobuf:"bytes,2,opt,name=style,proto3" json:"style,omitempty"`
@@ -4010,14 +4267,15 @@ func (m *EventBlockSetFileSize) GetValue() int64 {
 }
 
 type EventBlockSetLink struct {
-	Id            string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TargetBlockId *EventBlockSetLinkTargetBlockId `protobuf:"bytes,2,opt,name=targetBlockId,proto3" json:"targetBlockId,omitempty"`
-	Style         *EventBlockSetLinkStyle         `protobuf:"bytes,3,opt,name=style,proto3" json:"style,omitempty"`
-	Fields        *EventBlockSetLinkFields        `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
-	IconSize      *EventBlockSetLinkIconSize      `protobuf:"bytes,5,opt,name=iconSize,proto3" json:"iconSize,omitempty"`
-	CardStyle     *EventBlockSetLinkCardStyle     `protobuf:"bytes,6,opt,name=cardStyle,proto3" json:"cardStyle,omitempty"`
-	Description   *EventBlockSetLinkDescription   `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	Relations     *EventBlockSetLinkRelations     `protobuf:"bytes,8,opt,name=relations,proto3" json:"relations,omitempty"`
+	Id               string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetBlockId    *EventBlockSetLinkTargetBlockId    `protobuf:"bytes,2,opt,name=targetBlockId,proto3" json:"targetBlockId,omitempty"`
+	Style            *EventBlockSetLinkStyle            `protobuf:"bytes,3,opt,name=style,proto3" json:"style,omitempty"`
+	Fields           *EventBlockSetLinkFields           `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+	IconSize         *EventBlockSetLinkIconSize         `protobuf:"bytes,5,opt,name=iconSize,proto3" json:"iconSize,omitempty"`
+	CardStyle        *EventBlockSetLinkCardStyle        `protobuf:"bytes,6,opt,name=cardStyle,proto3" json:"cardStyle,omitempty"`
+	Description      *EventBlockSetLinkDescription      `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Relations        *EventBlockSetLinkRelations        `protobuf:"bytes,8,opt,name=relations,proto3" json:"relations,omitempty"`
+	TargetSubBlockId *EventBlockSetLinkTargetSubBlockId `protobuf:"bytes,9,opt,name=targetSubBlockId,proto3" json:"targetSubBlockId,omitempty"`
 }
 
 func (m *EventBlockSetLink) Reset()         { *m = EventBlockSetLink{} }
@@ -4109,6 +4367,13 @@ func (m *EventBlockSetLink) GetRelations() *EventBlockSetLinkRelations {
 	return nil
 }
 
+func (m *EventBlockSetLink) GetTargetSubBlockId() *EventBlockSetLinkTargetSubBlockId {
+	if m != nil {
+		return m.TargetSubBlockId
+	}
+	return nil
+}
+
 type EventBlockSetLinkTargetBlockId struct {
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -4153,6 +4418,50 @@ func (m *EventBlockSetLinkTargetBlockId) GetValue() string {
 	return ""
 }
 
+type EventBlockSetLinkTargetSubBlockId struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventBlockSetLinkTargetSubBlockId) Reset()         { *m = EventBlockSetLinkTargetSubBlockId{} }
+func (m *EventBlockSetLinkTargetSubBlockId) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetLinkTargetSubBlockId) ProtoMessage()    {}
+func (*EventBlockSetLinkTargetSubBlockId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 1}
+}
+func (m *EventBlockSetLinkTargetSubBlockId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetLinkTargetSubBlockId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetLinkTargetSubBlockId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetLinkTargetSubBlockId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetLinkTargetSubBlockId.Merge(m, src)
+}
+func (m *EventBlockSetLinkTargetSubBlockId) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetLinkTargetSubBlockId) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetLinkTargetSubBlockId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetLinkTargetSubBlockId proto.InternalMessageInfo
+
+func (m *EventBlockSetLinkTargetSubBlockId) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type EventBlockSetLinkStyle struct {
 	Value model.BlockContentLinkStyle `protobuf:"varint,1,opt,name=value,proto3,enum=anytype.model.BlockContentLinkStyle" json:"value,omitempty"`
 }
@@ -4161,7 +4470,7 @@ func (m *EventBlockSetLinkStyle) Reset()         { *m = EventBlockSetLinkStyle{}
 func (m *EventBlockSetLinkStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkStyle) ProtoMessage()    {}
 func (*EventBlockSetLinkStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 2}
 }
 func (m *EventBlockSetLinkStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4205,7 +4514,7 @@ func (m *EventBlockSetLinkFields) Reset()         { *m = EventBlockSetLinkFields
 func (m *EventBlockSetLinkFields) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkFields) ProtoMessage()    {}
 func (*EventBlockSetLinkFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 3}
 }
 func (m *EventBlockSetLinkFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4249,7 +4558,7 @@ func (m *EventBlockSetLinkIconSize) Reset()         { *m = EventBlockSetLinkIcon
 func (m *EventBlockSetLinkIconSize) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkIconSize) ProtoMessage()    {}
 func (*EventBlockSetLinkIconSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 4}
 }
 func (m *EventBlockSetLinkIconSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4293,7 +4602,7 @@ func (m *EventBlockSetLinkCardStyle) Reset()         { *m = EventBlockSetLinkCar
 func (m *EventBlockSetLinkCardStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkCardStyle) ProtoMessage()    {}
 func (*EventBlockSetLinkCardStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 5}
 }
 func (m *EventBlockSetLinkCardStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4337,7 +4646,7 @@ func (m *EventBlockSetLinkDescription) Reset()         { *m = EventBlockSetLinkD
 func (m *EventBlockSetLinkDescription) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkDescription) ProtoMessage()    {}
 func (*EventBlockSetLinkDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 6}
 }
 func (m *EventBlockSetLinkDescription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4381,7 +4690,7 @@ func (m *EventBlockSetLinkRelations) Reset()         { *m = EventBlockSetLinkRel
 func (m *EventBlockSetLinkRelations) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkRelations) ProtoMessage()    {}
 func (*EventBlockSetLinkRelations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 11, 7}
 }
 func (m *EventBlockSetLinkRelations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4973,6 +5282,102 @@ func (m *EventBlockSetTableRowIsHeader) GetValue() bool {
 	return false
 }
 
+type EventBlockSetTableColumn struct {
+	Id   string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type *EventBlockSetTableColumnType `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *EventBlockSetTableColumn) Reset()         { *m = EventBlockSetTableColumn{} }
+func (m *EventBlockSetTableColumn) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetTableColumn) ProtoMessage()    {}
+func (*EventBlockSetTableColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 14}
+}
+func (m *EventBlockSetTableColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetTableColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetTableColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetTableColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetTableColumn.Merge(m, src)
+}
+func (m *EventBlockSetTableColumn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetTableColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetTableColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetTableColumn proto.InternalMessageInfo
+
+func (m *EventBlockSetTableColumn) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventBlockSetTableColumn) GetType() *EventBlockSetTableColumnType {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+type EventBlockSetTableColumnType struct {
+	Value model.BlockContentTableColumnType `protobuf:"varint,1,opt,name=value,proto3,enum=anytype.model.BlockContentTableColumnType" json:"value,omitempty"`
+}
+
+func (m *EventBlockSetTableColumnType) Reset()         { *m = EventBlockSetTableColumnType{} }
+func (m *EventBlockSetTableColumnType) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetTableColumnType) ProtoMessage()    {}
+func (*EventBlockSetTableColumnType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 14, 0}
+}
+func (m *EventBlockSetTableColumnType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetTableColumnType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetTableColumnType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetTableColumnType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetTableColumnType.Merge(m, src)
+}
+func (m *EventBlockSetTableColumnType) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetTableColumnType) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetTableColumnType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetTableColumnType proto.InternalMessageInfo
+
+func (m *EventBlockSetTableColumnType) GetValue() model.BlockContentTableColumnType {
+	if m != nil {
+		return m.Value
+	}
+	return model.BlockContentTableColumn_Text
+}
+
 type EventBlockSetWidget struct {
 	Id     string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Layout *EventBlockSetWidgetLayout `protobuf:"bytes,2,opt,name=layout,proto3" json:"layout,omitempty"`
@@ -4984,7 +5389,7 @@ func (m *EventBlockSetWidget) Reset()         { *m = EventBlockSetWidget{} }
 func (m *EventBlockSetWidget) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetWidget) ProtoMessage()    {}
 func (*EventBlockSetWidget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 14}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 15}
 }
 func (m *EventBlockSetWidget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5049,7 +5454,7 @@ func (m *EventBlockSetWidgetLayout) Reset()         { *m = EventBlockSetWidgetLa
 func (m *EventBlockSetWidgetLayout) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetWidgetLayout) ProtoMessage()    {}
 func (*EventBlockSetWidgetLayout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 14, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 15, 0}
 }
 func (m *EventBlockSetWidgetLayout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5093,7 +5498,7 @@ func (m *EventBlockSetWidgetLimit) Reset()         { *m = EventBlockSetWidgetLim
 func (m *EventBlockSetWidgetLimit) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetWidgetLimit) ProtoMessage()    {}
 func (*EventBlockSetWidgetLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 14, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 15, 1}
 }
 func (m *EventBlockSetWidgetLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5137,7 +5542,7 @@ func (m *EventBlockSetWidgetViewId) Reset()         { *m = EventBlockSetWidgetVi
 func (m *EventBlockSetWidgetViewId) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetWidgetViewId) ProtoMessage()    {}
 func (*EventBlockSetWidgetViewId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 14, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 15, 2}
 }
 func (m *EventBlockSetWidgetViewId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7225,6 +7630,10 @@ type EventBlockDataviewViewUpdateFields struct {
 	GroupBackgroundColors bool                               `protobuf:"varint,8,opt,name=groupBackgroundColors,proto3" json:"groupBackgroundColors,omitempty"`
 	PageLimit             int32                              `protobuf:"varint,9,opt,name=pageLimit,proto3" json:"pageLimit,omitempty"`
 	DefaultTemplateId     string                             `protobuf:"bytes,10,opt,name=defaultTemplateId,proto3" json:"defaultTemplateId,omitempty"`
+	DateRelationKey       string                             `protobuf:"bytes,11,opt,name=dateRelationKey,proto3" json:"dateRelationKey,omitempty"`
+	EndDateRelationKey    string                             `protobuf:"bytes,12,opt,name=endDateRelationKey,proto3" json:"endDateRelationKey,omitempty"`
+	DependencyRelationKey string                             `protobuf:"bytes,13,opt,name=dependencyRelationKey,proto3" json:"dependencyRelationKey,omitempty"`
+	Query                 string                             `protobuf:"bytes,14,opt,name=query,proto3" json:"query,omitempty"`
 }
 
 func (m *EventBlockDataviewViewUpdateFields) Reset()         { *m = EventBlockDataviewViewUpdateFields{} }
@@ -7330,6 +7739,34 @@ func (m *EventBlockDataviewViewUpdateFields) GetDefaultTemplateId() string {
 	return ""
 }
 
+func (m *EventBlockDataviewViewUpdateFields) GetDateRelationKey() string {
+	if m != nil {
+		return m.DateRelationKey
+	}
+	return ""
+}
+
+func (m *EventBlockDataviewViewUpdateFields) GetEndDateRelationKey() string {
+	if m != nil {
+		return m.EndDateRelationKey
+	}
+	return ""
+}
+
+func (m *EventBlockDataviewViewUpdateFields) GetDependencyRelationKey() string {
+	if m != nil {
+		return m.DependencyRelationKey
+	}
+	return ""
+}
+
+func (m *EventBlockDataviewViewUpdateFields) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
 type EventBlockDataviewViewUpdateFilter struct {
 	// Types that are valid to be assigned to Operation:
 	//	*EventBlockDataviewViewUpdateFilterOperationOfAdd
@@ -10324,12 +10761,15 @@ func init() {
 	proto.RegisterType((*EventObjectSubscriptionPosition)(nil), "anytype.Event.Object.Subscription.Position")
 	proto.RegisterType((*EventObjectSubscriptionCounters)(nil), "anytype.Event.Object.Subscription.Counters")
 	proto.RegisterType((*EventObjectSubscriptionGroups)(nil), "anytype.Event.Object.Subscription.Groups")
+	proto.RegisterType((*EventObjectSubscriptionCalendarDay)(nil), "anytype.Event.Object.Subscription.CalendarDay")
 	proto.RegisterType((*EventObjectRelations)(nil), "anytype.Event.Object.Relations")
 	proto.RegisterType((*EventObjectRelationsAmend)(nil), "anytype.Event.Object.Relations.Amend")
 	proto.RegisterType((*EventObjectRelationsRemove)(nil), "anytype.Event.Object.Relations.Remove")
 	proto.RegisterType((*EventObjectRemove)(nil), "anytype.Event.Object.Remove")
 	proto.RegisterType((*EventObjectRestrictions)(nil), "anytype.Event.Object.Restrictions")
 	proto.RegisterType((*EventObjectRestrictionsSet)(nil), "anytype.Event.Object.Restrictions.Set")
+	proto.RegisterType((*EventObjectSyncedBlocks)(nil), "anytype.Event.Object.SyncedBlocks")
+	proto.RegisterType((*EventObjectSyncedBlocksSet)(nil), "anytype.Event.Object.SyncedBlocks.Set")
 	proto.RegisterType((*EventBlock)(nil), "anytype.Event.Block")
 	proto.RegisterType((*EventBlockAdd)(nil), "anytype.Event.Block.Add")
 	proto.RegisterType((*EventBlockFilesUpload)(nil), "anytype.Event.Block.FilesUpload")
@@ -10354,6 +10794,7 @@ func init() {
 	proto.RegisterType((*EventBlockSetTextIconImage)(nil), "anytype.Event.Block.Set.Text.IconImage")
 	proto.RegisterType((*EventBlockSetLatex)(nil), "anytype.Event.Block.Set.Latex")
 	proto.RegisterType((*EventBlockSetLatexText)(nil), "anytype.Event.Block.Set.Latex.Text")
+	proto.RegisterType((*EventBlockSetLatexLabel)(nil), "anytype.Event.Block.Set.Latex.Label")
 	proto.RegisterType((*EventBlockSetDiv)(nil), "anytype.Event.Block.Set.Div")
 	proto.RegisterType((*EventBlockSetDivStyle)(nil), "anytype.Event.Block.Set.Div.Style")
 	proto.RegisterType((*EventBlockSetFile)(nil), "anytype.Event.Block.Set.File")
@@ -10367,6 +10808,7 @@ func init() {
 	proto.RegisterType((*EventBlockSetFileSize)(nil), "anytype.Event.Block.Set.File.Size")
 	proto.RegisterType((*EventBlockSetLink)(nil), "anytype.Event.Block.Set.Link")
 	proto.RegisterType((*EventBlockSetLinkTargetBlockId)(nil), "anytype.Event.Block.Set.Link.TargetBlockId")
+	proto.RegisterType((*EventBlockSetLinkTargetSubBlockId)(nil), "anytype.Event.Block.Set.Link.TargetSubBlockId")
 	proto.RegisterType((*EventBlockSetLinkStyle)(nil), "anytype.Event.Block.Set.Link.Style")
 	proto.RegisterType((*EventBlockSetLinkFields)(nil), "anytype.Event.Block.Set.Link.Fields")
 	proto.RegisterType((*EventBlockSetLinkIconSize)(nil), "anytype.Event.Block.Set.Link.IconSize")
@@ -10384,6 +10826,8 @@ func init() {
 	proto.RegisterType((*EventBlockSetBookmarkState)(nil), "anytype.Event.Block.Set.Bookmark.State")
 	proto.RegisterType((*EventBlockSetTableRow)(nil), "anytype.Event.Block.Set.TableRow")
 	proto.RegisterType((*EventBlockSetTableRowIsHeader)(nil), "anytype.Event.Block.Set.TableRow.IsHeader")
+	proto.RegisterType((*EventBlockSetTableColumn)(nil), "anytype.Event.Block.Set.TableColumn")
+	proto.RegisterType((*EventBlockSetTableColumnType)(nil), "anytype.Event.Block.Set.TableColumn.Type")
 	proto.RegisterType((*EventBlockSetWidget)(nil), "anytype.Event.Block.Set.Widget")
 	proto.RegisterType((*EventBlockSetWidgetLayout)(nil), "anytype.Event.Block.Set.Widget.Layout")
 	proto.RegisterType((*EventBlockSetWidgetLimit)(nil), "anytype.Event.Block.Set.Widget.Limit")
@@ -10487,326 +10931,343 @@ func init() {
 func init() { proto.RegisterFile("pb/protos/events.proto", fileDescriptor_a966342d378ae5f5) }
 
 var fileDescriptor_a966342d378ae5f5 = []byte{
-	// 5101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4b, 0x8c, 0x1c, 0xc7,
-	0x79, 0xde, 0x99, 0xe9, 0x79, 0xfd, 0x4b, 0x2e, 0x87, 0x25, 0x8a, 0x6a, 0xb5, 0x56, 0x2b, 0x6a,
-	0xf9, 0x94, 0x44, 0x0d, 0x25, 0xbe, 0x4d, 0xf1, 0xb5, 0x2f, 0x6a, 0x87, 0x8f, 0xe5, 0xa6, 0x96,
-	0xa4, 0x65, 0xd9, 0x30, 0xd4, 0x3b, 0x5d, 0x3b, 0xdb, 0xde, 0xd9, 0xee, 0x71, 0x77, 0xef, 0x92,
-	0x6b, 0xe7, 0x85, 0x24, 0xc7, 0x04, 0x48, 0x72, 0x70, 0x72, 0x0d, 0xe0, 0xdc, 0x02, 0xc3, 0x40,
-	0x2e, 0x3e, 0x05, 0x0e, 0x82, 0x00, 0x79, 0x5c, 0x94, 0x5b, 0x72, 0xb2, 0x21, 0x5d, 0x72, 0x31,
-	0x90, 0x5c, 0x7c, 0x0e, 0xea, 0xd1, 0xdd, 0x55, 0xfd, 0x98, 0xee, 0xb1, 0x64, 0x38, 0x41, 0x74,
-	0xda, 0xa9, 0xaa, 0xff, 0xfb, 0xfe, 0x7a, 0xfc, 0x55, 0x7f, 0xd5, 0xdf, 0x55, 0x0b, 0xc7, 0x47,
-	0x9b, 0x17, 0x46, 0x9e, 0x1b, 0xb8, 0xfe, 0x05, 0xb2, 0x4f, 0x9c, 0xc0, 0xef, 0xb2, 0x14, 0x6a,
-	0x9a, 0xce, 0x41, 0x70, 0x30, 0x22, 0xc6, 0xa9, 0xd1, 0xce, 0xe0, 0xc2, 0xd0, 0xde, 0xbc, 0x30,
-	0xda, 0xbc, 0xb0, 0xeb, 0x5a, 0x64, 0x18, 0x8a, 0xb3, 0x84, 0x10, 0x37, 0x66, 0x07, 0xae, 0x3b,
-	0x18, 0x12, 0x5e, 0xb6, 0xb9, 0xb7, 0x75, 0xc1, 0x0f, 0xbc, 0xbd, 0x7e, 0xc0, 0x4b, 0xe7, 0xff,
-	0xe3, 0x87, 0x15, 0xa8, 0xaf, 0x50, 0x7a, 0x74, 0x11, 0x5a, 0xbb, 0xc4, 0xf7, 0xcd, 0x01, 0xf1,
-	0xf5, 0xca, 0x89, 0xda, 0xb9, 0xe9, 0x8b, 0xc7, 0xbb, 0x42, 0x55, 0x97, 0x49, 0x74, 0x1f, 0xf1,
-	0x62, 0x1c, 0xc9, 0xa1, 0x59, 0x68, 0xf7, 0x5d, 0x27, 0x20, 0x2f, 0x82, 0x9e, 0xa5, 0x57, 0x4f,
-	0x54, 0xce, 0xb5, 0x71, 0x9c, 0x81, 0x2e, 0x43, 0xdb, 0x76, 0xec, 0xc0, 0x36, 0x03, 0xd7, 0xd3,
-	0x6b, 0x27, 0x2a, 0x0a, 0x25, 0xab, 0x64, 0x77, 0xa1, 0xdf, 0x77, 0xf7, 0x9c, 0x00, 0xc7, 0x82,
-	0x48, 0x87, 0x66, 0xe0, 0x99, 0x7d, 0xd2, 0xb3, 0x74, 0x8d, 0x31, 0x86, 0x49, 0xe3, 0xcf, 0xcf,
-	0x41, 0x53, 0xd4, 0x01, 0xdd, 0x81, 0x69, 0x93, 0x63, 0x37, 0xb6, 0xdd, 0xe7, 0x7a, 0x85, 0xb1,
-	0xbf, 0x96, 0xa8, 0xb0, 0x60, 0xef, 0x52, 0x91, 0xd5, 0x29, 0x2c, 0x23, 0x50, 0x0f, 0x66, 0x44,
-	0x72, 0x99, 0x04, 0xa6, 0x3d, 0xf4, 0xf5, 0x7f, 0xe6, 0x24, 0x73, 0x39, 0x24, 0x42, 0x6c, 0x75,
-	0x0a, 0x27, 0x80, 0xe8, 0x1b, 0xf0, 0x92, 0xc8, 0x59, 0x72, 0x9d, 0x2d, 0x7b, 0xf0, 0x74, 0x64,
-	0x99, 0x01, 0xd1, 0xff, 0x85, 0xf3, 0x9d, 0xca, 0xe1, 0xe3, 0xb2, 0x5d, 0x2e, 0xbc, 0x3a, 0x85,
-	0xb3, 0x38, 0xd0, 0x3d, 0x38, 0x2c, 0xb2, 0x05, 0xe9, 0xbf, 0x72, 0xd2, 0xd7, 0x73, 0x48, 0x23,
-	0x36, 0x15, 0x86, 0x1e, 0x43, 0xc7, 0xdd, 0xfc, 0x0e, 0xe9, 0x87, 0x75, 0xde, 0x20, 0x81, 0xde,
-	0x61, 0x4c, 0x6f, 0x26, 0x98, 0x1e, 0x33, 0xb1, 0xb0, 0xb5, 0xdd, 0x0d, 0x12, 0xac, 0x4e, 0xe1,
-	0x14, 0x18, 0x3d, 0x05, 0xa4, 0xe4, 0x2d, 0xec, 0x12, 0xc7, 0xd2, 0x2f, 0x32, 0xca, 0x93, 0xe3,
-	0x29, 0x99, 0xe8, 0xea, 0x14, 0xce, 0x20, 0x48, 0xd1, 0x3e, 0x75, 0x7c, 0x12, 0xe8, 0x97, 0xca,
-	0xd0, 0x32, 0xd1, 0x14, 0x2d, 0xcb, 0x45, 0xdf, 0x84, 0x63, 0x3c, 0x17, 0x93, 0xa1, 0x19, 0xd8,
-	0xae, 0x23, 0xea, 0x7b, 0x99, 0x11, 0x9f, 0xce, 0x26, 0x8e, 0x64, 0xa3, 0x1a, 0x67, 0x92, 0xa0,
-	0x6f, 0xc3, 0xcb, 0x89, 0x7c, 0x4c, 0x76, 0xdd, 0x7d, 0xa2, 0x5f, 0x61, 0xec, 0x67, 0x8a, 0xd8,
-	0xb9, 0xf4, 0xea, 0x14, 0xce, 0xa6, 0x41, 0x8b, 0x70, 0x28, 0x2c, 0x60, 0xb4, 0x57, 0x19, 0xed,
-	0x6c, 0x1e, 0xad, 0x20, 0x53, 0x30, 0x72, 0x1d, 0xfd, 0xc0, 0xb3, 0xfb, 0x8c, 0x9f, 0x1a, 0xc1,
-	0xb5, 0xf1, 0x75, 0x8c, 0x85, 0x85, 0x25, 0x64, 0xd3, 0x20, 0x0c, 0x47, 0xfc, 0xbd, 0x4d, 0xbf,
-	0xef, 0xd9, 0x23, 0x9a, 0xb7, 0x60, 0x59, 0xfa, 0xcd, 0x71, 0xcc, 0x1b, 0x92, 0x70, 0x77, 0xc1,
-	0xa2, 0x9d, 0x9b, 0x24, 0x40, 0xdf, 0x04, 0x24, 0x67, 0x89, 0xd6, 0xdf, 0x62, 0xb4, 0x6f, 0x95,
-	0xa0, 0x8d, 0xba, 0x22, 0x83, 0x06, 0x99, 0x70, 0x4c, 0xce, 0x5d, 0x77, 0x7d, 0x9b, 0xfe, 0xd5,
-	0x6f, 0x33, 0xfa, 0x77, 0x4a, 0xd0, 0x87, 0x10, 0x6a, 0x17, 0x59, 0x54, 0x49, 0x15, 0x4b, 0x74,
-	0x3a, 0x12, 0xcf, 0xd7, 0xef, 0x94, 0x56, 0x11, 0x42, 0x92, 0x2a, 0xc2, 0xfc, 0x64, 0x17, 0x7d,
-	0xe8, 0xb9, 0x7b, 0x23, 0x5f, 0xbf, 0x5b, 0xba, 0x8b, 0x38, 0x20, 0xd9, 0x45, 0x3c, 0x17, 0x5d,
-	0x85, 0xd6, 0xe6, 0xd0, 0xed, 0xef, 0xd0, 0xc1, 0xac, 0x32, 0x4a, 0x3d, 0x41, 0xb9, 0x48, 0x8b,
-	0xc5, 0xf0, 0x45, 0xb2, 0x74, 0x69, 0x66, 0xbf, 0x97, 0xc9, 0x90, 0x04, 0x44, 0x2c, 0xfc, 0xaf,
-	0x65, 0x42, 0xb9, 0x08, 0x5d, 0x9a, 0x25, 0x04, 0x5a, 0x86, 0xe9, 0x2d, 0x7b, 0x48, 0xfc, 0xa7,
-	0xa3, 0xa1, 0x6b, 0x72, 0x2f, 0x30, 0x7d, 0xf1, 0x44, 0x26, 0xc1, 0xbd, 0x58, 0x8e, 0xb2, 0x48,
-	0x30, 0x74, 0x1b, 0xda, 0xbb, 0xa6, 0xb7, 0xe3, 0xf7, 0x9c, 0x2d, 0x57, 0xaf, 0x67, 0x2e, 0xed,
-	0x9c, 0xe3, 0x51, 0x28, 0xb5, 0x3a, 0x85, 0x63, 0x08, 0x75, 0x10, 0xac, 0x52, 0x1b, 0x24, 0xb8,
-	0x67, 0x93, 0xa1, 0xe5, 0xeb, 0x0d, 0x46, 0xf2, 0x46, 0x26, 0xc9, 0x06, 0x09, 0xba, 0x5c, 0x8c,
-	0x3a, 0x08, 0x15, 0x88, 0x3e, 0x82, 0x97, 0xc2, 0x9c, 0xa5, 0x6d, 0x7b, 0x68, 0x79, 0xc4, 0xe9,
-	0x59, 0xbe, 0xde, 0xcc, 0xf4, 0x0f, 0x31, 0x9f, 0x24, 0x4b, 0xfd, 0x43, 0x06, 0x05, 0x5d, 0xd8,
-	0xc2, 0x6c, 0x79, 0x4a, 0xea, 0xad, 0xcc, 0x85, 0x2d, 0xa6, 0x96, 0x85, 0xa9, 0x75, 0x65, 0x91,
-	0x20, 0x0b, 0x5e, 0x09, 0xf3, 0x17, 0xcd, 0xfe, 0xce, 0xc0, 0x73, 0xf7, 0x1c, 0x6b, 0xc9, 0x1d,
-	0xba, 0x9e, 0xde, 0x66, 0xfc, 0xe7, 0x72, 0xf9, 0x13, 0xf2, 0xab, 0x53, 0x38, 0x8f, 0x0a, 0x2d,
-	0xc1, 0xa1, 0xb0, 0xe8, 0x09, 0x79, 0x11, 0xe8, 0x90, 0xe9, 0xe0, 0x62, 0x6a, 0x2a, 0x44, 0xd7,
-	0x37, 0x19, 0x24, 0x93, 0x50, 0x93, 0xd0, 0xa7, 0x0b, 0x48, 0xa8, 0x90, 0x4c, 0x42, 0xd3, 0x32,
-	0xc9, 0x43, 0xdb, 0xd9, 0xd1, 0x0f, 0x17, 0x90, 0x50, 0x21, 0x99, 0x84, 0xa6, 0xa9, 0xa7, 0x8d,
-	0x5a, 0xea, 0xba, 0x3b, 0xd4, 0x9e, 0xf4, 0x99, 0x4c, 0x4f, 0x2b, 0xf5, 0x96, 0x10, 0xa4, 0x9e,
-	0x36, 0x09, 0xa6, 0x5b, 0x80, 0x30, 0x6f, 0x61, 0x68, 0x0f, 0x1c, 0xfd, 0xc8, 0x18, 0x5b, 0xa6,
-	0x6c, 0x4c, 0x8a, 0x6e, 0x01, 0x14, 0x18, 0xba, 0x2b, 0xa6, 0xe5, 0x06, 0x09, 0x96, 0xed, 0x7d,
-	0xfd, 0x68, 0xa6, 0x17, 0x89, 0x59, 0x96, 0xed, 0xfd, 0x68, 0x5e, 0x72, 0x88, 0xdc, 0xb4, 0xd0,
-	0x47, 0xe9, 0x2f, 0x17, 0x34, 0x2d, 0x14, 0x94, 0x9b, 0x16, 0xe6, 0xc9, 0x4d, 0x7b, 0x68, 0x06,
-	0xe4, 0x85, 0xfe, 0x6a, 0x41, 0xd3, 0x98, 0x94, 0xdc, 0x34, 0x96, 0x41, 0xbd, 0x5b, 0x98, 0xf1,
-	0x8c, 0x78, 0x81, 0xdd, 0x37, 0x87, 0xbc, 0xab, 0x4e, 0x65, 0xfa, 0xa0, 0x98, 0x4f, 0x91, 0xa6,
-	0xde, 0x2d, 0x93, 0x46, 0x6e, 0xf8, 0x13, 0x73, 0x73, 0x48, 0xb0, 0xfb, 0x5c, 0x3f, 0x5d, 0xd0,
-	0xf0, 0x50, 0x50, 0x6e, 0x78, 0x98, 0x27, 0xaf, 0x2d, 0x5f, 0xb7, 0xad, 0x01, 0x09, 0xf4, 0x73,
-	0x05, 0x6b, 0x0b, 0x17, 0x93, 0xd7, 0x16, 0x9e, 0x13, 0xad, 0x00, 0xcb, 0x66, 0x60, 0xee, 0xdb,
-	0xe4, 0xf9, 0x33, 0x9b, 0x3c, 0xa7, 0x8e, 0xfd, 0xa5, 0x31, 0x2b, 0x40, 0x28, 0xdb, 0x15, 0xc2,
-	0xd1, 0x0a, 0x90, 0x20, 0x89, 0x56, 0x00, 0x39, 0x5f, 0x2c, 0xeb, 0xc7, 0xc6, 0xac, 0x00, 0x0a,
-	0x7f, 0xb4, 0xc6, 0xe7, 0x51, 0x21, 0x13, 0x8e, 0xa7, 0x8a, 0x1e, 0x7b, 0x16, 0xf1, 0xf4, 0xd7,
-	0x99, 0x92, 0xb3, 0xc5, 0x4a, 0x98, 0xf8, 0xea, 0x14, 0xce, 0x21, 0x4a, 0xa9, 0xd8, 0x70, 0xf7,
-	0xbc, 0x3e, 0xa1, 0xfd, 0x74, 0xb2, 0x8c, 0x8a, 0x48, 0x3c, 0xa5, 0x22, 0x2a, 0x41, 0xfb, 0xf0,
-	0x7a, 0x54, 0x42, 0x15, 0x33, 0x2f, 0xca, 0xb4, 0x8b, 0xad, 0xfb, 0x19, 0xa6, 0xa9, 0x3b, 0x5e,
-	0x53, 0x12, 0xb5, 0x3a, 0x85, 0xc7, 0xd3, 0xa2, 0x03, 0x98, 0x53, 0x04, 0xb8, 0x9f, 0x97, 0x15,
-	0x9f, 0x65, 0x8a, 0x2f, 0x8c, 0x57, 0x9c, 0x82, 0xad, 0x4e, 0xe1, 0x02, 0x62, 0x34, 0x82, 0xd7,
-	0x94, 0xce, 0x08, 0x27, 0xb6, 0x30, 0x91, 0xdf, 0x66, 0x7a, 0xcf, 0x8f, 0xd7, 0xab, 0x62, 0x56,
-	0xa7, 0xf0, 0x38, 0x4a, 0x34, 0x00, 0x3d, 0xb3, 0x98, 0x8e, 0xe4, 0xf7, 0x33, 0xb7, 0x3d, 0x39,
-	0xea, 0xf8, 0x58, 0xe6, 0x92, 0x65, 0x5a, 0xbe, 0xe8, 0xce, 0xdf, 0x29, 0x6b, 0xf9, 0x51, 0x3f,
-	0xe6, 0x51, 0x29, 0x63, 0x47, 0x8b, 0x9e, 0x98, 0xde, 0x80, 0x04, 0xbc, 0xa3, 0x7b, 0x16, 0x6d,
-	0xd4, 0xef, 0x96, 0x19, 0xbb, 0x14, 0x4c, 0x19, 0xbb, 0x4c, 0x62, 0xe4, 0xc3, 0xac, 0x22, 0xd1,
-	0xf3, 0x97, 0xdc, 0xe1, 0x90, 0xf4, 0xc3, 0xde, 0xfc, 0x3d, 0xa6, 0xf8, 0xdd, 0xf1, 0x8a, 0x13,
-	0xa0, 0xd5, 0x29, 0x3c, 0x96, 0x34, 0xd5, 0xde, 0xc7, 0x43, 0x2b, 0x61, 0x33, 0x7a, 0x29, 0x5b,
-	0x4d, 0xc2, 0x52, 0xed, 0x4d, 0x49, 0xa4, 0x6c, 0x55, 0x92, 0xa0, 0xcd, 0x7d, 0xa5, 0x8c, 0xad,
-	0xaa, 0x98, 0x94, 0xad, 0xaa, 0xc5, 0xd4, 0xbb, 0xed, 0xf9, 0xc4, 0x63, 0x1c, 0xf7, 0x5d, 0xdb,
-	0xd1, 0xdf, 0xc8, 0xf4, 0x6e, 0x4f, 0x7d, 0xe2, 0x09, 0x45, 0x54, 0x8a, 0x7a, 0x37, 0x05, 0xa6,
-	0xf0, 0x3c, 0x24, 0x5b, 0x81, 0x7e, 0xa2, 0x88, 0x87, 0x4a, 0x29, 0x3c, 0x34, 0x83, 0x7a, 0x8a,
-	0x28, 0x63, 0x83, 0xd0, 0x51, 0xc1, 0xa6, 0x33, 0x20, 0xfa, 0x9b, 0x99, 0x9e, 0x42, 0xa2, 0x93,
-	0x84, 0xa9, 0xa7, 0xc8, 0x22, 0xa1, 0x07, 0xf7, 0x28, 0x9f, 0xee, 0xc8, 0x38, 0xf5, 0x7c, 0xe6,
-	0xc1, 0x5d, 0xa2, 0x8e, 0x44, 0xe9, 0x19, 0x24, 0x4d, 0x80, 0xde, 0x02, 0x6d, 0x64, 0x3b, 0x03,
-	0xdd, 0x62, 0x44, 0x2f, 0x25, 0x88, 0xd6, 0x6d, 0x67, 0xb0, 0x3a, 0x85, 0x99, 0x08, 0xba, 0x09,
-	0x30, 0xf2, 0xdc, 0x3e, 0xf1, 0xfd, 0x35, 0xf2, 0x5c, 0x27, 0x0c, 0x60, 0x24, 0x01, 0x5c, 0xa0,
-	0xbb, 0x46, 0xa8, 0x5f, 0x96, 0xe4, 0xd1, 0x0a, 0x1c, 0x16, 0x29, 0x31, 0xcb, 0xb7, 0x32, 0x37,
-	0x7f, 0x21, 0x41, 0x1c, 0x67, 0x51, 0x50, 0xf4, 0xec, 0x23, 0x32, 0x96, 0x5d, 0x87, 0xe8, 0x83,
-	0xcc, 0xb3, 0x4f, 0x48, 0x42, 0x45, 0xe8, 0x1e, 0x4b, 0x42, 0xd0, 0xc3, 0x7e, 0xb0, 0xed, 0x11,
-	0xd3, 0xda, 0x08, 0xcc, 0x60, 0xcf, 0xd7, 0x9d, 0xcc, 0x6d, 0x1a, 0x2f, 0xec, 0x3e, 0x61, 0x92,
-	0x74, 0x0b, 0x2a, 0x63, 0xd0, 0x1a, 0x74, 0xe8, 0x41, 0xe8, 0xa1, 0xbd, 0x6b, 0x07, 0x98, 0x98,
-	0xfd, 0x6d, 0x62, 0xe9, 0x6e, 0xe6, 0x21, 0x8a, 0x6e, 0x7b, 0xbb, 0xb2, 0x1c, 0xdd, 0xad, 0x24,
-	0xb1, 0x68, 0x15, 0x66, 0x68, 0xde, 0xc6, 0xc8, 0xec, 0x93, 0xa7, 0xbe, 0x39, 0x20, 0xfa, 0x28,
-	0xd3, 0x02, 0x19, 0x5b, 0x2c, 0x45, 0x37, 0x2b, 0x2a, 0x2e, 0x64, 0x7a, 0xe8, 0xf6, 0xcd, 0x21,
-	0x67, 0xfa, 0x6e, 0x3e, 0x53, 0x2c, 0x15, 0x32, 0xc5, 0x39, 0x8b, 0x4d, 0xa8, 0xef, 0x9b, 0xc3,
-	0x3d, 0x62, 0xfc, 0xb8, 0x06, 0x4d, 0x11, 0xfd, 0x32, 0xd6, 0x40, 0x63, 0xb1, 0xbd, 0x63, 0x50,
-	0xb7, 0x1d, 0x8b, 0xbc, 0x60, 0x61, 0xc1, 0x3a, 0xe6, 0x09, 0xf4, 0x1e, 0x34, 0x45, 0x50, 0x4c,
-	0x1c, 0x67, 0xf3, 0x82, 0x91, 0xa1, 0x98, 0xf1, 0x31, 0x34, 0xc3, 0x18, 0xdf, 0x2c, 0xb4, 0x47,
-	0x9e, 0x4b, 0x2b, 0xd1, 0xb3, 0x18, 0x6d, 0x1b, 0xc7, 0x19, 0xe8, 0x7d, 0x68, 0x5a, 0x22, 0x8a,
-	0xc8, 0xa9, 0x5f, 0xe9, 0xf2, 0xb0, 0x6b, 0x37, 0x0c, 0xbb, 0x76, 0x37, 0x58, 0xd8, 0x15, 0x87,
-	0x72, 0xc6, 0xef, 0x57, 0xa0, 0xc1, 0x43, 0x7d, 0xc6, 0x3e, 0x34, 0x84, 0xf9, 0x5c, 0x81, 0x46,
-	0x9f, 0xe5, 0xe9, 0xc9, 0x30, 0x9f, 0x52, 0x43, 0x11, 0x3b, 0xc4, 0x42, 0x98, 0xc2, 0x7c, 0x6e,
-	0x2e, 0xd5, 0xb1, 0x30, 0x6e, 0x1f, 0x58, 0x08, 0xff, 0xc6, 0xf4, 0xfe, 0x57, 0x0b, 0x1a, 0xdc,
-	0x15, 0x19, 0xbf, 0xac, 0x46, 0x5d, 0x6c, 0xfc, 0x43, 0x05, 0xea, 0x3c, 0xa2, 0x36, 0x03, 0x55,
-	0x3b, 0xec, 0xe5, 0xaa, 0x6d, 0xa1, 0x7b, 0x72, 0xf7, 0xd6, 0x32, 0xd6, 0xe9, 0xac, 0x08, 0x63,
-	0xf7, 0x01, 0x39, 0x78, 0x46, 0x4d, 0x24, 0xea, 0x73, 0x74, 0x1c, 0x1a, 0xfe, 0xde, 0x26, 0x3d,
-	0x7a, 0xd7, 0x4e, 0xd4, 0xce, 0xb5, 0xb1, 0x48, 0x19, 0xf7, 0xa1, 0x15, 0x0a, 0xa3, 0x0e, 0xd4,
-	0x76, 0xc8, 0x81, 0x50, 0x4e, 0x7f, 0xa2, 0xf3, 0xc2, 0xd4, 0x22, 0xab, 0x49, 0x0e, 0x2d, 0xd7,
-	0x22, 0xec, 0xf1, 0x13, 0xa8, 0xd1, 0xc5, 0x3f, 0xd9, 0x84, 0xc9, 0x2d, 0x24, 0xb7, 0xb6, 0x4b,
-	0x50, 0xe7, 0x51, 0xcd, 0xa4, 0x0e, 0x04, 0xda, 0x0e, 0x39, 0xe0, 0x7d, 0xd4, 0xc6, 0xec, 0x77,
-	0x2e, 0xc9, 0x4f, 0x6b, 0x70, 0x48, 0x0e, 0x05, 0x19, 0x2b, 0x50, 0x5b, 0xb0, 0xd2, 0x5d, 0xaf,
-	0x43, 0xd3, 0xdc, 0x0a, 0x88, 0x17, 0xc5, 0xf7, 0xc3, 0x24, 0x9d, 0x64, 0x8c, 0x8b, 0x05, 0x78,
-	0xda, 0x98, 0x27, 0x8c, 0x2e, 0x34, 0x44, 0x84, 0x2d, 0xc9, 0x14, 0xc9, 0x57, 0x65, 0xf9, 0xfb,
-	0xd0, 0x8a, 0x02, 0x66, 0x5f, 0x54, 0xb7, 0x07, 0xad, 0x28, 0x32, 0x76, 0x0c, 0xea, 0x81, 0x1b,
-	0x98, 0x43, 0x46, 0x57, 0xc3, 0x3c, 0x41, 0x67, 0xb1, 0x43, 0x5e, 0x04, 0x4b, 0xd1, 0x22, 0x50,
-	0xc3, 0x71, 0x06, 0x9f, 0xe3, 0x64, 0x9f, 0x97, 0xd6, 0x78, 0x69, 0x94, 0x11, 0xeb, 0xd4, 0x64,
-	0x9d, 0x07, 0xd0, 0x10, 0xe1, 0xb2, 0xa8, 0xbc, 0x22, 0x95, 0xa3, 0x05, 0xa8, 0x0f, 0x68, 0xb9,
-	0x18, 0xf5, 0x77, 0x12, 0x33, 0x84, 0x7b, 0xc1, 0x25, 0xd7, 0x09, 0xa8, 0x19, 0xab, 0xa7, 0x00,
-	0xcc, 0x91, 0x74, 0x08, 0x3d, 0x1e, 0xfb, 0xa4, 0x75, 0x6a, 0x61, 0x91, 0x32, 0xfe, 0xba, 0x02,
-	0xed, 0x28, 0x56, 0x6c, 0x7c, 0x9c, 0x37, 0x79, 0x16, 0xe0, 0xb0, 0x27, 0xa4, 0x1e, 0xda, 0xce,
-	0x4e, 0x38, 0x85, 0x5e, 0x4b, 0xd4, 0x04, 0x4b, 0x32, 0x58, 0x45, 0x18, 0x37, 0x73, 0x07, 0x75,
-	0x1e, 0x0e, 0x85, 0xa2, 0x0f, 0x62, 0xd3, 0x53, 0xf2, 0x0c, 0x23, 0x42, 0x77, 0xa0, 0x66, 0x5b,
-	0xfc, 0xeb, 0x52, 0x1b, 0xd3, 0x9f, 0xc6, 0x16, 0x1c, 0x92, 0x43, 0x4e, 0xc6, 0xb3, 0xec, 0xd9,
-	0x73, 0x87, 0xaa, 0x91, 0xc2, 0x5b, 0xd5, 0x84, 0x5f, 0x0d, 0x9b, 0x10, 0x8b, 0x60, 0x05, 0x60,
-	0x7c, 0xfa, 0x09, 0xd4, 0x59, 0x5f, 0x1b, 0x97, 0xb8, 0x9d, 0x9f, 0x87, 0x06, 0xdb, 0xbb, 0x85,
-	0xdf, 0xba, 0x8e, 0x65, 0x0d, 0x0c, 0x16, 0x32, 0xc6, 0x12, 0x4c, 0x4b, 0x91, 0x46, 0x6a, 0x98,
-	0xac, 0x20, 0x1a, 0xec, 0x30, 0x89, 0x0c, 0x68, 0x51, 0x97, 0xb0, 0x6e, 0x06, 0xdb, 0xa2, 0x2f,
-	0xa2, 0xb4, 0x71, 0x0a, 0x1a, 0x62, 0x2f, 0x6a, 0x88, 0xc8, 0x6a, 0x2f, 0xea, 0x8c, 0x28, 0x6d,
-	0x7c, 0x0b, 0xda, 0x51, 0x40, 0x12, 0x3d, 0x86, 0x43, 0x22, 0x20, 0xc9, 0xf7, 0x53, 0x54, 0x78,
-	0xa6, 0xc0, 0x88, 0xe8, 0xe6, 0x89, 0xc5, 0x34, 0xbb, 0x4f, 0x0e, 0x46, 0x04, 0x2b, 0x04, 0xc6,
-	0x2f, 0x4e, 0xb3, 0x0e, 0x36, 0x46, 0xd0, 0x8a, 0xa2, 0x30, 0xc9, 0xce, 0xbe, 0xc6, 0x57, 0xc0,
-	0x6a, 0x61, 0x08, 0x91, 0xe3, 0xe9, 0x3a, 0xcb, 0x16, 0x4a, 0xe3, 0x35, 0xa8, 0x3d, 0x20, 0x07,
-	0x74, 0x22, 0xf0, 0xf5, 0x52, 0x4c, 0x04, 0xbe, 0x2e, 0xf6, 0xa0, 0x21, 0xa2, 0xa1, 0x49, 0x7d,
-	0x17, 0xa0, 0xb1, 0xc5, 0x03, 0xac, 0x05, 0x2b, 0xa3, 0x10, 0x33, 0xee, 0xc0, 0xb4, 0x1c, 0x03,
-	0x4d, 0xf2, 0x9d, 0x80, 0xe9, 0xbe, 0x14, 0x65, 0xe5, 0xc3, 0x20, 0x67, 0x19, 0x44, 0xb5, 0xba,
-	0x14, 0xc3, 0x4a, 0xa6, 0xb9, 0xbd, 0x99, 0xd9, 0xed, 0x63, 0x8c, 0xee, 0x01, 0x1c, 0x49, 0x06,
-	0x3b, 0x93, 0x9a, 0xce, 0xc1, 0x91, 0xcd, 0x44, 0x68, 0x95, 0x2f, 0x75, 0xc9, 0x6c, 0xa3, 0x07,
-	0x75, 0x1e, 0x8c, 0x4a, 0x52, 0xbc, 0x07, 0x75, 0x93, 0x05, 0xbb, 0x28, 0x70, 0x46, 0xda, 0xf2,
-	0xca, 0xb5, 0x64, 0x50, 0xcc, 0x05, 0x0d, 0x1b, 0x0e, 0xab, 0xf1, 0xad, 0x24, 0xe5, 0x2a, 0x1c,
-	0xde, 0x57, 0xe2, 0x68, 0x9c, 0x7a, 0x3e, 0x93, 0x5a, 0xa1, 0xc2, 0x2a, 0xd0, 0xf8, 0x83, 0x06,
-	0x68, 0x2c, 0x40, 0x9b, 0x54, 0x71, 0x15, 0xb4, 0x80, 0xbc, 0x08, 0x77, 0x62, 0xf3, 0x63, 0xa3,
-	0xbd, 0xfc, 0x94, 0xc0, 0xe4, 0xd1, 0xd7, 0xa0, 0xee, 0x07, 0x07, 0xc3, 0xf0, 0xb3, 0xc2, 0xc9,
-	0xf1, 0xc0, 0x0d, 0x2a, 0x8a, 0x39, 0x82, 0x42, 0xd9, 0x5c, 0x10, 0x1f, 0x14, 0x0a, 0xa0, 0x6c,
-	0x12, 0x62, 0x8e, 0x40, 0x77, 0xa0, 0xd9, 0xdf, 0x26, 0xfd, 0x1d, 0x62, 0x89, 0x2f, 0x09, 0xa7,
-	0xc7, 0x83, 0x97, 0xb8, 0x30, 0x0e, 0x51, 0x54, 0x77, 0x9f, 0x8d, 0x6e, 0xa3, 0x8c, 0x6e, 0x36,
-	0xe2, 0x98, 0x23, 0xd0, 0x0a, 0xb4, 0xed, 0xbe, 0xeb, 0xac, 0xec, 0xba, 0xdf, 0xb1, 0xc5, 0x27,
-	0x83, 0xb3, 0xe3, 0xe1, 0xbd, 0x50, 0x1c, 0xc7, 0xc8, 0x90, 0xa6, 0xb7, 0x4b, 0x77, 0xdd, 0xad,
-	0xb2, 0x34, 0x4c, 0x1c, 0xc7, 0x48, 0x63, 0x56, 0x8c, 0x67, 0xf6, 0x24, 0xbf, 0x07, 0x75, 0xd6,
-	0xe5, 0xe8, 0x96, 0x5c, 0x3c, 0x23, 0x69, 0xca, 0x5d, 0xb1, 0xc4, 0x50, 0x45, 0x3c, 0xac, 0xff,
-	0x55, 0x9e, 0xe9, 0x32, 0x3c, 0x62, 0xdc, 0x38, 0xcf, 0x1b, 0xd0, 0x14, 0x43, 0xa1, 0x56, 0xb8,
-	0x15, 0x0a, 0xbc, 0x0e, 0x75, 0x3e, 0x31, 0xb3, 0xdb, 0xf3, 0x26, 0xb4, 0xa3, 0xce, 0x1c, 0x2f,
-	0xc2, 0x7a, 0x27, 0x47, 0xc4, 0x81, 0x3a, 0x8f, 0x53, 0xa7, 0x57, 0x5a, 0x79, 0x12, 0x9c, 0x1c,
-	0x1f, 0xf6, 0x96, 0x66, 0x41, 0xc1, 0x28, 0xfc, 0xa0, 0x02, 0xb5, 0x65, 0x7b, 0x3f, 0xa5, 0xee,
-	0x7a, 0x38, 0x77, 0x8a, 0x26, 0xdd, 0xb2, 0xbd, 0xaf, 0x4c, 0x1d, 0x63, 0x25, 0x1c, 0xd7, 0x9b,
-	0xea, 0xb8, 0x9e, 0x19, 0xbf, 0x9d, 0x89, 0x69, 0x78, 0xc5, 0xfe, 0xac, 0x01, 0x1a, 0xfb, 0xd2,
-	0x92, 0xb5, 0x1a, 0x1c, 0x8c, 0x8a, 0x2b, 0xc6, 0xce, 0x85, 0xcc, 0xad, 0x31, 0x79, 0xbe, 0x1a,
-	0x98, 0x41, 0xf1, 0x6a, 0xc0, 0x8f, 0xa6, 0x54, 0x14, 0x73, 0x04, 0x55, 0xb9, 0x6b, 0xef, 0x12,
-	0xb1, 0x18, 0x14, 0xa8, 0x7c, 0x64, 0xef, 0x12, 0xcc, 0xe4, 0x29, 0x6e, 0xdb, 0xf4, 0xb7, 0xc5,
-	0x3a, 0x50, 0x80, 0x5b, 0x35, 0xfd, 0x6d, 0xcc, 0xe4, 0x29, 0xce, 0x31, 0x77, 0x89, 0x58, 0x00,
-	0x0a, 0x70, 0x6b, 0x26, 0xd5, 0x47, 0xe5, 0x29, 0xce, 0xb7, 0xbf, 0x47, 0xc4, 0xcc, 0x2f, 0xc0,
-	0x6d, 0xd8, 0xdf, 0x23, 0x98, 0xc9, 0xc7, 0x0b, 0x65, 0xab, 0x5c, 0xd7, 0x48, 0xa3, 0x3d, 0x0b,
-	0x1a, 0xad, 0x40, 0x8e, 0x75, 0xbd, 0x0e, 0xf5, 0xaf, 0xdb, 0x56, 0xb0, 0xad, 0x16, 0xd7, 0x95,
-	0x25, 0x80, 0x76, 0xf0, 0x44, 0x4b, 0x80, 0x3c, 0x3e, 0x9c, 0x67, 0x19, 0x34, 0x3a, 0xd0, 0x93,
-	0x59, 0x5c, 0x6c, 0x1f, 0x5f, 0x68, 0x41, 0x92, 0xbb, 0x84, 0xf3, 0xcc, 0x82, 0x46, 0xc7, 0x32,
-	0xa7, 0x4b, 0x66, 0x41, 0xa3, 0x16, 0x92, 0x5f, 0x4a, 0xc7, 0x45, 0x2d, 0xad, 0x85, 0xa5, 0x7f,
-	0xd7, 0x04, 0x8d, 0x7d, 0x38, 0x4c, 0xce, 0x89, 0xdf, 0x82, 0xc3, 0x01, 0x8b, 0xda, 0x2e, 0x8a,
-	0xad, 0x66, 0x35, 0xf3, 0xde, 0x80, 0xfa, 0x39, 0x52, 0x84, 0x82, 0x05, 0x04, 0xab, 0x0c, 0xe5,
-	0x9d, 0x27, 0xa3, 0x52, 0x9c, 0xe7, 0xcd, 0x68, 0x93, 0xa6, 0x15, 0x7c, 0xb5, 0x66, 0x58, 0xbe,
-	0xd5, 0x0b, 0x77, 0x6c, 0x68, 0x11, 0x5a, 0xd4, 0x85, 0xd0, 0x6e, 0x10, 0x13, 0xe7, 0xcc, 0x78,
-	0x7c, 0x4f, 0x48, 0xe3, 0x08, 0x47, 0x1d, 0x58, 0xdf, 0xf4, 0x2c, 0x56, 0x2b, 0x31, 0x8b, 0xce,
-	0x8e, 0x27, 0x59, 0x0a, 0xc5, 0x71, 0x8c, 0x44, 0x0f, 0x60, 0xda, 0x22, 0xd1, 0xb1, 0x57, 0x4c,
-	0xab, 0xb7, 0xc6, 0x13, 0x2d, 0xc7, 0x00, 0x2c, 0xa3, 0x69, 0x9d, 0xc2, 0xa3, 0x8e, 0x5f, 0xe8,
-	0x54, 0x19, 0x55, 0x7c, 0xb9, 0x27, 0x46, 0x1a, 0xa7, 0xe1, 0xb0, 0x32, 0x6e, 0x5f, 0xaa, 0x77,
-	0x95, 0xc7, 0x92, 0xf3, 0x5c, 0x8b, 0xb6, 0xe2, 0xef, 0xaa, 0xee, 0x35, 0x77, 0xe7, 0x2d, 0x80,
-	0x0f, 0xa1, 0x15, 0x0e, 0x0c, 0xba, 0xab, 0xd6, 0xe1, 0xed, 0xe2, 0x3a, 0x44, 0x63, 0x2a, 0xd8,
-	0xd6, 0xa0, 0x1d, 0x8d, 0x10, 0x3d, 0x27, 0xcb, 0x74, 0xef, 0x14, 0xd3, 0xc5, 0xa3, 0x2b, 0xf8,
-	0x30, 0x4c, 0x4b, 0x03, 0x85, 0x96, 0x54, 0xc6, 0x77, 0x8b, 0x19, 0xe5, 0x61, 0x8e, 0xbd, 0x7b,
-	0x34, 0x62, 0xf2, 0xa8, 0xd4, 0xe2, 0x51, 0xf9, 0x71, 0x13, 0x5a, 0xd1, 0xc7, 0xfa, 0x8c, 0xb3,
-	0xd4, 0x9e, 0x37, 0x2c, 0x3c, 0x4b, 0x85, 0xf8, 0xee, 0x53, 0x6f, 0x88, 0x29, 0x82, 0x0e, 0x71,
-	0x60, 0x07, 0xd1, 0x54, 0x3d, 0x5b, 0x0c, 0x7d, 0x42, 0xc5, 0x31, 0x47, 0xa1, 0xc7, 0xaa, 0x95,
-	0x6b, 0x63, 0x3e, 0xe6, 0x28, 0x24, 0xb9, 0x96, 0xde, 0x83, 0xb6, 0x4d, 0xb7, 0x38, 0xab, 0xb1,
-	0xef, 0x7b, 0xa7, 0x98, 0xae, 0x17, 0x42, 0x70, 0x8c, 0xa6, 0x75, 0xdb, 0x32, 0xf7, 0xe9, 0xbc,
-	0x66, 0x64, 0x8d, 0xb2, 0x75, 0xbb, 0x17, 0x83, 0xb0, 0xcc, 0x80, 0x6e, 0x88, 0xdd, 0x43, 0xb3,
-	0x60, 0x65, 0x89, 0xbb, 0x2a, 0xde, 0x41, 0x7c, 0x04, 0x33, 0x81, 0xf2, 0x6d, 0x4c, 0x4c, 0xe3,
-	0xf7, 0x4a, 0xb0, 0x28, 0x38, 0x9c, 0xe0, 0xa1, 0x23, 0xc8, 0xf7, 0x26, 0xed, 0xb2, 0x23, 0x28,
-	0xef, 0x4f, 0xe8, 0x61, 0xfa, 0xa9, 0x37, 0xcc, 0xf7, 0xc1, 0x6c, 0xb8, 0x73, 0x8a, 0x4f, 0xaa,
-	0x33, 0x21, 0x7f, 0xe3, 0x1a, 0x8d, 0x49, 0x2e, 0x8f, 0xd4, 0xe9, 0x39, 0x42, 0xb7, 0x84, 0xa3,
-	0xbe, 0xa2, 0xce, 0xb7, 0x37, 0x12, 0xf3, 0x8d, 0xce, 0xb0, 0x75, 0x8f, 0xf0, 0xef, 0x95, 0x92,
-	0x87, 0x3e, 0x03, 0x33, 0x6a, 0x47, 0xe6, 0xa8, 0xb9, 0x1f, 0xee, 0x2b, 0x26, 0x5a, 0x29, 0x92,
-	0x7d, 0xcb, 0xb9, 0xfe, 0xa8, 0x02, 0xad, 0xe8, 0x2e, 0x46, 0x3a, 0xd8, 0xdc, 0xb2, 0xfd, 0x55,
-	0x62, 0x5a, 0xc4, 0x13, 0xf3, 0xf6, 0xed, 0xc2, 0x4b, 0x1e, 0xdd, 0x9e, 0x40, 0xe0, 0x08, 0x6b,
-	0x9c, 0x80, 0x56, 0x98, 0x9b, 0x73, 0xf8, 0xf8, 0x79, 0x15, 0x1a, 0xe2, 0x16, 0x47, 0xb2, 0x12,
-	0xb7, 0xa1, 0x31, 0x34, 0x0f, 0xdc, 0xbd, 0xf0, 0x6c, 0x70, 0xa6, 0xe0, 0x62, 0x48, 0xf7, 0x21,
-	0x93, 0xc6, 0x02, 0x85, 0x3e, 0x80, 0xfa, 0xd0, 0xde, 0xb5, 0x03, 0xb1, 0x7c, 0x9c, 0x2e, 0x84,
-	0xb3, 0xef, 0x3d, 0x1c, 0x43, 0x95, 0xb3, 0x8f, 0xb7, 0xe1, 0xd5, 0xbb, 0x42, 0xe5, 0xcf, 0x98,
-	0x34, 0x16, 0x28, 0xe3, 0x3e, 0x34, 0x78, 0x75, 0x26, 0x73, 0x12, 0x6a, 0x4b, 0x62, 0x4b, 0x67,
-	0x75, 0xcb, 0xd9, 0x6d, 0xce, 0x41, 0x83, 0x2b, 0xcf, 0xb1, 0x9a, 0x9f, 0xbd, 0xca, 0x4e, 0x1c,
-	0x43, 0xe3, 0x61, 0xfc, 0x29, 0xe7, 0x8b, 0x87, 0xe6, 0x8d, 0x27, 0x70, 0x64, 0xd9, 0x0c, 0xcc,
-	0x4d, 0xd3, 0x27, 0x98, 0xf4, 0x5d, 0xcf, 0xca, 0x64, 0xf5, 0x78, 0x91, 0x08, 0xb8, 0xe6, 0xb3,
-	0x0a, 0xb9, 0xaf, 0x42, 0x64, 0xff, 0x7b, 0x42, 0x64, 0x7f, 0xab, 0xe5, 0xc4, 0xad, 0xca, 0x1c,
-	0xd9, 0xa9, 0xc1, 0xa5, 0x02, 0x57, 0x37, 0xd4, 0xbd, 0xf7, 0xa9, 0x02, 0xa4, 0xb2, 0xf9, 0xbe,
-	0xa1, 0x46, 0xae, 0x8a, 0xb0, 0x4a, 0xe8, 0xea, 0x6e, 0x32, 0x74, 0x75, 0xa6, 0x00, 0x9d, 0x8a,
-	0x5d, 0xdd, 0x50, 0x63, 0x57, 0x45, 0xda, 0xe5, 0xe0, 0xd5, 0xff, 0xb3, 0x70, 0xd1, 0x5f, 0xe4,
-	0x04, 0x5e, 0xbe, 0xa6, 0x06, 0x5e, 0xc6, 0x58, 0xcd, 0xaf, 0x2b, 0xf2, 0xf2, 0x97, 0x79, 0x91,
-	0x97, 0x6b, 0x4a, 0xe4, 0x65, 0x4c, 0xcd, 0x92, 0xa1, 0x97, 0x1b, 0x6a, 0xe8, 0xe5, 0x54, 0x01,
-	0x52, 0x89, 0xbd, 0x5c, 0x53, 0x62, 0x2f, 0x45, 0x4a, 0xa5, 0xe0, 0xcb, 0x35, 0x25, 0xf8, 0x52,
-	0x04, 0x94, 0xa2, 0x2f, 0xd7, 0x94, 0xe8, 0x4b, 0x11, 0x50, 0x0a, 0xbf, 0x5c, 0x53, 0xc2, 0x2f,
-	0x45, 0x40, 0x29, 0xfe, 0x72, 0x43, 0x8d, 0xbf, 0x14, 0xf7, 0xcf, 0x57, 0x01, 0x98, 0xdf, 0x4c,
-	0x00, 0xe6, 0x4f, 0x6a, 0x39, 0x01, 0x18, 0x9c, 0x1d, 0x80, 0x39, 0x9f, 0x3f, 0x92, 0xc5, 0x11,
-	0x98, 0xf2, 0x5e, 0x20, 0x1d, 0x82, 0xb9, 0x95, 0x08, 0xc1, 0x9c, 0x2e, 0x00, 0xab, 0x31, 0x98,
-	0xff, 0x33, 0x41, 0x86, 0xbf, 0x69, 0x8c, 0x39, 0x4f, 0x5f, 0x97, 0xcf, 0xd3, 0x63, 0x3c, 0x59,
-	0xfa, 0x40, 0x7d, 0x5b, 0x3d, 0x50, 0x9f, 0x2b, 0x81, 0x55, 0x4e, 0xd4, 0xeb, 0x59, 0x27, 0xea,
-	0x6e, 0x09, 0x96, 0xdc, 0x23, 0xf5, 0xfd, 0xf4, 0x91, 0xfa, 0x7c, 0x09, 0xbe, 0xcc, 0x33, 0xf5,
-	0x7a, 0xd6, 0x99, 0xba, 0x4c, 0xed, 0x72, 0x0f, 0xd5, 0x1f, 0x28, 0x87, 0xea, 0xb3, 0x65, 0xba,
-	0x2b, 0x76, 0x0e, 0xdf, 0xc8, 0x39, 0x55, 0xbf, 0x5f, 0x86, 0x66, 0xec, 0xb1, 0xfa, 0xab, 0x73,
-	0x71, 0x42, 0xcd, 0x2f, 0xe7, 0xa0, 0x15, 0xde, 0x1b, 0x31, 0xbe, 0x0b, 0xcd, 0xf0, 0xea, 0x7e,
-	0x72, 0xe6, 0x1c, 0x8f, 0x0e, 0x75, 0x7c, 0xf7, 0x2c, 0x52, 0xe8, 0x36, 0x68, 0xf4, 0x97, 0x98,
-	0x16, 0x6f, 0x97, 0xbb, 0x9f, 0x42, 0x95, 0x60, 0x86, 0x33, 0xfe, 0xfe, 0x18, 0x80, 0x74, 0xa3,
-	0xb9, 0xac, 0xda, 0x0f, 0xe9, 0x62, 0x36, 0x0c, 0x88, 0xc7, 0xee, 0x25, 0x15, 0xde, 0xf8, 0x8d,
-	0x35, 0x50, 0x6b, 0x09, 0x88, 0x87, 0x05, 0x1c, 0x3d, 0x82, 0x56, 0x18, 0x48, 0xd5, 0x35, 0x46,
-	0xf5, 0x7e, 0x69, 0xaa, 0x30, 0xb4, 0x87, 0x23, 0x0a, 0xb4, 0x00, 0x9a, 0xef, 0x7a, 0x81, 0x5e,
-	0x67, 0x54, 0xef, 0x96, 0xa6, 0xda, 0x70, 0xbd, 0x00, 0x33, 0x28, 0x6f, 0x9a, 0xf4, 0x60, 0x6c,
-	0x92, 0xa6, 0x29, 0x2b, 0xf6, 0x4f, 0x6b, 0xd1, 0x1a, 0xba, 0x24, 0x66, 0x23, 0xb7, 0xa1, 0x0b,
-	0xe5, 0x47, 0x49, 0x9e, 0x95, 0x48, 0x6c, 0x82, 0xf8, 0x48, 0xf0, 0xfd, 0xcd, 0xdb, 0xd0, 0xe9,
-	0xbb, 0xfb, 0xc4, 0xc3, 0xf1, 0x8d, 0x1d, 0x71, 0xa9, 0x2a, 0x95, 0x8f, 0x0c, 0x68, 0x6d, 0xdb,
-	0x16, 0xe9, 0xf5, 0xc5, 0xfa, 0xd7, 0xc2, 0x51, 0x1a, 0x3d, 0x80, 0x16, 0x8b, 0xb1, 0x87, 0x11,
-	0xfe, 0xc9, 0x2a, 0xc9, 0x43, 0xfd, 0x21, 0x01, 0x55, 0xc4, 0x94, 0xdf, 0xb3, 0x03, 0xd6, 0x87,
-	0x2d, 0x1c, 0xa5, 0x69, 0x85, 0xd9, 0xb5, 0x28, 0xb9, 0xc2, 0x4d, 0x5e, 0xe1, 0x64, 0x3e, 0xba,
-	0x0c, 0x2f, 0xb3, 0xbc, 0xc4, 0x11, 0x93, 0x87, 0xea, 0x5b, 0x38, 0xbb, 0x90, 0x5d, 0x03, 0x33,
-	0x07, 0xfc, 0x0a, 0x2c, 0x0b, 0xde, 0xd5, 0x71, 0x9c, 0x81, 0xce, 0xc3, 0x51, 0x8b, 0x6c, 0x99,
-	0x7b, 0xc3, 0xe0, 0x09, 0xd9, 0x1d, 0x0d, 0xcd, 0x80, 0xf4, 0x2c, 0xf6, 0x66, 0xad, 0x8d, 0xd3,
-	0x05, 0xc6, 0xcf, 0x34, 0x3a, 0x84, 0xcc, 0x50, 0x3f, 0x84, 0x9a, 0x69, 0x59, 0xc2, 0x09, 0x5e,
-	0x9a, 0xd0, 0xdc, 0xc5, 0x23, 0x4b, 0xca, 0x80, 0xd6, 0xa3, 0xfb, 0x60, 0xdc, 0x0d, 0x5e, 0x9d,
-	0x94, 0x2b, 0x7a, 0x18, 0x2b, 0x78, 0x28, 0xe3, 0x1e, 0xbf, 0xf5, 0x5c, 0xfb, 0xd5, 0x18, 0xa3,
-	0xeb, 0xd0, 0x82, 0x07, 0xdd, 0x07, 0x8d, 0xd5, 0x90, 0xbb, 0xc9, 0xcb, 0x93, 0xf2, 0x3d, 0xe2,
-	0xf5, 0x63, 0x1c, 0x46, 0x9f, 0xdf, 0xd8, 0x92, 0x6e, 0x03, 0x56, 0xd4, 0xdb, 0x80, 0x8b, 0x50,
-	0xb7, 0x03, 0xb2, 0x9b, 0xbe, 0x1c, 0x3a, 0xd6, 0xf0, 0xc4, 0x3a, 0xc2, 0xa1, 0x63, 0x2f, 0xa9,
-	0x7d, 0x1c, 0xdd, 0x93, 0x4d, 0xae, 0x6e, 0x77, 0x41, 0xa3, 0xf0, 0xd4, 0xce, 0xb0, 0x8c, 0x62,
-	0x86, 0x34, 0x2e, 0x82, 0x46, 0x1b, 0x3b, 0xa6, 0x75, 0xa2, 0x3e, 0xd5, 0xa8, 0x3e, 0x8b, 0xd3,
-	0xd0, 0x76, 0x47, 0xc4, 0x63, 0x66, 0x6e, 0xfc, 0x42, 0x93, 0xae, 0x72, 0xf5, 0x64, 0x1b, 0xbb,
-	0x32, 0xf1, 0x3a, 0x28, 0x5b, 0x19, 0x4e, 0x58, 0xd9, 0xf5, 0xc9, 0xd9, 0x52, 0x76, 0x86, 0x13,
-	0x76, 0xf6, 0x2b, 0x70, 0xa6, 0x2c, 0xed, 0xa1, 0x62, 0x69, 0x57, 0x27, 0x67, 0x54, 0x6c, 0x8d,
-	0x14, 0xd9, 0xda, 0xb2, 0x6a, 0x6b, 0xdd, 0x72, 0x43, 0x1e, 0x39, 0x9a, 0x12, 0xd6, 0xf6, 0xad,
-	0x5c, 0x6b, 0x5b, 0x54, 0xac, 0x6d, 0x52, 0xd5, 0x5f, 0x92, 0xbd, 0xfd, 0x9b, 0x06, 0x1a, 0x75,
-	0x76, 0x68, 0x45, 0xb6, 0xb5, 0xf7, 0x27, 0x72, 0x94, 0xb2, 0x9d, 0xad, 0x25, 0xec, 0xec, 0xf2,
-	0x64, 0x4c, 0x29, 0x1b, 0x5b, 0x4b, 0xd8, 0xd8, 0x84, 0x7c, 0x29, 0xfb, 0x5a, 0x55, 0xec, 0xeb,
-	0xe2, 0x64, 0x6c, 0x8a, 0x6d, 0x99, 0x45, 0xb6, 0x75, 0x57, 0xb5, 0xad, 0x92, 0x7b, 0x31, 0xb6,
-	0xf3, 0x28, 0x61, 0x57, 0x1f, 0xe5, 0xda, 0xd5, 0x6d, 0xc5, 0xae, 0x26, 0x51, 0xfb, 0x25, 0xd9,
-	0xd4, 0x65, 0xbe, 0x85, 0x14, 0xb7, 0x63, 0x4b, 0x6e, 0x21, 0x8d, 0x2b, 0xd0, 0x8e, 0x1f, 0x78,
-	0x66, 0xdc, 0x1d, 0xe7, 0x62, 0xa1, 0xd6, 0x30, 0x69, 0x5c, 0x82, 0x76, 0xfc, 0x68, 0x33, 0x43,
-	0x97, 0xcf, 0x0a, 0x05, 0x4a, 0xa4, 0x8c, 0x15, 0x38, 0x9a, 0x7e, 0x52, 0x96, 0x11, 0x55, 0x97,
-	0x2e, 0x3e, 0x8b, 0xda, 0xca, 0x59, 0xc6, 0x73, 0x98, 0x49, 0x3c, 0x12, 0x9b, 0x98, 0x03, 0x5d,
-	0x92, 0x36, 0xbc, 0x35, 0x71, 0xa2, 0xce, 0xbe, 0xca, 0x1d, 0x6f, 0x6b, 0x8d, 0x65, 0x98, 0x29,
-	0xa8, 0x7c, 0x99, 0x9b, 0xdc, 0x9f, 0xc0, 0xf4, 0xb8, 0xba, 0x7f, 0x09, 0x37, 0xcd, 0x03, 0xe8,
-	0xa4, 0x1e, 0xb8, 0x26, 0xd5, 0xac, 0x03, 0x0c, 0x22, 0x19, 0x61, 0xb4, 0xef, 0x4d, 0x70, 0xaf,
-	0x9e, 0xe1, 0xb0, 0xc4, 0x61, 0xfc, 0xb0, 0x02, 0x47, 0xd3, 0xaf, 0x5b, 0xcb, 0x1e, 0x65, 0x74,
-	0x68, 0x32, 0xae, 0xe8, 0x39, 0x42, 0x98, 0x44, 0x8f, 0xe0, 0x90, 0x3f, 0xb4, 0xfb, 0x64, 0x69,
-	0xdb, 0x74, 0x06, 0xc4, 0x17, 0xe7, 0x93, 0x82, 0x17, 0xaa, 0x1b, 0x31, 0x02, 0x2b, 0x70, 0xe3,
-	0x39, 0x4c, 0x4b, 0x85, 0xe8, 0x26, 0x54, 0xdd, 0x91, 0x38, 0x11, 0x9c, 0x2f, 0xc1, 0xf9, 0x38,
-	0x9c, 0x6f, 0xb8, 0xea, 0x8e, 0xd2, 0x53, 0x52, 0x9e, 0xbe, 0x35, 0x65, 0xfa, 0x1a, 0x0f, 0xe0,
-	0x68, 0xfa, 0x01, 0x69, 0xb2, 0x7b, 0xce, 0xa4, 0xce, 0xfc, 0xbc, 0x9b, 0x92, 0x07, 0xf8, 0x6b,
-	0x70, 0x24, 0xf9, 0x2c, 0x34, 0xe3, 0xa9, 0x48, 0xfc, 0xe2, 0x26, 0x0c, 0xbe, 0xcf, 0xff, 0x71,
-	0x05, 0x66, 0xd4, 0x86, 0xa0, 0xe3, 0x80, 0xd4, 0x9c, 0x35, 0xd7, 0x21, 0x9d, 0x29, 0xf4, 0x32,
-	0x1c, 0x55, 0xf3, 0x17, 0x2c, 0xab, 0x53, 0x49, 0x8b, 0xd3, 0x65, 0xab, 0x53, 0x45, 0x3a, 0x1c,
-	0x4b, 0xf4, 0x10, 0x5b, 0x44, 0x3b, 0x35, 0xf4, 0x2a, 0xbc, 0x9c, 0x2c, 0x19, 0x0d, 0xcd, 0x3e,
-	0xe9, 0x68, 0xc6, 0x7f, 0x57, 0x41, 0x7b, 0xea, 0x13, 0xcf, 0xf8, 0xcf, 0x6a, 0xf8, 0xb6, 0xe0,
-	0x3a, 0x68, 0xec, 0xc5, 0xa6, 0xf4, 0xd2, 0xac, 0x92, 0x78, 0x69, 0xa6, 0xfc, 0xbb, 0xa6, 0xf8,
-	0xa5, 0xd9, 0x75, 0xd0, 0xd8, 0x1b, 0xcd, 0xc9, 0x91, 0x7f, 0x58, 0x81, 0x76, 0xfc, 0x5e, 0x72,
-	0x62, 0xbc, 0xfc, 0x96, 0xa1, 0xaa, 0xbe, 0x65, 0x78, 0x1b, 0xea, 0x1e, 0x7b, 0x75, 0xc0, 0x57,
-	0x99, 0xe4, 0x0b, 0x09, 0xa6, 0x10, 0x73, 0x11, 0x83, 0xc0, 0xb4, 0xfc, 0x1a, 0x74, 0xf2, 0x6a,
-	0x9c, 0x12, 0xff, 0x0a, 0xa2, 0x67, 0xf9, 0x0b, 0x9e, 0x67, 0x1e, 0x08, 0xc3, 0x54, 0x33, 0x8d,
-	0x59, 0xd0, 0xd6, 0x6d, 0x67, 0x90, 0xfd, 0xc0, 0xcf, 0xf8, 0x49, 0x05, 0x9a, 0xe2, 0x6d, 0xa5,
-	0x71, 0x0d, 0x6a, 0x6b, 0xe4, 0x39, 0xad, 0x88, 0x78, 0x5d, 0x99, 0xaa, 0xc8, 0x23, 0xd6, 0x0a,
-	0x21, 0x8f, 0x43, 0x31, 0xe3, 0x46, 0xe4, 0x26, 0x27, 0xc7, 0x5e, 0x07, 0x8d, 0x3d, 0xe2, 0x9c,
-	0x1c, 0xf9, 0x57, 0x2d, 0x68, 0xf0, 0x57, 0x72, 0xc6, 0x0f, 0x5a, 0xd0, 0xe0, 0x0f, 0x3b, 0xd1,
-	0x6d, 0x68, 0xfa, 0x7b, 0xbb, 0xbb, 0xa6, 0x77, 0xa0, 0x67, 0xff, 0x2f, 0x31, 0xe5, 0x1d, 0x68,
-	0x77, 0x83, 0xcb, 0xe2, 0x10, 0x84, 0xae, 0x80, 0xd6, 0x37, 0xb7, 0x48, 0xea, 0xe3, 0x6c, 0x16,
-	0x78, 0xc9, 0xdc, 0x22, 0x98, 0x89, 0xa3, 0xbb, 0xd0, 0x12, 0xc3, 0xe2, 0x8b, 0xe8, 0xcc, 0x78,
-	0xbd, 0xe1, 0x60, 0x46, 0x28, 0xe3, 0x3e, 0x34, 0x45, 0x65, 0xd0, 0x9d, 0xe8, 0x8d, 0x60, 0x32,
-	0x8e, 0x9c, 0xd9, 0x84, 0x03, 0xa7, 0x9f, 0x78, 0x2d, 0xf8, 0x8f, 0x55, 0xd0, 0x68, 0xe5, 0xbe,
-	0x30, 0x13, 0x9a, 0x03, 0x18, 0x9a, 0x7e, 0xb0, 0xbe, 0x37, 0x1c, 0x12, 0x4b, 0x3c, 0xff, 0x92,
-	0x72, 0xd0, 0x39, 0x38, 0xc2, 0x53, 0xfe, 0xf6, 0xc6, 0x5e, 0xbf, 0x4f, 0x88, 0x25, 0x5e, 0x5c,
-	0x25, 0xb3, 0xd1, 0x02, 0xd4, 0xd9, 0xbf, 0x1a, 0x12, 0xbb, 0xc2, 0x77, 0x0a, 0x7b, 0xb6, 0xbb,
-	0x6e, 0x3b, 0xa2, 0x36, 0x1c, 0x69, 0xb8, 0xd0, 0x8e, 0xf2, 0xe8, 0x24, 0x1c, 0xd9, 0x8e, 0x63,
-	0x3b, 0x03, 0x61, 0xd1, 0x61, 0x92, 0x3a, 0x1d, 0xfa, 0x53, 0xd4, 0xb7, 0x8e, 0x45, 0x8a, 0xe6,
-	0x6f, 0x99, 0xf6, 0x50, 0x54, 0xb1, 0x8e, 0x45, 0x8a, 0x32, 0xf1, 0x8d, 0x2b, 0xbf, 0xbc, 0x51,
-	0xc3, 0x61, 0xd2, 0xf8, 0xac, 0x12, 0x3d, 0x94, 0xcd, 0x7a, 0x39, 0x98, 0x8a, 0x0c, 0xcd, 0xca,
-	0xe1, 0x69, 0xee, 0x10, 0xa4, 0x80, 0xf3, 0x71, 0x68, 0xb8, 0xce, 0xd0, 0x76, 0x88, 0x88, 0x04,
-	0x89, 0x54, 0xa2, 0x8f, 0xeb, 0xa9, 0x3e, 0x16, 0xe5, 0x2b, 0x96, 0x4d, 0xab, 0xd8, 0x88, 0xcb,
-	0x79, 0x0e, 0xba, 0x05, 0x4d, 0x8b, 0xec, 0xdb, 0x7d, 0xe2, 0xeb, 0x4d, 0x66, 0x7a, 0x27, 0xc7,
-	0xf6, 0xed, 0x32, 0x93, 0xc5, 0x21, 0xc6, 0x08, 0xa0, 0xc1, 0xb3, 0xa2, 0x26, 0x55, 0xa4, 0x26,
-	0xc5, 0x95, 0xae, 0x8e, 0xa9, 0x74, 0xad, 0xa0, 0xd2, 0x5a, 0xb2, 0xd2, 0xf3, 0x16, 0x40, 0x6c,
-	0x6e, 0x68, 0x1a, 0x9a, 0x4f, 0x9d, 0x1d, 0xc7, 0x7d, 0xee, 0x74, 0xa6, 0x68, 0xe2, 0xf1, 0xd6,
-	0x16, 0xd5, 0xd2, 0xa9, 0xd0, 0x04, 0x95, 0xb3, 0x9d, 0x41, 0xa7, 0x8a, 0x00, 0x1a, 0x34, 0x41,
-	0xac, 0x4e, 0x8d, 0xfe, 0xbe, 0xc7, 0xc6, 0xaf, 0xa3, 0xa1, 0x57, 0xe0, 0xa5, 0x9e, 0xd3, 0x77,
-	0x77, 0x47, 0x66, 0x60, 0x6f, 0x0e, 0xc9, 0x33, 0xe2, 0xf9, 0xb6, 0xeb, 0x74, 0xea, 0xc6, 0x8f,
-	0x2a, 0xfc, 0x1b, 0xae, 0x71, 0x17, 0x0e, 0x29, 0xef, 0xb3, 0x75, 0x68, 0xfa, 0x23, 0xfe, 0x1f,
-	0x13, 0xc5, 0xbe, 0x5b, 0x24, 0x99, 0x95, 0xf0, 0x27, 0xcb, 0x62, 0xcb, 0xc2, 0x53, 0xc6, 0x79,
-	0x00, 0xe9, 0x55, 0xf6, 0x1c, 0xc0, 0xe6, 0x41, 0x40, 0x7c, 0xfe, 0x22, 0x9b, 0x52, 0x68, 0x58,
-	0xca, 0x31, 0xae, 0x02, 0xc4, 0x2f, 0xaf, 0xd9, 0x2c, 0xa1, 0xa9, 0xc5, 0x24, 0x24, 0x99, 0x3d,
-	0xff, 0x7d, 0x38, 0x8c, 0x89, 0x3f, 0x72, 0x1d, 0x9f, 0xfc, 0xba, 0xfe, 0xc5, 0x64, 0xee, 0x3f,
-	0x8b, 0x9c, 0xff, 0x49, 0x0d, 0xea, 0x6c, 0xb1, 0x35, 0x7e, 0x54, 0x8b, 0xdc, 0x42, 0xc6, 0xc5,
-	0x9a, 0xf8, 0xf3, 0xf7, 0x8c, 0xb4, 0x53, 0x55, 0x96, 0x69, 0x39, 0x86, 0x7a, 0x51, 0xfe, 0xec,
-	0x3d, 0x23, 0x3d, 0xcc, 0x57, 0x11, 0xca, 0xe7, 0xee, 0x0f, 0xa0, 0x35, 0xf2, 0xdc, 0x81, 0x47,
-	0xfd, 0x81, 0x96, 0xf8, 0x3f, 0x3f, 0x2a, 0x6c, 0x5d, 0x88, 0xe1, 0x08, 0x60, 0xac, 0x41, 0x2b,
-	0xcc, 0xcd, 0x79, 0xd4, 0x8a, 0x40, 0xb3, 0x5c, 0x61, 0xd3, 0x35, 0xcc, 0x7e, 0xd3, 0x7e, 0x11,
-	0x3d, 0x18, 0xee, 0xe5, 0x44, 0x72, 0xfe, 0xdb, 0xe2, 0xb3, 0xc4, 0x61, 0x68, 0x2f, 0x7b, 0xee,
-	0x88, 0x3d, 0x6b, 0xec, 0x4c, 0x51, 0x0b, 0xec, 0xed, 0x8e, 0x5c, 0x2f, 0xe8, 0x54, 0xe8, 0xef,
-	0x95, 0x17, 0xec, 0x77, 0x15, 0x1d, 0x82, 0xd6, 0x86, 0xb9, 0x4f, 0xa8, 0x58, 0xa7, 0x86, 0x10,
-	0x3d, 0x46, 0xb0, 0x50, 0xac, 0x58, 0x49, 0x3a, 0x1a, 0x25, 0x7a, 0x64, 0x0f, 0xf8, 0xee, 0xa8,
-	0x53, 0x9f, 0x5f, 0x08, 0x3f, 0x3f, 0xb7, 0x40, 0x13, 0xbb, 0xb1, 0x69, 0x68, 0xe2, 0x3d, 0xb6,
-	0x9c, 0x75, 0x2a, 0x34, 0x9b, 0xfa, 0x48, 0x4e, 0xbd, 0x64, 0x3a, 0x7d, 0x32, 0x64, 0x53, 0xa0,
-	0x0d, 0xf5, 0x15, 0xcf, 0x73, 0xbd, 0x8e, 0xb6, 0x38, 0xfb, 0x4f, 0x9f, 0xcd, 0x55, 0x3e, 0xfd,
-	0x6c, 0xae, 0xf2, 0xf3, 0xcf, 0xe6, 0x2a, 0x7f, 0xfa, 0xf9, 0xdc, 0xd4, 0xa7, 0x9f, 0xcf, 0x4d,
-	0xfd, 0xfb, 0xe7, 0x73, 0x53, 0x1f, 0x57, 0x47, 0x9b, 0x9b, 0x0d, 0xf6, 0xdd, 0xf0, 0xd2, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x56, 0x47, 0xb4, 0x1d, 0x20, 0x55, 0x00, 0x00,
+	// 5361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4b, 0x8c, 0xdc, 0xc8,
+	0x79, 0xff, 0xf4, 0xbb, 0xfb, 0x1b, 0x69, 0xd4, 0xaa, 0x95, 0xb4, 0x34, 0x77, 0x56, 0xab, 0xd5,
+	0x6a, 0x25, 0xad, 0x56, 0xdb, 0x5a, 0x8f, 0x9e, 0x96, 0xf5, 0x9a, 0x97, 0x3c, 0xa3, 0xf7, 0xbf,
+	0x46, 0x92, 0xed, 0xb5, 0xf1, 0x8f, 0x39, 0xcd, 0x9a, 0x19, 0x5a, 0x3d, 0x64, 0x9b, 0x64, 0x8f,
+	0x34, 0x76, 0x5e, 0x48, 0x7c, 0x4c, 0x80, 0xe4, 0x62, 0xe7, 0x90, 0x4b, 0x80, 0xe4, 0x10, 0xc0,
+	0x08, 0x0c, 0xe4, 0xe2, 0x7b, 0x12, 0xc0, 0x4e, 0x2e, 0x4e, 0x4e, 0xb9, 0xd9, 0x58, 0x03, 0x49,
+	0x2e, 0x39, 0xe4, 0x92, 0x8b, 0x2f, 0xc1, 0x57, 0x55, 0x24, 0xab, 0xd8, 0x64, 0x93, 0xed, 0x5d,
+	0xc3, 0x01, 0xb2, 0x17, 0x69, 0xaa, 0xea, 0xfb, 0xfd, 0xbe, 0x7a, 0x7c, 0xf5, 0xfa, 0x58, 0x5f,
+	0xc3, 0xb1, 0xe1, 0xe6, 0x85, 0xa1, 0xef, 0x85, 0x5e, 0x70, 0x81, 0xed, 0x31, 0x37, 0x0c, 0x7a,
+	0x3c, 0x45, 0x5a, 0x96, 0xbb, 0x1f, 0xee, 0x0f, 0x99, 0x79, 0x6a, 0xf8, 0x62, 0xfb, 0xc2, 0xc0,
+	0xd9, 0xbc, 0x30, 0xdc, 0xbc, 0xb0, 0xeb, 0xd9, 0x6c, 0x10, 0x89, 0xf3, 0x84, 0x14, 0x37, 0xe7,
+	0xb7, 0x3d, 0x6f, 0x7b, 0xc0, 0x44, 0xd9, 0xe6, 0x68, 0xeb, 0x42, 0x10, 0xfa, 0xa3, 0x7e, 0x28,
+	0x4a, 0x4f, 0xfe, 0xf2, 0x07, 0x15, 0x68, 0xac, 0x22, 0x3d, 0x59, 0x80, 0xf6, 0x2e, 0x0b, 0x02,
+	0x6b, 0x9b, 0x05, 0x46, 0xe5, 0x44, 0xed, 0xec, 0xec, 0xc2, 0xb1, 0x9e, 0x54, 0xd5, 0xe3, 0x12,
+	0xbd, 0x87, 0xa2, 0x98, 0xc6, 0x72, 0x64, 0x1e, 0x3a, 0x7d, 0xcf, 0x0d, 0xd9, 0xab, 0x70, 0xdd,
+	0x36, 0xaa, 0x27, 0x2a, 0x67, 0x3b, 0x34, 0xc9, 0x20, 0x97, 0xa0, 0xe3, 0xb8, 0x4e, 0xe8, 0x58,
+	0xa1, 0xe7, 0x1b, 0xb5, 0x13, 0x15, 0x8d, 0x92, 0x57, 0xb2, 0xb7, 0xd8, 0xef, 0x7b, 0x23, 0x37,
+	0xa4, 0x89, 0x20, 0x31, 0xa0, 0x15, 0xfa, 0x56, 0x9f, 0xad, 0xdb, 0x46, 0x9d, 0x33, 0x46, 0x49,
+	0xf3, 0x47, 0xe7, 0xa0, 0x25, 0xeb, 0x40, 0x6e, 0xc3, 0xac, 0x25, 0xb0, 0x1b, 0x3b, 0xde, 0x4b,
+	0xa3, 0xc2, 0xd9, 0xdf, 0x48, 0x55, 0x58, 0xb2, 0xf7, 0x50, 0x64, 0x6d, 0x86, 0xaa, 0x08, 0xb2,
+	0x0e, 0x73, 0x32, 0xb9, 0xc2, 0x42, 0xcb, 0x19, 0x04, 0xc6, 0x4f, 0x04, 0xc9, 0xf1, 0x1c, 0x12,
+	0x29, 0xb6, 0x36, 0x43, 0x53, 0x40, 0xf2, 0x55, 0x78, 0x4d, 0xe6, 0x2c, 0x7b, 0xee, 0x96, 0xb3,
+	0xfd, 0x6c, 0x68, 0x5b, 0x21, 0x33, 0xfe, 0x51, 0xf0, 0x9d, 0xca, 0xe1, 0x13, 0xb2, 0x3d, 0x21,
+	0xbc, 0x36, 0x43, 0xb3, 0x38, 0xc8, 0x5d, 0x38, 0x28, 0xb3, 0x25, 0xe9, 0x3f, 0x09, 0xd2, 0x37,
+	0x73, 0x48, 0x63, 0x36, 0x1d, 0x46, 0x1e, 0x43, 0xd7, 0xdb, 0xfc, 0x26, 0xeb, 0x47, 0x75, 0xde,
+	0x60, 0xa1, 0xd1, 0xe5, 0x4c, 0x6f, 0xa7, 0x98, 0x1e, 0x73, 0xb1, 0xa8, 0xb5, 0xbd, 0x0d, 0x16,
+	0xae, 0xcd, 0xd0, 0x31, 0x30, 0x79, 0x06, 0x44, 0xcb, 0x5b, 0xdc, 0x65, 0xae, 0x6d, 0x2c, 0x70,
+	0xca, 0x77, 0x26, 0x53, 0x72, 0xd1, 0xb5, 0x19, 0x9a, 0x41, 0x30, 0x46, 0xfb, 0xcc, 0x0d, 0x58,
+	0x68, 0x5c, 0x2c, 0x43, 0xcb, 0x45, 0xc7, 0x68, 0x79, 0x2e, 0xf9, 0x1a, 0x1c, 0x11, 0xb9, 0x94,
+	0x0d, 0xac, 0xd0, 0xf1, 0x5c, 0x59, 0xdf, 0x4b, 0x9c, 0xf8, 0xdd, 0x6c, 0xe2, 0x58, 0x36, 0xae,
+	0x71, 0x26, 0x09, 0xf9, 0xff, 0x70, 0x34, 0x95, 0x4f, 0xd9, 0xae, 0xb7, 0xc7, 0x8c, 0xcb, 0x9c,
+	0xfd, 0x74, 0x11, 0xbb, 0x90, 0x5e, 0x9b, 0xa1, 0xd9, 0x34, 0x64, 0x09, 0x0e, 0x44, 0x05, 0x9c,
+	0xf6, 0x0a, 0xa7, 0x9d, 0xcf, 0xa3, 0x95, 0x64, 0x1a, 0x46, 0xad, 0x63, 0x10, 0xfa, 0x4e, 0x9f,
+	0xf3, 0xa3, 0x11, 0x5c, 0x9d, 0x5c, 0xc7, 0x44, 0x58, 0x5a, 0x42, 0x36, 0x4d, 0xc2, 0xbf, 0xb1,
+	0xef, 0xf6, 0x99, 0xbd, 0x34, 0xf0, 0xfa, 0x2f, 0x38, 0xff, 0xb5, 0x49, 0xfc, 0xaa, 0xb0, 0xce,
+	0x9f, 0xa2, 0x21, 0x14, 0x0e, 0x05, 0xa3, 0xcd, 0xa0, 0xef, 0x3b, 0x43, 0xd4, 0xb9, 0x68, 0xdb,
+	0xc6, 0x8d, 0x89, 0xcc, 0x8a, 0x70, 0x6f, 0xd1, 0xc6, 0xc1, 0x4b, 0x13, 0x90, 0xaf, 0x01, 0x51,
+	0xb3, 0x64, 0xef, 0xde, 0xe4, 0xb4, 0xef, 0x95, 0xa0, 0x8d, 0xbb, 0x3a, 0x83, 0x86, 0x58, 0x70,
+	0x44, 0xcd, 0x7d, 0xe2, 0x05, 0x0e, 0xfe, 0x6f, 0xdc, 0xe2, 0xf4, 0xef, 0x97, 0xa0, 0x8f, 0x20,
+	0x68, 0x77, 0x59, 0x54, 0x69, 0x15, 0xcb, 0x38, 0xdd, 0x99, 0x1f, 0x18, 0xb7, 0x4b, 0xab, 0x88,
+	0x20, 0x69, 0x15, 0x51, 0x7e, 0xba, 0x8b, 0xbe, 0xe4, 0x7b, 0xa3, 0x61, 0x60, 0xdc, 0x29, 0xdd,
+	0x45, 0x02, 0x90, 0xee, 0x22, 0x91, 0x4b, 0xbe, 0x09, 0xaf, 0x6b, 0x4a, 0xad, 0x01, 0x73, 0x6d,
+	0xcb, 0x5f, 0xb1, 0xf6, 0x8d, 0x45, 0xae, 0xa1, 0x57, 0xa6, 0x09, 0x09, 0x6a, 0x6d, 0x86, 0xe6,
+	0x11, 0x92, 0x2b, 0xd0, 0xde, 0x44, 0x63, 0x5a, 0xb4, 0xc5, 0x3e, 0x35, 0xbb, 0x60, 0xa4, 0xc8,
+	0xb9, 0xad, 0x49, 0x53, 0x89, 0x65, 0x71, 0x9b, 0xe1, 0x7f, 0xaf, 0xb0, 0x01, 0x0b, 0x99, 0x51,
+	0xcb, 0xdc, 0x66, 0x04, 0x54, 0x88, 0xe0, 0x36, 0xa3, 0x20, 0xc8, 0x0a, 0xcc, 0x6e, 0x39, 0x03,
+	0x16, 0x3c, 0x1b, 0x0e, 0x3c, 0x4b, 0xec, 0x68, 0xb3, 0x0b, 0x27, 0x32, 0x09, 0xee, 0x26, 0x72,
+	0xc8, 0xa2, 0xc0, 0xc8, 0x2d, 0xe8, 0xec, 0x5a, 0xfe, 0x8b, 0x60, 0xdd, 0xdd, 0xf2, 0x8c, 0x46,
+	0xe6, 0x36, 0x25, 0x38, 0x1e, 0x46, 0x52, 0x6b, 0x33, 0x34, 0x81, 0xe0, 0x66, 0xc7, 0x2b, 0xb5,
+	0xc1, 0xc2, 0xbb, 0x0e, 0x1b, 0xd8, 0x81, 0xd1, 0xe4, 0x24, 0x6f, 0x65, 0x92, 0x6c, 0xb0, 0xb0,
+	0x27, 0xc4, 0x70, 0xb3, 0xd3, 0x81, 0xe4, 0x2b, 0xf0, 0x5a, 0x94, 0xb3, 0xbc, 0xe3, 0x0c, 0x6c,
+	0x9f, 0xb9, 0xeb, 0x76, 0x60, 0xb4, 0x32, 0xf7, 0xba, 0x84, 0x4f, 0x91, 0xc5, 0xbd, 0x2e, 0x83,
+	0x02, 0x17, 0xe9, 0x28, 0x5b, 0x5d, 0x5e, 0x8c, 0x76, 0xe6, 0x22, 0x9d, 0x50, 0xab, 0xc2, 0x68,
+	0xc9, 0x59, 0x24, 0xc4, 0x86, 0xd7, 0xa3, 0xfc, 0x25, 0xab, 0xff, 0x62, 0xdb, 0xf7, 0x46, 0xae,
+	0xbd, 0xec, 0x0d, 0x3c, 0xdf, 0xe8, 0x70, 0xfe, 0xb3, 0xb9, 0xfc, 0x29, 0x79, 0x34, 0xb3, 0x1c,
+	0x2a, 0xb2, 0x0c, 0x07, 0xa2, 0xa2, 0xa7, 0xec, 0x55, 0x68, 0x40, 0xe6, 0x66, 0x9d, 0x50, 0xa3,
+	0x10, 0xae, 0xd5, 0x2a, 0x48, 0x25, 0x41, 0x93, 0x30, 0x66, 0x0b, 0x48, 0x50, 0x48, 0x25, 0xc1,
+	0xb4, 0x4a, 0xf2, 0xc0, 0x71, 0x5f, 0x18, 0x07, 0x0b, 0x48, 0x50, 0x48, 0x25, 0xc1, 0x34, 0x9e,
+	0x1a, 0xe2, 0x96, 0x7a, 0xde, 0x0b, 0xb4, 0x27, 0x63, 0x2e, 0xf3, 0xd4, 0xa0, 0xf4, 0x96, 0x14,
+	0xc4, 0x53, 0x43, 0x1a, 0x8c, 0xc7, 0x99, 0x28, 0x6f, 0x71, 0xe0, 0x6c, 0xbb, 0xc6, 0xa1, 0x09,
+	0xb6, 0x8c, 0x6c, 0x5c, 0x0a, 0x8f, 0x33, 0x1a, 0x8c, 0xdc, 0x91, 0xd3, 0x72, 0x83, 0x85, 0x2b,
+	0xce, 0x9e, 0x71, 0x38, 0x73, 0x47, 0x4c, 0x58, 0x56, 0x9c, 0xbd, 0x78, 0x5e, 0x0a, 0x88, 0xda,
+	0xb4, 0x68, 0xbf, 0x35, 0x8e, 0x16, 0x34, 0x2d, 0x12, 0x54, 0x9b, 0x16, 0xe5, 0xa9, 0x4d, 0x7b,
+	0x60, 0x85, 0xec, 0x95, 0xf1, 0xb9, 0x82, 0xa6, 0x71, 0x29, 0xb5, 0x69, 0x3c, 0x03, 0x77, 0xd2,
+	0x28, 0xe3, 0x39, 0xf3, 0x43, 0xa7, 0x6f, 0x0d, 0x44, 0x57, 0x9d, 0xca, 0xdc, 0xef, 0x12, 0x3e,
+	0x4d, 0x1a, 0x77, 0xd2, 0x4c, 0x1a, 0xb5, 0xe1, 0x4f, 0xad, 0xcd, 0x01, 0xa3, 0xde, 0x4b, 0xe3,
+	0xdd, 0x82, 0x86, 0x47, 0x82, 0x6a, 0xc3, 0xa3, 0x3c, 0x75, 0x41, 0xe0, 0x79, 0xcb, 0xde, 0x60,
+	0xb4, 0xeb, 0x1a, 0xef, 0x15, 0x2c, 0x08, 0x8a, 0xac, 0xba, 0x20, 0x28, 0xd9, 0xea, 0xaa, 0xf5,
+	0x65, 0xc7, 0xde, 0x66, 0xa1, 0x71, 0xb6, 0x60, 0xd5, 0x12, 0x62, 0xea, 0xaa, 0x25, 0x72, 0xe2,
+	0xb5, 0x65, 0xc5, 0x0a, 0xad, 0x3d, 0x87, 0xbd, 0x7c, 0xee, 0xb0, 0x97, 0x78, 0x3c, 0x79, 0x6d,
+	0xc2, 0xda, 0x12, 0xc9, 0xf6, 0xa4, 0x70, 0xbc, 0xb6, 0xa4, 0x48, 0xe2, 0xb5, 0x45, 0xcd, 0x97,
+	0x1b, 0xc6, 0x91, 0x09, 0x6b, 0x8b, 0xc6, 0x1f, 0xef, 0x1e, 0x79, 0x54, 0xc4, 0x82, 0x63, 0x63,
+	0x45, 0x8f, 0x7d, 0x9b, 0xf9, 0xc6, 0x9b, 0x5c, 0xc9, 0x99, 0x62, 0x25, 0x5c, 0x7c, 0x6d, 0x86,
+	0xe6, 0x10, 0x8d, 0xa9, 0xd8, 0xf0, 0x46, 0x7e, 0x9f, 0x61, 0x3f, 0xbd, 0x53, 0x46, 0x45, 0x2c,
+	0x3e, 0xa6, 0x22, 0x2e, 0x21, 0x7b, 0xf0, 0x66, 0x5c, 0x82, 0x8a, 0xf9, 0x59, 0x80, 0x6b, 0x97,
+	0x17, 0x9c, 0xd3, 0x99, 0x5b, 0x7f, 0x4a, 0x53, 0x1a, 0xb5, 0x36, 0x43, 0x27, 0xd3, 0x92, 0x7d,
+	0x38, 0xae, 0x09, 0x88, 0xb3, 0x84, 0xaa, 0xf8, 0x0c, 0x57, 0x7c, 0x61, 0xb2, 0xe2, 0x31, 0xd8,
+	0xda, 0x0c, 0x2d, 0x20, 0x26, 0x43, 0x78, 0x43, 0xeb, 0x8c, 0x68, 0xc9, 0x90, 0x26, 0xf2, 0xdb,
+	0x5c, 0xef, 0xf9, 0xc9, 0x7a, 0x75, 0xcc, 0xda, 0x0c, 0x9d, 0x44, 0x49, 0xb6, 0xc1, 0xc8, 0x2c,
+	0xc6, 0x91, 0xfc, 0x4e, 0xe6, 0xe1, 0x2d, 0x47, 0x9d, 0x18, 0xcb, 0x5c, 0xb2, 0x4c, 0xcb, 0x97,
+	0xdd, 0xf9, 0x3b, 0x65, 0x2d, 0x3f, 0xee, 0xc7, 0x3c, 0x2a, 0x6d, 0xec, 0xb0, 0xe8, 0xa9, 0xe5,
+	0x6f, 0xb3, 0x50, 0x74, 0xf4, 0xba, 0x8d, 0x8d, 0xfa, 0xdd, 0x32, 0x63, 0x37, 0x06, 0xd3, 0xc6,
+	0x2e, 0x93, 0x98, 0x04, 0x30, 0xaf, 0x49, 0xac, 0x07, 0xcb, 0xde, 0x60, 0xc0, 0xfa, 0x51, 0x6f,
+	0xfe, 0x1e, 0x57, 0xfc, 0xc1, 0x64, 0xc5, 0x29, 0xd0, 0xda, 0x0c, 0x9d, 0x48, 0x3a, 0xd6, 0xde,
+	0xc7, 0x03, 0x3b, 0x65, 0x33, 0x46, 0x29, 0x5b, 0x4d, 0xc3, 0xc6, 0xda, 0x3b, 0x26, 0x31, 0x66,
+	0xab, 0x8a, 0x04, 0x36, 0xf7, 0xf5, 0x32, 0xb6, 0xaa, 0x63, 0xc6, 0x6c, 0x55, 0x2f, 0xc6, 0x7d,
+	0x73, 0x14, 0x30, 0x9f, 0x73, 0xdc, 0xf3, 0x1c, 0xd7, 0x78, 0x2b, 0x73, 0xdf, 0x7c, 0x16, 0x30,
+	0x5f, 0x2a, 0x42, 0x29, 0xdc, 0x37, 0x35, 0x98, 0xc6, 0xf3, 0x80, 0x6d, 0x85, 0xc6, 0x89, 0x22,
+	0x1e, 0x94, 0xd2, 0x78, 0x30, 0x03, 0x77, 0x8a, 0x38, 0x63, 0x83, 0xe1, 0xa8, 0x50, 0xcb, 0xdd,
+	0x66, 0xc6, 0xdb, 0x99, 0x3b, 0x85, 0x42, 0xa7, 0x08, 0xe3, 0x4e, 0x91, 0x45, 0x82, 0xee, 0x8d,
+	0x38, 0x1f, 0xcf, 0x7a, 0x82, 0xfa, 0x64, 0xa6, 0x7b, 0x43, 0xa1, 0x8e, 0x45, 0xf1, 0x26, 0x35,
+	0x4e, 0x40, 0xde, 0x83, 0xfa, 0xd0, 0x71, 0xb7, 0x0d, 0x9b, 0x13, 0xbd, 0x96, 0x22, 0x7a, 0xe2,
+	0xb8, 0xdb, 0x6b, 0x33, 0x94, 0x8b, 0x90, 0x1b, 0x00, 0x43, 0xdf, 0xeb, 0xb3, 0x20, 0x78, 0xc4,
+	0x5e, 0x1a, 0x8c, 0x03, 0xcc, 0x34, 0x40, 0x08, 0xf4, 0x1e, 0x31, 0xdc, 0xf1, 0x15, 0x79, 0xb2,
+	0x0a, 0x07, 0x65, 0x4a, 0xce, 0xf2, 0xad, 0xcc, 0x63, 0x65, 0x44, 0x90, 0x78, 0xa3, 0x34, 0x14,
+	0xde, 0xaa, 0x64, 0xc6, 0x8a, 0xe7, 0x32, 0x63, 0x3b, 0xf3, 0x56, 0x15, 0x91, 0xa0, 0x08, 0x9e,
+	0xde, 0x14, 0x04, 0xba, 0x44, 0xc2, 0x1d, 0x9f, 0x59, 0xf6, 0x46, 0x68, 0x85, 0xa3, 0xc0, 0x70,
+	0x33, 0x0f, 0x80, 0xa2, 0xb0, 0xf7, 0x94, 0x4b, 0xe2, 0xe1, 0x56, 0xc5, 0x90, 0x47, 0xd0, 0xc5,
+	0x2b, 0xd6, 0x03, 0x67, 0xd7, 0x09, 0x29, 0xb3, 0xfa, 0x3b, 0xcc, 0x36, 0xbc, 0xcc, 0xeb, 0x19,
+	0x1e, 0xa8, 0x7b, 0xaa, 0x1c, 0x9e, 0x83, 0xd2, 0x58, 0xb2, 0x06, 0x73, 0x98, 0xb7, 0x31, 0xb4,
+	0xfa, 0xec, 0x19, 0xfa, 0x28, 0x8d, 0x61, 0xa6, 0x05, 0x72, 0xb6, 0x44, 0x0a, 0x0f, 0x2b, 0x3a,
+	0x2e, 0x62, 0x7a, 0xe0, 0xf5, 0xad, 0x81, 0x60, 0xfa, 0x56, 0x3e, 0x53, 0x22, 0x15, 0x31, 0x25,
+	0x39, 0x4b, 0x2d, 0x68, 0xec, 0x59, 0x83, 0x11, 0x33, 0x7f, 0x58, 0x83, 0x96, 0xf4, 0x11, 0x9a,
+	0x8f, 0xa0, 0xce, 0x3d, 0xa0, 0x47, 0xa0, 0xe1, 0xb8, 0x36, 0x7b, 0xc5, 0x9d, 0xa7, 0x0d, 0x2a,
+	0x12, 0xe4, 0x43, 0x68, 0x49, 0xd7, 0xa1, 0x51, 0x9d, 0xe8, 0xb2, 0x8d, 0xc4, 0xcc, 0x8f, 0xa0,
+	0x15, 0x79, 0x42, 0xe7, 0xa1, 0x33, 0xf4, 0x3d, 0xac, 0xc4, 0xba, 0xcd, 0x69, 0x3b, 0x34, 0xc9,
+	0x20, 0x9f, 0x87, 0x96, 0x2d, 0x04, 0x25, 0xf5, 0xeb, 0x3d, 0xe1, 0x9c, 0xee, 0x45, 0xce, 0xe9,
+	0xde, 0x06, 0x77, 0x4e, 0xd3, 0x48, 0xce, 0xfc, 0xfd, 0x0a, 0x34, 0x85, 0x43, 0xd4, 0xdc, 0x83,
+	0xa6, 0x34, 0x9f, 0xcb, 0xd0, 0xec, 0xf3, 0x3c, 0x23, 0xed, 0x0c, 0xd5, 0x6a, 0x28, 0x3d, 0xac,
+	0x54, 0x0a, 0x23, 0x2c, 0x10, 0xe6, 0x52, 0x9d, 0x08, 0x13, 0xf6, 0x41, 0xa5, 0xf0, 0x6f, 0x4c,
+	0xef, 0x8f, 0x00, 0x9a, 0x62, 0x2b, 0x32, 0xff, 0xbb, 0x1a, 0x77, 0xb1, 0xf9, 0x77, 0x15, 0x68,
+	0x08, 0xbf, 0xe3, 0x1c, 0x54, 0x9d, 0xa8, 0x97, 0xab, 0x8e, 0x4d, 0xee, 0xaa, 0xdd, 0x5b, 0xcb,
+	0x58, 0xa7, 0xb3, 0xfc, 0xb0, 0xbd, 0xfb, 0x6c, 0xff, 0x39, 0x9a, 0x48, 0xdc, 0xe7, 0xe4, 0x18,
+	0x34, 0x83, 0xd1, 0x26, 0x5e, 0xea, 0x6b, 0x27, 0x6a, 0x67, 0x3b, 0x54, 0xa6, 0xcc, 0x7b, 0xd0,
+	0x8e, 0x84, 0x49, 0x17, 0x6a, 0x2f, 0xd8, 0xbe, 0x54, 0x8e, 0x7f, 0x92, 0xf3, 0xd2, 0xd4, 0x62,
+	0xab, 0x49, 0x0f, 0xad, 0xd0, 0x22, 0xed, 0xf1, 0x1b, 0x50, 0xc3, 0xc5, 0x3f, 0xdd, 0x84, 0xe9,
+	0x2d, 0x24, 0xb7, 0xb6, 0xcb, 0xd0, 0x10, 0xbe, 0xdf, 0xb4, 0x0e, 0x02, 0xf5, 0x17, 0x6c, 0x5f,
+	0xf4, 0x51, 0x87, 0xf2, 0xbf, 0x73, 0x49, 0xbe, 0x5f, 0x87, 0x03, 0xaa, 0xbb, 0xc9, 0x5c, 0x85,
+	0x1a, 0xba, 0x85, 0xd2, 0x9c, 0x06, 0xb4, 0xac, 0xad, 0x90, 0xf9, 0xf1, 0x57, 0x90, 0x28, 0x89,
+	0x93, 0x8c, 0x73, 0x71, 0xd7, 0x51, 0x87, 0x8a, 0x84, 0xd9, 0x83, 0xa6, 0xf4, 0x13, 0xa6, 0x99,
+	0x62, 0xf9, 0xaa, 0x2a, 0x7f, 0x0f, 0xda, 0xb1, 0xdb, 0xef, 0x93, 0xea, 0xf6, 0xa1, 0x1d, 0xfb,
+	0xf7, 0x8e, 0x40, 0x23, 0xf4, 0x42, 0x6b, 0xc0, 0xe9, 0x6a, 0x54, 0x24, 0x70, 0x16, 0xbb, 0xec,
+	0x55, 0xb8, 0x1c, 0x2f, 0x02, 0x35, 0x9a, 0x64, 0x88, 0x39, 0xce, 0xf6, 0x44, 0x69, 0x4d, 0x94,
+	0xc6, 0x19, 0x89, 0xce, 0xba, 0xaa, 0x73, 0x1f, 0x9a, 0xd2, 0xe9, 0x17, 0x97, 0x57, 0x94, 0x72,
+	0xb2, 0x08, 0x0d, 0x74, 0xa3, 0x0c, 0x8d, 0x6a, 0xca, 0x77, 0x29, 0x66, 0x88, 0xd8, 0x05, 0x97,
+	0x3d, 0x37, 0x44, 0x33, 0xd6, 0x6f, 0x01, 0x54, 0x20, 0x71, 0x08, 0x7d, 0xe1, 0xc1, 0xc5, 0x3a,
+	0xb5, 0xa9, 0x4c, 0x99, 0xcf, 0x60, 0x56, 0x75, 0x04, 0x66, 0xeb, 0x27, 0x50, 0xe7, 0xdb, 0x99,
+	0x68, 0x2c, 0xff, 0x1b, 0xdb, 0xe9, 0xc9, 0x93, 0x60, 0x64, 0x16, 0x49, 0x86, 0xf9, 0x57, 0x15,
+	0xe8, 0xc4, 0x8e, 0x7a, 0xf3, 0xa3, 0xbc, 0x39, 0xb9, 0x08, 0x07, 0x7d, 0x29, 0x85, 0x1e, 0x95,
+	0x68, 0x66, 0xbe, 0x91, 0x6a, 0x20, 0x55, 0x64, 0xa8, 0x8e, 0x30, 0x6f, 0xe4, 0xda, 0xca, 0x49,
+	0x38, 0x10, 0x89, 0xde, 0x4f, 0x2c, 0x5a, 0xcb, 0x33, 0xcd, 0x18, 0xdd, 0x85, 0x9a, 0x63, 0x8b,
+	0x4f, 0x7b, 0x1d, 0x8a, 0x7f, 0x9a, 0x5b, 0x70, 0x40, 0xf5, 0x91, 0x99, 0xcf, 0xb3, 0x27, 0xe5,
+	0x6d, 0x54, 0x93, 0x88, 0xc9, 0x31, 0x1a, 0x6f, 0x42, 0x22, 0x42, 0x35, 0x80, 0xe9, 0xc1, 0x01,
+	0xd5, 0x9f, 0x6f, 0xfe, 0x56, 0xb6, 0x1e, 0x13, 0xda, 0x51, 0xff, 0x4a, 0x4b, 0x8e, 0xd3, 0xe4,
+	0x3c, 0x34, 0xf9, 0x21, 0x52, 0x8c, 0xc4, 0xec, 0xc2, 0x91, 0x2c, 0x0b, 0xa1, 0x52, 0xc6, 0xfc,
+	0x2e, 0x83, 0x06, 0xcf, 0x31, 0x2f, 0x8a, 0xf9, 0x9a, 0xc0, 0x2b, 0x25, 0xe0, 0xcb, 0x30, 0xab,
+	0xf8, 0x62, 0x71, 0x82, 0xf1, 0x82, 0xd8, 0x68, 0xa2, 0x24, 0xd6, 0x18, 0xb7, 0xb6, 0x27, 0x56,
+	0xb8, 0x23, 0x3b, 0x3f, 0x4e, 0x9b, 0xa7, 0xa0, 0x29, 0xcf, 0xd4, 0xa6, 0xf4, 0x3d, 0xaf, 0xc7,
+	0xbd, 0x1f, 0xa7, 0xcd, 0xaf, 0x43, 0x27, 0x76, 0xd9, 0x92, 0xc7, 0x70, 0x40, 0xba, 0x6c, 0xc5,
+	0xb9, 0x10, 0x85, 0xe7, 0x0a, 0x26, 0x03, 0x1e, 0x02, 0xb9, 0xd7, 0xb7, 0xf7, 0x74, 0x7f, 0xc8,
+	0xa8, 0x46, 0x60, 0xfe, 0xf2, 0x2c, 0xef, 0x69, 0x73, 0x08, 0xed, 0xd8, 0x4f, 0x95, 0xee, 0xf5,
+	0xab, 0x62, 0x25, 0xaf, 0x16, 0x3a, 0x59, 0x05, 0x1e, 0xf7, 0x0b, 0xbe, 0xe0, 0x9b, 0x6f, 0x40,
+	0xed, 0x3e, 0xe3, 0x13, 0x4a, 0xac, 0xfb, 0x72, 0x42, 0xf1, 0x84, 0xb9, 0x0e, 0x4d, 0xe9, 0x2f,
+	0x4e, 0xeb, 0xbb, 0x00, 0xcd, 0x2d, 0x5e, 0x52, 0xb4, 0xc2, 0x4b, 0x31, 0xf3, 0x36, 0xcc, 0xaa,
+	0x5e, 0xe2, 0x34, 0xdf, 0x09, 0x98, 0xed, 0x27, 0xc5, 0x72, 0x18, 0xd4, 0x2c, 0x93, 0xe9, 0x66,
+	0x3e, 0xc6, 0xb0, 0x9a, 0x69, 0xdf, 0x6f, 0x67, 0x76, 0xfb, 0x04, 0x2b, 0xbf, 0x0f, 0x87, 0xd2,
+	0xee, 0xe0, 0xb4, 0xa6, 0xb3, 0x70, 0x68, 0x53, 0x17, 0x91, 0x86, 0x9e, 0xce, 0x36, 0xd7, 0xa1,
+	0x21, 0xdc, 0x75, 0x69, 0x8a, 0x0f, 0xa1, 0x61, 0x61, 0x01, 0x07, 0xce, 0x2d, 0x98, 0x99, 0xb5,
+	0xe4, 0x50, 0x2a, 0x04, 0x4d, 0x07, 0x0e, 0xea, 0x1e, 0xc0, 0x34, 0xe5, 0x1a, 0x1c, 0xdc, 0x53,
+	0x05, 0x24, 0xf5, 0xc9, 0x4c, 0x6a, 0x8d, 0x8a, 0xea, 0x40, 0xf3, 0x0f, 0x9a, 0x50, 0xe7, 0x2e,
+	0xec, 0xb4, 0x8a, 0x2b, 0x50, 0xc7, 0x37, 0x01, 0xb2, 0x6b, 0x4f, 0x4e, 0xf4, 0x87, 0xf3, 0x7f,
+	0x28, 0x97, 0x27, 0x5f, 0x80, 0x46, 0x10, 0xee, 0x0f, 0xa2, 0x0f, 0x2f, 0xef, 0x4c, 0x06, 0x6e,
+	0xa0, 0x28, 0x15, 0x08, 0x84, 0xf2, 0xb9, 0x60, 0xd4, 0xcb, 0x40, 0xf9, 0x24, 0xa4, 0x02, 0x41,
+	0x6e, 0x43, 0xab, 0xbf, 0xc3, 0xfa, 0x2f, 0x98, 0x6d, 0x34, 0x0a, 0xa6, 0x05, 0x07, 0x2f, 0x0b,
+	0x61, 0x1a, 0xa1, 0x50, 0x77, 0x9f, 0x8f, 0x6e, 0xb3, 0x8c, 0x6e, 0x3e, 0xe2, 0x54, 0x20, 0xc8,
+	0x2a, 0x74, 0x9c, 0xbe, 0xe7, 0xae, 0xee, 0x7a, 0xdf, 0x74, 0x8c, 0xd6, 0x04, 0xaf, 0x5b, 0x0c,
+	0x5f, 0x8f, 0xc4, 0x69, 0x82, 0x8c, 0x68, 0xd6, 0x77, 0xf1, 0xf6, 0xd0, 0x2e, 0x4b, 0xc3, 0xc5,
+	0x69, 0x82, 0x34, 0xe7, 0xe5, 0x78, 0x66, 0x4f, 0xf2, 0xbb, 0xd0, 0xe0, 0x5d, 0x4e, 0x6e, 0xaa,
+	0xc5, 0x73, 0x0b, 0x67, 0x32, 0x2d, 0x47, 0x5b, 0xb1, 0xe4, 0x50, 0xc5, 0x3c, 0xbc, 0xff, 0x75,
+	0x9e, 0xd9, 0x32, 0x3c, 0x72, 0xdc, 0x04, 0xcf, 0x5b, 0xd0, 0x92, 0x43, 0xa1, 0x57, 0xb8, 0x1d,
+	0x09, 0xbc, 0x09, 0x0d, 0x31, 0x31, 0xb3, 0xdb, 0xf3, 0x36, 0x74, 0xe2, 0xce, 0x9c, 0x2c, 0xc2,
+	0x7b, 0x27, 0x47, 0xe4, 0x27, 0x15, 0x68, 0x08, 0x57, 0xfe, 0xf8, 0x52, 0xab, 0xce, 0x82, 0x77,
+	0x26, 0x7f, 0x19, 0x50, 0xa7, 0xc1, 0x75, 0x68, 0x0c, 0xac, 0x4d, 0x36, 0x30, 0x6a, 0x05, 0x4e,
+	0x75, 0x81, 0x7c, 0x80, 0xb2, 0x54, 0x40, 0x0a, 0x86, 0xf0, 0x4d, 0xac, 0xeb, 0x26, 0x1b, 0xe4,
+	0x14, 0x7f, 0xaf, 0x02, 0x35, 0xfc, 0x5a, 0x92, 0x6e, 0xc9, 0xb5, 0x68, 0x5e, 0x16, 0x4d, 0xe8,
+	0x15, 0x67, 0x4f, 0x9b, 0x96, 0xe6, 0x6a, 0x64, 0x33, 0x37, 0x74, 0x9b, 0x39, 0x3d, 0xf9, 0xc8,
+	0x97, 0xd0, 0x88, 0x8a, 0xfd, 0x69, 0x13, 0xea, 0xfc, 0x3b, 0x57, 0xd6, 0x4a, 0xb3, 0x3f, 0x2c,
+	0xae, 0x18, 0x82, 0xc5, 0x96, 0xc9, 0xe5, 0xc5, 0x4a, 0x63, 0x85, 0xc5, 0x2b, 0x0d, 0x07, 0xe2,
+	0x55, 0x8d, 0x37, 0x09, 0x0f, 0x8a, 0x57, 0xa0, 0xbe, 0xeb, 0xec, 0x32, 0xa3, 0x5e, 0x46, 0xe5,
+	0x43, 0x67, 0x97, 0x51, 0x2e, 0x8f, 0xb8, 0x1d, 0x2b, 0xd8, 0x31, 0x1a, 0x65, 0x70, 0x6b, 0x56,
+	0xb0, 0x43, 0xb9, 0x3c, 0xe2, 0x5c, 0x6b, 0x97, 0x19, 0xcd, 0x32, 0xb8, 0x47, 0x16, 0xea, 0x43,
+	0x79, 0xc4, 0x05, 0xce, 0xb7, 0x99, 0xd1, 0x2a, 0x83, 0xdb, 0x70, 0xbe, 0xcd, 0x28, 0x97, 0x4f,
+	0x16, 0xe1, 0x76, 0xb9, 0xae, 0x51, 0x46, 0x7b, 0x1e, 0xea, 0x58, 0x81, 0x7c, 0xe3, 0xfb, 0xb2,
+	0x63, 0x87, 0x3b, 0x7a, 0x71, 0x43, 0x5b, 0x5e, 0xb0, 0x83, 0xa7, 0x5a, 0x5e, 0xd4, 0xf1, 0x11,
+	0x3c, 0x2b, 0x50, 0xc7, 0x81, 0x9e, 0xce, 0xe2, 0x12, 0xfb, 0xf8, 0x44, 0x8b, 0x9d, 0xda, 0x25,
+	0x82, 0x67, 0x1e, 0xea, 0x38, 0x96, 0x39, 0x5d, 0x32, 0x0f, 0x75, 0xb4, 0x90, 0xfc, 0x52, 0x1c,
+	0x17, 0xbd, 0xb4, 0x16, 0x95, 0xfe, 0x7d, 0x1b, 0xea, 0xfc, 0xb3, 0x6d, 0x7a, 0x4e, 0xfc, 0x3f,
+	0x38, 0x18, 0x72, 0xcf, 0xf6, 0x92, 0x3c, 0xc6, 0x56, 0x33, 0x5f, 0x88, 0xe8, 0x1f, 0x83, 0xa5,
+	0xbb, 0x5c, 0x42, 0xa8, 0xce, 0x50, 0x7e, 0x63, 0xe6, 0x54, 0xda, 0xc6, 0x7c, 0x23, 0x3e, 0x00,
+	0xd6, 0x8b, 0x56, 0x33, 0xc4, 0x8a, 0x63, 0x64, 0x74, 0x1a, 0x24, 0x4b, 0xd0, 0xc6, 0xed, 0x09,
+	0xbb, 0x41, 0x4e, 0x9c, 0xd3, 0x93, 0xf1, 0xeb, 0x52, 0x9a, 0xc6, 0x38, 0xdc, 0x1c, 0xfb, 0x96,
+	0x6f, 0xf3, 0x5a, 0xc9, 0x59, 0x74, 0x66, 0x32, 0xc9, 0x72, 0x24, 0x4e, 0x13, 0x24, 0xb9, 0x0f,
+	0xb3, 0x36, 0x8b, 0x5d, 0x03, 0x46, 0x6b, 0xc2, 0x87, 0x95, 0x98, 0x68, 0x25, 0x01, 0x50, 0x15,
+	0x8d, 0x75, 0x8a, 0xee, 0x6d, 0x41, 0xe1, 0x86, 0xcd, 0xa9, 0x92, 0x67, 0x62, 0x09, 0x92, 0x7c,
+	0x04, 0x5d, 0x31, 0x50, 0x1b, 0xa3, 0xcd, 0x68, 0xb4, 0x3b, 0x13, 0xbe, 0xa8, 0xa5, 0x46, 0x3b,
+	0x41, 0xd1, 0x31, 0x1e, 0xf3, 0x5d, 0x38, 0xa8, 0xd9, 0x44, 0x8e, 0x91, 0x9e, 0x85, 0x6e, 0x9a,
+	0xec, 0x53, 0x3d, 0x3f, 0xa8, 0x16, 0x25, 0x78, 0xae, 0xc6, 0x97, 0x8d, 0x0f, 0xf4, 0x03, 0x44,
+	0xee, 0xdd, 0x42, 0x02, 0x1f, 0x40, 0x3b, 0x32, 0x0f, 0x72, 0x47, 0xaf, 0xc3, 0xb9, 0xe2, 0x3a,
+	0xc4, 0x96, 0x25, 0xd9, 0x1e, 0x41, 0x27, 0xb6, 0x13, 0xf4, 0x68, 0xa8, 0x74, 0xef, 0x17, 0xd3,
+	0x25, 0x36, 0x26, 0xf9, 0x28, 0xcc, 0x2a, 0xe6, 0x42, 0x96, 0x75, 0xc6, 0x0f, 0x8a, 0x19, 0x55,
+	0x63, 0x4b, 0xce, 0x2f, 0xb1, 0xdd, 0xa8, 0xa3, 0x52, 0x4b, 0x46, 0xe5, 0x87, 0x2d, 0x68, 0xc7,
+	0x0f, 0x36, 0x32, 0x6e, 0x8b, 0x23, 0x7f, 0x50, 0x78, 0x5b, 0x8c, 0xf0, 0xbd, 0x67, 0xfe, 0x80,
+	0x22, 0x02, 0x87, 0x38, 0x74, 0xc2, 0x78, 0xc1, 0x38, 0x53, 0x0c, 0x7d, 0x8a, 0xe2, 0x54, 0xa0,
+	0xc8, 0x63, 0x7d, 0xae, 0xd5, 0x27, 0x7c, 0x76, 0xd3, 0x48, 0x72, 0xe7, 0xdb, 0x3a, 0x74, 0x1c,
+	0x3c, 0xc4, 0xad, 0x25, 0x3b, 0xf0, 0xfb, 0xc5, 0x74, 0xeb, 0x11, 0x84, 0x26, 0x68, 0xac, 0xdb,
+	0x96, 0xb5, 0x87, 0xab, 0x0b, 0x27, 0x6b, 0x96, 0xad, 0xdb, 0xdd, 0x04, 0x44, 0x55, 0x06, 0x72,
+	0x5d, 0x9e, 0x61, 0x5a, 0x05, 0xeb, 0x5b, 0xd2, 0x55, 0xc9, 0x39, 0xe6, 0x2b, 0x30, 0x17, 0x6a,
+	0x5f, 0x31, 0xe5, 0x62, 0xf2, 0x61, 0x09, 0x16, 0x0d, 0x47, 0x53, 0x3c, 0x38, 0x82, 0xe2, 0x84,
+	0xd4, 0x29, 0x3b, 0x82, 0xea, 0x29, 0x09, 0xdd, 0x05, 0xcf, 0xfc, 0x41, 0xfe, 0x49, 0x80, 0x0f,
+	0x77, 0x4e, 0xf1, 0x3b, 0xfa, 0x4c, 0xc8, 0x3f, 0x9a, 0xc7, 0x63, 0x92, 0xcb, 0xa3, 0x74, 0x7a,
+	0x8e, 0xd0, 0x4d, 0x79, 0x5c, 0xb8, 0xac, 0xcf, 0xb7, 0xb7, 0x52, 0xf3, 0x0d, 0x67, 0xd8, 0x13,
+	0x9f, 0x89, 0x2f, 0xcb, 0xca, 0x39, 0xe1, 0x34, 0xcc, 0xe9, 0x1d, 0x99, 0xa3, 0xe6, 0x5e, 0x74,
+	0xba, 0x99, 0x6a, 0xa5, 0x48, 0xf7, 0xad, 0xe0, 0xfa, 0x6e, 0x05, 0xda, 0xf1, 0x7b, 0x9c, 0xf1,
+	0xcf, 0x02, 0x6d, 0x27, 0x58, 0x63, 0x16, 0xbe, 0x14, 0x11, 0xf3, 0xf6, 0x5c, 0xe1, 0x43, 0x9f,
+	0xde, 0xba, 0x44, 0xd0, 0x18, 0x6b, 0x9e, 0x80, 0x76, 0x94, 0x9b, 0x73, 0xbd, 0xfa, 0xeb, 0x0a,
+	0xcc, 0xaa, 0xef, 0x77, 0xd2, 0x35, 0xb9, 0xa9, 0x9d, 0xcd, 0xdf, 0x2b, 0xf3, 0x34, 0x48, 0x31,
+	0x6d, 0xf3, 0xbe, 0x1c, 0x98, 0xa9, 0x16, 0xc2, 0x31, 0x2e, 0x59, 0xd7, 0x9f, 0x57, 0xa1, 0x29,
+	0xdf, 0x06, 0xa5, 0xab, 0x79, 0x0b, 0x9a, 0x03, 0x6b, 0xdf, 0x1b, 0x45, 0x17, 0xb5, 0xd3, 0x05,
+	0xcf, 0x8d, 0x7a, 0x0f, 0xb8, 0x34, 0x95, 0x28, 0xf2, 0x45, 0x68, 0x0c, 0xf0, 0xc3, 0xa0, 0x51,
+	0x2b, 0x58, 0x25, 0x23, 0x38, 0x0a, 0x53, 0x81, 0x41, 0xe5, 0xfc, 0x49, 0x40, 0xf4, 0x54, 0xb4,
+	0x50, 0xf9, 0x73, 0x2e, 0x4d, 0x25, 0xca, 0xbc, 0x07, 0x4d, 0x51, 0x9d, 0xe9, 0x36, 0x34, 0xbd,
+	0x25, 0xca, 0xe5, 0x90, 0x57, 0x2a, 0xfb, 0x7c, 0x7e, 0x1c, 0x9a, 0x42, 0x79, 0x8e, 0x85, 0xff,
+	0xec, 0x73, 0xfc, 0x8e, 0x36, 0x30, 0x1f, 0x24, 0x1f, 0x08, 0x3f, 0xf9, 0x07, 0x1f, 0xf3, 0x29,
+	0x1c, 0xc2, 0x2f, 0x00, 0x9b, 0x56, 0xc0, 0x28, 0xeb, 0x7b, 0xbe, 0x9d, 0xc9, 0xea, 0x8b, 0x22,
+	0xe9, 0x6f, 0xcf, 0x67, 0x95, 0x72, 0x9f, 0x39, 0x2c, 0xff, 0xf7, 0x38, 0x2c, 0xff, 0xb6, 0x9e,
+	0xe3, 0x45, 0x2c, 0xe3, 0x3f, 0x41, 0x83, 0x1b, 0x73, 0x23, 0x5e, 0xd7, 0x6f, 0x2b, 0xa7, 0x0a,
+	0x90, 0xda, 0x75, 0xe5, 0xba, 0xee, 0x47, 0x2c, 0xc2, 0x6a, 0x8e, 0xc4, 0x3b, 0x69, 0x47, 0xe2,
+	0xe9, 0x02, 0xf4, 0x98, 0x27, 0xf1, 0xba, 0xee, 0x49, 0x2c, 0xd2, 0xae, 0xba, 0x12, 0xff, 0x8f,
+	0x39, 0xef, 0xbe, 0x9f, 0xe3, 0xaa, 0xfa, 0x82, 0xee, 0xaa, 0x9a, 0x60, 0x35, 0xbf, 0x2e, 0x5f,
+	0xd5, 0x9f, 0xe5, 0xf9, 0xaa, 0xae, 0x6a, 0xfb, 0xe1, 0x84, 0x9a, 0xa5, 0x9d, 0x55, 0xd7, 0x75,
+	0x67, 0xd5, 0xa9, 0x02, 0xa4, 0xe6, 0xad, 0xba, 0xaa, 0x79, 0xab, 0x8a, 0x94, 0x2a, 0xee, 0xaa,
+	0xab, 0x9a, 0xbb, 0xaa, 0x08, 0xa8, 0xf8, 0xab, 0xae, 0x6a, 0xfe, 0xaa, 0x22, 0xa0, 0xe2, 0xb0,
+	0xba, 0xaa, 0x39, 0xac, 0x8a, 0x80, 0x8a, 0xc7, 0xea, 0xba, 0xee, 0xb1, 0x2a, 0xee, 0x9f, 0xcf,
+	0x5c, 0x56, 0xbf, 0x19, 0x97, 0xd5, 0x1f, 0xd7, 0x72, 0x5c, 0x56, 0x34, 0xdb, 0x65, 0x75, 0x3e,
+	0x7f, 0x24, 0x8b, 0x7d, 0x56, 0xe5, 0x77, 0x81, 0x71, 0xa7, 0xd5, 0xcd, 0x94, 0xd3, 0xea, 0xdd,
+	0x02, 0xb0, 0xee, 0xb5, 0x2a, 0xeb, 0x3a, 0xf9, 0x8d, 0x3b, 0x44, 0x7e, 0xd0, 0x9c, 0x70, 0xf7,
+	0xbf, 0xa6, 0xde, 0xfd, 0x27, 0xec, 0x64, 0xe3, 0x97, 0xff, 0x5b, 0xfa, 0xe5, 0xff, 0x6c, 0x09,
+	0xac, 0x76, 0xfb, 0x7f, 0x92, 0x75, 0xfb, 0xef, 0x95, 0x60, 0xc9, 0xbd, 0xfe, 0xdf, 0x1b, 0xbf,
+	0xfe, 0x9f, 0x2f, 0xc1, 0x97, 0x79, 0xff, 0x7f, 0x92, 0x75, 0xff, 0x2f, 0x53, 0xbb, 0x5c, 0x07,
+	0xc0, 0x17, 0x35, 0x07, 0xc0, 0x99, 0x32, 0xdd, 0x95, 0x6c, 0x0e, 0x5f, 0xcd, 0xf1, 0x00, 0x7c,
+	0xbe, 0x0c, 0xcd, 0x44, 0x17, 0xc0, 0x67, 0x77, 0xf8, 0x94, 0x9a, 0x3f, 0x3f, 0x01, 0xed, 0xe8,
+	0x35, 0x92, 0xf9, 0x2d, 0x68, 0x45, 0x01, 0x21, 0xe9, 0x99, 0x73, 0x2c, 0xbe, 0xd4, 0x89, 0xd3,
+	0xb3, 0x4c, 0x91, 0x5b, 0x50, 0xc7, 0xbf, 0xe4, 0xb4, 0x38, 0x57, 0xee, 0xd5, 0x13, 0x2a, 0xa1,
+	0x1c, 0x67, 0xfe, 0xfb, 0x51, 0x00, 0xe5, 0x9d, 0x7c, 0x59, 0xb5, 0x5f, 0xc2, 0xc5, 0x6c, 0x10,
+	0x32, 0x5f, 0x3e, 0xa6, 0xb9, 0x50, 0xf6, 0x91, 0x3e, 0x5a, 0x4b, 0xc8, 0x7c, 0x2a, 0xe1, 0xe4,
+	0x21, 0xb4, 0x23, 0xd7, 0xb3, 0x51, 0x3f, 0x51, 0xcb, 0x35, 0xb2, 0x2c, 0xaa, 0xc8, 0x0d, 0x49,
+	0x63, 0x0a, 0xb2, 0x08, 0xf5, 0xc0, 0xf3, 0x43, 0xa3, 0xc1, 0xa9, 0x3e, 0x28, 0x4d, 0xb5, 0xe1,
+	0xf9, 0x21, 0xe5, 0x50, 0xd1, 0x34, 0x25, 0xc0, 0x71, 0x9a, 0xa6, 0x69, 0x2b, 0xf6, 0xbf, 0xd5,
+	0xe3, 0x35, 0x74, 0x59, 0xce, 0x46, 0x61, 0x43, 0x17, 0xca, 0x8f, 0x92, 0x3a, 0x2b, 0x89, 0x3c,
+	0x04, 0x89, 0x91, 0xe0, 0x7f, 0x93, 0x73, 0xd0, 0xed, 0x7b, 0x7b, 0xcc, 0xa7, 0xc9, 0x83, 0x2d,
+	0xf9, 0x54, 0x6f, 0x2c, 0x1f, 0x1f, 0x11, 0xed, 0x38, 0x36, 0x5b, 0xef, 0xcb, 0xf5, 0xaf, 0x4d,
+	0xe3, 0x34, 0xb9, 0x0f, 0x6d, 0xfe, 0x55, 0x22, 0xfa, 0x26, 0x32, 0x5d, 0x25, 0xc5, 0xc7, 0x91,
+	0x88, 0x00, 0x15, 0x71, 0xe5, 0x77, 0x9d, 0x90, 0xf7, 0x61, 0x9b, 0xc6, 0x69, 0xac, 0x30, 0x7f,
+	0x6c, 0xa7, 0x56, 0xb8, 0x25, 0x2a, 0x9c, 0xce, 0x27, 0x97, 0xe0, 0x28, 0xcf, 0x4b, 0x5d, 0x31,
+	0xc5, 0xc7, 0x8d, 0x36, 0xcd, 0x2e, 0xe4, 0x8f, 0x0b, 0xad, 0x6d, 0xf1, 0xb0, 0x9a, 0x3b, 0x1a,
+	0x1b, 0x34, 0xc9, 0x20, 0xe7, 0xe1, 0xb0, 0xcd, 0xb6, 0xac, 0xd1, 0x20, 0x7c, 0xca, 0x76, 0x87,
+	0x03, 0x2b, 0xc4, 0x67, 0xc6, 0xc0, 0x2b, 0x30, 0x5e, 0x80, 0x97, 0x57, 0x1c, 0x59, 0xb5, 0xb2,
+	0xb3, 0xe2, 0xf2, 0x9a, 0xca, 0x26, 0x3d, 0x20, 0xcc, 0xb5, 0x57, 0x52, 0xc2, 0x07, 0xb8, 0x70,
+	0x46, 0x09, 0xb6, 0xcd, 0x66, 0x43, 0xe6, 0xda, 0xcc, 0xed, 0xef, 0xab, 0x90, 0x83, 0x1c, 0x92,
+	0x5d, 0x88, 0x6b, 0xc8, 0xb7, 0x46, 0xcc, 0xdf, 0xe7, 0x21, 0x94, 0x1d, 0x2a, 0x12, 0xe6, 0xcf,
+	0xb8, 0xa1, 0xf1, 0xe9, 0xf4, 0x25, 0xa8, 0x59, 0xb6, 0x2d, 0xb7, 0xea, 0x8b, 0x53, 0x4e, 0x4a,
+	0x19, 0xba, 0x8c, 0x0c, 0xe4, 0x49, 0xfc, 0x16, 0x52, 0x6c, 0xd6, 0x57, 0xa6, 0xe5, 0x8a, 0x43,
+	0xdb, 0x25, 0x0f, 0x32, 0x8e, 0xb8, 0x84, 0x51, 0xfb, 0xd5, 0x18, 0xe3, 0x50, 0x00, 0xc9, 0x43,
+	0xee, 0x41, 0x9d, 0xd7, 0x50, 0x6c, 0xe6, 0x97, 0xa6, 0xe5, 0x7b, 0x28, 0xea, 0xc7, 0x39, 0xcc,
+	0xbe, 0x78, 0xe5, 0xa7, 0xbc, 0x84, 0xad, 0xe8, 0x2f, 0x61, 0x97, 0xa0, 0xe1, 0x84, 0x6c, 0x77,
+	0xfc, 0x61, 0xf4, 0xc4, 0xe9, 0x21, 0x57, 0x3b, 0x01, 0x9d, 0xf8, 0x92, 0xf2, 0x23, 0x68, 0xe6,
+	0xac, 0xc1, 0x77, 0xa0, 0x8e, 0xf0, 0xb1, 0xf3, 0x6b, 0x19, 0xc5, 0x1c, 0x69, 0x2e, 0x40, 0x1d,
+	0x1b, 0x3b, 0xa1, 0x75, 0xb2, 0x3e, 0xd5, 0xb8, 0x3e, 0x4b, 0xb3, 0xd0, 0xf1, 0x86, 0xcc, 0xe7,
+	0xa6, 0x67, 0xfe, 0x67, 0x5d, 0x79, 0xfe, 0xb7, 0xae, 0xda, 0xd8, 0xe5, 0xa9, 0x57, 0x6b, 0xd5,
+	0xca, 0x68, 0xca, 0xca, 0xae, 0x4d, 0xcf, 0x36, 0x66, 0x67, 0x34, 0x65, 0x67, 0xbf, 0x02, 0xe7,
+	0x98, 0xa5, 0x3d, 0xd0, 0x2c, 0xed, 0xca, 0xf4, 0x8c, 0x9a, 0xad, 0xb1, 0x22, 0x5b, 0x5b, 0xd1,
+	0x6d, 0xad, 0x57, 0x6e, 0xc8, 0xe3, 0xed, 0xb0, 0x84, 0xb5, 0x7d, 0x3d, 0xd7, 0xda, 0x96, 0x34,
+	0x6b, 0x9b, 0x56, 0xf5, 0xa7, 0x64, 0x6f, 0xff, 0x5c, 0x87, 0x3a, 0x6e, 0xc9, 0x64, 0x55, 0xb5,
+	0xb5, 0xcf, 0x4f, 0xb5, 0x9d, 0xab, 0x76, 0xf6, 0x28, 0x65, 0x67, 0x97, 0xa6, 0x63, 0x1a, 0xb3,
+	0xb1, 0x47, 0x29, 0x1b, 0x9b, 0x92, 0x6f, 0xcc, 0xbe, 0xd6, 0x34, 0xfb, 0x5a, 0x98, 0x8e, 0x4d,
+	0xb3, 0x2d, 0xab, 0xc8, 0xb6, 0xee, 0xe8, 0xb6, 0x55, 0xf2, 0xc4, 0x88, 0x8a, 0xca, 0xd8, 0xd5,
+	0x57, 0x72, 0xed, 0xea, 0x96, 0x66, 0x57, 0xd3, 0xa8, 0xfd, 0x94, 0x6c, 0xea, 0x92, 0x38, 0xe8,
+	0xca, 0x17, 0xd5, 0x25, 0x0f, 0xba, 0xe6, 0x65, 0xe8, 0x24, 0xc1, 0xcd, 0x19, 0x71, 0x13, 0x42,
+	0x2c, 0xd2, 0x1a, 0x25, 0xcd, 0x8b, 0xd0, 0x49, 0x02, 0x96, 0x33, 0x74, 0x05, 0xbc, 0x50, 0xa2,
+	0x64, 0xca, 0x5c, 0x85, 0xc3, 0xe3, 0xe1, 0x94, 0x19, 0xbe, 0x7f, 0xe5, 0x75, 0xbe, 0xac, 0xad,
+	0x9a, 0x65, 0xbe, 0x84, 0xb9, 0x54, 0x80, 0xe4, 0xd4, 0x1c, 0xe4, 0xa2, 0x72, 0x2c, 0xaf, 0xc9,
+	0x7b, 0x7f, 0x76, 0xbc, 0x41, 0x72, 0xf8, 0x36, 0x57, 0x60, 0xae, 0xa0, 0xf2, 0x65, 0xc2, 0x0d,
+	0xbe, 0x01, 0xb3, 0x93, 0xea, 0xfe, 0x29, 0x84, 0x43, 0x84, 0xd0, 0x1d, 0x0b, 0xee, 0x4e, 0xab,
+	0x79, 0x02, 0xb0, 0x1d, 0xcb, 0x18, 0xd5, 0xd4, 0x07, 0xf0, 0xe2, 0x98, 0x12, 0x8e, 0xa3, 0x0a,
+	0x87, 0xf9, 0x97, 0x15, 0x38, 0x3c, 0x1e, 0xd9, 0x5d, 0xf6, 0xc2, 0x65, 0x40, 0x8b, 0x73, 0xc5,
+	0xa1, 0x38, 0x51, 0x92, 0x3c, 0x84, 0x03, 0xc1, 0xc0, 0xe9, 0xb3, 0xe5, 0x1d, 0x7c, 0xb0, 0x1f,
+	0xc8, 0x5b, 0x54, 0x41, 0x74, 0xf6, 0x46, 0x82, 0xa0, 0x1a, 0xdc, 0x7c, 0x09, 0xb3, 0x4a, 0x21,
+	0xb9, 0x01, 0x55, 0x6f, 0x28, 0xef, 0x2d, 0xe7, 0x4b, 0x70, 0x3e, 0x8e, 0xe6, 0x1b, 0xad, 0x7a,
+	0xc3, 0xf1, 0x29, 0xa9, 0x4e, 0xdf, 0x9a, 0x36, 0x7d, 0xcd, 0xfb, 0x70, 0x78, 0x3c, 0x78, 0x3a,
+	0xdd, 0x3d, 0xa7, 0xc7, 0x3c, 0x13, 0xa2, 0x9b, 0x52, 0xb9, 0xe6, 0x55, 0x38, 0x94, 0x0e, 0x89,
+	0xce, 0x08, 0x93, 0x4a, 0xa2, 0xcd, 0xa2, 0x4f, 0x04, 0x27, 0xff, 0xa8, 0x02, 0x73, 0x7a, 0x43,
+	0xc8, 0x31, 0x20, 0x7a, 0xce, 0x23, 0xcf, 0x65, 0xdd, 0x19, 0x72, 0x14, 0x0e, 0xeb, 0xf9, 0x8b,
+	0xb6, 0xdd, 0xad, 0x8c, 0x8b, 0xe3, 0xb2, 0xd5, 0xad, 0x12, 0x03, 0x8e, 0xa4, 0x7a, 0x88, 0x2f,
+	0xa2, 0xdd, 0x1a, 0xf9, 0x1c, 0x1c, 0x4d, 0x97, 0x0c, 0x07, 0x56, 0x9f, 0x75, 0xeb, 0xe6, 0x7f,
+	0x55, 0xa1, 0x8e, 0x51, 0xbc, 0xe6, 0x7f, 0x54, 0xa3, 0x78, 0x94, 0x6b, 0x50, 0xe7, 0xd1, 0xca,
+	0x4a, 0x94, 0x65, 0x25, 0x15, 0x65, 0xa9, 0xfd, 0xa0, 0x5b, 0x12, 0x65, 0x79, 0x0d, 0xea, 0x3c,
+	0x3e, 0x79, 0x7a, 0xe4, 0x1f, 0x56, 0xa0, 0x93, 0xc4, 0x0a, 0x4f, 0x8d, 0x57, 0xe3, 0x5f, 0xaa,
+	0x7a, 0xfc, 0xcb, 0x39, 0x68, 0xf8, 0x48, 0x2a, 0x57, 0x99, 0x74, 0x54, 0x0d, 0x57, 0x48, 0x85,
+	0x88, 0xc9, 0x60, 0x56, 0x8d, 0x84, 0x9e, 0xbe, 0x1a, 0xa7, 0xe4, 0x0f, 0xac, 0xac, 0xdb, 0xc1,
+	0xa2, 0xef, 0x5b, 0xfb, 0xd2, 0x30, 0xf5, 0x4c, 0xf4, 0x37, 0x63, 0xbc, 0x73, 0x76, 0x70, 0xab,
+	0xf9, 0xa3, 0x0a, 0xb4, 0x64, 0x5c, 0xb1, 0x79, 0x15, 0x6a, 0x18, 0xd2, 0xfc, 0x21, 0xb4, 0x64,
+	0x64, 0xf1, 0x58, 0x45, 0x1e, 0xf2, 0x56, 0x48, 0x79, 0x1a, 0x89, 0x99, 0xd7, 0xe3, 0x6d, 0x72,
+	0x7a, 0xec, 0x35, 0xa8, 0xf3, 0x00, 0xe6, 0xe9, 0x91, 0x7f, 0xd1, 0x86, 0xa6, 0x88, 0x10, 0x35,
+	0xbf, 0xd7, 0x86, 0xa6, 0x08, 0x6a, 0x26, 0xb7, 0xa0, 0x15, 0x8c, 0x76, 0x77, 0x2d, 0x7f, 0xdf,
+	0xc8, 0xfe, 0xb5, 0x41, 0x2d, 0x06, 0xba, 0xb7, 0x21, 0x64, 0x69, 0x04, 0x22, 0x97, 0xa1, 0xde,
+	0xb7, 0xb6, 0xd8, 0xd8, 0x27, 0xe4, 0x2c, 0xf0, 0xb2, 0xb5, 0xc5, 0x28, 0x17, 0x27, 0x77, 0xa0,
+	0x2d, 0x87, 0x25, 0x0a, 0xc8, 0x9a, 0xac, 0x37, 0x1a, 0xcc, 0x18, 0x65, 0xde, 0x83, 0x96, 0xac,
+	0x0c, 0xb9, 0x1d, 0xc7, 0xc7, 0xa6, 0xbd, 0xdd, 0x99, 0x4d, 0xd8, 0x77, 0xfb, 0xa9, 0x48, 0xd9,
+	0x7f, 0xa8, 0x42, 0x1d, 0x2b, 0xf7, 0x89, 0x99, 0xc8, 0x71, 0x80, 0x81, 0x15, 0x84, 0x4f, 0x46,
+	0x83, 0x01, 0xb3, 0x65, 0x34, 0xa0, 0x92, 0x83, 0x2e, 0x05, 0x91, 0x0a, 0x76, 0x36, 0x46, 0xfd,
+	0x3e, 0x63, 0xb6, 0x8c, 0x36, 0x4c, 0x67, 0xe3, 0xab, 0x1e, 0xfe, 0x03, 0x5e, 0xf2, 0x54, 0xf8,
+	0x7e, 0x61, 0xcf, 0x62, 0x98, 0xbe, 0xac, 0x8d, 0x40, 0x9a, 0x1e, 0x74, 0xe2, 0x3c, 0x9c, 0x84,
+	0x43, 0xc7, 0x75, 0x31, 0xca, 0x5f, 0x58, 0x74, 0x94, 0xc4, 0x4d, 0x07, 0xff, 0x94, 0xf5, 0x6d,
+	0x50, 0x99, 0xc2, 0xfc, 0x2d, 0xcb, 0x19, 0xc8, 0x2a, 0x36, 0xa8, 0x4c, 0x21, 0x93, 0x38, 0xb8,
+	0x8a, 0x27, 0x26, 0x35, 0x1a, 0x25, 0xcd, 0x8f, 0x2b, 0x71, 0x90, 0x78, 0x56, 0xd4, 0xec, 0x98,
+	0xff, 0x6a, 0x5e, 0x75, 0xa2, 0x8b, 0x0d, 0x21, 0xc9, 0x40, 0xfd, 0x9e, 0x3b, 0x70, 0x5c, 0x26,
+	0xfd, 0x55, 0x32, 0x95, 0xea, 0xe3, 0xc6, 0x58, 0x1f, 0xcb, 0xf2, 0x55, 0xdb, 0xc1, 0x2a, 0x36,
+	0x93, 0x72, 0x91, 0x43, 0x6e, 0xe2, 0x93, 0x91, 0x3d, 0xa7, 0xcf, 0xf0, 0x47, 0xc7, 0x6a, 0x19,
+	0x1f, 0x06, 0xf5, 0xbe, 0x5d, 0xe1, 0xb2, 0x34, 0xc2, 0x98, 0x21, 0xc6, 0xe5, 0xe1, 0x9f, 0x71,
+	0x93, 0x2a, 0x4a, 0x93, 0x92, 0x4a, 0x57, 0x27, 0x54, 0xba, 0x56, 0x50, 0xe9, 0x7a, 0xba, 0xd2,
+	0x27, 0x6d, 0x80, 0xc4, 0xdc, 0xc8, 0x2c, 0xb4, 0x9e, 0xb9, 0x2f, 0x5c, 0xef, 0xa5, 0xdb, 0x9d,
+	0xc1, 0xc4, 0xe3, 0xad, 0x2d, 0xd4, 0xd2, 0xad, 0x60, 0x02, 0xe5, 0x1c, 0x77, 0xbb, 0x5b, 0x25,
+	0x00, 0xcd, 0x0d, 0x1e, 0x37, 0xd9, 0xad, 0xe1, 0xdf, 0x77, 0xf9, 0xf8, 0x75, 0xeb, 0xe4, 0x75,
+	0x78, 0x6d, 0xdd, 0xed, 0x7b, 0xbb, 0x43, 0x2b, 0x74, 0x36, 0x07, 0xec, 0x39, 0xf3, 0x03, 0xc7,
+	0x73, 0xbb, 0x0d, 0xf3, 0x6f, 0x2a, 0xe2, 0x4b, 0xb3, 0x79, 0x07, 0x0e, 0x68, 0xbf, 0x4d, 0x60,
+	0x40, 0x2b, 0x18, 0x8a, 0xdf, 0x54, 0x95, 0xe7, 0x6e, 0x99, 0xe4, 0x56, 0x22, 0xc2, 0xf5, 0xe5,
+	0x91, 0x45, 0xa4, 0xcc, 0xf3, 0x00, 0xca, 0x2f, 0x12, 0x1c, 0x07, 0xd8, 0xdc, 0x0f, 0x59, 0xc0,
+	0x53, 0x9c, 0xa2, 0x4e, 0x95, 0x1c, 0xf3, 0x0a, 0x40, 0xf2, 0xab, 0x03, 0x7c, 0x96, 0x60, 0x6a,
+	0x29, 0x0d, 0x49, 0x67, 0x9f, 0xfc, 0x0e, 0x1c, 0xa4, 0x2c, 0x18, 0x7a, 0x6e, 0xc0, 0x7e, 0x5d,
+	0x3f, 0x42, 0x9b, 0xfb, 0x73, 0xb2, 0x27, 0xff, 0xa5, 0x06, 0x0d, 0xbe, 0xd8, 0x9a, 0x3f, 0xae,
+	0xc5, 0xdb, 0x42, 0xc6, 0xf3, 0x9f, 0xe4, 0x23, 0xfd, 0x9c, 0x72, 0x52, 0xd5, 0x96, 0x69, 0xd5,
+	0xd3, 0xbb, 0xa0, 0x7e, 0x9c, 0x9f, 0x5b, 0x98, 0xcf, 0x41, 0x68, 0x1f, 0xe5, 0xbf, 0x08, 0xed,
+	0xa1, 0xef, 0x6d, 0xfb, 0xb8, 0x1f, 0xd4, 0x53, 0xbf, 0x71, 0xa5, 0xc3, 0x9e, 0x48, 0x31, 0x1a,
+	0x03, 0xcc, 0x47, 0xd0, 0x8e, 0x72, 0x73, 0x02, 0xba, 0x31, 0xbc, 0xd9, 0x73, 0x93, 0xf0, 0x66,
+	0xdc, 0x81, 0x0c, 0x68, 0xc9, 0x1e, 0x8c, 0xce, 0x72, 0x32, 0x79, 0xf2, 0x95, 0xfc, 0x78, 0x72,
+	0x10, 0x3a, 0x2b, 0xbe, 0x37, 0xe4, 0xa1, 0xb0, 0xdd, 0x19, 0xb4, 0xc0, 0xf5, 0xdd, 0xa1, 0xe7,
+	0x87, 0xdd, 0x0a, 0xfe, 0xbd, 0xfa, 0x8a, 0xff, 0x5d, 0x25, 0x07, 0xa0, 0xbd, 0x61, 0xed, 0x31,
+	0x14, 0xeb, 0xd6, 0x08, 0xc1, 0x6b, 0x04, 0x77, 0x18, 0xcb, 0x95, 0xa4, 0x5b, 0x47, 0xa2, 0x87,
+	0xce, 0xb6, 0x38, 0x1d, 0x75, 0x1b, 0x08, 0x46, 0xbf, 0xef, 0x68, 0xd8, 0x6d, 0x22, 0x78, 0x69,
+	0x34, 0x78, 0x81, 0xb3, 0xa4, 0xdb, 0x3a, 0xb9, 0x18, 0x7d, 0x3e, 0x6f, 0x43, 0x5d, 0x9e, 0xd3,
+	0x66, 0xa1, 0x45, 0x47, 0x7c, 0xa1, 0xeb, 0x56, 0x48, 0x5b, 0xec, 0x9e, 0x42, 0xe9, 0xb2, 0xe5,
+	0xf6, 0xd9, 0x80, 0x4f, 0x8e, 0x0e, 0x34, 0x56, 0x7d, 0xdf, 0xf3, 0xbb, 0xf5, 0xa5, 0xf9, 0x1f,
+	0x7f, 0x7c, 0xbc, 0xf2, 0xd3, 0x8f, 0x8f, 0x57, 0x7e, 0xfe, 0xf1, 0xf1, 0xca, 0x9f, 0xfc, 0xe2,
+	0xf8, 0xcc, 0x4f, 0x7f, 0x71, 0x7c, 0xe6, 0x5f, 0x7f, 0x71, 0x7c, 0xe6, 0xa3, 0xea, 0x70, 0x73,
+	0xb3, 0xc9, 0xbf, 0x7b, 0x5e, 0xfc, 0x9f, 0x01, 0x00, 0x9d, 0xe8, 0x48, 0x0b, 0x5c, 0x59, 0x00,
+	0x00,
 }
 
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -11635,6 +12096,29 @@ func (m *EventMessageValueOfBlockSetWidget) MarshalToSizedBuffer(dAtA []byte) (i
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfBlockSetTableColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfBlockSetTableColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockSetTableColumn != nil {
+		{
+			size, err := m.BlockSetTableColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfObjectDetailsAmend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -11773,6 +12257,29 @@ func (m *EventMessageValueOfObjectRestrictionsSet) MarshalToSizedBuffer(dAtA []b
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfObjectSyncedBlocksSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfObjectSyncedBlocksSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ObjectSyncedBlocksSet != nil {
+		{
+			size, err := m.ObjectSyncedBlocksSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfSubscriptionAdd) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -11888,6 +12395,29 @@ func (m *EventMessageValueOfSubscriptionGroups) MarshalToSizedBuffer(dAtA []byte
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfSubscriptionCalendarDay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfSubscriptionCalendarDay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SubscriptionCalendarDay != nil {
+		{
+			size, err := m.SubscriptionCalendarDay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfPing) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -12963,6 +13493,50 @@ func (m *EventObjectSubscriptionGroups) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *EventObjectSubscriptionCalendarDay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObjectSubscriptionCalendarDay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObjectSubscriptionCalendarDay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObjectIds) > 0 {
+		for iNdEx := len(m.ObjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObjectIds[iNdEx])
+			copy(dAtA[i:], m.ObjectIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Date != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SubId) > 0 {
+		i -= len(m.SubId)
+		copy(dAtA[i:], m.SubId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventObjectRelations) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13166,6 +13740,80 @@ func (m *EventObjectRestrictionsSet) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *EventObjectSyncedBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObjectSyncedBlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObjectSyncedBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventObjectSyncedBlocksSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObjectSyncedBlocksSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObjectSyncedBlocksSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13318,20 +13966,20 @@ func (m *EventBlockMarksInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.MarksInRange) > 0 {
-		dAtA73 := make([]byte, len(m.MarksInRange)*10)
-		var j72 int
+		dAtA76 := make([]byte, len(m.MarksInRange)*10)
+		var j75 int
 		for _, num := range m.MarksInRange {
 			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j72++
+				j75++
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			dAtA76[j75] = uint8(num)
+			j75++
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintEvents(dAtA, i, uint64(j72))
+		i -= j75
+		copy(dAtA[i:], dAtA76[:j75])
+		i = encodeVarintEvents(dAtA, i, uint64(j75))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -14013,6 +14661,18 @@ func (m *EventBlockSetLatex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Label != nil {
+		{
+			size, err := m.Label.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Text != nil {
 		{
 			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
@@ -14065,6 +14725,36 @@ func (m *EventBlockSetLatexText) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlockSetLatexLabel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetLatexLabel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetLatexLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBlockSetDiv) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14499,6 +15189,18 @@ func (m *EventBlockSetLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TargetSubBlockId != nil {
+		{
+			size, err := m.TargetSubBlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Relations != nil {
 		{
 			size, err := m.Relations.MarshalToSizedBuffer(dAtA[:i])
@@ -14623,6 +15325,36 @@ func (m *EventBlockSetLinkTargetBlockId) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlockSetLinkTargetSubBlockId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetLinkTargetSubBlockId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetLinkTargetSubBlockId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBlockSetLinkStyle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15239,6 +15971,76 @@ func (m *EventBlockSetTableRowIsHeader) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlockSetTableColumn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetTableColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetTableColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Type != nil {
+		{
+			size, err := m.Type.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBlockSetTableColumnType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetTableColumnType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetTableColumnType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBlockSetWidget) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17019,6 +17821,34 @@ func (m *EventBlockDataviewViewUpdateFields) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.DependencyRelationKey) > 0 {
+		i -= len(m.DependencyRelationKey)
+		copy(dAtA[i:], m.DependencyRelationKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DependencyRelationKey)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.EndDateRelationKey) > 0 {
+		i -= len(m.EndDateRelationKey)
+		copy(dAtA[i:], m.EndDateRelationKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EndDateRelationKey)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DateRelationKey) > 0 {
+		i -= len(m.DateRelationKey)
+		copy(dAtA[i:], m.DateRelationKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DateRelationKey)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.DefaultTemplateId) > 0 {
 		i -= len(m.DefaultTemplateId)
 		copy(dAtA[i:], m.DefaultTemplateId)
@@ -19855,6 +20685,18 @@ func (m *EventMessageValueOfBlockSetWidget) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfBlockSetTableColumn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockSetTableColumn != nil {
+		l = m.BlockSetTableColumn.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfObjectDetailsAmend) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19927,6 +20769,18 @@ func (m *EventMessageValueOfObjectRestrictionsSet) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfObjectSyncedBlocksSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectSyncedBlocksSet != nil {
+		l = m.ObjectSyncedBlocksSet.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfSubscriptionAdd) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19987,6 +20841,18 @@ func (m *EventMessageValueOfSubscriptionGroups) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfSubscriptionCalendarDay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionCalendarDay != nil {
+		l = m.SubscriptionCalendarDay.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfPing) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20482,6 +21348,28 @@ func (m *EventObjectSubscriptionGroups) Size() (n int) {
 	return n
 }
 
+func (m *EventObjectSubscriptionCalendarDay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Date != 0 {
+		n += 1 + sovEvents(uint64(m.Date))
+	}
+	if len(m.ObjectIds) > 0 {
+		for _, s := range m.ObjectIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventObjectRelations) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20570,6 +21458,38 @@ func (m *EventObjectRestrictionsSet) Size() (n int) {
 	return n
 }
 
+func (m *EventObjectSyncedBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventObjectSyncedBlocksSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventBlock) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20929,6 +21849,10 @@ func (m *EventBlockSetLatex) Size() (n int) {
 		l = m.Text.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Label != nil {
+		l = m.Label.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -20945,6 +21869,19 @@ func (m *EventBlockSetLatexText) Size() (n int) {
 	return n
 }
 
+func (m *EventBlockSetLatexLabel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventBlockSetDiv) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21152,6 +22089,10 @@ func (m *EventBlockSetLink) Size() (n int) {
 		l = m.Relations.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.TargetSubBlockId != nil {
+		l = m.TargetSubBlockId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -21168,6 +22109,19 @@ func (m *EventBlockSetLinkTargetBlockId) Size() (n int) {
 	return n
 }
 
+func (m *EventBlockSetLinkTargetSubBlockId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventBlockSetLinkStyle) Size() (n int) {
 	if m == nil {
 		return 0
@@ -21420,6 +22374,35 @@ func (m *EventBlockSetTableRowIsHeader) Size() (n int) {
 	return n
 }
 
+func (m *EventBlockSetTableColumn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Type != nil {
+		l = m.Type.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBlockSetTableColumnType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovEvents(uint64(m.Value))
+	}
+	return n
+}
+
 func (m *EventBlockSetWidget) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22181,6 +23164,22 @@ func (m *EventBlockDataviewViewUpdateFields) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.DateRelationKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.EndDateRelationKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DependencyRelationKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -24591,6 +25590,41 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &EventMessageValueOfBlockSetWidget{v}
 			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSetTableColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockSetTableColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockSetTableColumn{v}
+			iNdEx = postIndex
 		case 50:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObjectDetailsAmend", wireType)
@@ -24801,9 +25835,9 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &EventMessageValueOfObjectRestrictionsSet{v}
 			iNdEx = postIndex
-		case 60:
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionAdd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectSyncedBlocksSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24830,15 +25864,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventObjectSubscriptionAdd{}
+			v := &EventObjectSyncedBlocksSet{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfSubscriptionAdd{v}
+			m.Value = &EventMessageValueOfObjectSyncedBlocksSet{v}
 			iNdEx = postIndex
-		case 61:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionAdd", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24865,15 +25899,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventObjectSubscriptionRemove{}
+			v := &EventObjectSubscriptionAdd{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfSubscriptionRemove{v}
+			m.Value = &EventMessageValueOfSubscriptionAdd{v}
 			iNdEx = postIndex
-		case 62:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionPosition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRemove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24900,15 +25934,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventObjectSubscriptionPosition{}
+			v := &EventObjectSubscriptionRemove{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfSubscriptionPosition{v}
+			m.Value = &EventMessageValueOfSubscriptionRemove{v}
 			iNdEx = postIndex
-		case 63:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionCounters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionPosition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24935,15 +25969,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventObjectSubscriptionCounters{}
+			v := &EventObjectSubscriptionPosition{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfSubscriptionCounters{v}
+			m.Value = &EventMessageValueOfSubscriptionPosition{v}
 			iNdEx = postIndex
-		case 64:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionCounters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24970,15 +26004,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventObjectSubscriptionGroups{}
+			v := &EventObjectSubscriptionCounters{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfSubscriptionGroups{v}
+			m.Value = &EventMessageValueOfSubscriptionCounters{v}
 			iNdEx = postIndex
-		case 100:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionGroups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25005,15 +26039,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventPing{}
+			v := &EventObjectSubscriptionGroups{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfPing{v}
+			m.Value = &EventMessageValueOfSubscriptionGroups{v}
 			iNdEx = postIndex
-		case 101:
+		case 65:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessNew", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionCalendarDay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25040,15 +26074,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventProcessNew{}
+			v := &EventObjectSubscriptionCalendarDay{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfProcessNew{v}
+			m.Value = &EventMessageValueOfSubscriptionCalendarDay{v}
 			iNdEx = postIndex
-		case 102:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ping", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25075,15 +26109,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventProcessUpdate{}
+			v := &EventPing{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfProcessUpdate{v}
+			m.Value = &EventMessageValueOfPing{v}
 			iNdEx = postIndex
-		case 103:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessDone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessNew", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25110,15 +26144,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventProcessDone{}
+			v := &EventProcessNew{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfProcessDone{v}
+			m.Value = &EventMessageValueOfProcessNew{v}
 			iNdEx = postIndex
-		case 110:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25145,15 +26179,85 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventStatusThread{}
+			v := &EventProcessUpdate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfThreadStatus{v}
+			m.Value = &EventMessageValueOfProcessUpdate{v}
 			iNdEx = postIndex
-		case 111:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileLimitReached", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessDone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventProcessDone{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfProcessDone{v}
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventStatusThread{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfThreadStatus{v}
+			iNdEx = postIndex
+		case 111:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileLimitReached", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27519,6 +28623,139 @@ func (m *EventObjectSubscriptionGroups) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventObjectSubscriptionCalendarDay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CalendarDay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CalendarDay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectIds = append(m.ObjectIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventObjectRelations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27748,221 +28985,421 @@ func (m *EventObjectRelationsRemove) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationKeys", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RelationKeys = append(m.RelationKeys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventObjectRemove) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventObjectRestrictions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Restrictions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Restrictions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventObjectRestrictionsSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Set: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationKeys = append(m.RelationKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectRestrictions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Restrictions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Restrictions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectRestrictionsSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restrictions == nil {
+				m.Restrictions = &model.Restrictions{}
+			}
+			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectSyncedBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncedBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectSyncedBlocksSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27990,11 +29427,11 @@ func (m *EventObjectRestrictionsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28021,10 +29458,8 @@ func (m *EventObjectRestrictionsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Restrictions == nil {
-				m.Restrictions = &model.Restrictions{}
-			}
-			if err := m.Restrictions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Blocks = append(m.Blocks, &model.Block{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30398,6 +31833,42 @@ func (m *EventBlockSetLatex) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Label == nil {
+				m.Label = &EventBlockSetLatexLabel{}
+			}
+			if err := m.Label.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -30501,6 +31972,88 @@ func (m *EventBlockSetLatexText) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBlockSetLatexLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Label: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Label: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventBlockSetDiv) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -31926,6 +33479,42 @@ func (m *EventBlockSetLink) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSubBlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetSubBlockId == nil {
+				m.TargetSubBlockId = &EventBlockSetLinkTargetSubBlockId{}
+			}
+			if err := m.TargetSubBlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -32029,6 +33618,88 @@ func (m *EventBlockSetLinkTargetBlockId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBlockSetLinkTargetSubBlockId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetSubBlockId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetSubBlockId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventBlockSetLinkStyle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -32671,16 +34342,88 @@ func (m *EventBlockSetBookmark) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ImageHash == nil {
-				m.ImageHash = &EventBlockSetBookmarkImageHash{}
+			if m.ImageHash == nil {
+				m.ImageHash = &EventBlockSetBookmarkImageHash{}
+			}
+			if err := m.ImageHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaviconHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FaviconHash == nil {
+				m.FaviconHash = &EventBlockSetBookmarkFaviconHash{}
+			}
+			if err := m.FaviconHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Type == nil {
+				m.Type = &EventBlockSetBookmarkType{}
 			}
-			if err := m.ImageHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FaviconHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32707,16 +34450,16 @@ func (m *EventBlockSetBookmark) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FaviconHash == nil {
-				m.FaviconHash = &EventBlockSetBookmarkFaviconHash{}
+			if m.TargetObjectId == nil {
+				m.TargetObjectId = &EventBlockSetBookmarkTargetObjectId{}
 			}
-			if err := m.FaviconHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32743,18 +34486,68 @@ func (m *EventBlockSetBookmark) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Type == nil {
-				m.Type = &EventBlockSetBookmarkType{}
+			if m.State == nil {
+				m.State = &EventBlockSetBookmarkState{}
 			}
-			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSetBookmarkUrl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Url: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Url: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -32764,33 +34557,79 @@ func (m *EventBlockSetBookmark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetObjectId == nil {
-				m.TargetObjectId = &EventBlockSetBookmarkTargetObjectId{}
-			}
-			if err := m.TargetObjectId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSetBookmarkTitle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Title: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Title: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -32800,27 +34639,23 @@ func (m *EventBlockSetBookmark) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &EventBlockSetBookmarkState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32843,7 +34678,7 @@ func (m *EventBlockSetBookmark) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetBookmarkUrl) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetBookmarkDescription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32866,10 +34701,10 @@ func (m *EventBlockSetBookmarkUrl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Url: wiretype end group for non-group")
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Url: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32925,7 +34760,7 @@ func (m *EventBlockSetBookmarkUrl) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetBookmarkTitle) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetBookmarkImageHash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32948,10 +34783,10 @@ func (m *EventBlockSetBookmarkTitle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Title: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageHash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Title: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageHash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -33007,7 +34842,7 @@ func (m *EventBlockSetBookmarkTitle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetBookmarkDescription) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetBookmarkFaviconHash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33030,10 +34865,10 @@ func (m *EventBlockSetBookmarkDescription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: FaviconHash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FaviconHash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -33089,7 +34924,7 @@ func (m *EventBlockSetBookmarkDescription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetBookmarkImageHash) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetBookmarkType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33112,10 +34947,79 @@ func (m *EventBlockSetBookmarkImageHash) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageHash: wiretype end group for non-group")
+			return fmt.Errorf("proto: Type: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageHash: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Type: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= model.LinkPreviewType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSetBookmarkTargetObjectId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetObjectId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetObjectId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -33171,7 +35075,7 @@ func (m *EventBlockSetBookmarkImageHash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetBookmarkFaviconHash) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetBookmarkState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33194,17 +35098,17 @@ func (m *EventBlockSetBookmarkFaviconHash) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FaviconHash: wiretype end group for non-group")
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FaviconHash: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -33214,24 +35118,11 @@ func (m *EventBlockSetBookmarkFaviconHash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Value |= model.BlockContentBookmarkState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -33253,7 +35144,7 @@ func (m *EventBlockSetBookmarkFaviconHash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetBookmarkType) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33276,17 +35167,17 @@ func (m *EventBlockSetBookmarkType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Type: wiretype end group for non-group")
+			return fmt.Errorf("proto: TableRow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Type: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TableRow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Value = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -33296,66 +35187,29 @@ func (m *EventBlockSetBookmarkType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= model.LinkPreviewType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventBlockSetBookmarkTargetObjectId) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TargetObjectId: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TargetObjectId: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsHeader", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -33365,23 +35219,27 @@ func (m *EventBlockSetBookmarkTargetObjectId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.IsHeader == nil {
+				m.IsHeader = &EventBlockSetTableRowIsHeader{}
+			}
+			if err := m.IsHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33404,7 +35262,7 @@ func (m *EventBlockSetBookmarkTargetObjectId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetBookmarkState) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetTableRowIsHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33427,17 +35285,17 @@ func (m *EventBlockSetBookmarkState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Value = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -33447,11 +35305,12 @@ func (m *EventBlockSetBookmarkState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= model.BlockContentBookmarkState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Value = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -33473,7 +35332,7 @@ func (m *EventBlockSetBookmarkState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetTableColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33496,10 +35355,10 @@ func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TableRow: wiretype end group for non-group")
+			return fmt.Errorf("proto: TableColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableRow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TableColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -33536,7 +35395,7 @@ func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33563,10 +35422,10 @@ func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IsHeader == nil {
-				m.IsHeader = &EventBlockSetTableRowIsHeader{}
+			if m.Type == nil {
+				m.Type = &EventBlockSetTableColumnType{}
 			}
-			if err := m.IsHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -33591,7 +35450,7 @@ func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetTableRowIsHeader) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetTableColumnType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33614,17 +35473,17 @@ func (m *EventBlockSetTableRowIsHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: Type: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Type: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var v int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -33634,12 +35493,11 @@ func (m *EventBlockSetTableRowIsHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Value |= model.BlockContentTableColumnType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Value = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -38731,6 +40589,134 @@ func (m *EventBlockDataviewViewUpdateFields) Unmarshal(dAtA []byte) error {
 			}
 			m.DefaultTemplateId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateRelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateRelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDateRelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDateRelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyRelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependencyRelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -26,243 +26,279 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("pb/protos/service/service.proto", fileDescriptor_93a29dc403579097) }
 
 var fileDescriptor_93a29dc403579097 = []byte{
-	// 3774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9c, 0x4b, 0x6f, 0x1c, 0xc7,
-	0xb5, 0xc7, 0x3d, 0x9b, 0xeb, 0x7b, 0xdb, 0xd7, 0xbe, 0x37, 0xe3, 0x44, 0x71, 0x14, 0x9b, 0x92,
-	0x65, 0x49, 0xa4, 0x44, 0x72, 0x48, 0x4b, 0xf2, 0x23, 0x0f, 0x20, 0xa0, 0x48, 0x51, 0x22, 0xac,
-	0x57, 0x38, 0x94, 0x04, 0x18, 0x08, 0x90, 0x66, 0x4f, 0x69, 0xd8, 0x61, 0x4f, 0x57, 0xbb, 0xbb,
-	0x86, 0x14, 0x13, 0x24, 0x48, 0x90, 0x20, 0x41, 0x82, 0x04, 0x09, 0xf2, 0x58, 0x65, 0x97, 0x4d,
-	0xbe, 0x4a, 0x96, 0xde, 0x25, 0xcb, 0xc0, 0xfe, 0x22, 0x41, 0x77, 0x9d, 0xae, 0xc7, 0xe9, 0x3a,
-	0xd5, 0x35, 0x5e, 0x49, 0x98, 0xf3, 0x3b, 0xe7, 0x5f, 0xd5, 0xf5, 0x3a, 0x55, 0xd5, 0xcd, 0xe8,
-	0x42, 0x71, 0xb8, 0x51, 0x94, 0x5c, 0xf0, 0x6a, 0xa3, 0x62, 0xe5, 0x49, 0x9a, 0xb0, 0xf6, 0xdf,
-	0x51, 0xf3, 0xf3, 0xf0, 0xe5, 0x38, 0x3f, 0x13, 0x67, 0x05, 0x3b, 0xff, 0x86, 0x26, 0x13, 0x3e,
-	0x9b, 0xc5, 0xf9, 0xa4, 0x92, 0xc8, 0xf9, 0x73, 0xda, 0xc2, 0x4e, 0x58, 0x2e, 0xe0, 0xf7, 0x1b,
-	0xff, 0xfc, 0xfb, 0x20, 0x7a, 0x6d, 0x3b, 0x4b, 0x59, 0x2e, 0xb6, 0xc1, 0x63, 0xf8, 0x71, 0xf4,
-	0xea, 0x56, 0x51, 0xdc, 0x65, 0xe2, 0x29, 0x2b, 0xab, 0x94, 0xe7, 0xc3, 0x77, 0x46, 0x20, 0x30,
-	0xda, 0x2f, 0x92, 0xd1, 0x56, 0x51, 0x8c, 0xb4, 0x71, 0xb4, 0xcf, 0x3e, 0x99, 0xb3, 0x4a, 0x9c,
-	0xbf, 0xec, 0x87, 0xaa, 0x82, 0xe7, 0x15, 0x1b, 0x3e, 0x8f, 0xbe, 0xb4, 0x55, 0x14, 0x63, 0x26,
-	0x76, 0x58, 0x5d, 0x81, 0xb1, 0x88, 0x05, 0x1b, 0x2e, 0x77, 0x5c, 0x6d, 0x40, 0x69, 0xac, 0xf4,
-	0x83, 0xa0, 0x73, 0x10, 0xbd, 0x52, 0xeb, 0x1c, 0xcd, 0xc5, 0x84, 0x9f, 0xe6, 0xc3, 0xb7, 0xbb,
-	0x8e, 0x60, 0x52, 0xb1, 0x2f, 0xf9, 0x10, 0x88, 0xfa, 0x2c, 0xfa, 0xdf, 0x67, 0x71, 0x96, 0x31,
-	0xb1, 0x5d, 0xb2, 0xba, 0xe0, 0xb6, 0x8f, 0x34, 0x8d, 0xa4, 0x4d, 0xc5, 0x7d, 0xc7, 0xcb, 0x40,
-	0xe0, 0x8f, 0xa3, 0x57, 0xa5, 0x65, 0x9f, 0x25, 0xfc, 0x84, 0x95, 0x43, 0xa7, 0x17, 0x18, 0x89,
-	0x47, 0xde, 0x81, 0x70, 0xec, 0x6d, 0x9e, 0x9f, 0xb0, 0x52, 0xb8, 0x63, 0x83, 0xd1, 0x1f, 0x5b,
-	0x43, 0x10, 0x3b, 0x8b, 0x5e, 0x37, 0x1f, 0xc8, 0x98, 0x55, 0x4d, 0x87, 0xb9, 0x46, 0xd7, 0x19,
-	0x10, 0xa5, 0x73, 0x3d, 0x04, 0x05, 0xb5, 0x34, 0x1a, 0x82, 0x5a, 0xc6, 0x2b, 0x25, 0xb6, 0xe2,
-	0x8c, 0x60, 0x10, 0x4a, 0xeb, 0x5a, 0x00, 0x09, 0x52, 0xdf, 0x8f, 0xfe, 0xef, 0x19, 0x2f, 0x8f,
-	0xab, 0x22, 0x4e, 0x18, 0x34, 0xf6, 0x15, 0xdb, 0xbb, 0xb5, 0xe2, 0xf6, 0xbe, 0xda, 0x87, 0x81,
-	0xc2, 0x71, 0x34, 0x54, 0xc6, 0x47, 0x87, 0x3f, 0x60, 0x89, 0xd8, 0x9a, 0x4c, 0xf0, 0x93, 0x53,
-	0xde, 0x92, 0x18, 0x6d, 0x4d, 0x26, 0xd4, 0x93, 0x73, 0xa3, 0x20, 0x76, 0x1a, 0x9d, 0x43, 0x62,
-	0xf7, 0xd3, 0xaa, 0x11, 0x5c, 0xf7, 0x47, 0x01, 0x4c, 0x89, 0x8e, 0x42, 0x71, 0x10, 0xfe, 0xe9,
-	0x20, 0xfa, 0x9a, 0x43, 0x79, 0x9f, 0xcd, 0xf8, 0x09, 0x1b, 0x6e, 0xf6, 0x47, 0x93, 0xa4, 0xd2,
-	0x7f, 0x77, 0x01, 0x0f, 0x47, 0x53, 0x8e, 0x59, 0xc6, 0x12, 0x41, 0x36, 0xa5, 0x34, 0xf7, 0x36,
-	0xa5, 0xc2, 0x8c, 0x51, 0xd0, 0x1a, 0xef, 0x32, 0xb1, 0x3d, 0x2f, 0x4b, 0x96, 0x0b, 0xb2, 0x2d,
-	0x35, 0xd2, 0xdb, 0x96, 0x16, 0xea, 0xa8, 0xcf, 0x5d, 0x26, 0xb6, 0xb2, 0x8c, 0xac, 0x8f, 0x34,
-	0xf7, 0xd6, 0x47, 0x61, 0xa0, 0xf0, 0x13, 0xa3, 0xcd, 0xc6, 0x4c, 0xec, 0x55, 0xf7, 0xd2, 0xe9,
-	0x51, 0x96, 0x4e, 0x8f, 0x04, 0x9b, 0x0c, 0x37, 0xc8, 0x87, 0x62, 0x83, 0x4a, 0x75, 0x33, 0xdc,
-	0xc1, 0x51, 0xc3, 0x3b, 0x2f, 0x0a, 0x5e, 0xd2, 0x2d, 0x26, 0xcd, 0xbd, 0x35, 0x54, 0x18, 0x28,
-	0x7c, 0x2f, 0x7a, 0x6d, 0x2b, 0x49, 0xf8, 0x3c, 0x57, 0x13, 0x2e, 0x5a, 0xbe, 0xa4, 0xb1, 0x33,
-	0xe3, 0x5e, 0xe9, 0xa1, 0xf4, 0x94, 0x0b, 0x36, 0x98, 0x3b, 0xde, 0x71, 0xfa, 0xa1, 0x99, 0xe3,
-	0xb2, 0x1f, 0xea, 0xc4, 0xde, 0x61, 0x19, 0x23, 0x63, 0x4b, 0x63, 0x4f, 0x6c, 0x05, 0x75, 0x62,
-	0xc3, 0x40, 0x71, 0xc7, 0x46, 0xc3, 0xe4, 0xb2, 0x1f, 0x32, 0x56, 0x64, 0x88, 0x2d, 0x78, 0x81,
-	0x57, 0xe4, 0xd6, 0x49, 0xf0, 0x82, 0x5a, 0x91, 0x6d, 0xa4, 0x13, 0xf5, 0x41, 0x3d, 0xa1, 0xb8,
-	0xa3, 0x3e, 0x30, 0x67, 0x90, 0x4b, 0x3e, 0x44, 0x0f, 0xe8, 0xb6, 0xfd, 0x78, 0xfe, 0x3c, 0x9d,
-	0x3e, 0x29, 0x26, 0x75, 0x2b, 0x5e, 0x73, 0x37, 0x90, 0x81, 0x10, 0x03, 0x9a, 0x40, 0x41, 0xed,
-	0x77, 0x83, 0x68, 0xc9, 0xee, 0x8d, 0xbb, 0x25, 0x9f, 0xdd, 0x67, 0xd3, 0x38, 0x39, 0x83, 0xee,
-	0x7f, 0xcb, 0xd7, 0xef, 0x30, 0xad, 0x0a, 0xf1, 0xde, 0x82, 0x5e, 0x50, 0x9e, 0xef, 0x46, 0x91,
-	0x9c, 0x4e, 0x1f, 0x15, 0x2c, 0x1f, 0x5e, 0xb4, 0x82, 0xc0, 0x3c, 0x5b, 0x5b, 0x94, 0xcc, 0xdb,
-	0x1e, 0x42, 0x37, 0x93, 0xfc, 0xbd, 0x59, 0x6d, 0x87, 0x4e, 0x8f, 0xc6, 0x44, 0x34, 0x13, 0x42,
-	0x70, 0x41, 0xc7, 0x47, 0xfc, 0xd4, 0x5d, 0xd0, 0xda, 0xe2, 0x2f, 0x28, 0x10, 0x3a, 0xc3, 0x83,
-	0x82, 0xba, 0x32, 0xbc, 0xb6, 0x18, 0xbe, 0x0c, 0x0f, 0x33, 0x10, 0x98, 0x47, 0x5f, 0x36, 0x03,
-	0xdf, 0xe6, 0xfc, 0x78, 0x16, 0x97, 0xc7, 0xc3, 0xeb, 0xb4, 0x73, 0xcb, 0x28, 0xa1, 0xd5, 0x20,
-	0x56, 0x4f, 0xa2, 0xa6, 0xe0, 0x98, 0xe1, 0x49, 0xd4, 0xf2, 0x1f, 0x33, 0x6a, 0x12, 0x75, 0x60,
-	0xb8, 0x51, 0xef, 0x96, 0x71, 0x71, 0xe4, 0x6e, 0xd4, 0xc6, 0xe4, 0x6f, 0xd4, 0x16, 0xc1, 0x2d,
-	0x30, 0x66, 0x71, 0x99, 0x1c, 0xb9, 0x5b, 0x40, 0xda, 0xfc, 0x2d, 0xa0, 0x18, 0x08, 0x5c, 0x46,
-	0x5f, 0x31, 0x03, 0x8f, 0xe7, 0x87, 0x55, 0x52, 0xa6, 0x87, 0x6c, 0xb8, 0x4a, 0x7b, 0x2b, 0x48,
-	0x49, 0xad, 0x85, 0xc1, 0x3a, 0x63, 0x05, 0xcd, 0xd6, 0xb6, 0x37, 0xa9, 0x50, 0xc6, 0xda, 0xc6,
-	0x30, 0x08, 0x22, 0x63, 0x75, 0x93, 0xb8, 0x7a, 0x77, 0x4b, 0x3e, 0x2f, 0xaa, 0x9e, 0xea, 0x21,
-	0xc8, 0x5f, 0xbd, 0x2e, 0x0c, 0x9a, 0x2f, 0xa2, 0xaf, 0x9a, 0x8f, 0xf4, 0x49, 0x5e, 0x29, 0xd5,
-	0x75, 0xfa, 0x39, 0x19, 0x18, 0x91, 0x57, 0x7a, 0x70, 0x50, 0x4e, 0xa2, 0xff, 0x6f, 0x95, 0xc5,
-	0x0e, 0x13, 0x71, 0x9a, 0x55, 0xc3, 0xab, 0xee, 0x18, 0xad, 0x5d, 0x69, 0x2d, 0xf7, 0x72, 0x78,
-	0x08, 0xed, 0xcc, 0x8b, 0x2c, 0x4d, 0xba, 0x9b, 0x00, 0xf0, 0x55, 0x66, 0xff, 0x10, 0x32, 0x31,
-	0xbd, 0xd0, 0xa8, 0x6a, 0xc8, 0xff, 0x1c, 0x9c, 0x15, 0x78, 0xa1, 0xd1, 0x25, 0xd4, 0x08, 0xb1,
-	0xd0, 0x10, 0x28, 0xae, 0xcf, 0x98, 0x89, 0xfb, 0xf1, 0x19, 0x9f, 0x13, 0x53, 0x82, 0x32, 0xfb,
-	0xeb, 0x63, 0x62, 0xa0, 0x30, 0x8f, 0xce, 0x29, 0x85, 0xbd, 0x5c, 0xb0, 0x32, 0x8f, 0xb3, 0xdd,
-	0x2c, 0x9e, 0x56, 0x43, 0x62, 0xdc, 0xd8, 0x94, 0xd2, 0x5b, 0x0f, 0xa4, 0x1d, 0x8f, 0x71, 0xaf,
-	0xda, 0x8d, 0x4f, 0x78, 0x99, 0x0a, 0xfa, 0x31, 0x6a, 0xa4, 0xf7, 0x31, 0x5a, 0xa8, 0x53, 0x6d,
-	0xab, 0x4c, 0x8e, 0xd2, 0x13, 0x36, 0xf1, 0xa8, 0xb5, 0x48, 0x80, 0x9a, 0x81, 0x3a, 0x1a, 0x6d,
-	0xcc, 0xe7, 0x65, 0xc2, 0xc8, 0x46, 0x93, 0xe6, 0xde, 0x46, 0x53, 0x18, 0x28, 0xfc, 0x62, 0x10,
-	0x7d, 0x5d, 0x5a, 0xcd, 0xac, 0x7f, 0x27, 0xae, 0x8e, 0x0e, 0x79, 0x5c, 0x4e, 0x86, 0xef, 0xba,
-	0xe2, 0x38, 0x51, 0x25, 0x7d, 0x63, 0x11, 0x17, 0xfc, 0x58, 0xeb, 0x4d, 0x9c, 0x1e, 0x71, 0xce,
-	0xc7, 0x6a, 0x21, 0xfe, 0xc7, 0x8a, 0x51, 0x3c, 0x81, 0x34, 0x76, 0x99, 0x49, 0x5f, 0x25, 0xfd,
-	0xed, 0x64, 0x7a, 0xb9, 0x97, 0xc3, 0xf3, 0x63, 0x6d, 0xb4, 0x7b, 0xcb, 0x3a, 0x15, 0xc3, 0xdd,
-	0x63, 0x46, 0xa1, 0x38, 0xa9, 0xac, 0x46, 0x85, 0x5f, 0xb9, 0x33, 0x32, 0x46, 0xa1, 0x38, 0x6e,
-	0xc6, 0xad, 0xa2, 0xc8, 0xce, 0x0e, 0xd8, 0xac, 0xc8, 0xc8, 0x66, 0xb4, 0x10, 0x7f, 0x33, 0x62,
-	0x14, 0xe7, 0x20, 0x07, 0xbc, 0xce, 0x70, 0x9c, 0x39, 0x48, 0x63, 0xf2, 0xe7, 0x20, 0x2d, 0x82,
-	0x97, 0xed, 0x03, 0xbe, 0xcd, 0xb3, 0x7a, 0x1f, 0xd3, 0x3d, 0x68, 0x52, 0x9e, 0x9a, 0xf0, 0x2f,
-	0xdb, 0x88, 0xd4, 0x07, 0xa2, 0x6d, 0x0e, 0x1b, 0x97, 0xec, 0xf6, 0xd9, 0xfd, 0x34, 0x3f, 0x1e,
-	0xba, 0x57, 0x28, 0x0d, 0x10, 0x07, 0xa2, 0x4e, 0x10, 0xe7, 0xca, 0x4f, 0xf2, 0x09, 0x77, 0xe7,
-	0xca, 0xb5, 0xc5, 0x9f, 0x2b, 0x03, 0x81, 0x43, 0xee, 0x33, 0x2a, 0x64, 0x6d, 0xf1, 0x87, 0x04,
-	0xc2, 0x35, 0x2a, 0x61, 0xef, 0x43, 0x8e, 0x4a, 0xb4, 0xdb, 0x59, 0xee, 0xe5, 0x70, 0x0f, 0x6d,
-	0x93, 0xe6, 0x5d, 0x26, 0x92, 0x23, 0x77, 0x0f, 0xb5, 0x10, 0x7f, 0x0f, 0xc5, 0x28, 0xae, 0xd2,
-	0x01, 0x57, 0x49, 0xff, 0x55, 0x77, 0xff, 0xe8, 0x24, 0xfc, 0xcb, 0xbd, 0x1c, 0x4e, 0x9a, 0xf7,
-	0x66, 0xcd, 0x33, 0x73, 0x76, 0x72, 0x69, 0xf3, 0x27, 0xcd, 0x8a, 0xc1, 0xa5, 0x97, 0x86, 0xfa,
-	0x71, 0xba, 0x4b, 0xaf, 0xed, 0xfe, 0xd2, 0x5b, 0x1c, 0x88, 0xfc, 0x79, 0x10, 0x5d, 0x30, 0x55,
-	0x1e, 0xf2, 0x7a, 0x8c, 0x3c, 0x8d, 0xb3, 0xb4, 0xde, 0x28, 0x1f, 0xf0, 0x63, 0x96, 0x0f, 0x3f,
-	0xf0, 0x94, 0x56, 0xf2, 0x23, 0xcb, 0x41, 0x95, 0xe2, 0xc3, 0xc5, 0x1d, 0x71, 0x3f, 0x91, 0xf4,
-	0x93, 0x8a, 0x6d, 0xc7, 0x15, 0x31, 0x93, 0x59, 0x88, 0xbf, 0x9f, 0x60, 0x14, 0xab, 0xe9, 0x59,
-	0xa2, 0x7b, 0x20, 0x8c, 0x09, 0xcf, 0x81, 0x30, 0x81, 0xe2, 0x44, 0x4d, 0x03, 0x70, 0x26, 0xbb,
-	0xe6, 0x8f, 0x82, 0xce, 0x63, 0xd7, 0x03, 0xe9, 0xce, 0x2e, 0x58, 0x31, 0xe3, 0xba, 0xbf, 0xf6,
-	0x14, 0x7d, 0x6c, 0xf6, 0xdb, 0xd5, 0x20, 0xd6, 0xbd, 0xed, 0xde, 0x67, 0x59, 0xdc, 0xcc, 0xe5,
-	0x9e, 0x6d, 0x77, 0xcb, 0x84, 0x6c, 0xbb, 0x0d, 0x16, 0x04, 0x7f, 0x36, 0x88, 0xce, 0xbb, 0x14,
-	0x1f, 0x15, 0x8d, 0xee, 0x66, 0x7f, 0x2c, 0x49, 0x12, 0x27, 0xde, 0x7e, 0x0f, 0x28, 0xc3, 0x8f,
-	0xa2, 0x37, 0x5a, 0x93, 0x3e, 0x10, 0x87, 0x02, 0xd8, 0xcb, 0xb9, 0x2a, 0x3f, 0xe6, 0x94, 0xfc,
-	0x46, 0x30, 0xaf, 0xf3, 0x55, 0xbb, 0x5c, 0x15, 0xca, 0x57, 0x55, 0x0c, 0x30, 0x13, 0xf9, 0xaa,
-	0x03, 0xc3, 0x4b, 0x66, 0x8b, 0xd4, 0xe3, 0xc4, 0x35, 0xd9, 0xa8, 0x10, 0xe6, 0x28, 0x59, 0xe9,
-	0x07, 0x71, 0xdf, 0x69, 0xcd, 0x90, 0x26, 0x5e, 0xf7, 0x45, 0x40, 0xa9, 0xe2, 0x6a, 0x10, 0xab,
-	0xcf, 0xdd, 0x3b, 0x15, 0xdb, 0x65, 0xb1, 0x98, 0x97, 0x9d, 0x73, 0xf7, 0x6e, 0xb9, 0x5b, 0x90,
-	0x38, 0x77, 0xf7, 0x3a, 0x80, 0xfe, 0xaf, 0x06, 0xd1, 0x9b, 0x36, 0x27, 0x9b, 0x58, 0x95, 0xe1,
-	0x86, 0x2f, 0xa4, 0xcd, 0xaa, 0x62, 0xdc, 0x5c, 0xc8, 0xa7, 0xb3, 0x25, 0x31, 0x3b, 0xf2, 0xd6,
-	0x49, 0x9c, 0x66, 0xf1, 0x61, 0xc6, 0x9c, 0x5b, 0x12, 0xab, 0x6f, 0x2a, 0xd4, 0xbb, 0x25, 0x21,
-	0x5d, 0x3a, 0xb3, 0x64, 0x33, 0xde, 0x8c, 0x1d, 0xfa, 0x1a, 0x3d, 0x2a, 0x1d, 0x9b, 0xf4, 0xf5,
-	0x40, 0x5a, 0xdf, 0xd6, 0xe9, 0x9f, 0xcd, 0x07, 0xe0, 0xcc, 0xdd, 0xc1, 0xd7, 0xa8, 0x89, 0x37,
-	0x77, 0x77, 0xe2, 0x20, 0x2c, 0xda, 0x33, 0x24, 0x53, 0xb8, 0x1e, 0x5d, 0x6b, 0xbd, 0x81, 0xcc,
-	0x21, 0xb6, 0x1e, 0x48, 0x83, 0xea, 0x8f, 0xa3, 0x37, 0xba, 0xaa, 0xb0, 0x1a, 0x6d, 0xf4, 0x86,
-	0x42, 0x0b, 0xd2, 0x66, 0xb8, 0x83, 0x4e, 0xf6, 0xef, 0xa5, 0x95, 0xe0, 0xe5, 0xd9, 0xf8, 0x88,
-	0x9f, 0xb6, 0xef, 0x3c, 0xd8, 0xd3, 0x04, 0x00, 0x23, 0x83, 0x20, 0x92, 0x7d, 0x37, 0xd9, 0x91,
-	0xd2, 0xef, 0x46, 0x54, 0x84, 0x94, 0x41, 0xf4, 0x48, 0xd9, 0xa4, 0x9e, 0x24, 0xdb, 0x5a, 0xe9,
-	0x17, 0x39, 0x96, 0xdd, 0x45, 0xed, 0xbe, 0xcc, 0xb1, 0xd2, 0x0f, 0xea, 0x0d, 0xd8, 0x6e, 0x9a,
-	0xb1, 0x47, 0xcf, 0x9f, 0x67, 0x3c, 0x9e, 0xa0, 0x0d, 0x58, 0x6d, 0x19, 0x81, 0x89, 0xd8, 0x80,
-	0x21, 0x44, 0x2f, 0x22, 0xb5, 0xa1, 0xee, 0x9d, 0x6d, 0xe4, 0x2b, 0x5d, 0x37, 0xc3, 0x4c, 0x2c,
-	0x22, 0x0e, 0x4c, 0x6f, 0x5e, 0x6a, 0xe3, 0x93, 0xa2, 0x09, 0x7e, 0xb1, 0xeb, 0x25, 0x2d, 0xc4,
-	0xe6, 0xc5, 0x26, 0x74, 0x12, 0x5e, 0xff, 0xbe, 0xc3, 0x4f, 0xf3, 0x26, 0xa8, 0xa3, 0xa2, 0xad,
-	0x8d, 0x48, 0xc2, 0x31, 0x03, 0x81, 0x3f, 0x8a, 0xfe, 0xbb, 0x09, 0x5c, 0xf2, 0x62, 0xb8, 0xe4,
-	0x70, 0x28, 0x8d, 0x4b, 0xb3, 0x0b, 0xa4, 0x5d, 0x5f, 0x7d, 0xd6, 0xbf, 0x8e, 0x8b, 0x38, 0x61,
-	0x4f, 0xaa, 0x78, 0xca, 0xd0, 0xd5, 0x67, 0xe3, 0xa2, 0xad, 0xc4, 0xd5, 0x67, 0x97, 0xd2, 0xc7,
-	0xd0, 0x0f, 0xe3, 0x93, 0x74, 0xaa, 0xe6, 0x2c, 0x39, 0x04, 0x2b, 0x74, 0x0c, 0xad, 0x99, 0x91,
-	0x01, 0x11, 0xc7, 0xd0, 0x24, 0x0c, 0x9a, 0x7f, 0x1a, 0x44, 0x17, 0x35, 0x73, 0xb7, 0x3d, 0xfc,
-	0xdc, 0xcb, 0x9f, 0xf3, 0x67, 0xa9, 0x38, 0xaa, 0x37, 0xc2, 0xd5, 0xf0, 0x7d, 0x2a, 0xa4, 0x9b,
-	0x57, 0x45, 0xf9, 0x60, 0x61, 0x3f, 0x9d, 0x85, 0xb5, 0xe7, 0x15, 0x72, 0xaa, 0xdf, 0x2d, 0xf9,
-	0x4c, 0x7a, 0xa0, 0x2c, 0x4c, 0x1d, 0x6b, 0x60, 0x8e, 0xc8, 0xc2, 0x7c, 0xbc, 0xb1, 0x94, 0x53,
-	0xea, 0xcd, 0x02, 0x76, 0x23, 0x2c, 0xa2, 0xb5, 0x8c, 0xdd, 0x5c, 0xc8, 0x47, 0xdf, 0x29, 0xab,
-	0x82, 0x64, 0x3c, 0xc7, 0xf7, 0xd5, 0x3a, 0x4a, 0x6d, 0x24, 0xee, 0x94, 0x3b, 0x90, 0x9e, 0xe4,
-	0x5a, 0x93, 0xdc, 0xe4, 0x6f, 0x65, 0x19, 0x9a, 0xe4, 0x94, 0xab, 0x02, 0x88, 0x49, 0xce, 0x09,
-	0x82, 0xce, 0x7e, 0xf4, 0x4a, 0xdd, 0xb8, 0x8f, 0x4b, 0x76, 0x92, 0x32, 0x7c, 0xd3, 0x68, 0x58,
-	0x88, 0xd9, 0xc2, 0x26, 0xf4, 0x38, 0x7c, 0x92, 0x57, 0x45, 0x16, 0x57, 0x47, 0x70, 0xd3, 0x65,
-	0xd7, 0xb9, 0x35, 0xe2, 0xbb, 0xae, 0x2b, 0x3d, 0x94, 0xde, 0xb8, 0xb7, 0x36, 0x35, 0x21, 0x5d,
-	0x75, 0xbb, 0x76, 0x26, 0xa5, 0xe5, 0x5e, 0x4e, 0x4f, 0xfe, 0xb7, 0x33, 0x9e, 0x1c, 0xc3, 0x2c,
-	0x6a, 0xd7, 0xba, 0xb1, 0xe0, 0x69, 0xf4, 0x92, 0x0f, 0xd1, 0xf3, 0x68, 0x63, 0xd8, 0x67, 0x45,
-	0x16, 0x27, 0xf8, 0x0e, 0x56, 0xfa, 0x80, 0x8d, 0x98, 0x47, 0x31, 0x83, 0x8a, 0x0b, 0x77, 0xbb,
-	0xae, 0xe2, 0xa2, 0xab, 0xdd, 0x4b, 0x3e, 0x44, 0xaf, 0x24, 0x8d, 0x61, 0x5c, 0x64, 0xa9, 0x40,
-	0x7d, 0x43, 0x7a, 0x34, 0x16, 0xa2, 0x6f, 0xd8, 0x04, 0x0a, 0xf9, 0x80, 0x95, 0x53, 0xe6, 0x0c,
-	0xd9, 0x58, 0xbc, 0x21, 0x5b, 0x02, 0x42, 0x3e, 0x8c, 0xfe, 0x47, 0xd6, 0x9d, 0x17, 0x67, 0xc3,
-	0x0b, 0xae, 0x6a, 0xf1, 0xe2, 0x4c, 0x05, 0xbc, 0x48, 0x03, 0xa8, 0x88, 0x8f, 0xe3, 0x4a, 0xb8,
-	0x8b, 0xd8, 0x58, 0xbc, 0x45, 0x6c, 0x09, 0xbd, 0xcc, 0xc9, 0x22, 0xce, 0x05, 0x5a, 0xe6, 0xa0,
-	0x00, 0xc6, 0x85, 0xd4, 0x05, 0xd2, 0xae, 0x87, 0x97, 0x6c, 0x15, 0x26, 0x76, 0x53, 0x96, 0x4d,
-	0x2a, 0x34, 0xbc, 0xe0, 0xb9, 0xb7, 0x56, 0x62, 0x78, 0x75, 0x29, 0xd4, 0x95, 0xe0, 0x8c, 0xd2,
-	0x55, 0x3b, 0x74, 0x3c, 0x79, 0xc9, 0x87, 0xe8, 0xb4, 0xa7, 0x31, 0x18, 0x77, 0x12, 0xae, 0xf2,
-	0x38, 0xae, 0x24, 0xae, 0xf6, 0x61, 0xa0, 0xf0, 0x9b, 0x41, 0xf4, 0x96, 0x92, 0x78, 0xc0, 0x4f,
-	0xd8, 0x01, 0xbf, 0xf3, 0x22, 0xad, 0x44, 0x9a, 0x4f, 0x61, 0x69, 0xba, 0x49, 0x44, 0x72, 0xc1,
-	0x4a, 0xfe, 0xd6, 0x62, 0x4e, 0x7a, 0x85, 0x44, 0x65, 0x79, 0xc8, 0x4e, 0x9d, 0x2b, 0x24, 0x8e,
-	0xa8, 0x38, 0x62, 0x85, 0xf4, 0xf1, 0x7a, 0xb3, 0xad, 0xc4, 0xe1, 0xbd, 0xd6, 0x03, 0xde, 0x26,
-	0x2b, 0x54, 0x34, 0x0c, 0x12, 0xdb, 0x0e, 0xaf, 0x83, 0xde, 0x0b, 0x28, 0x7d, 0xdd, 0x49, 0x57,
-	0x88, 0x38, 0xdd, 0x8e, 0x7a, 0x2d, 0x80, 0x74, 0x48, 0xe9, 0x8b, 0x35, 0x4a, 0xaa, 0x7b, 0xaf,
-	0x76, 0x2d, 0x80, 0x34, 0x36, 0xee, 0x66, 0xb5, 0x6e, 0xc7, 0xc9, 0xf1, 0xb4, 0xe4, 0xf3, 0x7c,
-	0xb2, 0xcd, 0x33, 0x5e, 0xa2, 0x8d, 0xbb, 0x55, 0x6a, 0x84, 0x12, 0x1b, 0xf7, 0x1e, 0x17, 0x9d,
-	0x18, 0x98, 0xa5, 0xd8, 0xca, 0xd2, 0x29, 0xde, 0xfd, 0x58, 0x81, 0x1a, 0x80, 0x48, 0x0c, 0x9c,
-	0xa0, 0xa3, 0x13, 0xc9, 0xdd, 0x91, 0x48, 0x93, 0x38, 0x93, 0x7a, 0x1b, 0x74, 0x18, 0x0b, 0xec,
-	0xed, 0x44, 0x0e, 0x07, 0x47, 0x3d, 0x0f, 0xe6, 0x65, 0xbe, 0x97, 0x0b, 0x4e, 0xd6, 0xb3, 0x05,
-	0x7a, 0xeb, 0x69, 0x80, 0x3a, 0x9b, 0x68, 0xcc, 0x07, 0xec, 0x45, 0x5d, 0x9a, 0xfa, 0x9f, 0xa1,
-	0x63, 0xca, 0xa9, 0x7f, 0x1f, 0x81, 0x9d, 0xc8, 0x26, 0x5c, 0x1c, 0xaa, 0x0c, 0x88, 0xc8, 0x0e,
-	0xe3, 0xf1, 0xb6, 0xbb, 0xc9, 0x4a, 0x3f, 0xe8, 0xd6, 0x19, 0x8b, 0xb3, 0x8c, 0xf9, 0x74, 0x1a,
-	0x20, 0x44, 0xa7, 0x05, 0xf5, 0x89, 0xbe, 0x55, 0x9f, 0x23, 0x96, 0x1c, 0x77, 0xde, 0x13, 0xb0,
-	0x0b, 0x2a, 0x11, 0xe2, 0x44, 0x9f, 0x40, 0xdd, 0x4d, 0xb4, 0x97, 0xf0, 0xdc, 0xd7, 0x44, 0xb5,
-	0x3d, 0xa4, 0x89, 0x80, 0xd3, 0xbb, 0x3b, 0x65, 0x85, 0x9e, 0x29, 0x9b, 0x69, 0x95, 0x88, 0x60,
-	0x42, 0xc4, 0xee, 0x8e, 0x84, 0xf5, 0x31, 0x2c, 0xd6, 0x7c, 0xd0, 0x7d, 0x73, 0xae, 0x13, 0xe5,
-	0x01, 0xfd, 0xe6, 0x1c, 0xc5, 0xd2, 0x95, 0x94, 0x7d, 0xa4, 0x27, 0x8a, 0xdd, 0x4f, 0xd6, 0xc2,
-	0x60, 0x7d, 0x5f, 0x6f, 0x69, 0x6e, 0x67, 0x2c, 0x2e, 0xa5, 0xea, 0xba, 0x27, 0x90, 0xc6, 0x88,
-	0x33, 0x3f, 0x0f, 0x8e, 0xa6, 0x30, 0x4b, 0x79, 0x9b, 0xe7, 0x82, 0xe5, 0xc2, 0x35, 0x85, 0xd9,
-	0xc1, 0x00, 0xf4, 0x4d, 0x61, 0x94, 0x03, 0xea, 0xb7, 0xcd, 0xa1, 0x04, 0x13, 0x0f, 0xe3, 0x19,
-	0x73, 0xf5, 0x5b, 0x79, 0xe0, 0x20, 0xed, 0xbe, 0x7e, 0x8b, 0x38, 0x34, 0xe4, 0xf7, 0x66, 0xf1,
-	0x54, 0xa9, 0x38, 0xbc, 0x1b, 0x7b, 0x47, 0x66, 0xa5, 0x1f, 0x44, 0x3a, 0x4f, 0xd3, 0x09, 0xe3,
-	0x1e, 0x9d, 0xc6, 0x1e, 0xa2, 0x83, 0x41, 0x94, 0x39, 0xd5, 0xb5, 0x95, 0xfb, 0x91, 0xad, 0x7c,
-	0x02, 0xbb, 0xb0, 0x11, 0xf1, 0x50, 0x10, 0xe7, 0xcb, 0x9c, 0x08, 0x1e, 0x8d, 0x8f, 0xf6, 0x84,
-	0xce, 0x37, 0x3e, 0xd4, 0x01, 0x5c, 0xc8, 0xf8, 0x70, 0xc1, 0xa0, 0xf9, 0x43, 0x18, 0x1f, 0x3b,
-	0xb1, 0x88, 0xeb, 0x7d, 0xf4, 0xd3, 0x94, 0x9d, 0xc2, 0x36, 0xce, 0x51, 0xdf, 0x96, 0x1a, 0xd5,
-	0x18, 0xde, 0xd3, 0x6d, 0x04, 0xf3, 0x1e, 0x6d, 0xc8, 0xce, 0x7b, 0xb5, 0x51, 0x9a, 0xbe, 0x11,
-	0xcc, 0x7b, 0xb4, 0xe1, 0x6d, 0xf4, 0x5e, 0x6d, 0xf4, 0x4a, 0xfa, 0x46, 0x30, 0x0f, 0xda, 0x3f,
-	0x1f, 0x44, 0xe7, 0x3b, 0xe2, 0x75, 0x0e, 0x94, 0x88, 0xf4, 0x84, 0xb9, 0x52, 0x39, 0x3b, 0x9e,
-	0x42, 0x7d, 0xa9, 0x1c, 0xed, 0x02, 0xa5, 0xf8, 0xf5, 0x20, 0x7a, 0xd3, 0x55, 0x8a, 0xc7, 0xbc,
-	0x4a, 0x9b, 0x1b, 0xcd, 0x9b, 0x01, 0x41, 0x5b, 0xd8, 0xb7, 0x61, 0xf1, 0x39, 0xe9, 0xfb, 0x20,
-	0x0b, 0xd5, 0xaf, 0xe4, 0xad, 0x79, 0xe2, 0x75, 0xdf, 0xcc, 0x5b, 0x0f, 0xa4, 0xf5, 0x05, 0x89,
-	0xc5, 0x98, 0x37, 0x33, 0xbe, 0x56, 0x75, 0x5e, 0xce, 0x6c, 0x86, 0x3b, 0x80, 0xfc, 0x2f, 0xdb,
-	0x9c, 0x1e, 0xeb, 0xc3, 0x20, 0xb8, 0x11, 0x12, 0x11, 0x0d, 0x84, 0x9b, 0x0b, 0xf9, 0x40, 0x41,
-	0xfe, 0x3a, 0x88, 0x2e, 0x39, 0x0b, 0x62, 0x5f, 0x0e, 0x7e, 0x23, 0x24, 0xb6, 0xfb, 0x92, 0xf0,
-	0x9b, 0x5f, 0xc4, 0x15, 0x4a, 0xf7, 0xdb, 0x76, 0x6b, 0xdd, 0x7a, 0x34, 0xaf, 0x4d, 0x3f, 0x2a,
-	0x27, 0xac, 0x84, 0x11, 0xeb, 0xeb, 0x74, 0x1a, 0xc6, 0xe3, 0xf6, 0xbd, 0x05, 0xbd, 0xa0, 0x38,
-	0xbf, 0x1f, 0x44, 0x4b, 0x16, 0x0c, 0xdf, 0x74, 0x18, 0xe5, 0xf1, 0x45, 0x36, 0x68, 0x5c, 0xa0,
-	0xf7, 0x17, 0x75, 0xa3, 0x46, 0xb2, 0x01, 0x37, 0x5f, 0xef, 0xdc, 0x0c, 0x0c, 0x6c, 0x7d, 0xcf,
-	0x73, 0x6b, 0x31, 0x27, 0x28, 0xcb, 0xdf, 0x06, 0xd1, 0x15, 0x8b, 0xd5, 0x87, 0xd8, 0xe8, 0x3c,
-	0xe4, 0x5b, 0x9e, 0xf8, 0x94, 0x93, 0x2a, 0xdc, 0xb7, 0xbf, 0x98, 0xb3, 0xbe, 0x07, 0xb6, 0x5c,
-	0x76, 0xd3, 0x4c, 0xb0, 0xb2, 0xfb, 0xd5, 0xa6, 0x1d, 0x57, 0x52, 0x23, 0xfa, 0xab, 0x4d, 0x0f,
-	0x6e, 0x7c, 0xb5, 0xe9, 0x50, 0x76, 0x7e, 0xb5, 0xe9, 0x8c, 0xe6, 0xfd, 0x6a, 0xd3, 0xef, 0x41,
-	0x2d, 0x3e, 0x6d, 0x11, 0xe4, 0x99, 0x70, 0x50, 0x44, 0xfb, 0x88, 0xf8, 0xc6, 0x22, 0x2e, 0xc4,
-	0xf2, 0x2b, 0xb9, 0xe6, 0x95, 0xa5, 0x80, 0x67, 0x6a, 0xbd, 0xb6, 0xb4, 0x11, 0xcc, 0x83, 0xf6,
-	0x27, 0xb0, 0xef, 0x51, 0x8b, 0x0d, 0x2f, 0x9b, 0x2f, 0x76, 0x57, 0x7d, 0x8b, 0x47, 0x1d, 0xc1,
-	0x6c, 0xf9, 0xb5, 0x30, 0x98, 0xa8, 0x6e, 0x4d, 0x40, 0xa3, 0x8f, 0xfa, 0x02, 0xa1, 0x26, 0xdf,
-	0x08, 0xe6, 0x89, 0x45, 0x4e, 0x6a, 0xcb, 0xd6, 0x0e, 0x08, 0x66, 0xb7, 0xf5, 0x66, 0xb8, 0x83,
-	0x7e, 0xf5, 0xa1, 0x23, 0xdf, 0xb4, 0x73, 0xef, 0x13, 0xb4, 0x5a, 0x79, 0x3d, 0x90, 0xf6, 0x25,
-	0x37, 0xe6, 0xf2, 0xde, 0x97, 0xdc, 0x38, 0x97, 0xf8, 0x5b, 0x8b, 0x39, 0x41, 0x59, 0xfe, 0x38,
-	0x88, 0x2e, 0x90, 0x65, 0x81, 0x5e, 0xf0, 0x7e, 0x68, 0x64, 0xd4, 0x1b, 0x3e, 0x58, 0xd8, 0x0f,
-	0x0a, 0xf5, 0x97, 0x41, 0x74, 0xd1, 0x53, 0x28, 0xd9, 0x3d, 0x16, 0x88, 0x6e, 0x77, 0x93, 0x0f,
-	0x17, 0x77, 0xa4, 0x16, 0x7b, 0x13, 0x1f, 0x77, 0x3f, 0xd9, 0xf4, 0xc4, 0x1e, 0xd3, 0x9f, 0x6c,
-	0xf6, 0x7b, 0xe1, 0xc3, 0x9f, 0x3a, 0x25, 0x81, 0x7d, 0x91, 0xeb, 0xf0, 0xa7, 0xc9, 0x58, 0xd0,
-	0x7e, 0x68, 0xb9, 0x97, 0x73, 0x89, 0xdc, 0x79, 0x51, 0xc4, 0xf9, 0x84, 0x16, 0x91, 0xf6, 0x7e,
-	0x11, 0xc5, 0xe1, 0x43, 0xb3, 0xda, 0xba, 0xcf, 0xdb, 0x4d, 0xde, 0x35, 0xca, 0x5f, 0x21, 0xde,
-	0x43, 0xb3, 0x0e, 0x4a, 0xa8, 0x41, 0x46, 0xeb, 0x53, 0x43, 0x89, 0xec, 0xf5, 0x10, 0x14, 0x6d,
-	0x1f, 0x94, 0x9a, 0x3a, 0x8b, 0x5f, 0xf3, 0x45, 0xe9, 0x9c, 0xc7, 0xaf, 0x07, 0xd2, 0x84, 0xec,
-	0x98, 0x89, 0x7b, 0x2c, 0x9e, 0xb0, 0xd2, 0x2b, 0xab, 0xa8, 0x20, 0x59, 0x93, 0x76, 0xc9, 0x6e,
-	0xf3, 0x6c, 0x3e, 0xcb, 0xa1, 0x31, 0x49, 0x59, 0x93, 0xea, 0x97, 0x45, 0x34, 0x3e, 0x2e, 0xd4,
-	0xb2, 0x4d, 0x72, 0x79, 0xdd, 0x1f, 0xc6, 0xca, 0x29, 0x57, 0x83, 0x58, 0xba, 0x9e, 0xd0, 0x8d,
-	0x7a, 0xea, 0x89, 0x7a, 0xd2, 0x7a, 0x20, 0x8d, 0xcf, 0xed, 0x0c, 0x59, 0xd5, 0x9f, 0x36, 0x7a,
-	0x62, 0x75, 0xba, 0xd4, 0x66, 0xb8, 0x03, 0x3e, 0x25, 0x85, 0x5e, 0x55, 0xef, 0x8a, 0x76, 0xd3,
-	0x2c, 0x1b, 0xae, 0x7a, 0xba, 0x49, 0x0b, 0x79, 0x4f, 0x49, 0x1d, 0x30, 0xd1, 0x93, 0xdb, 0x53,
-	0xc5, 0x7c, 0xd8, 0x17, 0xa7, 0xa1, 0x82, 0x7a, 0xb2, 0x49, 0xa3, 0xd3, 0x36, 0xe3, 0x51, 0xab,
-	0xda, 0x8e, 0xfc, 0x0f, 0xae, 0x53, 0xe1, 0x8d, 0x60, 0x1e, 0x5d, 0x64, 0x37, 0x54, 0xb3, 0xb2,
-	0x5c, 0xa6, 0x42, 0x58, 0x2b, 0xc9, 0x95, 0x1e, 0x0a, 0x9d, 0x58, 0xca, 0x61, 0xf4, 0x2c, 0x9d,
-	0x4c, 0x99, 0x70, 0xde, 0x20, 0x99, 0x80, 0xf7, 0x06, 0x09, 0x81, 0xa8, 0xe9, 0xe4, 0xef, 0x63,
-	0x26, 0x0e, 0xe2, 0x72, 0xca, 0xc4, 0xde, 0xc4, 0xd5, 0x74, 0xe0, 0x6c, 0x50, 0xbe, 0xa6, 0x73,
-	0xd2, 0x68, 0x36, 0x50, 0xb2, 0xf0, 0xdd, 0xeb, 0x75, 0x5f, 0x18, 0xf4, 0xf1, 0xeb, 0x6a, 0x10,
-	0x8b, 0x56, 0x14, 0x2d, 0x98, 0xce, 0x52, 0xe1, 0x5a, 0x51, 0x8c, 0x18, 0x35, 0xe2, 0x5b, 0x51,
-	0xba, 0x28, 0x55, 0xbd, 0x3a, 0x47, 0xd8, 0x9b, 0xf8, 0xab, 0x27, 0x99, 0xb0, 0xea, 0x29, 0xb6,
-	0x73, 0xe1, 0x99, 0xab, 0x2e, 0x23, 0x8e, 0x60, 0xab, 0xec, 0xe8, 0xdb, 0xcd, 0x47, 0x68, 0x18,
-	0xf4, 0xcd, 0x3a, 0x94, 0x83, 0xf1, 0x79, 0x85, 0xe2, 0xda, 0x3b, 0xd9, 0xa2, 0x60, 0x71, 0x19,
-	0xe7, 0x89, 0x73, 0x6b, 0xda, 0x04, 0xec, 0x90, 0xbe, 0xad, 0x29, 0xe9, 0x81, 0xae, 0xd3, 0xed,
-	0xcf, 0xc7, 0x1c, 0x43, 0x41, 0x7d, 0xa7, 0x65, 0x7f, 0x3d, 0x76, 0x2d, 0x80, 0xc4, 0xd7, 0xe9,
-	0x2d, 0xa0, 0x0e, 0xe5, 0xa5, 0xe8, 0xbb, 0x9e, 0x50, 0x36, 0xea, 0xdb, 0x06, 0xd3, 0x2e, 0xa8,
-	0x53, 0xab, 0x04, 0x97, 0x89, 0x8f, 0xd8, 0x99, 0xab, 0x53, 0xeb, 0xfc, 0xb4, 0x41, 0x7c, 0x9d,
-	0xba, 0x8b, 0xa2, 0x3c, 0xd3, 0xdc, 0x07, 0x5d, 0xf5, 0xf8, 0x9b, 0x5b, 0x9f, 0xe5, 0x5e, 0x0e,
-	0x8d, 0x9c, 0x9d, 0xf4, 0xc4, 0xba, 0xc3, 0x70, 0x14, 0x74, 0x27, 0x3d, 0x71, 0x5f, 0x61, 0xac,
-	0x06, 0xb1, 0xf8, 0xaa, 0x3e, 0x16, 0xec, 0x45, 0x7b, 0x87, 0xee, 0x28, 0x6e, 0x63, 0xef, 0x5c,
-	0xa2, 0xaf, 0xf4, 0x83, 0xfa, 0x7d, 0xcb, 0xc7, 0x25, 0x4f, 0x58, 0x55, 0x6d, 0xd7, 0xdd, 0x36,
-	0x43, 0xef, 0x5b, 0x82, 0x6d, 0x24, 0x8d, 0xc4, 0xfb, 0x96, 0x1d, 0x08, 0x62, 0xdf, 0x8b, 0x5e,
-	0xbe, 0xcf, 0xa7, 0x63, 0x96, 0x4f, 0x86, 0x6f, 0xd9, 0x6f, 0x38, 0xf2, 0xe9, 0xa8, 0xfe, 0x59,
-	0xc5, 0x5b, 0xa2, 0xcc, 0xfa, 0x75, 0xb4, 0x1d, 0x76, 0x38, 0x9f, 0x1e, 0x94, 0x8c, 0xa1, 0xd7,
-	0xd1, 0x9a, 0xdf, 0x47, 0xb5, 0x81, 0x78, 0x1d, 0xcd, 0x02, 0xf4, 0x2a, 0xa9, 0xe2, 0xd5, 0x89,
-	0x28, 0x7e, 0xdd, 0x4b, 0xfb, 0x34, 0x56, 0x62, 0x95, 0xec, 0x52, 0xba, 0xf1, 0x1a, 0x5b, 0xf3,
-	0xc6, 0xf3, 0x78, 0x3e, 0x9b, 0xc5, 0xe5, 0x19, 0x6a, 0x3c, 0xe9, 0x6b, 0x02, 0x44, 0xe3, 0x39,
-	0x41, 0x9d, 0x54, 0x35, 0x66, 0xf9, 0x62, 0xd8, 0x7d, 0x9e, 0xc4, 0x59, 0x25, 0x78, 0x89, 0xaf,
-	0xd6, 0x64, 0x08, 0x0c, 0x11, 0x49, 0x15, 0x09, 0xa3, 0xa6, 0x78, 0x9c, 0xe6, 0x53, 0x67, 0x53,
-	0xd4, 0x06, 0x6f, 0x53, 0x00, 0xa0, 0xa7, 0x47, 0xf9, 0xac, 0xe4, 0x5f, 0xed, 0x80, 0x6f, 0xc0,
-	0x9c, 0xcf, 0xc0, 0x24, 0x88, 0xe9, 0xd1, 0x4d, 0x22, 0xa9, 0x47, 0x05, 0xcb, 0xd9, 0xa4, 0x7d,
-	0x79, 0xcb, 0x25, 0x65, 0x11, 0x5e, 0x29, 0x4c, 0xea, 0xf9, 0xe2, 0x01, 0x13, 0x65, 0x9a, 0x54,
-	0x63, 0x26, 0x1e, 0xc7, 0x65, 0x3c, 0x63, 0x82, 0x95, 0x15, 0x9a, 0x2f, 0x00, 0x19, 0x59, 0x0c,
-	0x31, 0x5f, 0x50, 0x2c, 0x08, 0x7e, 0x27, 0x7a, 0xbd, 0x9e, 0x48, 0x58, 0x0e, 0x7f, 0x9b, 0xf0,
-	0x4e, 0xf3, 0x67, 0x3b, 0x87, 0xe7, 0x54, 0x8c, 0xb1, 0x28, 0x59, 0x3c, 0x6b, 0x63, 0xbf, 0xa6,
-	0x7e, 0x6f, 0xc0, 0xcd, 0xc1, 0xed, 0xb7, 0xff, 0xf1, 0xd9, 0xd2, 0xe0, 0xd3, 0xcf, 0x96, 0x06,
-	0xff, 0xfe, 0x6c, 0x69, 0xf0, 0x87, 0xcf, 0x97, 0x5e, 0xfa, 0xf4, 0xf3, 0xa5, 0x97, 0xfe, 0xf5,
-	0xf9, 0xd2, 0x4b, 0x1f, 0xbf, 0x0c, 0x7f, 0x3e, 0xf4, 0xf0, 0xbf, 0x9a, 0x3f, 0x02, 0x7a, 0xf3,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x77, 0x7b, 0xf9, 0xab, 0x62, 0x54, 0x00, 0x00,
+	// 4348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9d, 0x5b, 0x6f, 0x1c, 0x47,
+	0x76, 0x80, 0x3d, 0x2f, 0x71, 0xd2, 0x8e, 0x9d, 0xa4, 0x6d, 0x2b, 0x8e, 0x62, 0x53, 0x77, 0xf1,
+	0xde, 0xa4, 0x25, 0xf9, 0x92, 0x0b, 0x10, 0x50, 0xa4, 0x48, 0x11, 0xa6, 0x24, 0x9a, 0x43, 0x4a,
+	0x80, 0x81, 0x00, 0x69, 0xf6, 0x94, 0x66, 0x3a, 0xec, 0xe9, 0x6e, 0x77, 0xf7, 0x50, 0x9a, 0x04,
+	0x09, 0x12, 0x24, 0x48, 0xb0, 0x8b, 0x5d, 0xec, 0x62, 0x2f, 0x4f, 0xfb, 0xb6, 0x7f, 0x65, 0x5f,
+	0xf6, 0xd1, 0x8f, 0xfb, 0xb8, 0xb0, 0xff, 0xc0, 0xfe, 0x84, 0x45, 0x75, 0x55, 0xd7, 0xe5, 0x54,
+	0x9d, 0xea, 0x1a, 0x3f, 0x18, 0x86, 0xe6, 0x7c, 0xe7, 0x9c, 0xba, 0x9c, 0xaa, 0x3a, 0x75, 0x99,
+	0x61, 0x70, 0xad, 0x3c, 0xdf, 0x2a, 0xab, 0xa2, 0x29, 0xea, 0xad, 0x9a, 0x54, 0x97, 0x69, 0x42,
+	0xba, 0xff, 0x47, 0xed, 0xc7, 0xe1, 0x9b, 0x71, 0x3e, 0x6f, 0xe6, 0x25, 0xb9, 0xfa, 0x81, 0x24,
+	0x93, 0x62, 0x3a, 0x8d, 0xf3, 0x51, 0xcd, 0x90, 0xab, 0x57, 0xa4, 0x84, 0x5c, 0x92, 0xbc, 0xe1,
+	0x9f, 0xdf, 0xfb, 0xc3, 0x6f, 0x06, 0xc1, 0x3b, 0xbb, 0x59, 0x4a, 0xf2, 0x66, 0x97, 0x6b, 0x84,
+	0x5f, 0x05, 0x6f, 0xef, 0x94, 0xe5, 0x01, 0x69, 0x9e, 0x93, 0xaa, 0x4e, 0x8b, 0x3c, 0xbc, 0x15,
+	0x71, 0x07, 0xd1, 0x49, 0x99, 0x44, 0x3b, 0x65, 0x19, 0x49, 0x61, 0x74, 0x42, 0xbe, 0x9e, 0x91,
+	0xba, 0xb9, 0x7a, 0xdb, 0x0d, 0xd5, 0x65, 0x91, 0xd7, 0x24, 0x7c, 0x19, 0xfc, 0xd5, 0x4e, 0x59,
+	0x0e, 0x49, 0xb3, 0x47, 0x68, 0x05, 0x86, 0x4d, 0xdc, 0x90, 0x70, 0xd9, 0x50, 0xd5, 0x01, 0xe1,
+	0x63, 0xa5, 0x1f, 0xe4, 0x7e, 0x4e, 0x83, 0xb7, 0xa8, 0x9f, 0xc9, 0xac, 0x19, 0x15, 0xaf, 0xf2,
+	0xf0, 0x86, 0xa9, 0xc8, 0x45, 0xc2, 0xf6, 0x4d, 0x17, 0xc2, 0xad, 0xbe, 0x08, 0xfe, 0xfc, 0x45,
+	0x9c, 0x65, 0xa4, 0xd9, 0xad, 0x08, 0x2d, 0xb8, 0xae, 0xc3, 0x44, 0x11, 0x93, 0x09, 0xbb, 0xb7,
+	0x9c, 0x0c, 0x37, 0xfc, 0x55, 0xf0, 0x36, 0x93, 0x9c, 0x90, 0xa4, 0xb8, 0x24, 0x55, 0x68, 0xd5,
+	0xe2, 0x42, 0xa4, 0xc9, 0x0d, 0x08, 0xda, 0xde, 0x2d, 0xf2, 0x4b, 0x52, 0x35, 0x76, 0xdb, 0x5c,
+	0xe8, 0xb6, 0x2d, 0x21, 0x6e, 0x3b, 0x0b, 0xde, 0x55, 0x1b, 0x64, 0x48, 0xea, 0x36, 0x60, 0x56,
+	0xf1, 0x3a, 0x73, 0x44, 0xf8, 0x59, 0xf3, 0x41, 0xb9, 0xb7, 0x34, 0x08, 0xb9, 0xb7, 0xac, 0xa8,
+	0x85, 0xb3, 0x15, 0xab, 0x05, 0x85, 0x10, 0xbe, 0x56, 0x3d, 0x48, 0xee, 0xea, 0x5f, 0x82, 0xbf,
+	0x78, 0x51, 0x54, 0x17, 0x75, 0x19, 0x27, 0x84, 0x77, 0xf6, 0x1d, 0x5d, 0xbb, 0x93, 0xc2, 0xfe,
+	0xbe, 0xdb, 0x87, 0x71, 0x0f, 0x17, 0x41, 0x28, 0x84, 0xcf, 0xce, 0xff, 0x95, 0x24, 0xcd, 0xce,
+	0x68, 0x04, 0x5b, 0x4e, 0x68, 0x33, 0x22, 0xda, 0x19, 0x8d, 0xb0, 0x96, 0xb3, 0xa3, 0xdc, 0xd9,
+	0xab, 0xe0, 0x0a, 0x70, 0x76, 0x94, 0xd6, 0xad, 0xc3, 0x4d, 0xb7, 0x15, 0x8e, 0x09, 0xa7, 0x91,
+	0x2f, 0xce, 0x1d, 0xff, 0xd7, 0x20, 0xf8, 0x1b, 0x8b, 0xe7, 0x13, 0x32, 0x2d, 0x2e, 0x49, 0xb8,
+	0xdd, 0x6f, 0x8d, 0x91, 0xc2, 0xff, 0xc7, 0x0b, 0x68, 0x58, 0xba, 0x72, 0x48, 0x32, 0x92, 0x34,
+	0x68, 0x57, 0x32, 0x71, 0x6f, 0x57, 0x0a, 0x4c, 0x19, 0x05, 0x9d, 0xf0, 0x80, 0x34, 0xbb, 0xb3,
+	0xaa, 0x22, 0x79, 0x83, 0xf6, 0xa5, 0x44, 0x7a, 0xfb, 0x52, 0x43, 0x2d, 0xf5, 0x39, 0x20, 0xcd,
+	0x4e, 0x96, 0xa1, 0xf5, 0x61, 0xe2, 0xde, 0xfa, 0x08, 0x8c, 0x7b, 0xf8, 0x4f, 0xa5, 0xcf, 0x86,
+	0xa4, 0x39, 0xac, 0x1f, 0xa7, 0xe3, 0x49, 0x96, 0x8e, 0x27, 0x0d, 0x19, 0x85, 0x5b, 0x68, 0xa3,
+	0xe8, 0xa0, 0xf0, 0xba, 0xed, 0xaf, 0x60, 0xa9, 0xe1, 0xa3, 0xd7, 0x65, 0x51, 0xe1, 0x3d, 0xc6,
+	0xc4, 0xbd, 0x35, 0x14, 0x18, 0xf7, 0xf0, 0xcf, 0xc1, 0x3b, 0x3b, 0x49, 0x52, 0xcc, 0x72, 0x31,
+	0xe1, 0x82, 0xe5, 0x8b, 0x09, 0x8d, 0x19, 0xf7, 0x4e, 0x0f, 0x25, 0xa7, 0x5c, 0x2e, 0xe3, 0x73,
+	0xc7, 0x2d, 0xab, 0x1e, 0x98, 0x39, 0x6e, 0xbb, 0x21, 0xc3, 0xf6, 0x1e, 0xc9, 0x08, 0x6a, 0x9b,
+	0x09, 0x7b, 0x6c, 0x0b, 0xc8, 0xb0, 0xcd, 0x07, 0x8a, 0xdd, 0x36, 0x18, 0x26, 0xb7, 0xdd, 0x90,
+	0xb2, 0x22, 0x73, 0xdb, 0x4d, 0x51, 0xc2, 0x15, 0xb9, 0x53, 0x6a, 0x8a, 0x12, 0x5b, 0x91, 0x75,
+	0xc4, 0xb0, 0xfa, 0x84, 0x4e, 0x28, 0x76, 0xab, 0x4f, 0xd4, 0x19, 0xe4, 0xa6, 0x0b, 0x91, 0x03,
+	0xba, 0xeb, 0xbf, 0x22, 0x7f, 0x99, 0x8e, 0xcf, 0xca, 0x11, 0xed, 0xc5, 0x55, 0x7b, 0x07, 0x29,
+	0x08, 0x32, 0xa0, 0x11, 0x94, 0x7b, 0xfb, 0xf1, 0x20, 0x58, 0xd2, 0xa3, 0x71, 0xbf, 0x2a, 0xa6,
+	0x47, 0x64, 0x1c, 0x27, 0x73, 0x1e, 0xfe, 0x0f, 0x5c, 0x71, 0x07, 0x69, 0x51, 0x88, 0x4f, 0x16,
+	0xd4, 0x32, 0xa2, 0xe0, 0x61, 0x9c, 0x5c, 0xcc, 0x4a, 0x24, 0x0a, 0x98, 0xb0, 0x27, 0x0a, 0x04,
+	0xc4, 0x6d, 0xff, 0x7b, 0xf0, 0x81, 0x66, 0x7b, 0x48, 0x9a, 0x61, 0x32, 0x21, 0xa3, 0x59, 0x46,
+	0xc2, 0xc8, 0x61, 0x41, 0xe1, 0x84, 0xc7, 0x2d, 0x6f, 0x9e, 0x3b, 0x9f, 0x05, 0x57, 0x34, 0xe7,
+	0x07, 0xa4, 0xa1, 0x69, 0xe3, 0xac, 0x0e, 0x37, 0x1c, 0xa6, 0x04, 0x25, 0x1c, 0x6f, 0x7a, 0xd2,
+	0x46, 0x34, 0x3d, 0x27, 0x55, 0xfa, 0x72, 0xce, 0x5b, 0xd5, 0x1e, 0x4d, 0x2a, 0xd2, 0x13, 0x4d,
+	0x00, 0x35, 0x5a, 0x58, 0xe9, 0x68, 0xee, 0x32, 0xea, 0x0b, 0x08, 0xe0, 0x77, 0xcb, 0x9b, 0xe7,
+	0xce, 0xbf, 0x0c, 0x02, 0xb6, 0x12, 0x3f, 0x2b, 0x49, 0x1e, 0x5e, 0xd7, 0xd4, 0x99, 0x20, 0xa2,
+	0x12, 0xe1, 0xe0, 0x86, 0x83, 0x90, 0x23, 0x9c, 0x7d, 0xde, 0x26, 0x6a, 0xa1, 0x55, 0xa3, 0x15,
+	0x21, 0x23, 0x1c, 0x20, 0xb0, 0xa0, 0xc3, 0x49, 0xf1, 0xca, 0x5e, 0x50, 0x2a, 0x71, 0x17, 0x94,
+	0x13, 0x72, 0x73, 0xc0, 0x0b, 0x6a, 0xdb, 0x1c, 0x74, 0xc5, 0x70, 0x6d, 0x0e, 0x20, 0xc3, 0x0d,
+	0x17, 0xc1, 0x7b, 0xaa, 0xe1, 0x87, 0x45, 0x71, 0x31, 0x8d, 0xab, 0x8b, 0x70, 0x0d, 0x57, 0xee,
+	0x18, 0xe1, 0x68, 0xdd, 0x8b, 0x95, 0xeb, 0xaf, 0xea, 0x70, 0x48, 0xe0, 0xfa, 0xab, 0xe9, 0x0f,
+	0x09, 0xb6, 0xfe, 0x5a, 0x30, 0xd8, 0xa9, 0x07, 0x55, 0x5c, 0x4e, 0xec, 0x9d, 0xda, 0x8a, 0xdc,
+	0x9d, 0xda, 0x21, 0xb0, 0x07, 0x86, 0x24, 0xae, 0x92, 0x89, 0xbd, 0x07, 0x98, 0xcc, 0xdd, 0x03,
+	0x82, 0xe1, 0x86, 0xab, 0xe0, 0x7d, 0xd5, 0xf0, 0x70, 0x76, 0x5e, 0x27, 0x55, 0x7a, 0x4e, 0xc2,
+	0x75, 0x5c, 0x5b, 0x40, 0xc2, 0xd5, 0x86, 0x1f, 0xcc, 0x7d, 0x26, 0xc1, 0x5f, 0x32, 0xe4, 0xcb,
+	0x19, 0xa9, 0xe6, 0xc7, 0x71, 0x55, 0x93, 0xd0, 0xda, 0xbc, 0x52, 0x2e, 0x3c, 0x2d, 0xf7, 0x72,
+	0xdc, 0xc9, 0xeb, 0xe0, 0xaf, 0x79, 0x4f, 0xc7, 0x19, 0xc9, 0x47, 0x71, 0x25, 0xab, 0xb6, 0x69,
+	0xed, 0x4a, 0x88, 0x21, 0x1b, 0x03, 0x07, 0x2e, 0xf7, 0x72, 0xba, 0xe7, 0x76, 0xfd, 0x5e, 0x71,
+	0x59, 0xd1, 0x96, 0xf1, 0x55, 0x0f, 0x12, 0x56, 0xf2, 0x34, 0x9d, 0x92, 0x2c, 0xcd, 0x49, 0x4f,
+	0x25, 0x0d, 0xcc, 0x5d, 0x49, 0x1b, 0x0e, 0x2b, 0xd9, 0x31, 0x78, 0x25, 0x55, 0xc2, 0x5d, 0x49,
+	0x40, 0xca, 0x8c, 0x96, 0xc9, 0x1f, 0xce, 0xb2, 0x8b, 0x47, 0xa3, 0xb4, 0x01, 0x19, 0x2d, 0x57,
+	0xee, 0xa4, 0x48, 0x46, 0x6b, 0x52, 0xb0, 0x26, 0x9d, 0xe8, 0x2c, 0x1f, 0x15, 0xf6, 0x9a, 0xa8,
+	0x84, 0xbb, 0x26, 0x80, 0x84, 0xae, 0x44, 0x83, 0x1e, 0x8e, 0x6a, 0xbb, 0x2b, 0x95, 0x70, 0xbb,
+	0x02, 0x24, 0x1c, 0xd7, 0x07, 0x55, 0x31, 0x2b, 0xeb, 0x9e, 0x71, 0x0d, 0x20, 0xf7, 0xb8, 0x36,
+	0x61, 0x18, 0x8d, 0x6c, 0xe4, 0x9f, 0xe5, 0xb5, 0x3b, 0x1a, 0x0d, 0xcc, 0x1d, 0x8d, 0x36, 0x1c,
+	0xce, 0x28, 0xed, 0xa1, 0x59, 0x13, 0xa7, 0x59, 0x6d, 0x9f, 0x51, 0xa4, 0xdc, 0x3d, 0xa3, 0x68,
+	0x1c, 0x5c, 0x3b, 0xf6, 0x66, 0x65, 0x96, 0x26, 0xe6, 0xc1, 0x09, 0xd7, 0x15, 0x62, 0xf7, 0xda,
+	0xa1, 0x62, 0x32, 0x9d, 0x12, 0xd5, 0xe0, 0xa3, 0x6b, 0x5e, 0xc2, 0xe4, 0x5c, 0x96, 0x50, 0x22,
+	0x48, 0x3a, 0x85, 0xa0, 0xb0, 0x3e, 0x43, 0xd2, 0x1c, 0xc5, 0xf3, 0x62, 0x86, 0xac, 0x85, 0x42,
+	0xec, 0xae, 0x8f, 0x8a, 0xc9, 0xac, 0x54, 0x78, 0x38, 0xcc, 0x1b, 0x52, 0xe5, 0x71, 0xb6, 0x9f,
+	0xc5, 0x63, 0x98, 0x95, 0x4a, 0x0b, 0x1a, 0x85, 0x64, 0xa5, 0x38, 0x6d, 0x69, 0xc6, 0xc3, 0x7a,
+	0x3f, 0xbe, 0x2c, 0xaa, 0xb4, 0xc1, 0x9b, 0x51, 0x22, 0xbd, 0xcd, 0xa8, 0xa1, 0x56, 0x6f, 0x3b,
+	0x55, 0x32, 0x49, 0x2f, 0xc9, 0xc8, 0xe1, 0xad, 0x43, 0x3c, 0xbc, 0x29, 0xa8, 0xa5, 0xd3, 0x86,
+	0xc5, 0xac, 0x4a, 0x08, 0xda, 0x69, 0x4c, 0xdc, 0xdb, 0x69, 0x02, 0xe3, 0x1e, 0xfe, 0x77, 0x10,
+	0xfc, 0x2d, 0x93, 0xaa, 0x27, 0x25, 0x7b, 0x71, 0x3d, 0x39, 0x2f, 0xe2, 0x6a, 0x14, 0x7e, 0x6c,
+	0xb3, 0x63, 0x45, 0x85, 0xeb, 0x7b, 0x8b, 0xa8, 0xc0, 0x66, 0xa5, 0x07, 0x5f, 0x72, 0xc4, 0x59,
+	0x9b, 0x55, 0x43, 0xdc, 0xcd, 0x0a, 0x51, 0x38, 0x81, 0xb4, 0x72, 0x76, 0xfa, 0x70, 0x17, 0xd5,
+	0xd7, 0x0f, 0x20, 0x96, 0x7b, 0x39, 0x38, 0x3f, 0x52, 0xa1, 0x1e, 0x2d, 0x9b, 0x98, 0x0d, 0x7b,
+	0xc4, 0x44, 0xbe, 0x38, 0xea, 0x59, 0x8c, 0x0a, 0xb7, 0x67, 0x63, 0x64, 0x44, 0xbe, 0x38, 0xec,
+	0xc6, 0x9d, 0xb2, 0xcc, 0xe6, 0xa7, 0x64, 0x5a, 0x66, 0x68, 0x37, 0x6a, 0x88, 0xbb, 0x1b, 0x21,
+	0x0a, 0x93, 0xef, 0xd3, 0x82, 0xa6, 0xf6, 0xd6, 0xe4, 0xbb, 0x15, 0xb9, 0x93, 0xef, 0x0e, 0x31,
+	0x72, 0x9d, 0x62, 0xb7, 0xc8, 0x32, 0x92, 0x34, 0xe6, 0xe1, 0xbc, 0xd0, 0x94, 0x44, 0x4f, 0xae,
+	0xa3, 0x93, 0xf2, 0x12, 0xa9, 0xdb, 0xbc, 0xc5, 0x15, 0x79, 0x38, 0x3f, 0x4a, 0xf3, 0x8b, 0xd0,
+	0xbe, 0x42, 0x49, 0x00, 0xb9, 0x44, 0xb2, 0x82, 0x56, 0x3f, 0x27, 0xe4, 0xb2, 0xb8, 0x20, 0x0e,
+	0x3f, 0x0c, 0xf0, 0xf0, 0x23, 0x40, 0x63, 0xba, 0xa2, 0x52, 0x1a, 0x27, 0xc8, 0x74, 0xd5, 0x89,
+	0x7b, 0xa6, 0x2b, 0x05, 0xb3, 0xd6, 0xe4, 0x70, 0xda, 0x1e, 0x2a, 0xe1, 0x35, 0x61, 0x80, 0x47,
+	0x4d, 0x04, 0x08, 0xb7, 0xd5, 0x6d, 0x7a, 0x68, 0xdd, 0x56, 0x6b, 0x69, 0xe1, 0x0d, 0x07, 0x01,
+	0x4d, 0x9e, 0x10, 0xcc, 0xe4, 0x09, 0xe9, 0x33, 0x79, 0x42, 0x54, 0x93, 0xda, 0x3c, 0xc6, 0x4f,
+	0xd8, 0xd0, 0x79, 0x0c, 0x9c, 0xa9, 0x2d, 0xf7, 0x72, 0x70, 0x4c, 0x77, 0xfb, 0xeb, 0x7d, 0xd2,
+	0x24, 0x13, 0xfb, 0x98, 0xd6, 0x10, 0xf7, 0x98, 0x86, 0x28, 0xac, 0xd2, 0x69, 0xd1, 0x11, 0xf6,
+	0x2a, 0x49, 0xb9, 0xbb, 0x4a, 0x1a, 0x07, 0xf7, 0xd7, 0x3c, 0x80, 0xac, 0xd3, 0x02, 0x88, 0x9d,
+	0x5b, 0x4e, 0x06, 0x96, 0x9e, 0x09, 0xda, 0x11, 0x70, 0x17, 0x57, 0xd4, 0x86, 0xc0, 0x72, 0x2f,
+	0xc7, 0x9d, 0xfc, 0x62, 0x10, 0x5c, 0x53, 0xbd, 0x3c, 0x2d, 0xe8, 0xac, 0xf2, 0x3c, 0xce, 0xd2,
+	0x51, 0xdc, 0x90, 0xd3, 0xe2, 0x82, 0xe4, 0xe1, 0x67, 0x8e, 0xd2, 0x32, 0x3e, 0xd2, 0x14, 0x44,
+	0x29, 0x3e, 0x5f, 0x5c, 0x11, 0xc6, 0x09, 0xa3, 0xcf, 0x6a, 0xb2, 0x1b, 0xd7, 0xc8, 0xdc, 0xaf,
+	0x21, 0xee, 0x38, 0x81, 0x28, 0xf4, 0x26, 0xe7, 0x55, 0xf3, 0xda, 0x11, 0x12, 0x8e, 0x6b, 0x47,
+	0x04, 0x85, 0xa9, 0xad, 0x04, 0xf8, 0xcd, 0xdf, 0x86, 0xdb, 0x0a, 0xb8, 0xf5, 0xdb, 0xf4, 0xa4,
+	0x8d, 0x03, 0x33, 0xc1, 0x0c, 0x69, 0xbc, 0xf6, 0x14, 0x7d, 0xa8, 0xc6, 0xed, 0xba, 0x17, 0x6b,
+	0x8c, 0xf5, 0x38, 0xb9, 0xc8, 0xd2, 0xfc, 0xa2, 0x6e, 0x43, 0xd8, 0xd6, 0xaa, 0x82, 0x88, 0xb4,
+	0x28, 0x5e, 0xf3, 0x41, 0xb9, 0xb7, 0xff, 0x1e, 0x04, 0x57, 0x0d, 0x77, 0xf9, 0xc5, 0x13, 0x92,
+	0xb7, 0x4b, 0xee, 0x76, 0x8f, 0x29, 0x41, 0x22, 0x97, 0xaa, 0x6e, 0x0d, 0xfb, 0x99, 0xe4, 0x09,
+	0xc9, 0xe2, 0xd6, 0xb9, 0xe3, 0x4c, 0xb2, 0x63, 0x7c, 0xce, 0x24, 0x15, 0xd6, 0xa8, 0xb4, 0x4e,
+	0x3c, 0x2b, 0xd1, 0x4a, 0x47, 0x36, 0xd2, 0x59, 0x69, 0x4c, 0x43, 0x1e, 0xad, 0x77, 0x22, 0x79,
+	0xd1, 0xcc, 0x0b, 0xa0, 0xa7, 0x7c, 0xa2, 0xfc, 0x90, 0x43, 0x8e, 0xd6, 0x5d, 0xbc, 0x4c, 0x12,
+	0xf4, 0x72, 0xd5, 0x20, 0x49, 0x10, 0x36, 0xb8, 0x18, 0x49, 0x12, 0x2c, 0x98, 0x1c, 0xad, 0x6a,
+	0xf5, 0x9e, 0xa7, 0x05, 0xfb, 0x07, 0xdc, 0x88, 0x6a, 0x85, 0x95, 0x14, 0x32, 0x5a, 0x71, 0x1a,
+	0xe6, 0x26, 0x1d, 0x49, 0x27, 0x24, 0xdb, 0xac, 0x2e, 0x2c, 0xa9, 0xd3, 0xd1, 0x4a, 0x3f, 0x08,
+	0x43, 0xb6, 0x13, 0xf3, 0x1d, 0xcc, 0x9a, 0xcb, 0x02, 0xd8, 0xc5, 0xac, 0x7b, 0xb1, 0xf2, 0x1a,
+	0xdd, 0xa8, 0xd8, 0x3e, 0x89, 0x9b, 0x59, 0x65, 0x5c, 0xa3, 0x9b, 0xe5, 0xee, 0x40, 0xe4, 0x1a,
+	0xdd, 0xa9, 0xc0, 0xfd, 0xff, 0xff, 0x20, 0xf8, 0x50, 0xe7, 0x58, 0x64, 0x89, 0x32, 0xdc, 0x73,
+	0x99, 0xd4, 0x59, 0x51, 0x8c, 0xfb, 0x0b, 0xe9, 0x18, 0xbb, 0x65, 0x35, 0xc0, 0x76, 0x2e, 0xe3,
+	0x34, 0x8b, 0xcf, 0x33, 0x62, 0xdd, 0x2d, 0x6b, 0x71, 0x23, 0x50, 0xe7, 0x6e, 0x19, 0x55, 0x31,
+	0x96, 0xa3, 0x76, 0x98, 0x2b, 0x87, 0x47, 0x1b, 0xf8, 0x64, 0x60, 0x39, 0x3f, 0xda, 0xf4, 0xa4,
+	0xe5, 0xe3, 0x1b, 0xf9, 0xb1, 0xda, 0x00, 0xd6, 0x6d, 0x25, 0xd7, 0x55, 0x6a, 0xe2, 0xdc, 0x56,
+	0x5a, 0x71, 0xee, 0xb8, 0x09, 0xde, 0x97, 0x90, 0x3a, 0xba, 0x36, 0x7a, 0x0d, 0xa9, 0x43, 0x6c,
+	0xd3, 0x93, 0xe6, 0x5e, 0xff, 0x23, 0xf8, 0xc0, 0xf4, 0xca, 0x97, 0xfd, 0xad, 0x5e, 0x53, 0x60,
+	0xe5, 0xdf, 0xf6, 0x57, 0x90, 0xfb, 0xd0, 0xc7, 0x69, 0xdd, 0x14, 0xd5, 0x9c, 0x5e, 0xcf, 0x75,
+	0x4f, 0x18, 0xf5, 0x69, 0x82, 0x03, 0x91, 0x42, 0x20, 0xfb, 0x50, 0x3b, 0x69, 0xb8, 0x92, 0x4f,
+	0x1d, 0x6b, 0xc4, 0x95, 0x42, 0xf4, 0xb8, 0xd2, 0x49, 0x39, 0x49, 0x76, 0xb5, 0x12, 0x62, 0x30,
+	0x49, 0x8a, 0xa2, 0x9a, 0x6f, 0x33, 0x57, 0xfa, 0x41, 0x39, 0x44, 0xb8, 0xb8, 0x6b, 0x60, 0xfe,
+	0x4f, 0x10, 0x33, 0x9d, 0x0d, 0x40, 0x21, 0x31, 0x83, 0xd3, 0xa8, 0xdb, 0xdd, 0x49, 0x9c, 0x8f,
+	0x49, 0xdd, 0xe3, 0x96, 0x53, 0x9e, 0x6e, 0x25, 0x2d, 0x4f, 0x42, 0xf6, 0xd3, 0x8c, 0x3c, 0x7b,
+	0xf9, 0x32, 0x2b, 0xe2, 0x11, 0x38, 0x09, 0xa1, 0x92, 0x88, 0x8b, 0x90, 0x93, 0x10, 0x80, 0xc8,
+	0x95, 0x9a, 0x0a, 0xe8, 0x58, 0xec, 0x2c, 0xdf, 0x31, 0xd5, 0x14, 0x31, 0xb2, 0x52, 0x5b, 0x30,
+	0xb9, 0x27, 0xa6, 0xc2, 0xb3, 0xb2, 0x35, 0x7e, 0xdd, 0xd4, 0x3a, 0x2b, 0x35, 0xbb, 0x37, 0x1c,
+	0x84, 0xdc, 0xdb, 0xd1, 0xcf, 0xf7, 0x8a, 0x57, 0x79, 0x6b, 0xd4, 0x52, 0xd1, 0x4e, 0x86, 0xec,
+	0xed, 0x20, 0xc3, 0x0d, 0x7f, 0x11, 0xfc, 0x69, 0x6b, 0xb8, 0x2a, 0xca, 0x70, 0xc9, 0xa2, 0x50,
+	0x29, 0x2f, 0x7e, 0xae, 0xa1, 0x72, 0x79, 0xcb, 0x45, 0x3f, 0x1d, 0x96, 0x71, 0x42, 0xce, 0xea,
+	0x78, 0x4c, 0xc0, 0x2d, 0x57, 0xab, 0x22, 0xa5, 0xc8, 0x2d, 0x97, 0x49, 0xc9, 0x7d, 0x68, 0x6b,
+	0x9e, 0x34, 0x0f, 0xe3, 0x7c, 0xf4, 0x2a, 0x1d, 0x35, 0x93, 0xd0, 0xd2, 0x27, 0xaa, 0x1c, 0xd9,
+	0x87, 0xda, 0x38, 0xdd, 0xc9, 0x41, 0x8f, 0x93, 0x03, 0x4f, 0x27, 0x07, 0x56, 0x27, 0x8f, 0x83,
+	0x37, 0xa9, 0xf4, 0x38, 0xcd, 0xc3, 0x8f, 0x4c, 0x9d, 0xe3, 0x54, 0xce, 0x0d, 0x4b, 0x98, 0x98,
+	0x5b, 0x7a, 0x1a, 0xfc, 0x59, 0x1b, 0x6b, 0x79, 0x99, 0xe6, 0xa1, 0xa5, 0x83, 0x5a, 0x81, 0xb0,
+	0x76, 0x1d, 0x07, 0xf4, 0x2e, 0xa4, 0x71, 0x7d, 0x9c, 0xe6, 0x39, 0x19, 0xd9, 0xba, 0x50, 0x4a,
+	0x5d, 0x5d, 0xa8, 0x51, 0x72, 0xa2, 0xe4, 0x5d, 0xb8, 0x1b, 0x27, 0x13, 0x72, 0x94, 0x4e, 0x53,
+	0x78, 0xd2, 0xd5, 0xf5, 0x8d, 0x04, 0x90, 0x89, 0xd2, 0x0a, 0xca, 0xab, 0xc3, 0xa7, 0xf1, 0x65,
+	0x3a, 0x16, 0x8b, 0x39, 0x5b, 0x9b, 0x6a, 0x70, 0x75, 0x28, 0x99, 0x48, 0x81, 0x90, 0xab, 0x43,
+	0x14, 0xe6, 0x3e, 0x7f, 0x3e, 0x08, 0xae, 0x4b, 0xe6, 0xa0, 0xbb, 0xb0, 0x3a, 0xcc, 0x5f, 0x16,
+	0x2f, 0xd2, 0x66, 0x42, 0x37, 0x6a, 0x75, 0xf8, 0x29, 0x66, 0xd2, 0xce, 0x8b, 0xa2, 0x7c, 0xb6,
+	0xb0, 0x9e, 0xdc, 0x15, 0x75, 0x67, 0xcc, 0x2c, 0x07, 0xa2, 0x0f, 0x83, 0x98, 0x06, 0xd8, 0x15,
+	0x75, 0x58, 0x04, 0x39, 0x64, 0x57, 0xe4, 0xe2, 0x95, 0x1c, 0x17, 0xf3, 0xde, 0x66, 0x76, 0xf7,
+	0xfc, 0x2c, 0x6a, 0xf9, 0xdd, 0xfd, 0x85, 0x74, 0xe4, 0xab, 0x39, 0x51, 0x90, 0xac, 0xc8, 0xe1,
+	0xbb, 0x4c, 0x69, 0x85, 0x0a, 0x91, 0x57, 0x73, 0x06, 0x24, 0x83, 0xba, 0x13, 0xb1, 0x63, 0x46,
+	0xfa, 0xe8, 0x77, 0xd9, 0xae, 0x2a, 0x00, 0x24, 0xa8, 0xad, 0x20, 0xf7, 0x73, 0x12, 0xbc, 0x45,
+	0x3b, 0xf7, 0xb8, 0x22, 0x97, 0x29, 0x81, 0xcf, 0xa2, 0x14, 0x09, 0xb2, 0xb0, 0xe8, 0x84, 0x1c,
+	0xef, 0x67, 0x79, 0x5d, 0x66, 0x71, 0x3d, 0xe1, 0xcf, 0x72, 0xf4, 0x3a, 0x77, 0x42, 0xf8, 0x30,
+	0xe7, 0x4e, 0x0f, 0x25, 0x67, 0xd3, 0x4e, 0x26, 0xd6, 0xae, 0xbb, 0x76, 0x55, 0x63, 0xfd, 0x5a,
+	0xee, 0xe5, 0x64, 0x9e, 0xf0, 0x30, 0x2b, 0x92, 0x0b, 0xbe, 0xe0, 0xea, 0xb5, 0x6e, 0x25, 0x70,
+	0xc5, 0xbd, 0xe9, 0x42, 0xe4, 0x92, 0xdb, 0x0a, 0x4e, 0x48, 0x99, 0xc5, 0x09, 0x7c, 0x30, 0xc6,
+	0x74, 0xb8, 0x0c, 0x59, 0x72, 0x21, 0x03, 0x8a, 0xcb, 0x1f, 0xa2, 0xd9, 0x8a, 0x0b, 0xde, 0xa1,
+	0xdd, 0x74, 0x21, 0x32, 0xe9, 0x68, 0x05, 0xc3, 0x32, 0x4b, 0x1b, 0x10, 0x1b, 0x4c, 0xa3, 0x95,
+	0x20, 0xb1, 0xa1, 0x13, 0xc0, 0xe4, 0x13, 0x52, 0x8d, 0x89, 0xd5, 0x64, 0x2b, 0x71, 0x9a, 0xec,
+	0x08, 0xb9, 0x5c, 0xb1, 0xba, 0x17, 0xe5, 0x1c, 0x2c, 0x57, 0xbc, 0x5a, 0x45, 0x39, 0x47, 0x96,
+	0x2b, 0x0d, 0x00, 0x45, 0x3c, 0x8e, 0xeb, 0xc6, 0x5e, 0xc4, 0x56, 0xe2, 0x2c, 0x62, 0x47, 0xc8,
+	0x8c, 0x88, 0x15, 0x71, 0xd6, 0x80, 0x8c, 0x88, 0x17, 0x40, 0x79, 0x44, 0x70, 0x0d, 0x95, 0xcb,
+	0xe1, 0xc5, 0x7a, 0x85, 0x34, 0xfb, 0x29, 0xc9, 0x46, 0x35, 0x18, 0x5e, 0xbc, 0xdd, 0x3b, 0x29,
+	0x32, 0xbc, 0x4c, 0x0a, 0x84, 0x12, 0xbf, 0x25, 0xb1, 0xd5, 0x0e, 0x5c, 0x90, 0xdc, 0x74, 0x21,
+	0x32, 0x43, 0x6e, 0x05, 0xca, 0x3d, 0xb2, 0xad, 0x3c, 0x96, 0x6b, 0xe4, 0xbb, 0x7d, 0x18, 0xf7,
+	0xf0, 0xc3, 0x41, 0xf0, 0x91, 0x70, 0x41, 0x5f, 0x4a, 0x9d, 0x16, 0x8f, 0x5e, 0xa7, 0x75, 0x93,
+	0xe6, 0x63, 0xbe, 0x34, 0xdd, 0x47, 0x2c, 0xd9, 0x60, 0xe1, 0xfe, 0xc1, 0x62, 0x4a, 0x72, 0x85,
+	0x04, 0x65, 0x79, 0x4a, 0x5e, 0x59, 0x57, 0x48, 0x68, 0x51, 0x70, 0xc8, 0x0a, 0xe9, 0xe2, 0xe5,
+	0x29, 0x94, 0x70, 0xce, 0xbf, 0xbf, 0x75, 0x5a, 0x74, 0xc9, 0x0a, 0x66, 0x0d, 0x82, 0xc8, 0x7e,
+	0xdc, 0xa9, 0x20, 0x37, 0xc9, 0xc2, 0xbf, 0x0c, 0xd2, 0x15, 0xc4, 0x8e, 0x19, 0xa8, 0xab, 0x1e,
+	0xa4, 0xc5, 0x95, 0x7c, 0x0c, 0x81, 0xb9, 0x32, 0xdf, 0x42, 0xac, 0x7a, 0x90, 0xca, 0x89, 0x96,
+	0x5a, 0x2d, 0x7a, 0x5c, 0x3e, 0xae, 0x8a, 0x59, 0x3e, 0xda, 0x2d, 0xb2, 0xa2, 0x02, 0x27, 0x5a,
+	0x5a, 0xa9, 0x01, 0x8a, 0x9c, 0x68, 0xf5, 0xa8, 0xc8, 0xc4, 0x40, 0x2d, 0xc5, 0x4e, 0x96, 0x8e,
+	0xe1, 0xb1, 0x80, 0x66, 0xa8, 0x05, 0x90, 0xc4, 0xc0, 0x0a, 0x5a, 0x82, 0x88, 0x1d, 0x1b, 0x34,
+	0x69, 0x12, 0x67, 0xcc, 0xdf, 0x16, 0x6e, 0x46, 0x03, 0x7b, 0x83, 0xc8, 0xa2, 0x60, 0xa9, 0xe7,
+	0xe9, 0xac, 0xca, 0x0f, 0xf3, 0xa6, 0x40, 0xeb, 0xd9, 0x01, 0xbd, 0xf5, 0x54, 0x40, 0x99, 0x4d,
+	0xb4, 0xe2, 0x53, 0xf2, 0x9a, 0x96, 0x86, 0xfe, 0x2f, 0xb4, 0x4c, 0x39, 0xf4, 0xf3, 0x88, 0xcb,
+	0x91, 0x6c, 0xc2, 0xc6, 0x81, 0xca, 0x70, 0x27, 0x2c, 0x60, 0x1c, 0xda, 0x7a, 0x98, 0xac, 0xf4,
+	0x83, 0x76, 0x3f, 0xc3, 0x66, 0x9e, 0x11, 0x97, 0x9f, 0x16, 0xf0, 0xf1, 0xd3, 0x81, 0xf2, 0xf6,
+	0x4b, 0xab, 0xcf, 0x84, 0x24, 0x17, 0xc6, 0xdb, 0x2e, 0xbd, 0xa0, 0x0c, 0x41, 0x6e, 0xbf, 0x10,
+	0xd4, 0xde, 0x45, 0x87, 0x49, 0x91, 0xbb, 0xba, 0x88, 0xca, 0x7d, 0xba, 0x88, 0x73, 0x72, 0x77,
+	0x27, 0xa4, 0x3c, 0x32, 0x59, 0x37, 0xad, 0x23, 0x16, 0x54, 0x08, 0xd9, 0xdd, 0xa1, 0xb0, 0xbc,
+	0x9f, 0x80, 0x3e, 0x9f, 0x98, 0xcf, 0xfc, 0x0d, 0x2b, 0x4f, 0xf0, 0x67, 0xfe, 0x18, 0x8b, 0x57,
+	0x92, 0xc5, 0x48, 0x8f, 0x15, 0x3d, 0x4e, 0x36, 0xfc, 0x60, 0xf9, 0xc6, 0x4a, 0xf3, 0xb9, 0x9b,
+	0x91, 0xb8, 0x62, 0x5e, 0x37, 0x1d, 0x86, 0x24, 0x86, 0x1c, 0x86, 0x3b, 0x70, 0x30, 0x85, 0x69,
+	0x9e, 0x77, 0x8b, 0xbc, 0x21, 0x79, 0x63, 0x9b, 0xc2, 0x74, 0x63, 0x1c, 0x74, 0x4d, 0x61, 0x98,
+	0x02, 0x88, 0x5b, 0x7e, 0x3a, 0xf1, 0x34, 0x9e, 0x12, 0x5b, 0xdc, 0x76, 0x67, 0x0e, 0x54, 0xee,
+	0x8a, 0x5b, 0xc0, 0x81, 0x21, 0x7f, 0x38, 0x8d, 0xc7, 0xc2, 0x8b, 0x45, 0xbb, 0x95, 0x1b, 0x6e,
+	0x56, 0xfa, 0x41, 0xe0, 0xe7, 0x79, 0x3a, 0x22, 0x85, 0xc3, 0x4f, 0x2b, 0xf7, 0xf1, 0x03, 0x41,
+	0x90, 0x39, 0xd1, 0xda, 0xb2, 0xfd, 0xc8, 0x4e, 0x3e, 0xe2, 0xbb, 0xb0, 0x08, 0x69, 0x14, 0xc0,
+	0xb9, 0x32, 0x27, 0x84, 0x07, 0xe3, 0xa3, 0x3b, 0xae, 0x72, 0x8d, 0x0f, 0x71, 0x1e, 0xe5, 0x33,
+	0x3e, 0x6c, 0x30, 0xf7, 0xf9, 0x6f, 0x7c, 0x7c, 0xec, 0xc5, 0x4d, 0x4c, 0xf7, 0xd1, 0xcf, 0x53,
+	0xf2, 0x8a, 0x6f, 0xe3, 0x2c, 0xf5, 0xed, 0xa8, 0x88, 0x62, 0x70, 0x4f, 0xb7, 0xe5, 0xcd, 0x3b,
+	0x7c, 0xf3, 0xec, 0xbc, 0xd7, 0x37, 0x48, 0xd3, 0xb7, 0xbc, 0x79, 0x87, 0x6f, 0xfe, 0xad, 0xcb,
+	0x5e, 0xdf, 0xe0, 0xab, 0x97, 0x5b, 0xde, 0x3c, 0xf7, 0xfd, 0x3f, 0x83, 0xe0, 0xaa, 0xe1, 0x9c,
+	0xe6, 0x40, 0x49, 0x93, 0x5e, 0x12, 0x5b, 0x2a, 0xa7, 0xdb, 0x13, 0xa8, 0x2b, 0x95, 0xc3, 0x55,
+	0x78, 0x29, 0x7e, 0x30, 0x08, 0x3e, 0xb4, 0x95, 0xe2, 0xb8, 0xa8, 0xd3, 0xf6, 0x85, 0xc1, 0x7d,
+	0x0f, 0xa3, 0x1d, 0xec, 0xda, 0xb0, 0xb8, 0x94, 0xe4, 0x75, 0x8c, 0x86, 0xca, 0x67, 0xd4, 0x1b,
+	0x0e, 0x7b, 0xe6, 0x6b, 0xea, 0x4d, 0x4f, 0x5a, 0xde, 0x1c, 0x6a, 0x8c, 0x7a, 0x65, 0xe9, 0xea,
+	0x55, 0xeb, 0xad, 0xe5, 0xb6, 0xbf, 0x02, 0x77, 0xff, 0x7f, 0x5d, 0x4e, 0x0f, 0xfd, 0xf3, 0x41,
+	0x70, 0xcf, 0xc7, 0x22, 0x18, 0x08, 0xf7, 0x17, 0xd2, 0xe1, 0x05, 0xf9, 0xd5, 0x20, 0xb8, 0x69,
+	0x2d, 0x88, 0x7e, 0x6b, 0xfe, 0x77, 0x3e, 0xb6, 0xed, 0xb7, 0xe7, 0x7f, 0xff, 0x7d, 0x54, 0x79,
+	0xe9, 0x7e, 0xd4, 0x6d, 0xad, 0x3b, 0x8d, 0xf6, 0xab, 0x2e, 0xcf, 0xaa, 0x11, 0xa9, 0xf8, 0x88,
+	0x75, 0x05, 0x9d, 0x84, 0xe1, 0xb8, 0xfd, 0x64, 0x41, 0x2d, 0x5e, 0x9c, 0x9f, 0x0c, 0x82, 0x25,
+	0x0d, 0xe6, 0x5f, 0x40, 0x55, 0xca, 0xe3, 0xb2, 0xac, 0xd0, 0xb0, 0x40, 0x9f, 0x2e, 0xaa, 0x86,
+	0x8d, 0x64, 0x05, 0x6e, 0xbf, 0x00, 0x76, 0xdf, 0xd3, 0xb0, 0xf6, 0x5d, 0xb0, 0x07, 0x8b, 0x29,
+	0xf1, 0xb2, 0xfc, 0x7a, 0x10, 0xdc, 0xd1, 0x58, 0x79, 0x88, 0x0d, 0xce, 0x43, 0xfe, 0xc1, 0x61,
+	0x1f, 0x53, 0x12, 0x85, 0xfb, 0xc7, 0xef, 0xa7, 0x2c, 0x1f, 0x48, 0x68, 0x2a, 0xfb, 0x69, 0xd6,
+	0x90, 0xca, 0xfc, 0x75, 0x12, 0xdd, 0x2e, 0xa3, 0x22, 0xfc, 0xd7, 0x49, 0x1c, 0xb8, 0xf2, 0xeb,
+	0x24, 0x16, 0xcf, 0xd6, 0x5f, 0x27, 0xb1, 0x5a, 0x73, 0xfe, 0x3a, 0x89, 0x5b, 0x03, 0x5b, 0x7c,
+	0xba, 0x22, 0xb0, 0x33, 0x61, 0x2f, 0x8b, 0xfa, 0x11, 0xf1, 0xbd, 0x45, 0x54, 0x90, 0xe5, 0x97,
+	0x71, 0xed, 0xa3, 0x49, 0x8f, 0x36, 0xd5, 0x1e, 0x4e, 0x6e, 0x79, 0xf3, 0xdc, 0xf7, 0xd7, 0xc1,
+	0x7b, 0x1a, 0x45, 0xa5, 0xb4, 0xef, 0xd7, 0x5d, 0x8b, 0x07, 0xb5, 0xa0, 0xf6, 0xfc, 0x86, 0x1f,
+	0x8c, 0x54, 0x97, 0x12, 0xbc, 0xd3, 0xa3, 0x3e, 0x43, 0xa0, 0xcb, 0xb7, 0xbc, 0x79, 0x64, 0x91,
+	0x63, 0xbe, 0x59, 0x6f, 0x7b, 0x18, 0xd3, 0xfb, 0x7a, 0xdb, 0x5f, 0x41, 0xbe, 0x09, 0x32, 0xdc,
+	0xd3, 0xff, 0xc2, 0xde, 0x16, 0xd4, 0x7a, 0x79, 0xd3, 0x93, 0x76, 0x25, 0x37, 0xea, 0xf2, 0xde,
+	0x97, 0xdc, 0x58, 0x97, 0xf8, 0x07, 0x8b, 0x29, 0xf1, 0xb2, 0xfc, 0x6c, 0x10, 0x5c, 0x43, 0xcb,
+	0xc2, 0xa3, 0xe0, 0x53, 0x5f, 0xcb, 0x20, 0x1a, 0x3e, 0x5b, 0x58, 0x8f, 0x17, 0xea, 0x97, 0x83,
+	0xe0, 0xba, 0xa3, 0x50, 0x2c, 0x3c, 0x16, 0xb0, 0xae, 0x87, 0xc9, 0xe7, 0x8b, 0x2b, 0x62, 0x8b,
+	0xbd, 0x8a, 0x0f, 0xcd, 0x9f, 0x26, 0x71, 0xd8, 0x1e, 0xe2, 0x3f, 0x4d, 0xd2, 0xaf, 0x05, 0x0f,
+	0x7f, 0x68, 0x4a, 0xc2, 0xf7, 0x45, 0xb6, 0xc3, 0x1f, 0x2a, 0x86, 0xfb, 0xa1, 0xe5, 0x5e, 0xce,
+	0xe6, 0xe4, 0xd1, 0xeb, 0x32, 0xce, 0x47, 0xb8, 0x13, 0x26, 0xef, 0x77, 0x22, 0x38, 0x78, 0x68,
+	0x46, 0xa5, 0x27, 0x45, 0xb7, 0xc9, 0x5b, 0xc5, 0xf4, 0x05, 0xe2, 0x3c, 0x34, 0x33, 0x50, 0xc4,
+	0x1b, 0xcf, 0x68, 0x5d, 0xde, 0x40, 0x22, 0xbb, 0xe6, 0x83, 0x82, 0xed, 0x83, 0xf0, 0x26, 0xce,
+	0xe2, 0x37, 0x5c, 0x56, 0x8c, 0xf3, 0xf8, 0x4d, 0x4f, 0x1a, 0x71, 0x3b, 0x24, 0xcd, 0x63, 0x12,
+	0x8f, 0x48, 0xe5, 0x74, 0x2b, 0x28, 0x2f, 0xb7, 0x2a, 0x6d, 0x73, 0xbb, 0x5b, 0x64, 0xb3, 0x69,
+	0xce, 0x3b, 0x13, 0x75, 0xab, 0x52, 0xfd, 0x6e, 0x01, 0x0d, 0x8f, 0x0b, 0xa5, 0xdb, 0x36, 0xb9,
+	0x5c, 0x73, 0x9b, 0xd1, 0x72, 0xca, 0x75, 0x2f, 0x16, 0xaf, 0x27, 0x0f, 0xa3, 0x9e, 0x7a, 0x82,
+	0x48, 0xda, 0xf4, 0xa4, 0xe1, 0xb9, 0x9d, 0xe2, 0x56, 0xc4, 0xd3, 0x56, 0x8f, 0x2d, 0x23, 0xa4,
+	0xb6, 0xfd, 0x15, 0xe0, 0x29, 0x29, 0x8f, 0x2a, 0xba, 0x2b, 0xda, 0x4f, 0xb3, 0x2c, 0x5c, 0x77,
+	0x84, 0x49, 0x07, 0x39, 0x4f, 0x49, 0x2d, 0x30, 0x12, 0xc9, 0xdd, 0xa9, 0x62, 0x1e, 0xf6, 0xd9,
+	0x69, 0x29, 0xaf, 0x48, 0x56, 0x69, 0x70, 0xda, 0xa6, 0x34, 0xb5, 0xa8, 0x6d, 0xe4, 0x6e, 0x38,
+	0xa3, 0xc2, 0x5b, 0xde, 0x3c, 0xb8, 0xc8, 0x6e, 0xa9, 0x76, 0x65, 0xb9, 0x8d, 0x99, 0xd0, 0x56,
+	0x92, 0x3b, 0x3d, 0x14, 0x3c, 0x78, 0x96, 0x75, 0x1b, 0x12, 0xf6, 0x44, 0xa8, 0x27, 0x20, 0x39,
+	0xe6, 0x3c, 0x78, 0xb6, 0xe2, 0xd6, 0x56, 0x25, 0x59, 0x46, 0x6f, 0x2e, 0x8b, 0x6a, 0x3a, 0xcb,
+	0x62, 0x47, 0xab, 0x6a, 0x9c, 0x47, 0xab, 0x42, 0x1e, 0x1c, 0xd4, 0xb2, 0xd9, 0xe3, 0x45, 0x3a,
+	0x1a, 0x93, 0xc6, 0x7a, 0x71, 0xa6, 0x02, 0xce, 0x8b, 0x33, 0x00, 0x82, 0x88, 0x65, 0x9f, 0xd3,
+	0x36, 0x88, 0xab, 0x31, 0x69, 0x0e, 0x47, 0xb6, 0x88, 0xe5, 0xca, 0x0a, 0xe5, 0x8a, 0x58, 0x2b,
+	0x0d, 0x26, 0x41, 0xe1, 0x96, 0xff, 0x44, 0xc3, 0x9a, 0xcb, 0x0c, 0xf8, 0x9d, 0x86, 0x75, 0x2f,
+	0x16, 0x2c, 0xa4, 0xd2, 0x61, 0xfb, 0xc0, 0x70, 0xd5, 0x69, 0x43, 0x7b, 0x62, 0xb8, 0xe6, 0x83,
+	0x62, 0xd5, 0xa3, 0xa9, 0xd1, 0xe1, 0xc8, 0x5d, 0x3d, 0xc6, 0xf8, 0x55, 0x4f, 0xb0, 0xc6, 0x3d,
+	0x6f, 0x2e, 0x42, 0xa6, 0x99, 0xf0, 0x13, 0x02, 0x4b, 0xf0, 0x51, 0x2e, 0x82, 0xa0, 0x6b, 0xb2,
+	0xc5, 0x14, 0x94, 0x6f, 0x79, 0x09, 0xae, 0xbb, 0x8a, 0x2e, 0x4b, 0x12, 0x57, 0x71, 0x9e, 0x58,
+	0x77, 0xe4, 0xad, 0x41, 0x83, 0x74, 0xed, 0xc8, 0x51, 0x0d, 0xf0, 0x8a, 0x40, 0xff, 0xde, 0xae,
+	0x65, 0x28, 0x74, 0x40, 0xa4, 0x7f, 0x6d, 0x77, 0xd5, 0x83, 0x84, 0xaf, 0x08, 0x3a, 0x40, 0xdc,
+	0x45, 0x30, 0xa7, 0x1f, 0x3b, 0x4c, 0xe9, 0xa8, 0x6b, 0xf7, 0x8f, 0xab, 0x80, 0xa0, 0x16, 0x79,
+	0x3d, 0x69, 0xbe, 0x20, 0x73, 0x5b, 0x50, 0xcb, 0xb4, 0xbc, 0x45, 0x5c, 0x41, 0x6d, 0xa2, 0x20,
+	0xbd, 0x56, 0xb7, 0x7f, 0x77, 0x1d, 0xfa, 0xea, 0x8e, 0x6f, 0xb9, 0x97, 0x03, 0x23, 0x67, 0x2f,
+	0xbd, 0xd4, 0xae, 0x6e, 0x2c, 0x05, 0xdd, 0x4b, 0x2f, 0xed, 0x37, 0x37, 0xeb, 0x5e, 0x2c, 0x7c,
+	0xa1, 0x10, 0x37, 0xe4, 0x75, 0xf7, 0x74, 0xc0, 0x52, 0xdc, 0x56, 0x6e, 0xbc, 0x1d, 0x58, 0xe9,
+	0x07, 0xe1, 0x1b, 0x17, 0xee, 0xe7, 0x28, 0x3e, 0x27, 0x59, 0xe8, 0xd2, 0x6f, 0x09, 0x57, 0x74,
+	0x1a, 0xa4, 0x7c, 0xd1, 0x7a, 0x5c, 0x15, 0x09, 0xa9, 0xeb, 0x5d, 0x3a, 0x42, 0x32, 0xf0, 0xa2,
+	0x95, 0xcb, 0x22, 0x26, 0x44, 0x5e, 0xb4, 0x1a, 0x90, 0x7c, 0x9f, 0x7e, 0x4c, 0xd8, 0x19, 0x9f,
+	0xfe, 0x3e, 0x9d, 0x7e, 0xaa, 0x75, 0xf9, 0x12, 0x26, 0x96, 0x0f, 0xf4, 0xe8, 0x87, 0x7c, 0xe3,
+	0x7e, 0xdd, 0xa4, 0xc1, 0x16, 0xfd, 0x86, 0x83, 0x90, 0x0f, 0xf4, 0xe8, 0xe7, 0xed, 0x57, 0xb4,
+	0x2c, 0xee, 0xb5, 0xef, 0x64, 0x5d, 0x43, 0xe5, 0x32, 0x7f, 0xa4, 0x9f, 0x1e, 0x90, 0xe6, 0x38,
+	0x4e, 0xab, 0x34, 0x1f, 0x1f, 0xc7, 0xf3, 0xf6, 0xfe, 0x72, 0xdd, 0xd4, 0x34, 0x20, 0x24, 0x7f,
+	0x44, 0x61, 0xd9, 0xba, 0x47, 0xc5, 0x78, 0x48, 0x72, 0xd8, 0xba, 0x47, 0xc5, 0x38, 0xa2, 0x1f,
+	0x23, 0xad, 0xab, 0x88, 0xe5, 0x73, 0xca, 0x3d, 0x72, 0x3e, 0x1b, 0x9f, 0x56, 0x84, 0x80, 0xe7,
+	0x94, 0xed, 0xe7, 0x11, 0x15, 0x20, 0xcf, 0x29, 0x35, 0x40, 0x66, 0x79, 0xc2, 0x1e, 0xdd, 0x48,
+	0xc1, 0xe7, 0x8a, 0x52, 0xa7, 0x95, 0x22, 0x59, 0x9e, 0x49, 0xc9, 0x51, 0xd8, 0xca, 0xda, 0x2f,
+	0x77, 0x0c, 0x67, 0xd3, 0x69, 0x5c, 0xcd, 0xc1, 0x28, 0x64, 0xba, 0x2a, 0x80, 0x8c, 0x42, 0x2b,
+	0x28, 0xa7, 0x17, 0xc5, 0xcf, 0x3c, 0x4f, 0x4e, 0x48, 0x69, 0x7e, 0xc3, 0x5c, 0xb5, 0x20, 0x18,
+	0x64, 0x7a, 0xc1, 0x58, 0x19, 0x45, 0x2d, 0xc1, 0x5e, 0x52, 0x1e, 0x15, 0x49, 0x9c, 0xd1, 0xef,
+	0x36, 0xc1, 0xbb, 0x68, 0x66, 0x05, 0x42, 0x48, 0x14, 0xa1, 0x30, 0xe8, 0xfb, 0xe3, 0x34, 0x1f,
+	0x5b, 0xfb, 0x9e, 0x0a, 0x9c, 0x7d, 0xcf, 0x01, 0x39, 0x75, 0xb1, 0x46, 0x63, 0x3f, 0x4d, 0xc6,
+	0xbf, 0xc4, 0x6c, 0x6d, 0x74, 0x95, 0x40, 0xa6, 0x2e, 0x3b, 0x09, 0x5c, 0x3d, 0x2b, 0x49, 0x4e,
+	0x46, 0xdd, 0x6b, 0x47, 0x9b, 0x2b, 0x8d, 0x70, 0xba, 0x82, 0xa4, 0x0c, 0x85, 0x27, 0xa4, 0xa9,
+	0xd2, 0xa4, 0xa6, 0x57, 0xa9, 0x71, 0x15, 0x4f, 0x49, 0x43, 0xaa, 0x1a, 0x84, 0x02, 0x47, 0x22,
+	0x8d, 0x41, 0x42, 0x01, 0x63, 0xb9, 0xc3, 0x7f, 0x0a, 0xde, 0xa5, 0x33, 0x0c, 0xc9, 0xf9, 0x8f,
+	0xd6, 0x3f, 0x6a, 0xff, 0x9e, 0x43, 0x78, 0x45, 0xd8, 0x18, 0x36, 0x15, 0x89, 0xa7, 0x9d, 0xed,
+	0x77, 0xc4, 0xe7, 0x2d, 0xb8, 0x3d, 0x78, 0x78, 0xe3, 0xb7, 0xdf, 0x2e, 0x0d, 0xbe, 0xf9, 0x76,
+	0x69, 0xf0, 0xfb, 0x6f, 0x97, 0x06, 0x3f, 0xfd, 0x6e, 0xe9, 0x8d, 0x6f, 0xbe, 0x5b, 0x7a, 0xe3,
+	0x77, 0xdf, 0x2d, 0xbd, 0xf1, 0xd5, 0x9b, 0xfc, 0xef, 0x4a, 0x9c, 0xff, 0x49, 0xfb, 0xd7, 0x21,
+	0xee, 0xff, 0x71, 0x00, 0x6a, 0xfa, 0x08, 0x03, 0x7b, 0x62, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -308,6 +344,11 @@ type ClientCommandsClient interface {
 	AccountMove(ctx context.Context, in *pb.RpcAccountMoveRequest, opts ...grpc.CallOption) (*pb.RpcAccountMoveResponse, error)
 	AccountConfigUpdate(ctx context.Context, in *pb.RpcAccountConfigUpdateRequest, opts ...grpc.CallOption) (*pb.RpcAccountConfigUpdateResponse, error)
 	AccountRecoverFromLegacyExport(ctx context.Context, in *pb.RpcAccountRecoverFromLegacyExportRequest, opts ...grpc.CallOption) (*pb.RpcAccountRecoverFromLegacyExportResponse, error)
+	AccountBackup(ctx context.Context, in *pb.RpcAccountBackupRequest, opts ...grpc.CallOption) (*pb.RpcAccountBackupResponse, error)
+	AccountBackupSetSchedule(ctx context.Context, in *pb.RpcAccountBackupSetScheduleRequest, opts ...grpc.CallOption) (*pb.RpcAccountBackupSetScheduleResponse, error)
+	AccountBackupGetStatus(ctx context.Context, in *pb.RpcAccountBackupGetStatusRequest, opts ...grpc.CallOption) (*pb.RpcAccountBackupGetStatusResponse, error)
+	AccountVerifyBackup(ctx context.Context, in *pb.RpcAccountVerifyBackupRequest, opts ...grpc.CallOption) (*pb.RpcAccountVerifyBackupResponse, error)
+	AccountRecoverFromBackup(ctx context.Context, in *pb.RpcAccountRecoverFromBackupRequest, opts ...grpc.CallOption) (*pb.RpcAccountRecoverFromBackupResponse, error)
 	// Object
 	// ***
 	ObjectOpen(ctx context.Context, in *pb.RpcObjectOpenRequest, opts ...grpc.CallOption) (*pb.RpcObjectOpenResponse, error)
@@ -321,6 +362,13 @@ type ClientCommandsClient interface {
 	ObjectGraph(ctx context.Context, in *pb.RpcObjectGraphRequest, opts ...grpc.CallOption) (*pb.RpcObjectGraphResponse, error)
 	ObjectSearch(ctx context.Context, in *pb.RpcObjectSearchRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchResponse, error)
 	ObjectSearchSubscribe(ctx context.Context, in *pb.RpcObjectSearchSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchSubscribeResponse, error)
+	ObjectQueryParse(ctx context.Context, in *pb.RpcObjectQueryParseRequest, opts ...grpc.CallOption) (*pb.RpcObjectQueryParseResponse, error)
+	ObjectCalendarSubscribe(ctx context.Context, in *pb.RpcObjectCalendarSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectCalendarSubscribeResponse, error)
+	ObjectCalendarMove(ctx context.Context, in *pb.RpcObjectCalendarMoveRequest, opts ...grpc.CallOption) (*pb.RpcObjectCalendarMoveResponse, error)
+	ObjectTimelineSubscribe(ctx context.Context, in *pb.RpcObjectTimelineSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectTimelineSubscribeResponse, error)
+	ObjectTimelineMove(ctx context.Context, in *pb.RpcObjectTimelineMoveRequest, opts ...grpc.CallOption) (*pb.RpcObjectTimelineMoveResponse, error)
+	ObjectBulkEdit(ctx context.Context, in *pb.RpcObjectBulkEditRequest, opts ...grpc.CallOption) (*pb.RpcObjectBulkEditResponse, error)
+	ObjectBulkEditUndo(ctx context.Context, in *pb.RpcObjectBulkEditUndoRequest, opts ...grpc.CallOption) (*pb.RpcObjectBulkEditUndoResponse, error)
 	ObjectSubscribeIds(ctx context.Context, in *pb.RpcObjectSubscribeIdsRequest, opts ...grpc.CallOption) (*pb.RpcObjectSubscribeIdsResponse, error)
 	ObjectGroupsSubscribe(ctx context.Context, in *pb.RpcObjectGroupsSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectGroupsSubscribeResponse, error)
 	ObjectSearchUnsubscribe(ctx context.Context, in *pb.RpcObjectSearchUnsubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchUnsubscribeResponse, error)
@@ -343,6 +391,9 @@ type ClientCommandsClient interface {
 	ObjectToSet(ctx context.Context, in *pb.RpcObjectToSetRequest, opts ...grpc.CallOption) (*pb.RpcObjectToSetResponse, error)
 	ObjectToCollection(ctx context.Context, in *pb.RpcObjectToCollectionRequest, opts ...grpc.CallOption) (*pb.RpcObjectToCollectionResponse, error)
 	ObjectShareByLink(ctx context.Context, in *pb.RpcObjectShareByLinkRequest, opts ...grpc.CallOption) (*pb.RpcObjectShareByLinkResponse, error)
+	ObjectShareRevoke(ctx context.Context, in *pb.RpcObjectShareRevokeRequest, opts ...grpc.CallOption) (*pb.RpcObjectShareRevokeResponse, error)
+	ObjectShareList(ctx context.Context, in *pb.RpcObjectShareListRequest, opts ...grpc.CallOption) (*pb.RpcObjectShareListResponse, error)
+	ObjectShareImport(ctx context.Context, in *pb.RpcObjectShareImportRequest, opts ...grpc.CallOption) (*pb.RpcObjectShareImportResponse, error)
 	ObjectUndo(ctx context.Context, in *pb.RpcObjectUndoRequest, opts ...grpc.CallOption) (*pb.RpcObjectUndoResponse, error)
 	ObjectRedo(ctx context.Context, in *pb.RpcObjectRedoRequest, opts ...grpc.CallOption) (*pb.RpcObjectRedoResponse, error)
 	ObjectListExport(ctx context.Context, in *pb.RpcObjectListExportRequest, opts ...grpc.CallOption) (*pb.RpcObjectListExportResponse, error)
@@ -357,12 +408,17 @@ type ClientCommandsClient interface {
 	ObjectCollectionAdd(ctx context.Context, in *pb.RpcObjectCollectionAddRequest, opts ...grpc.CallOption) (*pb.RpcObjectCollectionAddResponse, error)
 	ObjectCollectionRemove(ctx context.Context, in *pb.RpcObjectCollectionRemoveRequest, opts ...grpc.CallOption) (*pb.RpcObjectCollectionRemoveResponse, error)
 	ObjectCollectionSort(ctx context.Context, in *pb.RpcObjectCollectionSortRequest, opts ...grpc.CallOption) (*pb.RpcObjectCollectionSortResponse, error)
+	// Backlinks
+	// ***
+	ObjectBacklinksList(ctx context.Context, in *pb.RpcObjectBacklinksListRequest, opts ...grpc.CallOption) (*pb.RpcObjectBacklinksListResponse, error)
+	ObjectBacklinksLinkMention(ctx context.Context, in *pb.RpcObjectBacklinksLinkMentionRequest, opts ...grpc.CallOption) (*pb.RpcObjectBacklinksLinkMentionResponse, error)
 	// Relations
 	// ***
 	ObjectCreateRelation(ctx context.Context, in *pb.RpcObjectCreateRelationRequest, opts ...grpc.CallOption) (*pb.RpcObjectCreateRelationResponse, error)
 	ObjectCreateRelationOption(ctx context.Context, in *pb.RpcObjectCreateRelationOptionRequest, opts ...grpc.CallOption) (*pb.RpcObjectCreateRelationOptionResponse, error)
 	RelationListRemoveOption(ctx context.Context, in *pb.RpcRelationListRemoveOptionRequest, opts ...grpc.CallOption) (*pb.RpcRelationListRemoveOptionResponse, error)
 	RelationOptions(ctx context.Context, in *pb.RpcRelationOptionsRequest, opts ...grpc.CallOption) (*pb.RpcRelationOptionsResponse, error)
+	RelationListViolations(ctx context.Context, in *pb.RpcRelationListViolationsRequest, opts ...grpc.CallOption) (*pb.RpcRelationListViolationsResponse, error)
 	// Object Relations
 	// ***
 	ObjectRelationAdd(ctx context.Context, in *pb.RpcObjectRelationAddRequest, opts ...grpc.CallOption) (*pb.RpcObjectRelationAddResponse, error)
@@ -379,6 +435,8 @@ type ClientCommandsClient interface {
 	HistoryShowVersion(ctx context.Context, in *pb.RpcHistoryShowVersionRequest, opts ...grpc.CallOption) (*pb.RpcHistoryShowVersionResponse, error)
 	HistoryGetVersions(ctx context.Context, in *pb.RpcHistoryGetVersionsRequest, opts ...grpc.CallOption) (*pb.RpcHistoryGetVersionsResponse, error)
 	HistorySetVersion(ctx context.Context, in *pb.RpcHistorySetVersionRequest, opts ...grpc.CallOption) (*pb.RpcHistorySetVersionResponse, error)
+	HistoryRelationHistory(ctx context.Context, in *pb.RpcHistoryRelationHistoryRequest, opts ...grpc.CallOption) (*pb.RpcHistoryRelationHistoryResponse, error)
+	HistoryRelationChanges(ctx context.Context, in *pb.RpcHistoryRelationChangesRequest, opts ...grpc.CallOption) (*pb.RpcHistoryRelationChangesResponse, error)
 	// Files
 	// ***
 	FileOffload(ctx context.Context, in *pb.RpcFileOffloadRequest, opts ...grpc.CallOption) (*pb.RpcFileOffloadResponse, error)
@@ -387,6 +445,12 @@ type ClientCommandsClient interface {
 	FileDownload(ctx context.Context, in *pb.RpcFileDownloadRequest, opts ...grpc.CallOption) (*pb.RpcFileDownloadResponse, error)
 	FileDrop(ctx context.Context, in *pb.RpcFileDropRequest, opts ...grpc.CallOption) (*pb.RpcFileDropResponse, error)
 	FileSpaceUsage(ctx context.Context, in *pb.RpcFileSpaceUsageRequest, opts ...grpc.CallOption) (*pb.RpcFileSpaceUsageResponse, error)
+	FileSetBandwidth(ctx context.Context, in *pb.RpcFileSetBandwidthRequest, opts ...grpc.CallOption) (*pb.RpcFileSetBandwidthResponse, error)
+	FileGetBandwidth(ctx context.Context, in *pb.RpcFileGetBandwidthRequest, opts ...grpc.CallOption) (*pb.RpcFileGetBandwidthResponse, error)
+	FilePin(ctx context.Context, in *pb.RpcFilePinRequest, opts ...grpc.CallOption) (*pb.RpcFilePinResponse, error)
+	FileUnpin(ctx context.Context, in *pb.RpcFileUnpinRequest, opts ...grpc.CallOption) (*pb.RpcFileUnpinResponse, error)
+	FileListPinned(ctx context.Context, in *pb.RpcFileListPinnedRequest, opts ...grpc.CallOption) (*pb.RpcFileListPinnedResponse, error)
+	FileSetCacheLimit(ctx context.Context, in *pb.RpcFileSetCacheLimitRequest, opts ...grpc.CallOption) (*pb.RpcFileSetCacheLimitResponse, error)
 	NavigationListObjects(ctx context.Context, in *pb.RpcNavigationListObjectsRequest, opts ...grpc.CallOption) (*pb.RpcNavigationListObjectsResponse, error)
 	NavigationGetObjectInfoWithLinks(ctx context.Context, in *pb.RpcNavigationGetObjectInfoWithLinksRequest, opts ...grpc.CallOption) (*pb.RpcNavigationGetObjectInfoWithLinksResponse, error)
 	TemplateCreateFromObject(ctx context.Context, in *pb.RpcTemplateCreateFromObjectRequest, opts ...grpc.CallOption) (*pb.RpcTemplateCreateFromObjectResponse, error)
@@ -482,6 +546,8 @@ type ClientCommandsClient interface {
 	BlockTableRowListClean(ctx context.Context, in *pb.RpcBlockTableRowListCleanRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableRowListCleanResponse, error)
 	BlockTableColumnListFill(ctx context.Context, in *pb.RpcBlockTableColumnListFillRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableColumnListFillResponse, error)
 	BlockTableSort(ctx context.Context, in *pb.RpcBlockTableSortRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableSortResponse, error)
+	BlockTableColumnSetType(ctx context.Context, in *pb.RpcBlockTableColumnSetTypeRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableColumnSetTypeResponse, error)
+	BlockTableCellSetFormula(ctx context.Context, in *pb.RpcBlockTableCellSetFormulaRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableCellSetFormulaResponse, error)
 	// Widget commands
 	// ***
 	BlockCreateWidget(ctx context.Context, in *pb.RpcBlockCreateWidgetRequest, opts ...grpc.CallOption) (*pb.RpcBlockCreateWidgetResponse, error)
@@ -499,11 +565,17 @@ type ClientCommandsClient interface {
 	BlockRelationAdd(ctx context.Context, in *pb.RpcBlockRelationAddRequest, opts ...grpc.CallOption) (*pb.RpcBlockRelationAddResponse, error)
 	BlockDivListSetStyle(ctx context.Context, in *pb.RpcBlockDivListSetStyleRequest, opts ...grpc.CallOption) (*pb.RpcBlockDivListSetStyleResponse, error)
 	BlockLatexSetText(ctx context.Context, in *pb.RpcBlockLatexSetTextRequest, opts ...grpc.CallOption) (*pb.RpcBlockLatexSetTextResponse, error)
+	BlockLatexSetLabel(ctx context.Context, in *pb.RpcBlockLatexSetLabelRequest, opts ...grpc.CallOption) (*pb.RpcBlockLatexSetLabelResponse, error)
 	ProcessCancel(ctx context.Context, in *pb.RpcProcessCancelRequest, opts ...grpc.CallOption) (*pb.RpcProcessCancelResponse, error)
+	PeerAdd(ctx context.Context, in *pb.RpcPeerAddRequest, opts ...grpc.CallOption) (*pb.RpcPeerAddResponse, error)
+	PeerRemove(ctx context.Context, in *pb.RpcPeerRemoveRequest, opts ...grpc.CallOption) (*pb.RpcPeerRemoveResponse, error)
+	PeerList(ctx context.Context, in *pb.RpcPeerListRequest, opts ...grpc.CallOption) (*pb.RpcPeerListResponse, error)
+	PeerGetPairingPayload(ctx context.Context, in *pb.RpcPeerGetPairingPayloadRequest, opts ...grpc.CallOption) (*pb.RpcPeerGetPairingPayloadResponse, error)
 	LogSend(ctx context.Context, in *pb.RpcLogSendRequest, opts ...grpc.CallOption) (*pb.RpcLogSendResponse, error)
 	DebugTree(ctx context.Context, in *pb.RpcDebugTreeRequest, opts ...grpc.CallOption) (*pb.RpcDebugTreeResponse, error)
 	DebugTreeHeads(ctx context.Context, in *pb.RpcDebugTreeHeadsRequest, opts ...grpc.CallOption) (*pb.RpcDebugTreeHeadsResponse, error)
 	DebugSpaceSummary(ctx context.Context, in *pb.RpcDebugSpaceSummaryRequest, opts ...grpc.CallOption) (*pb.RpcDebugSpaceSummaryResponse, error)
+	DebugSpaceSyncReport(ctx context.Context, in *pb.RpcDebugSpaceSyncReportRequest, opts ...grpc.CallOption) (*pb.RpcDebugSpaceSyncReportResponse, error)
 	DebugExportLocalstore(ctx context.Context, in *pb.RpcDebugExportLocalstoreRequest, opts ...grpc.CallOption) (*pb.RpcDebugExportLocalstoreResponse, error)
 	DebugPing(ctx context.Context, in *pb.RpcDebugPingRequest, opts ...grpc.CallOption) (*pb.RpcDebugPingResponse, error)
 	DebugSubscriptions(ctx context.Context, in *pb.RpcDebugSubscriptionsRequest, opts ...grpc.CallOption) (*pb.RpcDebugSubscriptionsResponse, error)
@@ -746,6 +818,51 @@ func (c *clientCommandsClient) AccountRecoverFromLegacyExport(ctx context.Contex
 	return out, nil
 }
 
+func (c *clientCommandsClient) AccountBackup(ctx context.Context, in *pb.RpcAccountBackupRequest, opts ...grpc.CallOption) (*pb.RpcAccountBackupResponse, error) {
+	out := new(pb.RpcAccountBackupResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/AccountBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) AccountBackupSetSchedule(ctx context.Context, in *pb.RpcAccountBackupSetScheduleRequest, opts ...grpc.CallOption) (*pb.RpcAccountBackupSetScheduleResponse, error) {
+	out := new(pb.RpcAccountBackupSetScheduleResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/AccountBackupSetSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) AccountBackupGetStatus(ctx context.Context, in *pb.RpcAccountBackupGetStatusRequest, opts ...grpc.CallOption) (*pb.RpcAccountBackupGetStatusResponse, error) {
+	out := new(pb.RpcAccountBackupGetStatusResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/AccountBackupGetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) AccountVerifyBackup(ctx context.Context, in *pb.RpcAccountVerifyBackupRequest, opts ...grpc.CallOption) (*pb.RpcAccountVerifyBackupResponse, error) {
+	out := new(pb.RpcAccountVerifyBackupResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/AccountVerifyBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) AccountRecoverFromBackup(ctx context.Context, in *pb.RpcAccountRecoverFromBackupRequest, opts ...grpc.CallOption) (*pb.RpcAccountRecoverFromBackupResponse, error) {
+	out := new(pb.RpcAccountRecoverFromBackupResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/AccountRecoverFromBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectOpen(ctx context.Context, in *pb.RpcObjectOpenRequest, opts ...grpc.CallOption) (*pb.RpcObjectOpenResponse, error) {
 	out := new(pb.RpcObjectOpenResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectOpen", in, out, opts...)
@@ -827,6 +944,69 @@ func (c *clientCommandsClient) ObjectSearchSubscribe(ctx context.Context, in *pb
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectQueryParse(ctx context.Context, in *pb.RpcObjectQueryParseRequest, opts ...grpc.CallOption) (*pb.RpcObjectQueryParseResponse, error) {
+	out := new(pb.RpcObjectQueryParseResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectQueryParse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectCalendarSubscribe(ctx context.Context, in *pb.RpcObjectCalendarSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectCalendarSubscribeResponse, error) {
+	out := new(pb.RpcObjectCalendarSubscribeResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectCalendarSubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectCalendarMove(ctx context.Context, in *pb.RpcObjectCalendarMoveRequest, opts ...grpc.CallOption) (*pb.RpcObjectCalendarMoveResponse, error) {
+	out := new(pb.RpcObjectCalendarMoveResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectCalendarMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectTimelineSubscribe(ctx context.Context, in *pb.RpcObjectTimelineSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectTimelineSubscribeResponse, error) {
+	out := new(pb.RpcObjectTimelineSubscribeResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectTimelineSubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectTimelineMove(ctx context.Context, in *pb.RpcObjectTimelineMoveRequest, opts ...grpc.CallOption) (*pb.RpcObjectTimelineMoveResponse, error) {
+	out := new(pb.RpcObjectTimelineMoveResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectTimelineMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectBulkEdit(ctx context.Context, in *pb.RpcObjectBulkEditRequest, opts ...grpc.CallOption) (*pb.RpcObjectBulkEditResponse, error) {
+	out := new(pb.RpcObjectBulkEditResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectBulkEdit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectBulkEditUndo(ctx context.Context, in *pb.RpcObjectBulkEditUndoRequest, opts ...grpc.CallOption) (*pb.RpcObjectBulkEditUndoResponse, error) {
+	out := new(pb.RpcObjectBulkEditUndoResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectBulkEditUndo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectSubscribeIds(ctx context.Context, in *pb.RpcObjectSubscribeIdsRequest, opts ...grpc.CallOption) (*pb.RpcObjectSubscribeIdsResponse, error) {
 	out := new(pb.RpcObjectSubscribeIdsResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectSubscribeIds", in, out, opts...)
@@ -1007,6 +1187,33 @@ func (c *clientCommandsClient) ObjectShareByLink(ctx context.Context, in *pb.Rpc
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectShareRevoke(ctx context.Context, in *pb.RpcObjectShareRevokeRequest, opts ...grpc.CallOption) (*pb.RpcObjectShareRevokeResponse, error) {
+	out := new(pb.RpcObjectShareRevokeResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectShareRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectShareList(ctx context.Context, in *pb.RpcObjectShareListRequest, opts ...grpc.CallOption) (*pb.RpcObjectShareListResponse, error) {
+	out := new(pb.RpcObjectShareListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectShareList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectShareImport(ctx context.Context, in *pb.RpcObjectShareImportRequest, opts ...grpc.CallOption) (*pb.RpcObjectShareImportResponse, error) {
+	out := new(pb.RpcObjectShareImportResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectShareImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectUndo(ctx context.Context, in *pb.RpcObjectUndoRequest, opts ...grpc.CallOption) (*pb.RpcObjectUndoResponse, error) {
 	out := new(pb.RpcObjectUndoResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectUndo", in, out, opts...)
@@ -1115,6 +1322,24 @@ func (c *clientCommandsClient) ObjectCollectionSort(ctx context.Context, in *pb.
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectBacklinksList(ctx context.Context, in *pb.RpcObjectBacklinksListRequest, opts ...grpc.CallOption) (*pb.RpcObjectBacklinksListResponse, error) {
+	out := new(pb.RpcObjectBacklinksListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectBacklinksList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectBacklinksLinkMention(ctx context.Context, in *pb.RpcObjectBacklinksLinkMentionRequest, opts ...grpc.CallOption) (*pb.RpcObjectBacklinksLinkMentionResponse, error) {
+	out := new(pb.RpcObjectBacklinksLinkMentionResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectBacklinksLinkMention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectCreateRelation(ctx context.Context, in *pb.RpcObjectCreateRelationRequest, opts ...grpc.CallOption) (*pb.RpcObjectCreateRelationResponse, error) {
 	out := new(pb.RpcObjectCreateRelationResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectCreateRelation", in, out, opts...)
@@ -1151,6 +1376,15 @@ func (c *clientCommandsClient) RelationOptions(ctx context.Context, in *pb.RpcRe
 	return out, nil
 }
 
+func (c *clientCommandsClient) RelationListViolations(ctx context.Context, in *pb.RpcRelationListViolationsRequest, opts ...grpc.CallOption) (*pb.RpcRelationListViolationsResponse, error) {
+	out := new(pb.RpcRelationListViolationsResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/RelationListViolations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectRelationAdd(ctx context.Context, in *pb.RpcObjectRelationAddRequest, opts ...grpc.CallOption) (*pb.RpcObjectRelationAddResponse, error) {
 	out := new(pb.RpcObjectRelationAddResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectRelationAdd", in, out, opts...)
@@ -1259,6 +1493,24 @@ func (c *clientCommandsClient) HistorySetVersion(ctx context.Context, in *pb.Rpc
 	return out, nil
 }
 
+func (c *clientCommandsClient) HistoryRelationHistory(ctx context.Context, in *pb.RpcHistoryRelationHistoryRequest, opts ...grpc.CallOption) (*pb.RpcHistoryRelationHistoryResponse, error) {
+	out := new(pb.RpcHistoryRelationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/HistoryRelationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) HistoryRelationChanges(ctx context.Context, in *pb.RpcHistoryRelationChangesRequest, opts ...grpc.CallOption) (*pb.RpcHistoryRelationChangesResponse, error) {
+	out := new(pb.RpcHistoryRelationChangesResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/HistoryRelationChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) FileOffload(ctx context.Context, in *pb.RpcFileOffloadRequest, opts ...grpc.CallOption) (*pb.RpcFileOffloadResponse, error) {
 	out := new(pb.RpcFileOffloadResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileOffload", in, out, opts...)
@@ -1313,6 +1565,60 @@ func (c *clientCommandsClient) FileSpaceUsage(ctx context.Context, in *pb.RpcFil
 	return out, nil
 }
 
+func (c *clientCommandsClient) FileSetBandwidth(ctx context.Context, in *pb.RpcFileSetBandwidthRequest, opts ...grpc.CallOption) (*pb.RpcFileSetBandwidthResponse, error) {
+	out := new(pb.RpcFileSetBandwidthResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileSetBandwidth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) FileGetBandwidth(ctx context.Context, in *pb.RpcFileGetBandwidthRequest, opts ...grpc.CallOption) (*pb.RpcFileGetBandwidthResponse, error) {
+	out := new(pb.RpcFileGetBandwidthResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileGetBandwidth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) FilePin(ctx context.Context, in *pb.RpcFilePinRequest, opts ...grpc.CallOption) (*pb.RpcFilePinResponse, error) {
+	out := new(pb.RpcFilePinResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FilePin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) FileUnpin(ctx context.Context, in *pb.RpcFileUnpinRequest, opts ...grpc.CallOption) (*pb.RpcFileUnpinResponse, error) {
+	out := new(pb.RpcFileUnpinResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileUnpin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) FileListPinned(ctx context.Context, in *pb.RpcFileListPinnedRequest, opts ...grpc.CallOption) (*pb.RpcFileListPinnedResponse, error) {
+	out := new(pb.RpcFileListPinnedResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileListPinned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) FileSetCacheLimit(ctx context.Context, in *pb.RpcFileSetCacheLimitRequest, opts ...grpc.CallOption) (*pb.RpcFileSetCacheLimitResponse, error) {
+	out := new(pb.RpcFileSetCacheLimitResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileSetCacheLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) NavigationListObjects(ctx context.Context, in *pb.RpcNavigationListObjectsRequest, opts ...grpc.CallOption) (*pb.RpcNavigationListObjectsResponse, error) {
 	out := new(pb.RpcNavigationListObjectsResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/NavigationListObjects", in, out, opts...)
@@ -2060,6 +2366,24 @@ func (c *clientCommandsClient) BlockTableSort(ctx context.Context, in *pb.RpcBlo
 	return out, nil
 }
 
+func (c *clientCommandsClient) BlockTableColumnSetType(ctx context.Context, in *pb.RpcBlockTableColumnSetTypeRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableColumnSetTypeResponse, error) {
+	out := new(pb.RpcBlockTableColumnSetTypeResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BlockTableColumnSetType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) BlockTableCellSetFormula(ctx context.Context, in *pb.RpcBlockTableCellSetFormulaRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableCellSetFormulaResponse, error) {
+	out := new(pb.RpcBlockTableCellSetFormulaResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BlockTableCellSetFormula", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) BlockCreateWidget(ctx context.Context, in *pb.RpcBlockCreateWidgetRequest, opts ...grpc.CallOption) (*pb.RpcBlockCreateWidgetResponse, error) {
 	out := new(pb.RpcBlockCreateWidgetResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BlockCreateWidget", in, out, opts...)
@@ -2177,6 +2501,15 @@ func (c *clientCommandsClient) BlockLatexSetText(ctx context.Context, in *pb.Rpc
 	return out, nil
 }
 
+func (c *clientCommandsClient) BlockLatexSetLabel(ctx context.Context, in *pb.RpcBlockLatexSetLabelRequest, opts ...grpc.CallOption) (*pb.RpcBlockLatexSetLabelResponse, error) {
+	out := new(pb.RpcBlockLatexSetLabelResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BlockLatexSetLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ProcessCancel(ctx context.Context, in *pb.RpcProcessCancelRequest, opts ...grpc.CallOption) (*pb.RpcProcessCancelResponse, error) {
 	out := new(pb.RpcProcessCancelResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ProcessCancel", in, out, opts...)
@@ -2186,6 +2519,42 @@ func (c *clientCommandsClient) ProcessCancel(ctx context.Context, in *pb.RpcProc
 	return out, nil
 }
 
+func (c *clientCommandsClient) PeerAdd(ctx context.Context, in *pb.RpcPeerAddRequest, opts ...grpc.CallOption) (*pb.RpcPeerAddResponse, error) {
+	out := new(pb.RpcPeerAddResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/PeerAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) PeerRemove(ctx context.Context, in *pb.RpcPeerRemoveRequest, opts ...grpc.CallOption) (*pb.RpcPeerRemoveResponse, error) {
+	out := new(pb.RpcPeerRemoveResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/PeerRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) PeerList(ctx context.Context, in *pb.RpcPeerListRequest, opts ...grpc.CallOption) (*pb.RpcPeerListResponse, error) {
+	out := new(pb.RpcPeerListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/PeerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) PeerGetPairingPayload(ctx context.Context, in *pb.RpcPeerGetPairingPayloadRequest, opts ...grpc.CallOption) (*pb.RpcPeerGetPairingPayloadResponse, error) {
+	out := new(pb.RpcPeerGetPairingPayloadResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/PeerGetPairingPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) LogSend(ctx context.Context, in *pb.RpcLogSendRequest, opts ...grpc.CallOption) (*pb.RpcLogSendResponse, error) {
 	out := new(pb.RpcLogSendResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/LogSend", in, out, opts...)
@@ -2222,6 +2591,15 @@ func (c *clientCommandsClient) DebugSpaceSummary(ctx context.Context, in *pb.Rpc
 	return out, nil
 }
 
+func (c *clientCommandsClient) DebugSpaceSyncReport(ctx context.Context, in *pb.RpcDebugSpaceSyncReportRequest, opts ...grpc.CallOption) (*pb.RpcDebugSpaceSyncReportResponse, error) {
+	out := new(pb.RpcDebugSpaceSyncReportResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/DebugSpaceSyncReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) DebugExportLocalstore(ctx context.Context, in *pb.RpcDebugExportLocalstoreRequest, opts ...grpc.CallOption) (*pb.RpcDebugExportLocalstoreResponse, error) {
 	out := new(pb.RpcDebugExportLocalstoreResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/DebugExportLocalstore", in, out, opts...)
@@ -2332,6 +2710,11 @@ type ClientCommandsServer interface {
 	AccountMove(context.Context, *pb.RpcAccountMoveRequest) *pb.RpcAccountMoveResponse
 	AccountConfigUpdate(context.Context, *pb.RpcAccountConfigUpdateRequest) *pb.RpcAccountConfigUpdateResponse
 	AccountRecoverFromLegacyExport(context.Context, *pb.RpcAccountRecoverFromLegacyExportRequest) *pb.RpcAccountRecoverFromLegacyExportResponse
+	AccountBackup(context.Context, *pb.RpcAccountBackupRequest) *pb.RpcAccountBackupResponse
+	AccountBackupSetSchedule(context.Context, *pb.RpcAccountBackupSetScheduleRequest) *pb.RpcAccountBackupSetScheduleResponse
+	AccountBackupGetStatus(context.Context, *pb.RpcAccountBackupGetStatusRequest) *pb.RpcAccountBackupGetStatusResponse
+	AccountVerifyBackup(context.Context, *pb.RpcAccountVerifyBackupRequest) *pb.RpcAccountVerifyBackupResponse
+	AccountRecoverFromBackup(context.Context, *pb.RpcAccountRecoverFromBackupRequest) *pb.RpcAccountRecoverFromBackupResponse
 	// Object
 	// ***
 	ObjectOpen(context.Context, *pb.RpcObjectOpenRequest) *pb.RpcObjectOpenResponse
@@ -2345,6 +2728,13 @@ type ClientCommandsServer interface {
 	ObjectGraph(context.Context, *pb.RpcObjectGraphRequest) *pb.RpcObjectGraphResponse
 	ObjectSearch(context.Context, *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse
 	ObjectSearchSubscribe(context.Context, *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse
+	ObjectQueryParse(context.Context, *pb.RpcObjectQueryParseRequest) *pb.RpcObjectQueryParseResponse
+	ObjectCalendarSubscribe(context.Context, *pb.RpcObjectCalendarSubscribeRequest) *pb.RpcObjectCalendarSubscribeResponse
+	ObjectCalendarMove(context.Context, *pb.RpcObjectCalendarMoveRequest) *pb.RpcObjectCalendarMoveResponse
+	ObjectTimelineSubscribe(context.Context, *pb.RpcObjectTimelineSubscribeRequest) *pb.RpcObjectTimelineSubscribeResponse
+	ObjectTimelineMove(context.Context, *pb.RpcObjectTimelineMoveRequest) *pb.RpcObjectTimelineMoveResponse
+	ObjectBulkEdit(context.Context, *pb.RpcObjectBulkEditRequest) *pb.RpcObjectBulkEditResponse
+	ObjectBulkEditUndo(context.Context, *pb.RpcObjectBulkEditUndoRequest) *pb.RpcObjectBulkEditUndoResponse
 	ObjectSubscribeIds(context.Context, *pb.RpcObjectSubscribeIdsRequest) *pb.RpcObjectSubscribeIdsResponse
 	ObjectGroupsSubscribe(context.Context, *pb.RpcObjectGroupsSubscribeRequest) *pb.RpcObjectGroupsSubscribeResponse
 	ObjectSearchUnsubscribe(context.Context, *pb.RpcObjectSearchUnsubscribeRequest) *pb.RpcObjectSearchUnsubscribeResponse
@@ -2367,6 +2757,9 @@ type ClientCommandsServer interface {
 	ObjectToSet(context.Context, *pb.RpcObjectToSetRequest) *pb.RpcObjectToSetResponse
 	ObjectToCollection(context.Context, *pb.RpcObjectToCollectionRequest) *pb.RpcObjectToCollectionResponse
 	ObjectShareByLink(context.Context, *pb.RpcObjectShareByLinkRequest) *pb.RpcObjectShareByLinkResponse
+	ObjectShareRevoke(context.Context, *pb.RpcObjectShareRevokeRequest) *pb.RpcObjectShareRevokeResponse
+	ObjectShareList(context.Context, *pb.RpcObjectShareListRequest) *pb.RpcObjectShareListResponse
+	ObjectShareImport(context.Context, *pb.RpcObjectShareImportRequest) *pb.RpcObjectShareImportResponse
 	ObjectUndo(context.Context, *pb.RpcObjectUndoRequest) *pb.RpcObjectUndoResponse
 	ObjectRedo(context.Context, *pb.RpcObjectRedoRequest) *pb.RpcObjectRedoResponse
 	ObjectListExport(context.Context, *pb.RpcObjectListExportRequest) *pb.RpcObjectListExportResponse
@@ -2381,12 +2774,17 @@ type ClientCommandsServer interface {
 	ObjectCollectionAdd(context.Context, *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse
 	ObjectCollectionRemove(context.Context, *pb.RpcObjectCollectionRemoveRequest) *pb.RpcObjectCollectionRemoveResponse
 	ObjectCollectionSort(context.Context, *pb.RpcObjectCollectionSortRequest) *pb.RpcObjectCollectionSortResponse
+	// Backlinks
+	// ***
+	ObjectBacklinksList(context.Context, *pb.RpcObjectBacklinksListRequest) *pb.RpcObjectBacklinksListResponse
+	ObjectBacklinksLinkMention(context.Context, *pb.RpcObjectBacklinksLinkMentionRequest) *pb.RpcObjectBacklinksLinkMentionResponse
 	// Relations
 	// ***
 	ObjectCreateRelation(context.Context, *pb.RpcObjectCreateRelationRequest) *pb.RpcObjectCreateRelationResponse
 	ObjectCreateRelationOption(context.Context, *pb.RpcObjectCreateRelationOptionRequest) *pb.RpcObjectCreateRelationOptionResponse
 	RelationListRemoveOption(context.Context, *pb.RpcRelationListRemoveOptionRequest) *pb.RpcRelationListRemoveOptionResponse
 	RelationOptions(context.Context, *pb.RpcRelationOptionsRequest) *pb.RpcRelationOptionsResponse
+	RelationListViolations(context.Context, *pb.RpcRelationListViolationsRequest) *pb.RpcRelationListViolationsResponse
 	// Object Relations
 	// ***
 	ObjectRelationAdd(context.Context, *pb.RpcObjectRelationAddRequest) *pb.RpcObjectRelationAddResponse
@@ -2403,6 +2801,8 @@ type ClientCommandsServer interface {
 	HistoryShowVersion(context.Context, *pb.RpcHistoryShowVersionRequest) *pb.RpcHistoryShowVersionResponse
 	HistoryGetVersions(context.Context, *pb.RpcHistoryGetVersionsRequest) *pb.RpcHistoryGetVersionsResponse
 	HistorySetVersion(context.Context, *pb.RpcHistorySetVersionRequest) *pb.RpcHistorySetVersionResponse
+	HistoryRelationHistory(context.Context, *pb.RpcHistoryRelationHistoryRequest) *pb.RpcHistoryRelationHistoryResponse
+	HistoryRelationChanges(context.Context, *pb.RpcHistoryRelationChangesRequest) *pb.RpcHistoryRelationChangesResponse
 	// Files
 	// ***
 	FileOffload(context.Context, *pb.RpcFileOffloadRequest) *pb.RpcFileOffloadResponse
@@ -2411,6 +2811,12 @@ type ClientCommandsServer interface {
 	FileDownload(context.Context, *pb.RpcFileDownloadRequest) *pb.RpcFileDownloadResponse
 	FileDrop(context.Context, *pb.RpcFileDropRequest) *pb.RpcFileDropResponse
 	FileSpaceUsage(context.Context, *pb.RpcFileSpaceUsageRequest) *pb.RpcFileSpaceUsageResponse
+	FileSetBandwidth(context.Context, *pb.RpcFileSetBandwidthRequest) *pb.RpcFileSetBandwidthResponse
+	FileGetBandwidth(context.Context, *pb.RpcFileGetBandwidthRequest) *pb.RpcFileGetBandwidthResponse
+	FilePin(context.Context, *pb.RpcFilePinRequest) *pb.RpcFilePinResponse
+	FileUnpin(context.Context, *pb.RpcFileUnpinRequest) *pb.RpcFileUnpinResponse
+	FileListPinned(context.Context, *pb.RpcFileListPinnedRequest) *pb.RpcFileListPinnedResponse
+	FileSetCacheLimit(context.Context, *pb.RpcFileSetCacheLimitRequest) *pb.RpcFileSetCacheLimitResponse
 	NavigationListObjects(context.Context, *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse
 	NavigationGetObjectInfoWithLinks(context.Context, *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse
 	TemplateCreateFromObject(context.Context, *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse
@@ -2506,6 +2912,8 @@ type ClientCommandsServer interface {
 	BlockTableRowListClean(context.Context, *pb.RpcBlockTableRowListCleanRequest) *pb.RpcBlockTableRowListCleanResponse
 	BlockTableColumnListFill(context.Context, *pb.RpcBlockTableColumnListFillRequest) *pb.RpcBlockTableColumnListFillResponse
 	BlockTableSort(context.Context, *pb.RpcBlockTableSortRequest) *pb.RpcBlockTableSortResponse
+	BlockTableColumnSetType(context.Context, *pb.RpcBlockTableColumnSetTypeRequest) *pb.RpcBlockTableColumnSetTypeResponse
+	BlockTableCellSetFormula(context.Context, *pb.RpcBlockTableCellSetFormulaRequest) *pb.RpcBlockTableCellSetFormulaResponse
 	// Widget commands
 	// ***
 	BlockCreateWidget(context.Context, *pb.RpcBlockCreateWidgetRequest) *pb.RpcBlockCreateWidgetResponse
@@ -2523,11 +2931,17 @@ type ClientCommandsServer interface {
 	BlockRelationAdd(context.Context, *pb.RpcBlockRelationAddRequest) *pb.RpcBlockRelationAddResponse
 	BlockDivListSetStyle(context.Context, *pb.RpcBlockDivListSetStyleRequest) *pb.RpcBlockDivListSetStyleResponse
 	BlockLatexSetText(context.Context, *pb.RpcBlockLatexSetTextRequest) *pb.RpcBlockLatexSetTextResponse
+	BlockLatexSetLabel(context.Context, *pb.RpcBlockLatexSetLabelRequest) *pb.RpcBlockLatexSetLabelResponse
 	ProcessCancel(context.Context, *pb.RpcProcessCancelRequest) *pb.RpcProcessCancelResponse
+	PeerAdd(context.Context, *pb.RpcPeerAddRequest) *pb.RpcPeerAddResponse
+	PeerRemove(context.Context, *pb.RpcPeerRemoveRequest) *pb.RpcPeerRemoveResponse
+	PeerList(context.Context, *pb.RpcPeerListRequest) *pb.RpcPeerListResponse
+	PeerGetPairingPayload(context.Context, *pb.RpcPeerGetPairingPayloadRequest) *pb.RpcPeerGetPairingPayloadResponse
 	LogSend(context.Context, *pb.RpcLogSendRequest) *pb.RpcLogSendResponse
 	DebugTree(context.Context, *pb.RpcDebugTreeRequest) *pb.RpcDebugTreeResponse
 	DebugTreeHeads(context.Context, *pb.RpcDebugTreeHeadsRequest) *pb.RpcDebugTreeHeadsResponse
 	DebugSpaceSummary(context.Context, *pb.RpcDebugSpaceSummaryRequest) *pb.RpcDebugSpaceSummaryResponse
+	DebugSpaceSyncReport(context.Context, *pb.RpcDebugSpaceSyncReportRequest) *pb.RpcDebugSpaceSyncReportResponse
 	DebugExportLocalstore(context.Context, *pb.RpcDebugExportLocalstoreRequest) *pb.RpcDebugExportLocalstoreResponse
 	DebugPing(context.Context, *pb.RpcDebugPingRequest) *pb.RpcDebugPingResponse
 	DebugSubscriptions(context.Context, *pb.RpcDebugSubscriptionsRequest) *pb.RpcDebugSubscriptionsResponse
@@ -2616,6 +3030,21 @@ func (*UnimplementedClientCommandsServer) AccountConfigUpdate(ctx context.Contex
 func (*UnimplementedClientCommandsServer) AccountRecoverFromLegacyExport(ctx context.Context, req *pb.RpcAccountRecoverFromLegacyExportRequest) *pb.RpcAccountRecoverFromLegacyExportResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) AccountBackup(ctx context.Context, req *pb.RpcAccountBackupRequest) *pb.RpcAccountBackupResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) AccountBackupSetSchedule(ctx context.Context, req *pb.RpcAccountBackupSetScheduleRequest) *pb.RpcAccountBackupSetScheduleResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) AccountBackupGetStatus(ctx context.Context, req *pb.RpcAccountBackupGetStatusRequest) *pb.RpcAccountBackupGetStatusResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) AccountVerifyBackup(ctx context.Context, req *pb.RpcAccountVerifyBackupRequest) *pb.RpcAccountVerifyBackupResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) AccountRecoverFromBackup(ctx context.Context, req *pb.RpcAccountRecoverFromBackupRequest) *pb.RpcAccountRecoverFromBackupResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectOpen(ctx context.Context, req *pb.RpcObjectOpenRequest) *pb.RpcObjectOpenResponse {
 	return nil
 }
@@ -2643,6 +3072,27 @@ func (*UnimplementedClientCommandsServer) ObjectSearch(ctx context.Context, req
 func (*UnimplementedClientCommandsServer) ObjectSearchSubscribe(ctx context.Context, req *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectQueryParse(ctx context.Context, req *pb.RpcObjectQueryParseRequest) *pb.RpcObjectQueryParseResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectCalendarSubscribe(ctx context.Context, req *pb.RpcObjectCalendarSubscribeRequest) *pb.RpcObjectCalendarSubscribeResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectCalendarMove(ctx context.Context, req *pb.RpcObjectCalendarMoveRequest) *pb.RpcObjectCalendarMoveResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectTimelineSubscribe(ctx context.Context, req *pb.RpcObjectTimelineSubscribeRequest) *pb.RpcObjectTimelineSubscribeResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectTimelineMove(ctx context.Context, req *pb.RpcObjectTimelineMoveRequest) *pb.RpcObjectTimelineMoveResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectBulkEdit(ctx context.Context, req *pb.RpcObjectBulkEditRequest) *pb.RpcObjectBulkEditResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectBulkEditUndo(ctx context.Context, req *pb.RpcObjectBulkEditUndoRequest) *pb.RpcObjectBulkEditUndoResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectSubscribeIds(ctx context.Context, req *pb.RpcObjectSubscribeIdsRequest) *pb.RpcObjectSubscribeIdsResponse {
 	return nil
 }
@@ -2703,6 +3153,15 @@ func (*UnimplementedClientCommandsServer) ObjectToCollection(ctx context.Context
 func (*UnimplementedClientCommandsServer) ObjectShareByLink(ctx context.Context, req *pb.RpcObjectShareByLinkRequest) *pb.RpcObjectShareByLinkResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectShareRevoke(ctx context.Context, req *pb.RpcObjectShareRevokeRequest) *pb.RpcObjectShareRevokeResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectShareList(ctx context.Context, req *pb.RpcObjectShareListRequest) *pb.RpcObjectShareListResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectShareImport(ctx context.Context, req *pb.RpcObjectShareImportRequest) *pb.RpcObjectShareImportResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectUndo(ctx context.Context, req *pb.RpcObjectUndoRequest) *pb.RpcObjectUndoResponse {
 	return nil
 }
@@ -2739,6 +3198,12 @@ func (*UnimplementedClientCommandsServer) ObjectCollectionRemove(ctx context.Con
 func (*UnimplementedClientCommandsServer) ObjectCollectionSort(ctx context.Context, req *pb.RpcObjectCollectionSortRequest) *pb.RpcObjectCollectionSortResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectBacklinksList(ctx context.Context, req *pb.RpcObjectBacklinksListRequest) *pb.RpcObjectBacklinksListResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectBacklinksLinkMention(ctx context.Context, req *pb.RpcObjectBacklinksLinkMentionRequest) *pb.RpcObjectBacklinksLinkMentionResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectCreateRelation(ctx context.Context, req *pb.RpcObjectCreateRelationRequest) *pb.RpcObjectCreateRelationResponse {
 	return nil
 }
@@ -2751,6 +3216,9 @@ func (*UnimplementedClientCommandsServer) RelationListRemoveOption(ctx context.C
 func (*UnimplementedClientCommandsServer) RelationOptions(ctx context.Context, req *pb.RpcRelationOptionsRequest) *pb.RpcRelationOptionsResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) RelationListViolations(ctx context.Context, req *pb.RpcRelationListViolationsRequest) *pb.RpcRelationListViolationsResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectRelationAdd(ctx context.Context, req *pb.RpcObjectRelationAddRequest) *pb.RpcObjectRelationAddResponse {
 	return nil
 }
@@ -2787,6 +3255,12 @@ func (*UnimplementedClientCommandsServer) HistoryGetVersions(ctx context.Context
 func (*UnimplementedClientCommandsServer) HistorySetVersion(ctx context.Context, req *pb.RpcHistorySetVersionRequest) *pb.RpcHistorySetVersionResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) HistoryRelationHistory(ctx context.Context, req *pb.RpcHistoryRelationHistoryRequest) *pb.RpcHistoryRelationHistoryResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) HistoryRelationChanges(ctx context.Context, req *pb.RpcHistoryRelationChangesRequest) *pb.RpcHistoryRelationChangesResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) FileOffload(ctx context.Context, req *pb.RpcFileOffloadRequest) *pb.RpcFileOffloadResponse {
 	return nil
 }
@@ -2805,28 +3279,46 @@ func (*UnimplementedClientCommandsServer) FileDrop(ctx context.Context, req *pb.
 func (*UnimplementedClientCommandsServer) FileSpaceUsage(ctx context.Context, req *pb.RpcFileSpaceUsageRequest) *pb.RpcFileSpaceUsageResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) NavigationListObjects(ctx context.Context, req *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse {
+func (*UnimplementedClientCommandsServer) FileSetBandwidth(ctx context.Context, req *pb.RpcFileSetBandwidthRequest) *pb.RpcFileSetBandwidthResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) NavigationGetObjectInfoWithLinks(ctx context.Context, req *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse {
+func (*UnimplementedClientCommandsServer) FileGetBandwidth(ctx context.Context, req *pb.RpcFileGetBandwidthRequest) *pb.RpcFileGetBandwidthResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) TemplateCreateFromObject(ctx context.Context, req *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse {
+func (*UnimplementedClientCommandsServer) FilePin(ctx context.Context, req *pb.RpcFilePinRequest) *pb.RpcFilePinResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) TemplateCreateFromObjectType(ctx context.Context, req *pb.RpcTemplateCreateFromObjectTypeRequest) *pb.RpcTemplateCreateFromObjectTypeResponse {
+func (*UnimplementedClientCommandsServer) FileUnpin(ctx context.Context, req *pb.RpcFileUnpinRequest) *pb.RpcFileUnpinResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) TemplateClone(ctx context.Context, req *pb.RpcTemplateCloneRequest) *pb.RpcTemplateCloneResponse {
+func (*UnimplementedClientCommandsServer) FileListPinned(ctx context.Context, req *pb.RpcFileListPinnedRequest) *pb.RpcFileListPinnedResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) TemplateExportAll(ctx context.Context, req *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse {
+func (*UnimplementedClientCommandsServer) FileSetCacheLimit(ctx context.Context, req *pb.RpcFileSetCacheLimitRequest) *pb.RpcFileSetCacheLimitResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) LinkPreview(ctx context.Context, req *pb.RpcLinkPreviewRequest) *pb.RpcLinkPreviewResponse {
+func (*UnimplementedClientCommandsServer) NavigationListObjects(ctx context.Context, req *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) UnsplashSearch(ctx context.Context, req *pb.RpcUnsplashSearchRequest) *pb.RpcUnsplashSearchResponse {
+func (*UnimplementedClientCommandsServer) NavigationGetObjectInfoWithLinks(ctx context.Context, req *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) TemplateCreateFromObject(ctx context.Context, req *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) TemplateCreateFromObjectType(ctx context.Context, req *pb.RpcTemplateCreateFromObjectTypeRequest) *pb.RpcTemplateCreateFromObjectTypeResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) TemplateClone(ctx context.Context, req *pb.RpcTemplateCloneRequest) *pb.RpcTemplateCloneResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) TemplateExportAll(ctx context.Context, req *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) LinkPreview(ctx context.Context, req *pb.RpcLinkPreviewRequest) *pb.RpcLinkPreviewResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) UnsplashSearch(ctx context.Context, req *pb.RpcUnsplashSearchRequest) *pb.RpcUnsplashSearchResponse {
 	return nil
 }
 func (*UnimplementedClientCommandsServer) UnsplashDownload(ctx context.Context, req *pb.RpcUnsplashDownloadRequest) *pb.RpcUnsplashDownloadResponse {
@@ -3054,6 +3546,12 @@ func (*UnimplementedClientCommandsServer) BlockTableColumnListFill(ctx context.C
 func (*UnimplementedClientCommandsServer) BlockTableSort(ctx context.Context, req *pb.RpcBlockTableSortRequest) *pb.RpcBlockTableSortResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) BlockTableColumnSetType(ctx context.Context, req *pb.RpcBlockTableColumnSetTypeRequest) *pb.RpcBlockTableColumnSetTypeResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) BlockTableCellSetFormula(ctx context.Context, req *pb.RpcBlockTableCellSetFormulaRequest) *pb.RpcBlockTableCellSetFormulaResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) BlockCreateWidget(ctx context.Context, req *pb.RpcBlockCreateWidgetRequest) *pb.RpcBlockCreateWidgetResponse {
 	return nil
 }
@@ -3093,9 +3591,24 @@ func (*UnimplementedClientCommandsServer) BlockDivListSetStyle(ctx context.Conte
 func (*UnimplementedClientCommandsServer) BlockLatexSetText(ctx context.Context, req *pb.RpcBlockLatexSetTextRequest) *pb.RpcBlockLatexSetTextResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) BlockLatexSetLabel(ctx context.Context, req *pb.RpcBlockLatexSetLabelRequest) *pb.RpcBlockLatexSetLabelResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ProcessCancel(ctx context.Context, req *pb.RpcProcessCancelRequest) *pb.RpcProcessCancelResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) PeerAdd(ctx context.Context, req *pb.RpcPeerAddRequest) *pb.RpcPeerAddResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) PeerRemove(ctx context.Context, req *pb.RpcPeerRemoveRequest) *pb.RpcPeerRemoveResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) PeerList(ctx context.Context, req *pb.RpcPeerListRequest) *pb.RpcPeerListResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) PeerGetPairingPayload(ctx context.Context, req *pb.RpcPeerGetPairingPayloadRequest) *pb.RpcPeerGetPairingPayloadResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) LogSend(ctx context.Context, req *pb.RpcLogSendRequest) *pb.RpcLogSendResponse {
 	return nil
 }
@@ -3108,6 +3621,9 @@ func (*UnimplementedClientCommandsServer) DebugTreeHeads(ctx context.Context, re
 func (*UnimplementedClientCommandsServer) DebugSpaceSummary(ctx context.Context, req *pb.RpcDebugSpaceSummaryRequest) *pb.RpcDebugSpaceSummaryResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) DebugSpaceSyncReport(ctx context.Context, req *pb.RpcDebugSpaceSyncReportRequest) *pb.RpcDebugSpaceSyncReportResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) DebugExportLocalstore(ctx context.Context, req *pb.RpcDebugExportLocalstoreRequest) *pb.RpcDebugExportLocalstoreResponse {
 	return nil
 }
@@ -3581,6 +4097,96 @@ func _ClientCommands_AccountRecoverFromLegacyExport_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_AccountBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcAccountBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).AccountBackup(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/AccountBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).AccountBackup(ctx, req.(*pb.RpcAccountBackupRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_AccountBackupSetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcAccountBackupSetScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).AccountBackupSetSchedule(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/AccountBackupSetSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).AccountBackupSetSchedule(ctx, req.(*pb.RpcAccountBackupSetScheduleRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_AccountBackupGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcAccountBackupGetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).AccountBackupGetStatus(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/AccountBackupGetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).AccountBackupGetStatus(ctx, req.(*pb.RpcAccountBackupGetStatusRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_AccountVerifyBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcAccountVerifyBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).AccountVerifyBackup(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/AccountVerifyBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).AccountVerifyBackup(ctx, req.(*pb.RpcAccountVerifyBackupRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_AccountRecoverFromBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcAccountRecoverFromBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).AccountRecoverFromBackup(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/AccountRecoverFromBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).AccountRecoverFromBackup(ctx, req.(*pb.RpcAccountRecoverFromBackupRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectOpenRequest)
 	if err := dec(in); err != nil {
@@ -3743,6 +4349,132 @@ func _ClientCommands_ObjectSearchSubscribe_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectQueryParse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectQueryParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectQueryParse(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectQueryParse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectQueryParse(ctx, req.(*pb.RpcObjectQueryParseRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectCalendarSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectCalendarSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectCalendarSubscribe(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectCalendarSubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectCalendarSubscribe(ctx, req.(*pb.RpcObjectCalendarSubscribeRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectCalendarMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectCalendarMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectCalendarMove(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectCalendarMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectCalendarMove(ctx, req.(*pb.RpcObjectCalendarMoveRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectTimelineSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectTimelineSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectTimelineSubscribe(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectTimelineSubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectTimelineSubscribe(ctx, req.(*pb.RpcObjectTimelineSubscribeRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectTimelineMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectTimelineMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectTimelineMove(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectTimelineMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectTimelineMove(ctx, req.(*pb.RpcObjectTimelineMoveRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectBulkEdit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectBulkEditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectBulkEdit(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectBulkEdit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectBulkEdit(ctx, req.(*pb.RpcObjectBulkEditRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectBulkEditUndo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectBulkEditUndoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectBulkEditUndo(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectBulkEditUndo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectBulkEditUndo(ctx, req.(*pb.RpcObjectBulkEditUndoRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectSubscribeIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectSubscribeIdsRequest)
 	if err := dec(in); err != nil {
@@ -4103,6 +4835,60 @@ func _ClientCommands_ObjectShareByLink_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectShareRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectShareRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectShareRevoke(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectShareRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectShareRevoke(ctx, req.(*pb.RpcObjectShareRevokeRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectShareList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectShareListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectShareList(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectShareList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectShareList(ctx, req.(*pb.RpcObjectShareListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectShareImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectShareImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectShareImport(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectShareImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectShareImport(ctx, req.(*pb.RpcObjectShareImportRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectUndo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectUndoRequest)
 	if err := dec(in); err != nil {
@@ -4319,6 +5105,42 @@ func _ClientCommands_ObjectCollectionSort_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectBacklinksList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectBacklinksListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectBacklinksList(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectBacklinksList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectBacklinksList(ctx, req.(*pb.RpcObjectBacklinksListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectBacklinksLinkMention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectBacklinksLinkMentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectBacklinksLinkMention(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectBacklinksLinkMention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectBacklinksLinkMention(ctx, req.(*pb.RpcObjectBacklinksLinkMentionRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectCreateRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectCreateRelationRequest)
 	if err := dec(in); err != nil {
@@ -4391,6 +5213,24 @@ func _ClientCommands_RelationOptions_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_RelationListViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcRelationListViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).RelationListViolations(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/RelationListViolations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).RelationListViolations(ctx, req.(*pb.RpcRelationListViolationsRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectRelationAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectRelationAddRequest)
 	if err := dec(in); err != nil {
@@ -4509,208 +5349,352 @@ func _ClientCommands_ObjectTypeRelationList_Handler(srv interface{}, ctx context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/ObjectTypeRelationList",
+		FullMethod: "/anytype.ClientCommands/ObjectTypeRelationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectTypeRelationList(ctx, req.(*pb.RpcObjectTypeRelationListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectTypeRelationAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectTypeRelationAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectTypeRelationAdd(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectTypeRelationAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectTypeRelationAdd(ctx, req.(*pb.RpcObjectTypeRelationAddRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectTypeRelationRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectTypeRelationRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectTypeRelationRemove(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectTypeRelationRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectTypeRelationRemove(ctx, req.(*pb.RpcObjectTypeRelationRemoveRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_HistoryShowVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcHistoryShowVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).HistoryShowVersion(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/HistoryShowVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).HistoryShowVersion(ctx, req.(*pb.RpcHistoryShowVersionRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_HistoryGetVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcHistoryGetVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).HistoryGetVersions(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/HistoryGetVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).HistoryGetVersions(ctx, req.(*pb.RpcHistoryGetVersionsRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_HistorySetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcHistorySetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).HistorySetVersion(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/HistorySetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).HistorySetVersion(ctx, req.(*pb.RpcHistorySetVersionRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_HistoryRelationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcHistoryRelationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).HistoryRelationHistory(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/HistoryRelationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).HistoryRelationHistory(ctx, req.(*pb.RpcHistoryRelationHistoryRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_HistoryRelationChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcHistoryRelationChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).HistoryRelationChanges(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/HistoryRelationChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).HistoryRelationChanges(ctx, req.(*pb.RpcHistoryRelationChangesRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FileOffload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileOffloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileOffload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileOffload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).ObjectTypeRelationList(ctx, req.(*pb.RpcObjectTypeRelationListRequest)), nil
+		return srv.(ClientCommandsServer).FileOffload(ctx, req.(*pb.RpcFileOffloadRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_ObjectTypeRelationAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcObjectTypeRelationAddRequest)
+func _ClientCommands_FileListOffload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileListOffloadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).ObjectTypeRelationAdd(ctx, in), nil
+		return srv.(ClientCommandsServer).FileListOffload(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/ObjectTypeRelationAdd",
+		FullMethod: "/anytype.ClientCommands/FileListOffload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).ObjectTypeRelationAdd(ctx, req.(*pb.RpcObjectTypeRelationAddRequest)), nil
+		return srv.(ClientCommandsServer).FileListOffload(ctx, req.(*pb.RpcFileListOffloadRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_ObjectTypeRelationRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcObjectTypeRelationRemoveRequest)
+func _ClientCommands_FileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).ObjectTypeRelationRemove(ctx, in), nil
+		return srv.(ClientCommandsServer).FileUpload(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/ObjectTypeRelationRemove",
+		FullMethod: "/anytype.ClientCommands/FileUpload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).ObjectTypeRelationRemove(ctx, req.(*pb.RpcObjectTypeRelationRemoveRequest)), nil
+		return srv.(ClientCommandsServer).FileUpload(ctx, req.(*pb.RpcFileUploadRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_HistoryShowVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcHistoryShowVersionRequest)
+func _ClientCommands_FileDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileDownloadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).HistoryShowVersion(ctx, in), nil
+		return srv.(ClientCommandsServer).FileDownload(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/HistoryShowVersion",
+		FullMethod: "/anytype.ClientCommands/FileDownload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).HistoryShowVersion(ctx, req.(*pb.RpcHistoryShowVersionRequest)), nil
+		return srv.(ClientCommandsServer).FileDownload(ctx, req.(*pb.RpcFileDownloadRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_HistoryGetVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcHistoryGetVersionsRequest)
+func _ClientCommands_FileDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileDropRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).HistoryGetVersions(ctx, in), nil
+		return srv.(ClientCommandsServer).FileDrop(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/HistoryGetVersions",
+		FullMethod: "/anytype.ClientCommands/FileDrop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).HistoryGetVersions(ctx, req.(*pb.RpcHistoryGetVersionsRequest)), nil
+		return srv.(ClientCommandsServer).FileDrop(ctx, req.(*pb.RpcFileDropRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_HistorySetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcHistorySetVersionRequest)
+func _ClientCommands_FileSpaceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileSpaceUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).HistorySetVersion(ctx, in), nil
+		return srv.(ClientCommandsServer).FileSpaceUsage(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/HistorySetVersion",
+		FullMethod: "/anytype.ClientCommands/FileSpaceUsage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).HistorySetVersion(ctx, req.(*pb.RpcHistorySetVersionRequest)), nil
+		return srv.(ClientCommandsServer).FileSpaceUsage(ctx, req.(*pb.RpcFileSpaceUsageRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileOffload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileOffloadRequest)
+func _ClientCommands_FileSetBandwidth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileSetBandwidthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileOffload(ctx, in), nil
+		return srv.(ClientCommandsServer).FileSetBandwidth(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileOffload",
+		FullMethod: "/anytype.ClientCommands/FileSetBandwidth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileOffload(ctx, req.(*pb.RpcFileOffloadRequest)), nil
+		return srv.(ClientCommandsServer).FileSetBandwidth(ctx, req.(*pb.RpcFileSetBandwidthRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileListOffload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileListOffloadRequest)
+func _ClientCommands_FileGetBandwidth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileGetBandwidthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileListOffload(ctx, in), nil
+		return srv.(ClientCommandsServer).FileGetBandwidth(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileListOffload",
+		FullMethod: "/anytype.ClientCommands/FileGetBandwidth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileListOffload(ctx, req.(*pb.RpcFileListOffloadRequest)), nil
+		return srv.(ClientCommandsServer).FileGetBandwidth(ctx, req.(*pb.RpcFileGetBandwidthRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileUploadRequest)
+func _ClientCommands_FilePin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFilePinRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileUpload(ctx, in), nil
+		return srv.(ClientCommandsServer).FilePin(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileUpload",
+		FullMethod: "/anytype.ClientCommands/FilePin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileUpload(ctx, req.(*pb.RpcFileUploadRequest)), nil
+		return srv.(ClientCommandsServer).FilePin(ctx, req.(*pb.RpcFilePinRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileDownloadRequest)
+func _ClientCommands_FileUnpin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileUnpinRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileDownload(ctx, in), nil
+		return srv.(ClientCommandsServer).FileUnpin(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileDownload",
+		FullMethod: "/anytype.ClientCommands/FileUnpin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileDownload(ctx, req.(*pb.RpcFileDownloadRequest)), nil
+		return srv.(ClientCommandsServer).FileUnpin(ctx, req.(*pb.RpcFileUnpinRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileDropRequest)
+func _ClientCommands_FileListPinned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileListPinnedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileDrop(ctx, in), nil
+		return srv.(ClientCommandsServer).FileListPinned(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileDrop",
+		FullMethod: "/anytype.ClientCommands/FileListPinned",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileDrop(ctx, req.(*pb.RpcFileDropRequest)), nil
+		return srv.(ClientCommandsServer).FileListPinned(ctx, req.(*pb.RpcFileListPinnedRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_FileSpaceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcFileSpaceUsageRequest)
+func _ClientCommands_FileSetCacheLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileSetCacheLimitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).FileSpaceUsage(ctx, in), nil
+		return srv.(ClientCommandsServer).FileSetCacheLimit(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/FileSpaceUsage",
+		FullMethod: "/anytype.ClientCommands/FileSetCacheLimit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).FileSpaceUsage(ctx, req.(*pb.RpcFileSpaceUsageRequest)), nil
+		return srv.(ClientCommandsServer).FileSetCacheLimit(ctx, req.(*pb.RpcFileSetCacheLimitRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -6209,6 +7193,42 @@ func _ClientCommands_BlockTableSort_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_BlockTableColumnSetType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBlockTableColumnSetTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BlockTableColumnSetType(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BlockTableColumnSetType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BlockTableColumnSetType(ctx, req.(*pb.RpcBlockTableColumnSetTypeRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_BlockTableCellSetFormula_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBlockTableCellSetFormulaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BlockTableCellSetFormula(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BlockTableCellSetFormula",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BlockTableCellSetFormula(ctx, req.(*pb.RpcBlockTableCellSetFormulaRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_BlockCreateWidget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcBlockCreateWidgetRequest)
 	if err := dec(in); err != nil {
@@ -6443,6 +7463,24 @@ func _ClientCommands_BlockLatexSetText_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_BlockLatexSetLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBlockLatexSetLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BlockLatexSetLabel(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BlockLatexSetLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BlockLatexSetLabel(ctx, req.(*pb.RpcBlockLatexSetLabelRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ProcessCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcProcessCancelRequest)
 	if err := dec(in); err != nil {
@@ -6461,6 +7499,78 @@ func _ClientCommands_ProcessCancel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_PeerAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcPeerAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).PeerAdd(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/PeerAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).PeerAdd(ctx, req.(*pb.RpcPeerAddRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_PeerRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcPeerRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).PeerRemove(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/PeerRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).PeerRemove(ctx, req.(*pb.RpcPeerRemoveRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_PeerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcPeerListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).PeerList(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/PeerList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).PeerList(ctx, req.(*pb.RpcPeerListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_PeerGetPairingPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcPeerGetPairingPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).PeerGetPairingPayload(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/PeerGetPairingPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).PeerGetPairingPayload(ctx, req.(*pb.RpcPeerGetPairingPayloadRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_LogSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcLogSendRequest)
 	if err := dec(in); err != nil {
@@ -6533,6 +7643,24 @@ func _ClientCommands_DebugSpaceSummary_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_DebugSpaceSyncReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcDebugSpaceSyncReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).DebugSpaceSyncReport(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/DebugSpaceSyncReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).DebugSpaceSyncReport(ctx, req.(*pb.RpcDebugSpaceSyncReportRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_DebugExportLocalstore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcDebugExportLocalstoreRequest)
 	if err := dec(in); err != nil {
@@ -6749,6 +7877,26 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountRecoverFromLegacyExport",
 			Handler:    _ClientCommands_AccountRecoverFromLegacyExport_Handler,
 		},
+		{
+			MethodName: "AccountBackup",
+			Handler:    _ClientCommands_AccountBackup_Handler,
+		},
+		{
+			MethodName: "AccountBackupSetSchedule",
+			Handler:    _ClientCommands_AccountBackupSetSchedule_Handler,
+		},
+		{
+			MethodName: "AccountBackupGetStatus",
+			Handler:    _ClientCommands_AccountBackupGetStatus_Handler,
+		},
+		{
+			MethodName: "AccountVerifyBackup",
+			Handler:    _ClientCommands_AccountVerifyBackup_Handler,
+		},
+		{
+			MethodName: "AccountRecoverFromBackup",
+			Handler:    _ClientCommands_AccountRecoverFromBackup_Handler,
+		},
 		{
 			MethodName: "ObjectOpen",
 			Handler:    _ClientCommands_ObjectOpen_Handler,
@@ -6785,6 +7933,34 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectSearchSubscribe",
 			Handler:    _ClientCommands_ObjectSearchSubscribe_Handler,
 		},
+		{
+			MethodName: "ObjectQueryParse",
+			Handler:    _ClientCommands_ObjectQueryParse_Handler,
+		},
+		{
+			MethodName: "ObjectCalendarSubscribe",
+			Handler:    _ClientCommands_ObjectCalendarSubscribe_Handler,
+		},
+		{
+			MethodName: "ObjectCalendarMove",
+			Handler:    _ClientCommands_ObjectCalendarMove_Handler,
+		},
+		{
+			MethodName: "ObjectTimelineSubscribe",
+			Handler:    _ClientCommands_ObjectTimelineSubscribe_Handler,
+		},
+		{
+			MethodName: "ObjectTimelineMove",
+			Handler:    _ClientCommands_ObjectTimelineMove_Handler,
+		},
+		{
+			MethodName: "ObjectBulkEdit",
+			Handler:    _ClientCommands_ObjectBulkEdit_Handler,
+		},
+		{
+			MethodName: "ObjectBulkEditUndo",
+			Handler:    _ClientCommands_ObjectBulkEditUndo_Handler,
+		},
 		{
 			MethodName: "ObjectSubscribeIds",
 			Handler:    _ClientCommands_ObjectSubscribeIds_Handler,
@@ -6865,6 +8041,18 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectShareByLink",
 			Handler:    _ClientCommands_ObjectShareByLink_Handler,
 		},
+		{
+			MethodName: "ObjectShareRevoke",
+			Handler:    _ClientCommands_ObjectShareRevoke_Handler,
+		},
+		{
+			MethodName: "ObjectShareList",
+			Handler:    _ClientCommands_ObjectShareList_Handler,
+		},
+		{
+			MethodName: "ObjectShareImport",
+			Handler:    _ClientCommands_ObjectShareImport_Handler,
+		},
 		{
 			MethodName: "ObjectUndo",
 			Handler:    _ClientCommands_ObjectUndo_Handler,
@@ -6913,6 +8101,14 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectCollectionSort",
 			Handler:    _ClientCommands_ObjectCollectionSort_Handler,
 		},
+		{
+			MethodName: "ObjectBacklinksList",
+			Handler:    _ClientCommands_ObjectBacklinksList_Handler,
+		},
+		{
+			MethodName: "ObjectBacklinksLinkMention",
+			Handler:    _ClientCommands_ObjectBacklinksLinkMention_Handler,
+		},
 		{
 			MethodName: "ObjectCreateRelation",
 			Handler:    _ClientCommands_ObjectCreateRelation_Handler,
@@ -6929,6 +8125,10 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RelationOptions",
 			Handler:    _ClientCommands_RelationOptions_Handler,
 		},
+		{
+			MethodName: "RelationListViolations",
+			Handler:    _ClientCommands_RelationListViolations_Handler,
+		},
 		{
 			MethodName: "ObjectRelationAdd",
 			Handler:    _ClientCommands_ObjectRelationAdd_Handler,
@@ -6977,6 +8177,14 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HistorySetVersion",
 			Handler:    _ClientCommands_HistorySetVersion_Handler,
 		},
+		{
+			MethodName: "HistoryRelationHistory",
+			Handler:    _ClientCommands_HistoryRelationHistory_Handler,
+		},
+		{
+			MethodName: "HistoryRelationChanges",
+			Handler:    _ClientCommands_HistoryRelationChanges_Handler,
+		},
 		{
 			MethodName: "FileOffload",
 			Handler:    _ClientCommands_FileOffload_Handler,
@@ -7001,6 +8209,30 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FileSpaceUsage",
 			Handler:    _ClientCommands_FileSpaceUsage_Handler,
 		},
+		{
+			MethodName: "FileSetBandwidth",
+			Handler:    _ClientCommands_FileSetBandwidth_Handler,
+		},
+		{
+			MethodName: "FileGetBandwidth",
+			Handler:    _ClientCommands_FileGetBandwidth_Handler,
+		},
+		{
+			MethodName: "FilePin",
+			Handler:    _ClientCommands_FilePin_Handler,
+		},
+		{
+			MethodName: "FileUnpin",
+			Handler:    _ClientCommands_FileUnpin_Handler,
+		},
+		{
+			MethodName: "FileListPinned",
+			Handler:    _ClientCommands_FileListPinned_Handler,
+		},
+		{
+			MethodName: "FileSetCacheLimit",
+			Handler:    _ClientCommands_FileSetCacheLimit_Handler,
+		},
 		{
 			MethodName: "NavigationListObjects",
 			Handler:    _ClientCommands_NavigationListObjects_Handler,
@@ -7333,6 +8565,14 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockTableSort",
 			Handler:    _ClientCommands_BlockTableSort_Handler,
 		},
+		{
+			MethodName: "BlockTableColumnSetType",
+			Handler:    _ClientCommands_BlockTableColumnSetType_Handler,
+		},
+		{
+			MethodName: "BlockTableCellSetFormula",
+			Handler:    _ClientCommands_BlockTableCellSetFormula_Handler,
+		},
 		{
 			MethodName: "BlockCreateWidget",
 			Handler:    _ClientCommands_BlockCreateWidget_Handler,
@@ -7385,10 +8625,30 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockLatexSetText",
 			Handler:    _ClientCommands_BlockLatexSetText_Handler,
 		},
+		{
+			MethodName: "BlockLatexSetLabel",
+			Handler:    _ClientCommands_BlockLatexSetLabel_Handler,
+		},
 		{
 			MethodName: "ProcessCancel",
 			Handler:    _ClientCommands_ProcessCancel_Handler,
 		},
+		{
+			MethodName: "PeerAdd",
+			Handler:    _ClientCommands_PeerAdd_Handler,
+		},
+		{
+			MethodName: "PeerRemove",
+			Handler:    _ClientCommands_PeerRemove_Handler,
+		},
+		{
+			MethodName: "PeerList",
+			Handler:    _ClientCommands_PeerList_Handler,
+		},
+		{
+			MethodName: "PeerGetPairingPayload",
+			Handler:    _ClientCommands_PeerGetPairingPayload_Handler,
+		},
 		{
 			MethodName: "LogSend",
 			Handler:    _ClientCommands_LogSend_Handler,
@@ -7405,6 +8665,10 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DebugSpaceSummary",
 			Handler:    _ClientCommands_DebugSpaceSummary_Handler,
 		},
+		{
+			MethodName: "DebugSpaceSyncReport",
+			Handler:    _ClientCommands_DebugSpaceSyncReport_Handler,
+		},
 		{
 			MethodName: "DebugExportLocalstore",
 			Handler:    _ClientCommands_DebugExportLocalstore_Handler,
@@ -9,7 +9,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-const RelationChecksum = "165040560b4a08b762713b5f5f4bdecb02432de64306c5b475421ffff0efa0c6"
+const RelationChecksum = "2b1c53f421504ffb63c5be61e11c3d907093505e2daa5789e902cfce515357d8"
 
 type RelationKey string
 
@@ -136,11 +136,18 @@ const (
 	RelationKeyCameraIso                 RelationKey = "cameraIso"
 	RelationKeyIsDeleted                 RelationKey = "isDeleted"
 	RelationKeyLinks                     RelationKey = "links"
+	RelationKeyMentions                  RelationKey = "mentions"
+	RelationKeyBlockLinks                RelationKey = "blockLinks"
 	RelationKeyServings                  RelationKey = "servings"
 	RelationKeyCategory                  RelationKey = "category"
 	RelationKeyCoverId                   RelationKey = "coverId"
 	RelationKeyLastModifiedBy            RelationKey = "lastModifiedBy"
 	RelationKeyRelationMaxCount          RelationKey = "relationMaxCount"
+	RelationKeyRelationRequired          RelationKey = "relationRequired"
+	RelationKeyRelationUnique            RelationKey = "relationUnique"
+	RelationKeyRelationMinValue          RelationKey = "relationMinValue"
+	RelationKeyRelationMaxValue          RelationKey = "relationMaxValue"
+	RelationKeyRelationRegex             RelationKey = "relationRegex"
 	RelationKeyQuestions                 RelationKey = "questions"
 	RelationKeyWidthInPixels             RelationKey = "widthInPixels"
 	RelationKeyProgress                  RelationKey = "progress"
@@ -338,6 +345,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyBlockLinks: {
+
+			DataSource:       model.Relation_derived,
+			Description:      "Objects linked by the blocks of the object other than the text",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brblockLinks",
+			Key:              "blockLinks",
+			Name:             "Block links",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyBudget: {
 
 			DataSource:       model.Relation_details,
@@ -1230,6 +1250,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyMentions: {
+
+			DataSource:       model.Relation_derived,
+			Description:      "Objects mentioned in the text of the object",
+			Format:           model.RelationFormat_object,
+			Hidden:           true,
+			Id:               "_brmentions",
+			Key:              "mentions",
+			Name:             "Mentions",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyMood: {
 
 			DataSource:       model.Relation_details,
@@ -1523,6 +1556,34 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyRelationMaxValue: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Max value of the number or the date relation",
+			Format:           model.RelationFormat_number,
+			Hidden:           true,
+			Id:               "_brrelationMaxValue",
+			Key:              "relationMaxValue",
+			MaxCount:         1,
+			Name:             "Max value",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeyRelationMinValue: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Min value of the number or the date relation",
+			Format:           model.RelationFormat_number,
+			Hidden:           true,
+			Id:               "_brrelationMinValue",
+			Key:              "relationMinValue",
+			MaxCount:         1,
+			Name:             "Min value",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyRelationOptionColor: {
 
 			DataSource:       model.Relation_details,
@@ -1551,6 +1612,48 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyRelationRegex: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Regular expression the text values of the relation must match",
+			Format:           model.RelationFormat_longtext,
+			Hidden:           true,
+			Id:               "_brrelationRegex",
+			Key:              "relationRegex",
+			MaxCount:         1,
+			Name:             "Pattern",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeyRelationRequired: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Objects must have a value of the relation",
+			Format:           model.RelationFormat_checkbox,
+			Hidden:           true,
+			Id:               "_brrelationRequired",
+			Key:              "relationRequired",
+			MaxCount:         1,
+			Name:             "Required",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeyRelationUnique: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Values of the relation are unique among objects of the same type",
+			Format:           model.RelationFormat_checkbox,
+			Hidden:           true,
+			Id:               "_brrelationUnique",
+			Key:              "relationUnique",
+			MaxCount:         1,
+			Name:             "Unique",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyReleasedYear: {
 
 			DataSource:       model.Relation_details,
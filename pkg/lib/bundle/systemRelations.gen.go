@@ -4,11 +4,13 @@ source: pkg/lib/bundle/systemRelations.json
 */
 package bundle
 
-const SystemRelationsChecksum = "84d6c6144354f7ecb8aa415671d8821b54fa804454d17ff85f2274985a8c5705"
+const SystemRelationsChecksum = "d4f11de43036f181d805eda620fffb586442d8e272ec76dfc8d377e26535a8c5"
 
 // SystemRelations contains relations that have some special biz logic depends on them in some objects
 // in case EVERY object depend on the relation please add it to RequiredInternalRelations
 var SystemRelations = append(RequiredInternalRelations, []RelationKey{
+	RelationKeyMentions,
+	RelationKeyBlockLinks,
 	RelationKeyAddedDate,
 	RelationKeySource,
 	RelationKeySourceObject,
@@ -20,6 +22,11 @@ var SystemRelations = append(RequiredInternalRelations, []RelationKey{
 	RelationKeyRelationMaxCount,
 	RelationKeyRelationOptionColor,
 	RelationKeyRelationFormatObjectTypes,
+	RelationKeyRelationRequired,
+	RelationKeyRelationUnique,
+	RelationKeyRelationMinValue,
+	RelationKeyRelationMaxValue,
+	RelationKeyRelationRegex,
 	RelationKeyIsReadonly,
 	RelationKeyIsDeleted,
 	RelationKeyIsHidden,
@@ -0,0 +1,149 @@
+package chunks
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/crypto/symmetric"
+)
+
+// Overhead is the difference between the ciphertext and the plaintext sizes of a chunk
+const Overhead = 16
+
+// zeroNonce is safe because every key is used to encrypt only one plaintext
+var zeroNonce = make([]byte, 12)
+
+// Chunk describes the encrypted chunk, Size is the size of the plaintext
+type Chunk struct {
+	Key  symmetric.Key
+	Size int64
+}
+
+// Encrypt performs AES-256 GCM encryption of the chunk with the key derived from the secret and the chunk content,
+// so the same chunk always produces the same ciphertext. The secret prevents confirming the content by others
+func Encrypt(secret []byte, plaintext []byte) (symmetric.Key, []byte, error) {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(plaintext)
+	key := symmetric.Key(mac.Sum(nil))
+	aesgcm, err := newGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, aesgcm.Seal(nil, zeroNonce, plaintext, nil), nil
+}
+
+// Decrypt uses the chunk key to perform AES-256 GCM decryption of the ciphertext
+func Decrypt(key symmetric.Key, ciphertext []byte) ([]byte, error) {
+	aesgcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return aesgcm.Open(ciphertext[:0], zeroNonce, ciphertext, nil)
+}
+
+func newGCM(key symmetric.Key) (cipher.AEAD, error) {
+	if len(key) != symmetric.KeyBytes {
+		return nil, fmt.Errorf("invalid key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// NewDecryptReader returns the reader of the plaintext from the concatenated encrypted chunks
+func NewDecryptReader(r io.ReadSeeker, chunks []Chunk) symmetric.ReadSeekCloser {
+	dr := &decryptReader{
+		r:       r,
+		chunks:  chunks,
+		offsets: make([]int64, len(chunks)+1),
+		current: -1,
+	}
+	for i, c := range chunks {
+		dr.offsets[i+1] = dr.offsets[i] + c.Size
+	}
+	return dr
+}
+
+type decryptReader struct {
+	r      io.ReadSeeker
+	chunks []Chunk
+	// offsets are plaintext offsets of chunks, the last one is the total size
+	offsets []int64
+	pos     int64
+	current int
+	buf     []byte
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	if d.pos >= d.size() {
+		return 0, io.EOF
+	}
+	i := sort.Search(len(d.chunks), func(i int) bool {
+		return d.offsets[i+1] > d.pos
+	})
+	if err := d.load(i); err != nil {
+		return 0, err
+	}
+	n := copy(p, d.buf[d.pos-d.offsets[i]:])
+	d.pos += int64(n)
+	return n, nil
+}
+
+func (d *decryptReader) load(i int) error {
+	if d.current == i {
+		return nil
+	}
+	// ciphertext offset includes overhead of all previous chunks
+	if _, err := d.r.Seek(d.offsets[i]+int64(i*Overhead), io.SeekStart); err != nil {
+		return err
+	}
+	ciphertext := make([]byte, d.chunks[i].Size+Overhead)
+	if _, err := io.ReadFull(d.r, ciphertext); err != nil {
+		return err
+	}
+	plaintext, err := Decrypt(d.chunks[i].Key, ciphertext)
+	if err != nil {
+		return fmt.Errorf("decrypt chunk %d: %w", i, err)
+	}
+	d.buf = plaintext
+	d.current = i
+	return nil
+}
+
+func (d *decryptReader) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = d.pos + offset
+	case io.SeekEnd:
+		pos = d.size() + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if pos < 0 {
+		return 0, errors.New("negative position")
+	}
+	d.pos = pos
+	return pos, nil
+}
+
+func (d *decryptReader) Close() error {
+	if c, ok := d.r.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+func (d *decryptReader) size() int64 {
+	return d.offsets[len(d.chunks)]
+}
@@ -0,0 +1,57 @@
+package chunks
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncrypt(t *testing.T) {
+	secret := []byte("secret")
+	key1, c1, err := Encrypt(secret, []byte("chunk"))
+	require.NoError(t, err)
+	key2, c2, err := Encrypt(secret, []byte("chunk"))
+	require.NoError(t, err)
+	assert.Equal(t, key1, key2)
+	assert.Equal(t, c1, c2)
+	assert.Len(t, c1, len("chunk")+Overhead)
+
+	_, c3, err := Encrypt([]byte("other secret"), []byte("chunk"))
+	require.NoError(t, err)
+	assert.NotEqual(t, c1, c3)
+
+	plaintext, err := Decrypt(key1, c1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("chunk"), plaintext)
+}
+
+func TestDecryptReader(t *testing.T) {
+	var (
+		ciphertext []byte
+		chunks     []Chunk
+	)
+	for _, p := range []string{"first ", "second ", "third"} {
+		key, c, err := Encrypt([]byte("secret"), []byte(p))
+		require.NoError(t, err)
+		ciphertext = append(ciphertext, c...)
+		chunks = append(chunks, Chunk{Key: key, Size: int64(len(p))})
+	}
+	r := NewDecryptReader(bytes.NewReader(ciphertext), chunks)
+
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "first second third", string(b))
+
+	_, err = r.Seek(8, io.SeekStart)
+	require.NoError(t, err)
+	b, err = io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "cond third", string(b))
+
+	size, err := r.Seek(0, io.SeekEnd)
+	require.NoError(t, err)
+	assert.Equal(t, int64(18), size)
+}
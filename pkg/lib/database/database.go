@@ -35,6 +35,7 @@ type Query struct {
 	Sorts    []*model.BlockContentDataviewSort   // order results. apply hierarchically
 	Limit    int                                 // maximum number of results
 	Offset   int                                 // skip given number of results
+	Now      time.Time                           // time of dates relative to the current day in its time zone, time.Now when zero
 }
 
 func (q Query) DSQuery(sch schema.Schema) (qq query.Query, err error) {
@@ -58,20 +59,20 @@ func injectDefaultFilters(filters []*model.BlockContentDataviewFilter) []*model.
 		hasTypeFilter     bool
 	)
 
-	for _, filter := range filters {
+	filter.Walk(filters, func(f *model.BlockContentDataviewFilter) {
 		// include archived objects if we have explicit filter about it
-		if filter.RelationKey == bundle.RelationKeyIsArchived.String() {
+		if f.RelationKey == bundle.RelationKeyIsArchived.String() {
 			hasArchivedFilter = true
 		}
 
-		if filter.RelationKey == bundle.RelationKeyType.String() {
+		if f.RelationKey == bundle.RelationKeyType.String() {
 			hasTypeFilter = true
 		}
 
-		if filter.RelationKey == bundle.RelationKeyIsDeleted.String() {
+		if f.RelationKey == bundle.RelationKeyIsDeleted.String() {
 			hasDeletedFilter = true
 		}
-	}
+	})
 
 	if !hasArchivedFilter {
 		filters = append(filters, &model.BlockContentDataviewFilter{RelationKey: bundle.RelationKeyIsArchived.String(), Condition: model.BlockContentDataviewFilter_NotEqual, Value: pbtypes.Bool(true)})
@@ -94,7 +95,10 @@ func NewFilters(qry Query, schema schema.Schema, store filter.OptionsGetter) (fi
 	qry.Filters = qryFilters
 	filters.dateKeys = dateKeys
 
-	filterObj, err = compose(qry.Filters, store, filterObj)
+	if qry.Now.IsZero() {
+		qry.Now = time.Now()
+	}
+	filterObj, err = compose(qry.Filters, store, filterObj, qry.Now)
 	if err != nil {
 		return
 	}
@@ -108,8 +112,9 @@ func compose(
 	filters []*model.BlockContentDataviewFilter,
 	store filter.OptionsGetter,
 	filterObj filter.AndFilters,
+	now time.Time,
 ) (filter.AndFilters, error) {
-	qryFilter, err := filter.MakeAndFilter(filters, store)
+	qryFilter, err := filter.MakeAndFilterAt(filters, store, now)
 	if err != nil {
 		return nil, err
 	}
@@ -146,11 +151,11 @@ func applyFilterDateOnlyWhenExactDate(
 	filters []*model.BlockContentDataviewFilter,
 	dateKeys []string,
 ) []*model.BlockContentDataviewFilter {
-	for _, filtr := range filters {
+	filter.Walk(filters, func(filtr *model.BlockContentDataviewFilter) {
 		if lo.Contains(dateKeys, filtr.RelationKey) && filtr.QuickOption == model.BlockContentDataviewFilter_ExactDate {
 			filtr.Value = dateOnly(filtr.Value)
 		}
-	}
+	})
 	return filters
 }
 
@@ -174,27 +179,33 @@ func extractOrder(sorts []*model.BlockContentDataviewSort, store filter.OptionsG
 		order := filter.SetOrder{}
 		for _, sort := range sorts {
 
+			key := sort.RelationKey
+			if sort.RelationProperty != "" {
+				key = sort.RelationProperty
+			}
 			keyOrder := &filter.KeyOrder{
-				Key:            sort.RelationKey,
+				Key:            key,
 				Type:           sort.Type,
-				EmptyLast:      sort.RelationKey == bundle.RelationKeyName.String(),
+				EmptyLast:      key == bundle.RelationKeyName.String(),
 				IncludeTime:    isIncludeTime(sorts, sort),
 				RelationFormat: sort.Format,
 				Store:          store,
 			}
 
-			order = appendCustomOrder(sort, order, keyOrder)
+			order = append(order, makeOrder(sort, keyOrder, store))
 		}
 		return order
 	}
 	return nil
 }
 
-func appendCustomOrder(sort *model.BlockContentDataviewSort, order filter.SetOrder, keyOrder *filter.KeyOrder) filter.SetOrder {
+func makeOrder(sort *model.BlockContentDataviewSort, keyOrder *filter.KeyOrder, store filter.OptionsGetter) filter.Order {
+	var order filter.Order = keyOrder
 	if sort.Type == model.BlockContentDataviewSort_Custom && len(sort.CustomOrder) > 0 {
-		order = append(order, filter.NewCustomOrder(sort.RelationKey, sort.CustomOrder, *keyOrder))
-	} else {
-		order = append(order, keyOrder)
+		order = filter.NewCustomOrder(keyOrder.Key, sort.CustomOrder, *keyOrder)
+	}
+	if sort.RelationProperty != "" {
+		order = filter.NewRelationPathOrder(sort.RelationKey, order, store)
 	}
 	return order
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 
@@ -17,23 +18,72 @@ var (
 )
 
 func MakeAndFilter(protoFilters []*model.BlockContentDataviewFilter, store OptionsGetter) (AndFilters, error) {
+	return MakeAndFilterAt(protoFilters, store, time.Now())
+}
+
+// MakeAndFilterAt makes the filter with dates relative to the current day resolved for the given time,
+// days start in the time zone of the given time
+func MakeAndFilterAt(protoFilters []*model.BlockContentDataviewFilter, store OptionsGetter, now time.Time) (AndFilters, error) {
+	protoFilters = TransformQuickOption(protoFilters, now, now.Location())
 
-	protoFilters = TransformQuickOption(protoFilters, nil)
+	return makeFilters(protoFilters, store)
+}
 
-	var and AndFilters
+func makeFilters(protoFilters []*model.BlockContentDataviewFilter, store OptionsGetter) ([]Filter, error) {
+	var filters []Filter
 	for _, pf := range protoFilters {
-		if pf.Condition != model.BlockContentDataviewFilter_None {
+		if pf.Condition != model.BlockContentDataviewFilter_None || IsGroup(pf) {
 			f, err := MakeFilter(pf, store)
 			if err != nil {
 				return nil, err
 			}
-			and = append(and, f)
+			filters = append(filters, f)
 		}
 	}
-	return and, nil
+	return filters, nil
+}
+
+// IsGroup reports whether the filter combines nested filters with its operator
+func IsGroup(proto *model.BlockContentDataviewFilter) bool {
+	return len(proto.NestedFilters) > 0
+}
+
+// Walk calls f for every filter including nested filters of groups
+func Walk(protoFilters []*model.BlockContentDataviewFilter, f func(proto *model.BlockContentDataviewFilter)) {
+	for _, pf := range protoFilters {
+		f(pf)
+		Walk(pf.NestedFilters, f)
+	}
+}
+
+func makeGroupFilter(proto *model.BlockContentDataviewFilter, store OptionsGetter) (Filter, error) {
+	filters, err := makeFilters(proto.NestedFilters, store)
+	if err != nil {
+		return nil, err
+	}
+	// the group of empty filters doesn't restrict the result whatever the operator is
+	if len(filters) == 0 {
+		return AndFilters(nil), nil
+	}
+	switch proto.Operator {
+	case model.BlockContentDataviewFilter_And:
+		return AndFilters(filters), nil
+	case model.BlockContentDataviewFilter_Or:
+		return OrFilters(filters), nil
+	case model.BlockContentDataviewFilter_Not:
+		return Not{AndFilters(filters)}, nil
+	default:
+		return nil, fmt.Errorf("unexpected filter operator: %v", proto.Operator)
+	}
 }
 
 func MakeFilter(proto *model.BlockContentDataviewFilter, store OptionsGetter) (Filter, error) {
+	if IsGroup(proto) {
+		return makeGroupFilter(proto, store)
+	}
+	if proto.RelationProperty != "" {
+		return makeRelationPathFilter(proto, store)
+	}
 	// replaces "value == false" to "value != true" for expected work with checkboxes
 	if proto.Condition == model.BlockContentDataviewFilter_Equal && proto.Value != nil && proto.Value.Equal(pbtypes.Bool(false)) {
 		proto = &model.BlockContentDataviewFilter{
@@ -146,6 +196,30 @@ func MakeFilter(proto *model.BlockContentDataviewFilter, store OptionsGetter) (F
 		return Exists{
 			Key: proto.RelationKey,
 		}, nil
+	case model.BlockContentDataviewFilter_Regex:
+		re, err := compileRegex(proto.Value.GetStringValue())
+		if err != nil {
+			return nil, err
+		}
+		return Regex{
+			Key:   proto.RelationKey,
+			Value: re,
+		}, nil
+	case model.BlockContentDataviewFilter_StartsWith:
+		return StartsWith{
+			Key:   proto.RelationKey,
+			Value: proto.Value,
+		}, nil
+	case model.BlockContentDataviewFilter_EndsWith:
+		return EndsWith{
+			Key:   proto.RelationKey,
+			Value: proto.Value,
+		}, nil
+	case model.BlockContentDataviewFilter_WholeWord:
+		return WholeWord{
+			Key:   proto.RelationKey,
+			Value: proto.Value,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unexpected filter cond: %v", proto.Condition)
 	}
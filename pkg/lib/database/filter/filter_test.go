@@ -381,3 +381,45 @@ func TestMakeAndFilter(t *testing.T) {
 		assert.True(t, f.FilterObject(g))
 	})
 }
+
+func TestMakeAndFilter_Groups(t *testing.T) {
+	// (status = Doing OR assignee = me) AND NOT archived
+	f, err := MakeAndFilter([]*model.BlockContentDataviewFilter{
+		{
+			Operator: model.BlockContentDataviewFilter_Or,
+			NestedFilters: []*model.BlockContentDataviewFilter{
+				{RelationKey: "status", Condition: model.BlockContentDataviewFilter_Equal, Value: pbtypes.String("Doing")},
+				{RelationKey: "assignee", Condition: model.BlockContentDataviewFilter_Equal, Value: pbtypes.String("me")},
+			},
+		},
+		{
+			Operator: model.BlockContentDataviewFilter_Not,
+			NestedFilters: []*model.BlockContentDataviewFilter{
+				{RelationKey: "archived", Condition: model.BlockContentDataviewFilter_Equal, Value: pbtypes.Bool(true)},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	assert.True(t, f.FilterObject(testGetter{"status": pbtypes.String("Doing")}))
+	assert.True(t, f.FilterObject(testGetter{"assignee": pbtypes.String("me"), "archived": pbtypes.Bool(false)}))
+	assert.False(t, f.FilterObject(testGetter{"status": pbtypes.String("Done"), "assignee": pbtypes.String("other")}))
+	assert.False(t, f.FilterObject(testGetter{"status": pbtypes.String("Doing"), "archived": pbtypes.Bool(true)}))
+}
+
+func TestMakeAndFilter_EmptyGroups(t *testing.T) {
+	for _, operator := range []model.BlockContentDataviewFilterOperator{
+		model.BlockContentDataviewFilter_And,
+		model.BlockContentDataviewFilter_Or,
+		model.BlockContentDataviewFilter_Not,
+	} {
+		f, err := MakeAndFilter([]*model.BlockContentDataviewFilter{{
+			Operator: operator,
+			NestedFilters: []*model.BlockContentDataviewFilter{
+				{RelationKey: "status", Condition: model.BlockContentDataviewFilter_None},
+			},
+		}}, nil)
+		require.NoError(t, err)
+		assert.True(t, f.FilterObject(testGetter{"status": pbtypes.String("Doing")}), operator.String())
+	}
+}
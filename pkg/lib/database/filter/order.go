@@ -21,6 +21,8 @@ type Order interface {
 
 type OptionsGetter interface {
 	GetAggregatedOptions(relationKey string) (options []*model.RelationOption, err error)
+	// GetDetails is used by filters and sorts by relations of linked objects
+	GetDetails(id string) (*model.ObjectDetails, error)
 }
 
 type SetOrder []Order
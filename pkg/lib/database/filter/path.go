@@ -0,0 +1,152 @@
+package filter
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func makeRelationPathFilter(proto *model.BlockContentDataviewFilter, store OptionsGetter) (Filter, error) {
+	propertyProto := pbtypes.CopyFilter(proto)
+	propertyProto.RelationKey = proto.RelationProperty
+	propertyProto.RelationProperty = ""
+	f, err := MakeFilter(propertyProto, store)
+	if err != nil {
+		return nil, err
+	}
+	return RelationPath{Key: proto.RelationKey, Filter: f, Store: store}, nil
+}
+
+// RelationPath matches objects by relations of objects linked by the Key relation.
+// The object matches when any of linked objects matches, the object without links is checked as an empty object
+type RelationPath struct {
+	Key    string
+	Filter Filter
+	Store  OptionsGetter
+}
+
+func (p RelationPath) FilterObject(g Getter) bool {
+	ids := pbtypes.GetStringListValue(g.Get(p.Key))
+	if len(ids) == 0 {
+		return p.Filter.FilterObject(detailsGetter{})
+	}
+	for _, id := range ids {
+		if p.Filter.FilterObject(linkedObject(p.Store, id)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p RelationPath) String() string {
+	return fmt.Sprintf("%s.(%s)", p.Key, p.Filter.String())
+}
+
+// RelationPathOrder sorts objects by the Order applied to the first object linked by the Key relation.
+// Linked objects are resolved once per object when the order is created by NewRelationPathOrder,
+// ForgetLinked should be called when the linked object of the object could change
+type RelationPathOrder struct {
+	Key   string
+	Order Order
+	Store OptionsGetter
+
+	linked *linkedCache
+}
+
+func NewRelationPathOrder(key string, order Order, store OptionsGetter) RelationPathOrder {
+	return RelationPathOrder{
+		Key:    key,
+		Order:  order,
+		Store:  store,
+		linked: &linkedCache{objects: map[string]linkedEntry{}},
+	}
+}
+
+func (o RelationPathOrder) Compare(a, b Getter) int {
+	return o.Order.Compare(o.firstLinked(a), o.firstLinked(b))
+}
+
+func (o RelationPathOrder) firstLinked(g Getter) Getter {
+	ids := pbtypes.GetStringListValue(g.Get(o.Key))
+	if len(ids) == 0 {
+		return detailsGetter{}
+	}
+	id := g.Get(bundle.RelationKeyId.String()).GetStringValue()
+	if o.linked == nil || id == "" {
+		return linkedObject(o.Store, ids[0])
+	}
+	return o.linked.get(id, ids[0], func() Getter {
+		return linkedObject(o.Store, ids[0])
+	})
+}
+
+func (o RelationPathOrder) String() string {
+	return fmt.Sprintf("%s.(%s)", o.Key, o.Order.String())
+}
+
+type detailsGetter struct {
+	details *types.Struct
+}
+
+func (d detailsGetter) Get(key string) *types.Value {
+	return pbtypes.Get(d.details, key)
+}
+
+type linkedEntry struct {
+	linkedId string
+	linked   Getter
+}
+
+// linkedCache keeps linked objects by ids of linking objects
+type linkedCache struct {
+	mu      sync.Mutex
+	objects map[string]linkedEntry
+}
+
+func (c *linkedCache) get(id, linkedId string, resolve func() Getter) Getter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.objects[id]; ok && e.linkedId == linkedId {
+		return e.linked
+	}
+	linked := resolve()
+	c.objects[id] = linkedEntry{linkedId: linkedId, linked: linked}
+	return linked
+}
+
+func (c *linkedCache) forget(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.objects, id)
+}
+
+// ForgetLinked drops linked objects of the object resolved by relation path orders of the order
+func ForgetLinked(order Order, id string) {
+	switch o := order.(type) {
+	case SetOrder:
+		for _, so := range o {
+			ForgetLinked(so, id)
+		}
+	case RelationPathOrder:
+		if o.linked != nil {
+			o.linked.forget(id)
+		}
+	}
+}
+
+func linkedObject(store OptionsGetter, id string) Getter {
+	if store == nil {
+		return detailsGetter{}
+	}
+	details, err := store.GetDetails(id)
+	if err != nil {
+		log.Errorf("can't get details of linked object %s: %v", id, err)
+		return detailsGetter{}
+	}
+	return detailsGetter{details: details.GetDetails()}
+}
@@ -0,0 +1,94 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type testObjects map[string]*types.Struct
+
+func (o testObjects) GetAggregatedOptions(relationKey string) ([]*model.RelationOption, error) {
+	return nil, nil
+}
+
+func (o testObjects) GetDetails(id string) (*model.ObjectDetails, error) {
+	return &model.ObjectDetails{Details: o[id]}, nil
+}
+
+type countingObjects struct {
+	testObjects
+	calls int
+}
+
+func (o *countingObjects) GetDetails(id string) (*model.ObjectDetails, error) {
+	o.calls++
+	return o.testObjects.GetDetails(id)
+}
+
+func TestRelationPath(t *testing.T) {
+	store := testObjects{
+		"active":   &types.Struct{Fields: map[string]*types.Value{"status": pbtypes.String("Active"), "due": pbtypes.Int64(2)}},
+		"archived": &types.Struct{Fields: map[string]*types.Value{"status": pbtypes.String("Archived"), "due": pbtypes.Int64(1)}},
+	}
+
+	t.Run("filter", func(t *testing.T) {
+		f, err := MakeAndFilter([]*model.BlockContentDataviewFilter{
+			{
+				RelationKey:      "project",
+				RelationProperty: "status",
+				Condition:        model.BlockContentDataviewFilter_Equal,
+				Value:            pbtypes.String("Active"),
+			},
+		}, store)
+		require.NoError(t, err)
+
+		assert.True(t, f.FilterObject(testGetter{"project": pbtypes.StringList([]string{"active"})}))
+		assert.True(t, f.FilterObject(testGetter{"project": pbtypes.StringList([]string{"archived", "active"})}))
+		assert.False(t, f.FilterObject(testGetter{"project": pbtypes.StringList([]string{"archived"})}))
+		assert.False(t, f.FilterObject(testGetter{}))
+	})
+	t.Run("empty", func(t *testing.T) {
+		f, err := MakeFilter(&model.BlockContentDataviewFilter{
+			RelationKey:      "project",
+			RelationProperty: "status",
+			Condition:        model.BlockContentDataviewFilter_Empty,
+		}, store)
+		require.NoError(t, err)
+
+		assert.True(t, f.FilterObject(testGetter{}))
+		assert.False(t, f.FilterObject(testGetter{"project": pbtypes.StringList([]string{"active"})}))
+	})
+	t.Run("order", func(t *testing.T) {
+		o := RelationPathOrder{Key: "project", Order: &KeyOrder{Key: "due"}, Store: store}
+		a := testGetter{"project": pbtypes.StringList([]string{"active"})}
+		b := testGetter{"project": pbtypes.StringList([]string{"archived"})}
+		assert.Equal(t, 1, o.Compare(a, b))
+		assert.Equal(t, -1, o.Compare(b, a))
+	})
+	t.Run("linked objects are resolved once per object", func(t *testing.T) {
+		counting := &countingObjects{testObjects: testObjects{"active": store["active"], "archived": store["archived"]}}
+		o := NewRelationPathOrder("project", &KeyOrder{Key: "due"}, counting)
+		a := testGetter{"id": pbtypes.String("a"), "project": pbtypes.StringList([]string{"active"})}
+		b := testGetter{"id": pbtypes.String("b"), "project": pbtypes.StringList([]string{"archived"})}
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, 1, o.Compare(a, b))
+		}
+		assert.Equal(t, 2, counting.calls)
+
+		// the changed link is resolved again
+		b = testGetter{"id": pbtypes.String("b"), "project": pbtypes.StringList([]string{"active"})}
+		assert.Equal(t, 0, o.Compare(a, b))
+		assert.Equal(t, 3, counting.calls)
+
+		counting.testObjects["active"] = &types.Struct{Fields: map[string]*types.Value{"due": pbtypes.Int64(3)}}
+		ForgetLinked(SetOrder{o}, "a")
+		assert.Equal(t, 1, o.Compare(a, b))
+		assert.Equal(t, 4, counting.calls)
+	})
+}
@@ -8,7 +8,7 @@ import (
 	timeutil "github.com/anyproto/anytype-heart/util/time"
 )
 
-func TransformQuickOption(protoFilters []*model.BlockContentDataviewFilter, loc *time.Location) []*model.BlockContentDataviewFilter {
+func TransformQuickOption(protoFilters []*model.BlockContentDataviewFilter, now time.Time, loc *time.Location) []*model.BlockContentDataviewFilter {
 	if protoFilters == nil {
 		return nil
 	}
@@ -17,20 +17,22 @@ func TransformQuickOption(protoFilters []*model.BlockContentDataviewFilter, loc
 	for i, f := range protoFilters {
 		filters[i] = pbtypes.CopyFilter(f)
 	}
+	transformQuickOption(filters, now, loc)
+	return filters
+}
 
-	for _, f := range filters {
+// transformQuickOption replaces date options with ranges in place, so it works for filters inside Or groups too
+func transformQuickOption(filters []*model.BlockContentDataviewFilter, now time.Time, loc *time.Location) {
+	for i, f := range filters {
+		if IsGroup(f) {
+			transformQuickOption(f.NestedFilters, now, loc)
+			continue
+		}
 		if f.QuickOption > model.BlockContentDataviewFilter_ExactDate || f.Format == model.RelationFormat_date {
-			d1, d2 := getRange(f, loc)
+			d1, d2 := getRange(f, now, loc)
 			switch f.Condition {
-			case model.BlockContentDataviewFilter_Equal:
-				f.Condition = model.BlockContentDataviewFilter_GreaterOrEqual
-				f.Value = pbtypes.ToValue(d1)
-
-				filters = append(filters, &model.BlockContentDataviewFilter{
-					RelationKey: f.RelationKey,
-					Condition:   model.BlockContentDataviewFilter_LessOrEqual,
-					Value:       pbtypes.ToValue(d2),
-				})
+			case model.BlockContentDataviewFilter_Equal, model.BlockContentDataviewFilter_In:
+				filters[i] = rangeFilter(f.RelationKey, d1, d2)
 			case model.BlockContentDataviewFilter_Less:
 				f.Value = pbtypes.ToValue(d1)
 			case model.BlockContentDataviewFilter_Greater:
@@ -39,25 +41,35 @@ func TransformQuickOption(protoFilters []*model.BlockContentDataviewFilter, loc
 				f.Value = pbtypes.ToValue(d2)
 			case model.BlockContentDataviewFilter_GreaterOrEqual:
 				f.Value = pbtypes.ToValue(d1)
-			case model.BlockContentDataviewFilter_In:
-				f.Condition = model.BlockContentDataviewFilter_GreaterOrEqual
-				f.Value = pbtypes.ToValue(d1)
-
-				filters = append(filters, &model.BlockContentDataviewFilter{
-					RelationKey: f.RelationKey,
-					Condition:   model.BlockContentDataviewFilter_LessOrEqual,
-					Value:       pbtypes.ToValue(d2),
-				})
 			}
 		}
 	}
+}
 
-	return filters
+func rangeFilter(relationKey string, d1, d2 int64) *model.BlockContentDataviewFilter {
+	return &model.BlockContentDataviewFilter{
+		Operator: model.BlockContentDataviewFilter_And,
+		NestedFilters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: relationKey,
+				Condition:   model.BlockContentDataviewFilter_GreaterOrEqual,
+				Value:       pbtypes.ToValue(d1),
+			},
+			{
+				RelationKey: relationKey,
+				Condition:   model.BlockContentDataviewFilter_LessOrEqual,
+				Value:       pbtypes.ToValue(d2),
+			},
+		},
+	}
 }
 
-func getRange(f *model.BlockContentDataviewFilter, loc *time.Location) (int64, int64) {
+func getRange(f *model.BlockContentDataviewFilter, now time.Time, loc *time.Location) (int64, int64) {
 	var d1, d2 time.Time
-	calendar := timeutil.NewCalendar(time.Now(), loc)
+	if loc == nil {
+		loc = now.Location()
+	}
+	calendar := timeutil.NewCalendar(now.In(loc), loc)
 	switch f.QuickOption {
 	case model.BlockContentDataviewFilter_Yesterday:
 		d1 = calendar.DayNumStart(-1)
@@ -96,7 +108,7 @@ func getRange(f *model.BlockContentDataviewFilter, loc *time.Location) (int64, i
 		d2 = calendar.DayNumEnd(int(daysCnt))
 	case model.BlockContentDataviewFilter_ExactDate:
 		timestamp := f.GetValue().GetNumberValue()
-		t := time.Unix(int64(timestamp), 0)
+		t := time.Unix(int64(timestamp), 0).In(loc)
 		calendar2 := timeutil.NewCalendar(t, loc)
 		d1 = calendar2.DayNumStart(0)
 		d2 = calendar2.DayNumEnd(0)
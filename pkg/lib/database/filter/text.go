@@ -0,0 +1,154 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	// maxRegexLen is the max length of the pattern in bytes
+	maxRegexLen = 512
+	// maxRegexInst is the max size of the compiled program. RE2 matches in linear time, but large repetitions like
+	// ((abcdefgh){100}){10} blow up the program and the time of every match with it
+	maxRegexInst = 5000
+)
+
+var (
+	ErrInvalidRegex    = errors.New("invalid regular expression")
+	ErrRegexTooComplex = errors.New("regular expression is too complex")
+)
+
+// compileRegex compiles the case-insensitive pattern once for the filter, so it's reused for every object
+// of the subscription
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	return CompileRegex("(?i)" + pattern)
+}
+
+// CompileRegex compiles the pattern provided by the user, rejecting patterns too long or too complex to be matched
+// against every object
+func CompileRegex(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) > maxRegexLen {
+		return nil, fmt.Errorf("%w: pattern is longer than %d bytes", ErrRegexTooComplex, maxRegexLen)
+	}
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRegex, err)
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRegex, err)
+	}
+	if len(prog.Inst) > maxRegexInst {
+		return nil, ErrRegexTooComplex
+	}
+	return regexp.Compile(pattern)
+}
+
+// textValue returns the string value of the relation, empty values never match text conditions
+func textValue(g Getter, key string) (string, bool) {
+	val := g.Get(key)
+	if val == nil {
+		return "", false
+	}
+	valStr := val.GetStringValue()
+	return valStr, valStr != ""
+}
+
+type Regex struct {
+	Key   string
+	Value *regexp.Regexp
+}
+
+func (r Regex) FilterObject(g Getter) bool {
+	valStr, ok := textValue(g, r.Key)
+	return ok && r.Value.MatchString(valStr)
+}
+
+func (r Regex) String() string {
+	return fmt.Sprintf("%v REGEX '%s'", r.Key, r.Value.String())
+}
+
+type StartsWith struct {
+	Key   string
+	Value *types.Value
+}
+
+func (s StartsWith) FilterObject(g Getter) bool {
+	valStr, ok := textValue(g, s.Key)
+	return ok && strings.HasPrefix(strings.ToLower(valStr), strings.ToLower(s.Value.GetStringValue()))
+}
+
+func (s StartsWith) String() string {
+	return fmt.Sprintf("%v STARTS WITH '%s'", s.Key, pbtypes.Sprint(s.Value))
+}
+
+type EndsWith struct {
+	Key   string
+	Value *types.Value
+}
+
+func (e EndsWith) FilterObject(g Getter) bool {
+	valStr, ok := textValue(g, e.Key)
+	return ok && strings.HasSuffix(strings.ToLower(valStr), strings.ToLower(e.Value.GetStringValue()))
+}
+
+func (e EndsWith) String() string {
+	return fmt.Sprintf("%v ENDS WITH '%s'", e.Key, pbtypes.Sprint(e.Value))
+}
+
+type WholeWord struct {
+	Key   string
+	Value *types.Value
+}
+
+func (w WholeWord) FilterObject(g Getter) bool {
+	valStr, ok := textValue(g, w.Key)
+	if !ok {
+		return false
+	}
+	text, word := strings.ToLower(valStr), strings.ToLower(w.Value.GetStringValue())
+	if word == "" {
+		return false
+	}
+	for offset := 0; offset < len(text); {
+		i := strings.Index(text[offset:], word)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(word)
+		if isWordBoundary(text, start, end) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+	return false
+}
+
+// isWordBoundary reports whether text[start:end] is not surrounded by letters or digits
+func isWordBoundary(text string, start, end int) bool {
+	if r, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && isWordRune(r) {
+		return false
+	}
+	if r, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) && isWordRune(r) {
+		return false
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func (w WholeWord) String() string {
+	return fmt.Sprintf("%v WHOLE WORD '%s'", w.Key, pbtypes.Sprint(w.Value))
+}
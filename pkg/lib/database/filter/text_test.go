@@ -0,0 +1,62 @@
+package filter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestTextConditions(t *testing.T) {
+	g := testGetter{"k": pbtypes.String("Quarterly Report: draft_v2")}
+	for _, tc := range []struct {
+		cond  model.BlockContentDataviewFilterCondition
+		value string
+		ok    bool
+	}{
+		{model.BlockContentDataviewFilter_Regex, `^quarterly\s+rep`, true},
+		{model.BlockContentDataviewFilter_Regex, `v\d$`, true},
+		{model.BlockContentDataviewFilter_Regex, `^report`, false},
+		{model.BlockContentDataviewFilter_StartsWith, "quarter", true},
+		{model.BlockContentDataviewFilter_StartsWith, "report", false},
+		{model.BlockContentDataviewFilter_EndsWith, "DRAFT_V2", true},
+		{model.BlockContentDataviewFilter_EndsWith, "draft", false},
+		{model.BlockContentDataviewFilter_WholeWord, "report", true},
+		{model.BlockContentDataviewFilter_WholeWord, "quarterly report", true},
+		{model.BlockContentDataviewFilter_WholeWord, "port", false},
+		{model.BlockContentDataviewFilter_WholeWord, "draft", false},
+	} {
+		f, err := MakeFilter(&model.BlockContentDataviewFilter{
+			RelationKey: "k",
+			Condition:   tc.cond,
+			Value:       pbtypes.String(tc.value),
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, tc.ok, f.FilterObject(g), f.String())
+		assert.False(t, f.FilterObject(testGetter{}), f.String())
+	}
+}
+
+func TestWholeWord_FilterObject(t *testing.T) {
+	w := WholeWord{Key: "k", Value: pbtypes.String("кот")}
+	assert.True(t, w.FilterObject(testGetter{"k": pbtypes.String("котик и Кот")}))
+	assert.False(t, w.FilterObject(testGetter{"k": pbtypes.String("котик")}))
+}
+
+func TestCompileRegex(t *testing.T) {
+	_, err := compileRegex(`(`)
+	assert.ErrorIs(t, err, ErrInvalidRegex)
+
+	_, err = compileRegex(strings.Repeat("a", maxRegexLen+1))
+	assert.ErrorIs(t, err, ErrRegexTooComplex)
+
+	_, err = compileRegex(`((abcdefgh){100}){10}`)
+	assert.ErrorIs(t, err, ErrRegexTooComplex)
+
+	_, err = compileRegex(`^[a-z]+\d{1,4}$`)
+	assert.NoError(t, err)
+}
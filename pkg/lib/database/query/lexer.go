@@ -0,0 +1,116 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOp
+	tokenLParen
+	tokenRParen
+	tokenMinus
+)
+
+type token struct {
+	kind tokenKind
+	val  string
+	// pos is the position of the token in runes
+	pos int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenString:
+		return fmt.Sprintf("%q", t.val)
+	default:
+		return fmt.Sprintf("'%s'", t.val)
+	}
+}
+
+var ops = []string{"!=", "<=", ">=", ":", "=", "<", ">", "~"}
+
+func isSpecial(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(`()":=<>!~`, r)
+}
+
+func lex(text string) ([]token, error) {
+	var (
+		runes  = []rune(text)
+		tokens []token
+		i      int
+	)
+	prevIsOp := func() bool {
+		return len(tokens) > 0 && tokens[len(tokens)-1].kind == tokenOp
+	}
+	for i < len(runes) {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, val: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, val: ")", pos: i})
+			i++
+		case r == '"':
+			start := i
+			var sb strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				return nil, &Error{Pos: start, Msg: "unterminated string"}
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, val: sb.String(), pos: start})
+		case r == '-' && !prevIsOp() && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]):
+			tokens = append(tokens, token{kind: tokenMinus, val: "-", pos: i})
+			i++
+		case strings.ContainsRune(":=<>!~", r):
+			op := matchOp(runes[i:])
+			if op == "" {
+				return nil, &Error{Pos: i, Msg: fmt.Sprintf("unexpected '%c'", r)}
+			}
+			tokens = append(tokens, token{kind: tokenOp, val: op, pos: i})
+			i += len([]rune(op))
+		default:
+			start := i
+			for i < len(runes) && !isSpecial(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, val: string(runes[start:i]), pos: start})
+		}
+	}
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(runes)})
+	return tokens, nil
+}
+
+func matchOp(runes []rune) string {
+	for _, op := range ops {
+		if strings.HasPrefix(string(runes[:min(len(runes), 2)]), op) {
+			return op
+		}
+	}
+	return ""
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
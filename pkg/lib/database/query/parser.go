@@ -0,0 +1,487 @@
+// Package query parses the text query language into dataview filters.
+//
+// The query is a sequence of terms combined by AND:
+//
+//	type:Task status:"In progress" due<2026-11-01 tag:(urgent OR blocked) "free text"
+//
+// A term is a relation key or name, an operator and a value. Operators are ':' (equals, contains for text
+// relations), '=', '!=', '<', '<=', '>', '>=' and '~' (contains). A value is a word, a quoted string or a list of
+// values in parentheses combined by OR or AND. Dates are written as YYYY-MM-DD or as today, yesterday and tomorrow.
+// The key of a linked object relation is written as relation.property.
+//
+// Terms are combined with AND unless they are joined by OR, negated with NOT or '-' and grouped with parentheses. Words and quoted strings
+// that are not terms form the free text clause, they are allowed only at the top level of the query
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// Error is the error of the invalid query, Pos is the position of the error in runes
+type Error struct {
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid query at position %d: %s", e.Pos, e.Msg)
+}
+
+// Result is the parsed query, Filters must be combined by AND
+type Result struct {
+	Filters  []*model.BlockContentDataviewFilter
+	FullText string
+}
+
+// Parse parses the query. When the store is not nil, relation names are resolved to keys and names of objects
+// and options are resolved to ids, otherwise keys and values are used as is
+func Parse(text string, store Store) (*Result, error) {
+	tokens, err := lex(text)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if store != nil {
+		p.resolver = &resolver{store: store}
+	}
+	nodes, err := p.parseSequence()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.unexpected(t)
+	}
+	res := &Result{}
+	var texts []string
+	for _, n := range nodes {
+		if n.filter != nil {
+			res.Filters = append(res.Filters, n.filter)
+		} else {
+			texts = append(texts, n.text)
+		}
+	}
+	res.FullText = strings.Join(texts, " ")
+	return res, nil
+}
+
+// ViewFilterId is the id of the filter of the dataview view compiled from the query of the view
+const ViewFilterId = "query"
+
+// ViewFilter compiles the query of the dataview view into the group of filters combined by AND. The free text is
+// matched by FullTextFilter. Nil is returned for the empty query
+func ViewFilter(text string, store Store) (*model.BlockContentDataviewFilter, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	res, err := Parse(text, store)
+	if err != nil {
+		return nil, err
+	}
+	filters := res.Filters
+	if res.FullText != "" {
+		filters = append(filters, FullTextFilter(res.FullText))
+	}
+	return &model.BlockContentDataviewFilter{
+		Id:            ViewFilterId,
+		Operator:      model.BlockContentDataviewFilter_And,
+		NestedFilters: filters,
+	}, nil
+}
+
+// FullTextFilter matches the free text with names and snippets of objects. It is used instead of the full-text index
+// where the index is not available, e.g. in subscriptions
+func FullTextFilter(text string) *model.BlockContentDataviewFilter {
+	return &model.BlockContentDataviewFilter{
+		Operator: model.BlockContentDataviewFilter_Or,
+		NestedFilters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyName.String(),
+				Condition:   model.BlockContentDataviewFilter_Like,
+				Value:       pbtypes.String(text),
+			},
+			{
+				RelationKey: bundle.RelationKeySnippet.String(),
+				Condition:   model.BlockContentDataviewFilter_Like,
+				Value:       pbtypes.String(text),
+			},
+		},
+	}
+}
+
+// node is a filter or a part of the free text
+type node struct {
+	filter *model.BlockContentDataviewFilter
+	text   string
+	pos    int
+}
+
+type parser struct {
+	tokens   []token
+	i        int
+	resolver *resolver
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) isKeyword(t token, keyword string) bool {
+	return t.kind == tokenWord && t.val == keyword
+}
+
+func (p *parser) unexpected(t token) error {
+	return &Error{Pos: t.pos, Msg: fmt.Sprintf("unexpected %s", t)}
+}
+
+// parseSequence parses terms combined by AND until the end of the query or the closing parenthesis
+func (p *parser) parseSequence() (nodes []node, err error) {
+	for {
+		t := p.peek()
+		if t.kind == tokenEOF || t.kind == tokenRParen {
+			return nodes, nil
+		}
+		if p.isKeyword(t, "AND") {
+			p.next()
+			continue
+		}
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+}
+
+func (p *parser) parseOr() (node, error) {
+	first, err := p.parseUnary()
+	if err != nil {
+		return node{}, err
+	}
+	if !p.isKeyword(p.peek(), "OR") {
+		return first, nil
+	}
+	nodes := []node{first}
+	for p.isKeyword(p.peek(), "OR") {
+		p.next()
+		n, err := p.parseUnary()
+		if err != nil {
+			return node{}, err
+		}
+		nodes = append(nodes, n)
+	}
+	filters, err := nodeFilters(nodes)
+	if err != nil {
+		return node{}, err
+	}
+	return node{pos: first.pos, filter: &model.BlockContentDataviewFilter{
+		Operator:      model.BlockContentDataviewFilter_Or,
+		NestedFilters: filters,
+	}}, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	t := p.peek()
+	if t.kind == tokenMinus || p.isKeyword(t, "NOT") {
+		p.next()
+		n, err := p.parseUnary()
+		if err != nil {
+			return node{}, err
+		}
+		filters, err := nodeFilters([]node{n})
+		if err != nil {
+			return node{}, err
+		}
+		return node{pos: t.pos, filter: &model.BlockContentDataviewFilter{
+			Operator:      model.BlockContentDataviewFilter_Not,
+			NestedFilters: filters,
+		}}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenLParen:
+		nodes, err := p.parseSequence()
+		if err != nil {
+			return node{}, err
+		}
+		if end := p.next(); end.kind != tokenRParen {
+			return node{}, &Error{Pos: end.pos, Msg: "expected ')'"}
+		}
+		if len(nodes) == 0 {
+			return node{}, &Error{Pos: t.pos, Msg: "empty group"}
+		}
+		filters, err := nodeFilters(nodes)
+		if err != nil {
+			return node{}, err
+		}
+		if len(filters) == 1 {
+			return node{pos: t.pos, filter: filters[0]}, nil
+		}
+		return node{pos: t.pos, filter: &model.BlockContentDataviewFilter{
+			Operator:      model.BlockContentDataviewFilter_And,
+			NestedFilters: filters,
+		}}, nil
+	case tokenWord, tokenString:
+		if op := p.peek(); op.kind == tokenOp {
+			p.next()
+			return p.parseTerm(t, op)
+		}
+		if t.kind == tokenWord && (t.val == "OR" || t.val == "NOT") {
+			return node{}, p.unexpected(t)
+		}
+		return node{pos: t.pos, text: t.val}, nil
+	default:
+		return node{}, p.unexpected(t)
+	}
+}
+
+// nodeFilters returns filters of nodes, the free text is allowed only at the top level of the query
+func nodeFilters(nodes []node) ([]*model.BlockContentDataviewFilter, error) {
+	filters := make([]*model.BlockContentDataviewFilter, 0, len(nodes))
+	for _, n := range nodes {
+		if n.filter == nil {
+			return nil, &Error{Pos: n.pos, Msg: fmt.Sprintf("free text %q is allowed only at the top level of the query", n.text)}
+		}
+		filters = append(filters, n.filter)
+	}
+	return filters, nil
+}
+
+type value struct {
+	token
+	quoted bool
+}
+
+func (p *parser) parseTerm(key, op token) (node, error) {
+	var (
+		values    []value
+		connector = "OR"
+	)
+	t := p.next()
+	switch t.kind {
+	case tokenWord, tokenString:
+		values = append(values, value{token: t, quoted: t.kind == tokenString})
+	case tokenLParen:
+		for {
+			v := p.next()
+			if v.kind != tokenWord && v.kind != tokenString {
+				return node{}, &Error{Pos: v.pos, Msg: "expected value"}
+			}
+			values = append(values, value{token: v, quoted: v.kind == tokenString})
+			end := p.next()
+			if end.kind == tokenRParen {
+				break
+			}
+			if !p.isKeyword(end, "OR") && !p.isKeyword(end, "AND") {
+				return node{}, &Error{Pos: end.pos, Msg: "expected OR, AND or ')'"}
+			}
+			if len(values) > 1 && end.val != connector {
+				return node{}, &Error{Pos: end.pos, Msg: "OR and AND can't be mixed in the list of values"}
+			}
+			connector = end.val
+		}
+	default:
+		return node{}, &Error{Pos: t.pos, Msg: "expected value"}
+	}
+	f, err := p.makeFilter(key, op, values, connector)
+	if err != nil {
+		return node{}, err
+	}
+	return node{pos: key.pos, filter: f}, nil
+}
+
+func (p *parser) makeFilter(key, op token, values []value, connector string) (*model.BlockContentDataviewFilter, error) {
+	f := &model.BlockContentDataviewFilter{RelationKey: key.val}
+	if key.kind == tokenWord {
+		if dot := strings.Index(key.val, "."); dot > 0 && dot < len(key.val)-1 {
+			f.RelationKey, f.RelationProperty = key.val[:dot], key.val[dot+1:]
+		}
+	}
+	var rel *model.Relation
+	if p.resolver != nil {
+		var err error
+		if rel, err = p.resolver.relation(f.RelationKey); err != nil {
+			return nil, &Error{Pos: key.pos, Msg: err.Error()}
+		}
+		f.RelationKey = rel.Key
+		if f.RelationProperty != "" {
+			if rel, err = p.resolver.relation(f.RelationProperty); err != nil {
+				return nil, &Error{Pos: key.pos, Msg: err.Error()}
+			}
+			f.RelationProperty = rel.Key
+		}
+		f.Format = rel.Format
+	}
+
+	isList := len(values) > 1 || isObjectFormat(rel)
+	if isList && op.val != ":" && op.val != "=" && op.val != "!=" {
+		return nil, &Error{Pos: op.pos, Msg: fmt.Sprintf("operator %s can't be used with the list of values", op.val)}
+	}
+	if isList {
+		var list []*types.Value
+		for _, v := range values {
+			vals, err := p.listValue(rel, v)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, vals...)
+		}
+		f.Value = &types.Value{Kind: &types.Value_ListValue{ListValue: &types.ListValue{Values: list}}}
+		switch {
+		case op.val == "!=" && connector == "AND":
+			f.Condition = model.BlockContentDataviewFilter_NotAllIn
+		case op.val == "!=":
+			f.Condition = model.BlockContentDataviewFilter_NotIn
+		case connector == "AND":
+			f.Condition = model.BlockContentDataviewFilter_AllIn
+		default:
+			f.Condition = model.BlockContentDataviewFilter_In
+		}
+		return f, nil
+	}
+
+	v := values[0]
+	if err := p.setValue(f, rel, v); err != nil {
+		return nil, err
+	}
+	switch op.val {
+	case ":":
+		f.Condition = model.BlockContentDataviewFilter_Equal
+		if isTextFormat(rel) {
+			f.Condition = model.BlockContentDataviewFilter_Like
+		}
+	case "=":
+		f.Condition = model.BlockContentDataviewFilter_Equal
+	case "!=":
+		f.Condition = model.BlockContentDataviewFilter_NotEqual
+	case "<":
+		f.Condition = model.BlockContentDataviewFilter_Less
+	case "<=":
+		f.Condition = model.BlockContentDataviewFilter_LessOrEqual
+	case ">":
+		f.Condition = model.BlockContentDataviewFilter_Greater
+	case ">=":
+		f.Condition = model.BlockContentDataviewFilter_GreaterOrEqual
+	case "~":
+		f.Condition = model.BlockContentDataviewFilter_Like
+	}
+	return f, nil
+}
+
+func (p *parser) listValue(rel *model.Relation, v value) ([]*types.Value, error) {
+	if isObjectFormat(rel) {
+		ids, err := p.resolver.objectIds(rel, v.val)
+		if err != nil {
+			return nil, &Error{Pos: v.pos, Msg: err.Error()}
+		}
+		if len(ids) > 0 {
+			return pbtypes.StringList(ids).GetListValue().Values, nil
+		}
+		return []*types.Value{pbtypes.String(v.val)}, nil
+	}
+	if v.quoted {
+		return []*types.Value{pbtypes.String(v.val)}, nil
+	}
+	return []*types.Value{scalarValue(v.val)}, nil
+}
+
+var quickOptions = map[string]model.BlockContentDataviewFilterQuickOption{
+	"yesterday": model.BlockContentDataviewFilter_Yesterday,
+	"today":     model.BlockContentDataviewFilter_Today,
+	"tomorrow":  model.BlockContentDataviewFilter_Tomorrow,
+}
+
+const dateLayout = "2006-01-02"
+
+func (p *parser) setValue(f *model.BlockContentDataviewFilter, rel *model.Relation, v value) error {
+	if rel == nil || rel.Format == model.RelationFormat_date {
+		if option, ok := quickOptions[v.val]; ok && !v.quoted {
+			f.Format = model.RelationFormat_date
+			f.QuickOption = option
+			f.Value = pbtypes.Int64(0)
+			return nil
+		}
+		if t, err := time.ParseInLocation(dateLayout, v.val, time.Local); err == nil {
+			f.Format = model.RelationFormat_date
+			f.QuickOption = model.BlockContentDataviewFilter_ExactDate
+			f.Value = pbtypes.Int64(t.Unix())
+			return nil
+		}
+		if rel != nil {
+			return &Error{Pos: v.pos, Msg: fmt.Sprintf("expected date in %s format, got %q", dateLayout, v.val)}
+		}
+	}
+	switch {
+	case rel != nil && rel.Format == model.RelationFormat_number:
+		n, err := strconv.ParseFloat(v.val, 64)
+		if err != nil {
+			return &Error{Pos: v.pos, Msg: fmt.Sprintf("expected number, got %q", v.val)}
+		}
+		f.Value = pbtypes.Float64(n)
+	case rel != nil && rel.Format == model.RelationFormat_checkbox:
+		b, err := strconv.ParseBool(v.val)
+		if err != nil {
+			return &Error{Pos: v.pos, Msg: fmt.Sprintf("expected true or false, got %q", v.val)}
+		}
+		f.Value = pbtypes.Bool(b)
+	case v.quoted || isTextFormat(rel):
+		f.Value = pbtypes.String(v.val)
+	default:
+		f.Value = scalarValue(v.val)
+	}
+	return nil
+}
+
+// scalarValue guesses the type of the value of the unknown relation
+func scalarValue(s string) *types.Value {
+	if b, err := strconv.ParseBool(s); err == nil && (s == "true" || s == "false") {
+		return pbtypes.Bool(b)
+	}
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return pbtypes.Float64(n)
+	}
+	return pbtypes.String(s)
+}
+
+func isObjectFormat(rel *model.Relation) bool {
+	if rel == nil {
+		return false
+	}
+	switch rel.Format {
+	case model.RelationFormat_object, model.RelationFormat_tag, model.RelationFormat_status, model.RelationFormat_file:
+		return true
+	}
+	return false
+}
+
+func isTextFormat(rel *model.Relation) bool {
+	if rel == nil {
+		return false
+	}
+	switch rel.Format {
+	case model.RelationFormat_shorttext, model.RelationFormat_longtext, model.RelationFormat_url,
+		model.RelationFormat_email, model.RelationFormat_phone:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+package query
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/schema"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type testStore struct {
+	relations map[string]*model.Relation
+	objects   []*types.Struct
+}
+
+func (s *testStore) GetRelationByKey(key string) (*model.Relation, error) {
+	if rel, ok := s.relations[key]; ok {
+		return rel, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (s *testStore) Query(_ schema.Schema, q database.Query) (records []database.Record, total int, err error) {
+	f, err := database.NewFilters(q, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, obj := range s.objects {
+		if f.FilterObj.FilterObject(pbtypes.ValueGetter(obj)) {
+			records = append(records, database.Record{Details: obj})
+		}
+	}
+	return records, len(records), nil
+}
+
+func TestParse(t *testing.T) {
+	t.Run("terms and free text", func(t *testing.T) {
+		res, err := Parse(`type:Task status:"In progress" due<2026-11-01 tag:(urgent OR blocked) "free text" word`, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "free text word", res.FullText)
+		require.Len(t, res.Filters, 4)
+
+		assert.Equal(t, "type", res.Filters[0].RelationKey)
+		assert.Equal(t, model.BlockContentDataviewFilter_Equal, res.Filters[0].Condition)
+		assert.Equal(t, pbtypes.String("Task"), res.Filters[0].Value)
+
+		assert.Equal(t, pbtypes.String("In progress"), res.Filters[1].Value)
+
+		due, _ := time.ParseInLocation("2006-01-02", "2026-11-01", time.Local)
+		assert.Equal(t, model.BlockContentDataviewFilter_Less, res.Filters[2].Condition)
+		assert.Equal(t, model.RelationFormat_date, res.Filters[2].Format)
+		assert.Equal(t, pbtypes.Int64(due.Unix()), res.Filters[2].Value)
+
+		assert.Equal(t, model.BlockContentDataviewFilter_In, res.Filters[3].Condition)
+		assert.Equal(t, pbtypes.StringList([]string{"urgent", "blocked"}), res.Filters[3].Value)
+	})
+	t.Run("groups", func(t *testing.T) {
+		res, err := Parse(`(status:Doing OR assignee:me) -archived:true NOT done:true`, nil)
+		require.NoError(t, err)
+		require.Len(t, res.Filters, 3)
+		assert.Equal(t, model.BlockContentDataviewFilter_Or, res.Filters[0].Operator)
+		assert.Len(t, res.Filters[0].NestedFilters, 2)
+		assert.Equal(t, model.BlockContentDataviewFilter_Not, res.Filters[1].Operator)
+		assert.Equal(t, pbtypes.Bool(true), res.Filters[1].NestedFilters[0].Value)
+		assert.Equal(t, model.BlockContentDataviewFilter_Not, res.Filters[2].Operator)
+	})
+	t.Run("relation path and operators", func(t *testing.T) {
+		res, err := Parse(`project.status!=Done estimate>=2.5 name~report tag:(a AND b) due:today`, nil)
+		require.NoError(t, err)
+		require.Len(t, res.Filters, 5)
+		assert.Equal(t, "project", res.Filters[0].RelationKey)
+		assert.Equal(t, "status", res.Filters[0].RelationProperty)
+		assert.Equal(t, model.BlockContentDataviewFilter_NotEqual, res.Filters[0].Condition)
+		assert.Equal(t, pbtypes.Float64(2.5), res.Filters[1].Value)
+		assert.Equal(t, model.BlockContentDataviewFilter_Like, res.Filters[2].Condition)
+		assert.Equal(t, model.BlockContentDataviewFilter_AllIn, res.Filters[3].Condition)
+		assert.Equal(t, model.BlockContentDataviewFilter_Today, res.Filters[4].QuickOption)
+	})
+	t.Run("errors", func(t *testing.T) {
+		for query, pos := range map[string]int{
+			`name:"unterminated`:      5,
+			`(status:Doing`:           13,
+			`status:`:                 7,
+			`a OR "text"`:             0,
+			`(a b)`:                   1,
+			`tag:(a OR b AND c)`:      12,
+			`due<(1 OR 2)`:            3,
+			`status:Doing)`:           12,
+			`()`:                      0,
+			`name!text`:               4,
+			`estimate>1 OR`:           13,
+			`x:1 (y:2 OR NOT "text")`: 16,
+		} {
+			_, err := Parse(query, nil)
+			var qErr *Error
+			require.ErrorAs(t, err, &qErr, query)
+			assert.Equal(t, pos, qErr.Pos, query)
+		}
+	})
+}
+
+func TestParse_Resolve(t *testing.T) {
+	store := &testStore{
+		relations: map[string]*model.Relation{
+			"type":   {Key: "type", Format: model.RelationFormat_object},
+			"status": {Key: "status", Format: model.RelationFormat_status},
+			"name":   {Key: "name", Format: model.RelationFormat_shorttext},
+		},
+		objects: []*types.Struct{
+			{Fields: map[string]*types.Value{
+				"id":             pbtypes.String("rel-due"),
+				"name":           pbtypes.String("Due date"),
+				"type":           pbtypes.String(bundle.TypeKeyRelation.URL()),
+				"relationKey":    pbtypes.String("dueDate"),
+				"relationFormat": pbtypes.Int64(int64(model.RelationFormat_date)),
+			}},
+			{Fields: map[string]*types.Value{
+				"id":   pbtypes.String("task"),
+				"name": pbtypes.String("Task"),
+				"type": pbtypes.String(bundle.TypeKeyObjectType.URL()),
+			}},
+			{Fields: map[string]*types.Value{
+				"id":   pbtypes.String("task-object"),
+				"name": pbtypes.String("Task"),
+				"type": pbtypes.String("page"),
+			}},
+			{Fields: map[string]*types.Value{
+				"id":          pbtypes.String("opt-progress"),
+				"name":        pbtypes.String("In progress"),
+				"type":        pbtypes.String(bundle.TypeKeyRelationOption.URL()),
+				"relationKey": pbtypes.String("status"),
+			}},
+		},
+	}
+
+	res, err := Parse(`type:task status:"in progress" "Due date">=2026-11-01 name:report`, store)
+	require.NoError(t, err)
+	require.Len(t, res.Filters, 4)
+
+	assert.Equal(t, model.BlockContentDataviewFilter_In, res.Filters[0].Condition)
+	assert.Equal(t, pbtypes.StringList([]string{"task"}), res.Filters[0].Value)
+	assert.Equal(t, pbtypes.StringList([]string{"opt-progress"}), res.Filters[1].Value)
+	assert.Equal(t, "dueDate", res.Filters[2].RelationKey)
+	assert.Equal(t, model.BlockContentDataviewFilter_GreaterOrEqual, res.Filters[2].Condition)
+	assert.Equal(t, model.BlockContentDataviewFilter_Like, res.Filters[3].Condition)
+
+	_, err = Parse(`unknown:1`, store)
+	var qErr *Error
+	require.ErrorAs(t, err, &qErr)
+	assert.Equal(t, 0, qErr.Pos)
+
+	_, err = Parse(`"Due date"<soon`, store)
+	require.ErrorAs(t, err, &qErr)
+	assert.Equal(t, 11, qErr.Pos)
+}
+
+func TestViewFilter(t *testing.T) {
+	f, err := ViewFilter(`status:done priority:high notes`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ViewFilterId, f.Id)
+	assert.Equal(t, model.BlockContentDataviewFilter_And, f.Operator)
+	require.Len(t, f.NestedFilters, 3)
+
+	// terms are combined by AND
+	filters, err := database.NewFilters(database.Query{Filters: []*model.BlockContentDataviewFilter{f}}, nil, nil)
+	require.NoError(t, err)
+	match := func(details map[string]*types.Value) bool {
+		return filters.FilterObj.FilterObject(pbtypes.ValueGetter(&types.Struct{Fields: details}))
+	}
+	assert.True(t, match(map[string]*types.Value{
+		"status":   pbtypes.String("done"),
+		"priority": pbtypes.String("high"),
+		"name":     pbtypes.String("meeting notes"),
+	}))
+	assert.False(t, match(map[string]*types.Value{
+		"status": pbtypes.String("done"),
+		"name":   pbtypes.String("meeting notes"),
+	}))
+
+	f, err = ViewFilter(" ", nil)
+	require.NoError(t, err)
+	assert.Nil(t, f)
+
+	_, err = ViewFilter(`status:(done`, nil)
+	var queryErr *Error
+	require.ErrorAs(t, err, &queryErr)
+	assert.Equal(t, 12, queryErr.Pos)
+}
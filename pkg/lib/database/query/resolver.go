@@ -0,0 +1,110 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/relation/relationutils"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/schema"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// Store is the part of the object store used to resolve names in the query
+type Store interface {
+	GetRelationByKey(key string) (*model.Relation, error)
+	Query(schema schema.Schema, q database.Query) (records []database.Record, total int, err error)
+}
+
+type resolver struct {
+	store     Store
+	relations map[string]*model.Relation
+}
+
+// relation finds the relation by key or by name ignoring case
+func (r *resolver) relation(name string) (*model.Relation, error) {
+	if rel, ok := r.relations[name]; ok {
+		return rel, nil
+	}
+	rel, err := r.store.GetRelationByKey(name)
+	if err != nil {
+		records, err := r.queryByName(name, []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyType.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(bundle.TypeKeyRelation.URL()),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(records) == 0 {
+			return nil, fmt.Errorf("unknown relation %q", name)
+		}
+		rel = relationutils.RelationFromStruct(records[0].Details).Relation
+	}
+	if r.relations == nil {
+		r.relations = map[string]*model.Relation{}
+	}
+	r.relations[name] = rel
+	return rel, nil
+}
+
+// objectIds returns ids of objects and options with the name that can be values of the relation
+func (r *resolver) objectIds(rel *model.Relation, name string) ([]string, error) {
+	var filters []*model.BlockContentDataviewFilter
+	switch {
+	case rel.Format == model.RelationFormat_tag || rel.Format == model.RelationFormat_status:
+		filters = []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyType.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(bundle.TypeKeyRelationOption.URL()),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationKey.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(rel.Key),
+			},
+		}
+	case rel.Key == bundle.RelationKeyType.String():
+		filters = []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyType.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(bundle.TypeKeyObjectType.URL()),
+			},
+		}
+	}
+	records, err := r.queryByName(name, filters)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(records))
+	for _, rec := range records {
+		ids = append(ids, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+	}
+	return ids, nil
+}
+
+func (r *resolver) queryByName(name string, filters []*model.BlockContentDataviewFilter) ([]database.Record, error) {
+	filters = append(filters, &model.BlockContentDataviewFilter{
+		RelationKey: bundle.RelationKeyName.String(),
+		Condition:   model.BlockContentDataviewFilter_Like,
+		Value:       pbtypes.String(name),
+	})
+	records, _, err := r.store.Query(nil, database.Query{Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("query objects by name %q: %w", name, err)
+	}
+	// like matches substrings, so only objects with the same name are taken
+	res := records[:0]
+	for _, rec := range records {
+		if strings.EqualFold(pbtypes.GetString(rec.Details, bundle.RelationKeyName.String()), name) {
+			res = append(res, rec)
+		}
+	}
+	return res, nil
+}
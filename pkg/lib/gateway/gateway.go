@@ -17,6 +17,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/filestorage"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/util/netutil"
@@ -220,7 +221,7 @@ func (g *gateway) fileHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	enableCors(w)
 
-	ctx, cancel := context.WithTimeout(r.Context(), getFileTimeout)
+	ctx, cancel := context.WithTimeout(remoteLoadRequested(r.Context()), getFileTimeout)
 	defer cancel()
 	file, reader, err := g.getFile(ctx, r)
 	if err != nil {
@@ -241,6 +242,11 @@ func (g *gateway) fileHandler(w http.ResponseWriter, r *http.Request) {
 	http.ServeContent(w, r, meta.Name, meta.Added, reader)
 }
 
+// remoteLoadRequested allows loading of files shown by the client in metered mode
+func remoteLoadRequested(ctx context.Context) context.Context {
+	return context.WithValue(ctx, filestorage.CtxKeyRemoteLoadRequested, true)
+}
+
 func (g *gateway) getFile(ctx context.Context, r *http.Request) (files.File, io.ReadSeeker, error) {
 	fileHashAndPath := strings.TrimPrefix(r.URL.Path, "/file/")
 	parts := strings.Split(fileHashAndPath, "/")
@@ -266,7 +272,7 @@ func (g *gateway) imageHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	enableCors(w)
 
-	ctx, cancel := context.WithTimeout(r.Context(), getFileTimeout)
+	ctx, cancel := context.WithTimeout(remoteLoadRequested(r.Context()), getFileTimeout)
 	defer cancel()
 
 	file, reader, err := g.getImage(ctx, r)
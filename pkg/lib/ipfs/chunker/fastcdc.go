@@ -0,0 +1,133 @@
+package chunker
+
+import (
+	"io"
+	"math/bits"
+
+	chunker "github.com/ipfs/go-ipfs-chunker"
+)
+
+const (
+	DefaultMinSize = 256 << 10
+	DefaultAvgSize = 512 << 10
+	// DefaultMaxSize leaves room for the 16 bytes of the GCM tag, so encrypted chunks are not larger than the 1MB
+	// chunks of the fixed size splitter of the file service. Both are stored as UnixFS leaf nodes, not raw blocks,
+	// so the blocks are up to 14 bytes larger than 1MB because of the protobuf framing of the leaf data
+	DefaultMaxSize = 1<<20 - 16
+)
+
+// gear is the table of random values for the rolling hash. It must never change, otherwise new versions of files
+// will be chunked differently from the already uploaded ones
+var gear [256]uint64
+
+func init() {
+	// splitmix64 with a fixed seed
+	seed := uint64(0x616e7974797065)
+	for i := range gear {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gear[i] = z ^ (z >> 31)
+	}
+}
+
+// FastCDC is the content-defined splitter using FastCDC algorithm with the normalized chunking. Chunk boundaries
+// depend only on the content, so an insertion or a deletion changes only the chunks around it
+type FastCDC struct {
+	r        io.Reader
+	min, max int
+	avg      int
+	maskS    uint64
+	maskL    uint64
+	buf      []byte
+	n        int
+	eof      bool
+}
+
+var _ chunker.Splitter = (*FastCDC)(nil)
+
+func NewDefaultFastCDC(r io.Reader) *FastCDC {
+	return NewFastCDC(r, DefaultMinSize, DefaultAvgSize, DefaultMaxSize)
+}
+
+// NewFastCDC creates the splitter producing chunks from minSize to maxSize bytes, avgSize is rounded to a power of two
+func NewFastCDC(r io.Reader, minSize, avgSize, maxSize int) *FastCDC {
+	avgBits := bits.Len(uint(avgSize)) - 1
+	return &FastCDC{
+		r:   r,
+		min: minSize,
+		avg: avgSize,
+		max: maxSize,
+		// before the average size the cut is harder to find, after it is easier
+		maskS: topBits(avgBits + 1),
+		maskL: topBits(avgBits - 1),
+		buf:   make([]byte, maxSize),
+	}
+}
+
+// topBits returns the mask of n highest bits, they depend on the widest window of the gear hash
+func topBits(n int) uint64 {
+	return ^uint64(0) << (64 - n)
+}
+
+func (c *FastCDC) Reader() io.Reader {
+	return c.r
+}
+
+func (c *FastCDC) NextBytes() ([]byte, error) {
+	if err := c.fill(); err != nil {
+		return nil, err
+	}
+	if c.n == 0 {
+		return nil, io.EOF
+	}
+	size := c.cut(c.buf[:c.n])
+	chunk := make([]byte, size)
+	copy(chunk, c.buf[:size])
+	c.n = copy(c.buf, c.buf[size:c.n])
+	return chunk, nil
+}
+
+func (c *FastCDC) fill() error {
+	for !c.eof && c.n < len(c.buf) {
+		n, err := c.r.Read(c.buf[c.n:])
+		c.n += n
+		if err == io.EOF {
+			c.eof = true
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cut returns the size of the next chunk
+func (c *FastCDC) cut(data []byte) int {
+	n := len(data)
+	if n <= c.min {
+		return n
+	}
+	if n > c.max {
+		n = c.max
+	}
+	normal := c.avg
+	if normal > n {
+		normal = n
+	}
+	var h uint64
+	i := c.min
+	for ; i < normal; i++ {
+		h = (h << 1) + gear[data[i]]
+		if h&c.maskS == 0 {
+			return i + 1
+		}
+	}
+	for ; i < n; i++ {
+		h = (h << 1) + gear[data[i]]
+		if h&c.maskL == 0 {
+			return i + 1
+		}
+	}
+	return n
+}
@@ -0,0 +1,61 @@
+package chunker
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func split(t *testing.T, data []byte) [][]byte {
+	c := NewFastCDC(bytes.NewReader(data), 2<<10, 8<<10, 32<<10)
+	var chunks [][]byte
+	for {
+		chunk, err := c.NextBytes()
+		if err == io.EOF {
+			return chunks
+		}
+		require.NoError(t, err)
+		chunks = append(chunks, chunk)
+	}
+}
+
+func TestFastCDC(t *testing.T) {
+	data := make([]byte, 1<<20)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	t.Run("sizes", func(t *testing.T) {
+		chunks := split(t, data)
+		assert.Equal(t, data, bytes.Join(chunks, nil))
+		for _, chunk := range chunks[:len(chunks)-1] {
+			assert.GreaterOrEqual(t, len(chunk), 2<<10)
+			assert.LessOrEqual(t, len(chunk), 32<<10)
+		}
+		assert.Greater(t, len(chunks), 1<<20/(32<<10))
+	})
+	t.Run("insertion changes only nearby chunks", func(t *testing.T) {
+		modified := make([]byte, 0, len(data)+10)
+		modified = append(modified, data[:len(data)/2]...)
+		modified = append(modified, []byte("inserted!!")...)
+		modified = append(modified, data[len(data)/2:]...)
+
+		original := map[string]struct{}{}
+		for _, chunk := range split(t, data) {
+			original[string(chunk)] = struct{}{}
+		}
+		chunks := split(t, modified)
+		var changed int
+		for _, chunk := range chunks {
+			if _, ok := original[string(chunk)]; !ok {
+				changed++
+			}
+		}
+		assert.LessOrEqual(t, changed, 2)
+	})
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, split(t, nil))
+	})
+}
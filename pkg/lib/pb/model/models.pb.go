@@ -678,10 +678,12 @@ func (BlockContentFileState) EnumDescriptor() ([]byte, []int) {
 type BlockContentDataviewViewType int32
 
 const (
-	BlockContentDataviewView_Table   BlockContentDataviewViewType = 0
-	BlockContentDataviewView_List    BlockContentDataviewViewType = 1
-	BlockContentDataviewView_Gallery BlockContentDataviewViewType = 2
-	BlockContentDataviewView_Kanban  BlockContentDataviewViewType = 3
+	BlockContentDataviewView_Table    BlockContentDataviewViewType = 0
+	BlockContentDataviewView_List     BlockContentDataviewViewType = 1
+	BlockContentDataviewView_Gallery  BlockContentDataviewViewType = 2
+	BlockContentDataviewView_Kanban   BlockContentDataviewViewType = 3
+	BlockContentDataviewView_Calendar BlockContentDataviewViewType = 4
+	BlockContentDataviewView_Timeline BlockContentDataviewViewType = 5
 )
 
 var BlockContentDataviewViewType_name = map[int32]string{
@@ -689,13 +691,17 @@ var BlockContentDataviewViewType_name = map[int32]string{
 	1: "List",
 	2: "Gallery",
 	3: "Kanban",
+	4: "Calendar",
+	5: "Timeline",
 }
 
 var BlockContentDataviewViewType_value = map[string]int32{
-	"Table":   0,
-	"List":    1,
-	"Gallery": 2,
-	"Kanban":  3,
+	"Table":    0,
+	"List":     1,
+	"Gallery":  2,
+	"Kanban":   3,
+	"Calendar": 4,
+	"Timeline": 5,
 }
 
 func (x BlockContentDataviewViewType) String() string {
@@ -826,16 +832,20 @@ type BlockContentDataviewFilterOperator int32
 const (
 	BlockContentDataviewFilter_And BlockContentDataviewFilterOperator = 0
 	BlockContentDataviewFilter_Or  BlockContentDataviewFilterOperator = 1
+	// negation of the And group
+	BlockContentDataviewFilter_Not BlockContentDataviewFilterOperator = 2
 )
 
 var BlockContentDataviewFilterOperator_name = map[int32]string{
 	0: "And",
 	1: "Or",
+	2: "Not",
 }
 
 var BlockContentDataviewFilterOperator_value = map[string]int32{
 	"And": 0,
 	"Or":  1,
+	"Not": 2,
 }
 
 func (x BlockContentDataviewFilterOperator) String() string {
@@ -867,6 +877,10 @@ const (
 	BlockContentDataviewFilter_ExactIn        BlockContentDataviewFilterCondition = 15
 	BlockContentDataviewFilter_NotExactIn     BlockContentDataviewFilterCondition = 16
 	BlockContentDataviewFilter_Exists         BlockContentDataviewFilterCondition = 17
+	BlockContentDataviewFilter_Regex          BlockContentDataviewFilterCondition = 18
+	BlockContentDataviewFilter_StartsWith     BlockContentDataviewFilterCondition = 19
+	BlockContentDataviewFilter_EndsWith       BlockContentDataviewFilterCondition = 20
+	BlockContentDataviewFilter_WholeWord      BlockContentDataviewFilterCondition = 21
 )
 
 var BlockContentDataviewFilterCondition_name = map[int32]string{
@@ -888,6 +902,10 @@ var BlockContentDataviewFilterCondition_name = map[int32]string{
 	15: "ExactIn",
 	16: "NotExactIn",
 	17: "Exists",
+	18: "Regex",
+	19: "StartsWith",
+	20: "EndsWith",
+	21: "WholeWord",
 }
 
 var BlockContentDataviewFilterCondition_value = map[string]int32{
@@ -909,6 +927,10 @@ var BlockContentDataviewFilterCondition_value = map[string]int32{
 	"ExactIn":        15,
 	"NotExactIn":     16,
 	"Exists":         17,
+	"Regex":          18,
+	"StartsWith":     19,
+	"EndsWith":       20,
+	"WholeWord":      21,
 }
 
 func (x BlockContentDataviewFilterCondition) String() string {
@@ -974,6 +996,37 @@ func (BlockContentDataviewFilterQuickOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_98a910b73321e591, []int{1, 1, 9, 3, 2}
 }
 
+type BlockContentTableColumnType int32
+
+const (
+	BlockContentTableColumn_Text     BlockContentTableColumnType = 0
+	BlockContentTableColumn_Number   BlockContentTableColumnType = 1
+	BlockContentTableColumn_Date     BlockContentTableColumnType = 2
+	BlockContentTableColumn_Checkbox BlockContentTableColumnType = 3
+)
+
+var BlockContentTableColumnType_name = map[int32]string{
+	0: "Text",
+	1: "Number",
+	2: "Date",
+	3: "Checkbox",
+}
+
+var BlockContentTableColumnType_value = map[string]int32{
+	"Text":     0,
+	"Number":   1,
+	"Date":     2,
+	"Checkbox": 3,
+}
+
+func (x BlockContentTableColumnType) String() string {
+	return proto.EnumName(BlockContentTableColumnType_name, int32(x))
+}
+
+func (BlockContentTableColumnType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{1, 1, 14, 0}
+}
+
 type BlockContentWidgetLayout int32
 
 const (
@@ -1284,6 +1337,43 @@ func (RelationDataSource) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_98a910b73321e591, []int{11, 1}
 }
 
+type RelationViolationRule int32
+
+const (
+	RelationViolation_Required    RelationViolationRule = 0
+	RelationViolation_Unique      RelationViolationRule = 1
+	RelationViolation_MinValue    RelationViolationRule = 2
+	RelationViolation_MaxValue    RelationViolationRule = 3
+	RelationViolation_Regex       RelationViolationRule = 4
+	RelationViolation_ObjectTypes RelationViolationRule = 5
+)
+
+var RelationViolationRule_name = map[int32]string{
+	0: "Required",
+	1: "Unique",
+	2: "MinValue",
+	3: "MaxValue",
+	4: "Regex",
+	5: "ObjectTypes",
+}
+
+var RelationViolationRule_value = map[string]int32{
+	"Required":    0,
+	"Unique":      1,
+	"MinValue":    2,
+	"MaxValue":    3,
+	"Regex":       4,
+	"ObjectTypes": 5,
+}
+
+func (x RelationViolationRule) String() string {
+	return proto.EnumName(RelationViolationRule_name, int32(x))
+}
+
+func (RelationViolationRule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{11, 2, 0}
+}
+
 // Use such a weird construction due to the issue with imported repeated enum type
 // Look https://github.com/golang/protobuf/issues/1135 for more information.
 type InternalFlagValue int32
@@ -1914,13 +2004,14 @@ func (m *BlockContentLayout) GetStyle() BlockContentLayoutStyle {
 
 // Link: block to link some content from an external sources.
 type BlockContentLink struct {
-	TargetBlockId string                      `protobuf:"bytes,1,opt,name=targetBlockId,proto3" json:"targetBlockId,omitempty"`
-	Style         BlockContentLinkStyle       `protobuf:"varint,2,opt,name=style,proto3,enum=anytype.model.BlockContentLinkStyle" json:"style,omitempty"`
-	Fields        *types.Struct               `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
-	IconSize      BlockContentLinkIconSize    `protobuf:"varint,4,opt,name=iconSize,proto3,enum=anytype.model.BlockContentLinkIconSize" json:"iconSize,omitempty"`
-	CardStyle     BlockContentLinkCardStyle   `protobuf:"varint,5,opt,name=cardStyle,proto3,enum=anytype.model.BlockContentLinkCardStyle" json:"cardStyle,omitempty"`
-	Description   BlockContentLinkDescription `protobuf:"varint,6,opt,name=description,proto3,enum=anytype.model.BlockContentLinkDescription" json:"description,omitempty"`
-	Relations     []string                    `protobuf:"bytes,7,rep,name=relations,proto3" json:"relations,omitempty"`
+	TargetBlockId    string                      `protobuf:"bytes,1,opt,name=targetBlockId,proto3" json:"targetBlockId,omitempty"`
+	Style            BlockContentLinkStyle       `protobuf:"varint,2,opt,name=style,proto3,enum=anytype.model.BlockContentLinkStyle" json:"style,omitempty"`
+	Fields           *types.Struct               `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	IconSize         BlockContentLinkIconSize    `protobuf:"varint,4,opt,name=iconSize,proto3,enum=anytype.model.BlockContentLinkIconSize" json:"iconSize,omitempty"`
+	CardStyle        BlockContentLinkCardStyle   `protobuf:"varint,5,opt,name=cardStyle,proto3,enum=anytype.model.BlockContentLinkCardStyle" json:"cardStyle,omitempty"`
+	Description      BlockContentLinkDescription `protobuf:"varint,6,opt,name=description,proto3,enum=anytype.model.BlockContentLinkDescription" json:"description,omitempty"`
+	Relations        []string                    `protobuf:"bytes,7,rep,name=relations,proto3" json:"relations,omitempty"`
+	TargetSubBlockId string                      `protobuf:"bytes,8,opt,name=targetSubBlockId,proto3" json:"targetSubBlockId,omitempty"`
 }
 
 func (m *BlockContentLink) Reset()         { *m = BlockContentLink{} }
@@ -2005,6 +2096,13 @@ func (m *BlockContentLink) GetRelations() []string {
 	return nil
 }
 
+func (m *BlockContentLink) GetTargetSubBlockId() string {
+	if m != nil {
+		return m.TargetSubBlockId
+	}
+	return ""
+}
+
 // Divider: block, that contains only one horizontal thin line
 type BlockContentDiv struct {
 	Style BlockContentDivStyle `protobuf:"varint,1,opt,name=style,proto3,enum=anytype.model.BlockContentDivStyle" json:"style,omitempty"`
@@ -2691,6 +2789,10 @@ type BlockContentDataviewView struct {
 	GroupBackgroundColors bool                            `protobuf:"varint,12,opt,name=groupBackgroundColors,proto3" json:"groupBackgroundColors,omitempty"`
 	PageLimit             int32                           `protobuf:"varint,13,opt,name=pageLimit,proto3" json:"pageLimit,omitempty"`
 	DefaultTemplateId     string                          `protobuf:"bytes,14,opt,name=defaultTemplateId,proto3" json:"defaultTemplateId,omitempty"`
+	DateRelationKey       string                          `protobuf:"bytes,15,opt,name=dateRelationKey,proto3" json:"dateRelationKey,omitempty"`
+	EndDateRelationKey    string                          `protobuf:"bytes,16,opt,name=endDateRelationKey,proto3" json:"endDateRelationKey,omitempty"`
+	DependencyRelationKey string                          `protobuf:"bytes,17,opt,name=dependencyRelationKey,proto3" json:"dependencyRelationKey,omitempty"`
+	Query                 string                          `protobuf:"bytes,18,opt,name=query,proto3" json:"query,omitempty"`
 }
 
 func (m *BlockContentDataviewView) Reset()         { *m = BlockContentDataviewView{} }
@@ -2824,6 +2926,34 @@ func (m *BlockContentDataviewView) GetDefaultTemplateId() string {
 	return ""
 }
 
+func (m *BlockContentDataviewView) GetDateRelationKey() string {
+	if m != nil {
+		return m.DateRelationKey
+	}
+	return ""
+}
+
+func (m *BlockContentDataviewView) GetEndDateRelationKey() string {
+	if m != nil {
+		return m.EndDateRelationKey
+	}
+	return ""
+}
+
+func (m *BlockContentDataviewView) GetDependencyRelationKey() string {
+	if m != nil {
+		return m.DependencyRelationKey
+	}
+	return ""
+}
+
+func (m *BlockContentDataviewView) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
 type BlockContentDataviewRelation struct {
 	Key             string                                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	IsVisible       bool                                   `protobuf:"varint,2,opt,name=isVisible,proto3" json:"isVisible,omitempty"`
@@ -2915,6 +3045,8 @@ type BlockContentDataviewSort struct {
 	CustomOrder []*types.Value               `protobuf:"bytes,3,rep,name=customOrder,proto3" json:"customOrder,omitempty"`
 	Format      RelationFormat               `protobuf:"varint,4,opt,name=format,proto3,enum=anytype.model.RelationFormat" json:"format,omitempty"`
 	IncludeTime bool                         `protobuf:"varint,5,opt,name=includeTime,proto3" json:"includeTime,omitempty"`
+	// sorts by the relation of the first object linked by RelationKey
+	RelationProperty string `protobuf:"bytes,7,opt,name=relationProperty,proto3" json:"relationProperty,omitempty"`
 }
 
 func (m *BlockContentDataviewSort) Reset()         { *m = BlockContentDataviewSort{} }
@@ -2992,16 +3124,26 @@ func (m *BlockContentDataviewSort) GetIncludeTime() bool {
 	return false
 }
 
+func (m *BlockContentDataviewSort) GetRelationProperty() string {
+	if m != nil {
+		return m.RelationProperty
+	}
+	return ""
+}
+
 type BlockContentDataviewFilter struct {
-	Id               string                                `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
-	Operator         BlockContentDataviewFilterOperator    `protobuf:"varint,1,opt,name=operator,proto3,enum=anytype.model.BlockContentDataviewFilterOperator" json:"operator,omitempty"`
-	RelationKey      string                                `protobuf:"bytes,2,opt,name=RelationKey,proto3" json:"RelationKey,omitempty"`
+	Id          string                             `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	Operator    BlockContentDataviewFilterOperator `protobuf:"varint,1,opt,name=operator,proto3,enum=anytype.model.BlockContentDataviewFilterOperator" json:"operator,omitempty"`
+	RelationKey string                             `protobuf:"bytes,2,opt,name=RelationKey,proto3" json:"RelationKey,omitempty"`
+	// filters by the relation of objects linked by RelationKey, the object matches when any of linked objects matches
 	RelationProperty string                                `protobuf:"bytes,5,opt,name=relationProperty,proto3" json:"relationProperty,omitempty"`
 	Condition        BlockContentDataviewFilterCondition   `protobuf:"varint,3,opt,name=condition,proto3,enum=anytype.model.BlockContentDataviewFilterCondition" json:"condition,omitempty"`
 	Value            *types.Value                          `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 	QuickOption      BlockContentDataviewFilterQuickOption `protobuf:"varint,6,opt,name=quickOption,proto3,enum=anytype.model.BlockContentDataviewFilterQuickOption" json:"quickOption,omitempty"`
 	Format           RelationFormat                        `protobuf:"varint,7,opt,name=format,proto3,enum=anytype.model.RelationFormat" json:"format,omitempty"`
 	IncludeTime      bool                                  `protobuf:"varint,8,opt,name=includeTime,proto3" json:"includeTime,omitempty"`
+	// the filter with nested filters is the group, its condition and relation are ignored
+	NestedFilters []*BlockContentDataviewFilter `protobuf:"bytes,10,rep,name=nestedFilters,proto3" json:"nestedFilters,omitempty"`
 }
 
 func (m *BlockContentDataviewFilter) Reset()         { *m = BlockContentDataviewFilter{} }
@@ -3100,6 +3242,13 @@ func (m *BlockContentDataviewFilter) GetIncludeTime() bool {
 	return false
 }
 
+func (m *BlockContentDataviewFilter) GetNestedFilters() []*BlockContentDataviewFilter {
+	if m != nil {
+		return m.NestedFilters
+	}
+	return nil
+}
+
 type BlockContentDataviewGroupOrder struct {
 	ViewId     string                           `protobuf:"bytes,1,opt,name=viewId,proto3" json:"viewId,omitempty"`
 	ViewGroups []*BlockContentDataviewViewGroup `protobuf:"bytes,2,rep,name=viewGroups,proto3" json:"viewGroups,omitempty"`
@@ -3613,6 +3762,8 @@ func (m *BlockContentRelation) GetKey() string {
 
 type BlockContentLatex struct {
 	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// equation label, labeled equations are numbered within the object and can be referenced from text marks
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 }
 
 func (m *BlockContentLatex) Reset()         { *m = BlockContentLatex{} }
@@ -3655,6 +3806,13 @@ func (m *BlockContentLatex) GetText() string {
 	return ""
 }
 
+func (m *BlockContentLatex) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type BlockContentTableOfContents struct {
 }
 
@@ -3728,6 +3886,7 @@ func (m *BlockContentTable) XXX_DiscardUnknown() {
 var xxx_messageInfo_BlockContentTable proto.InternalMessageInfo
 
 type BlockContentTableColumn struct {
+	Type BlockContentTableColumnType `protobuf:"varint,1,opt,name=type,proto3,enum=anytype.model.BlockContentTableColumnType" json:"type,omitempty"`
 }
 
 func (m *BlockContentTableColumn) Reset()         { *m = BlockContentTableColumn{} }
@@ -3763,6 +3922,13 @@ func (m *BlockContentTableColumn) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BlockContentTableColumn proto.InternalMessageInfo
 
+func (m *BlockContentTableColumn) GetType() BlockContentTableColumnType {
+	if m != nil {
+		return m.Type
+	}
+	return BlockContentTableColumn_Text
+}
+
 type BlockContentTableRow struct {
 	IsHeader bool `protobuf:"varint,1,opt,name=isHeader,proto3" json:"isHeader,omitempty"`
 }
@@ -4930,8 +5096,9 @@ type Relation struct {
 	MaxCount    int32             `protobuf:"varint,13,opt,name=maxCount,proto3" json:"maxCount,omitempty"`
 	Description string            `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
 	// on-store fields, injected only locally
-	Scope   RelationScope `protobuf:"varint,20,opt,name=scope,proto3,enum=anytype.model.RelationScope" json:"scope,omitempty"`
-	Creator string        `protobuf:"bytes,21,opt,name=creator,proto3" json:"creator,omitempty"`
+	Scope   RelationScope  `protobuf:"varint,20,opt,name=scope,proto3,enum=anytype.model.RelationScope" json:"scope,omitempty"`
+	Creator string         `protobuf:"bytes,21,opt,name=creator,proto3" json:"creator,omitempty"`
+	Rules   *RelationRules `protobuf:"bytes,22,opt,name=rules,proto3" json:"rules,omitempty"`
 }
 
 func (m *Relation) Reset()         { *m = Relation{} }
@@ -5079,6 +5246,267 @@ func (m *Relation) GetCreator() string {
 	return ""
 }
 
+func (m *Relation) GetRules() *RelationRules {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type RelationRules struct {
+	Required bool         `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	Unique   bool         `protobuf:"varint,2,opt,name=unique,proto3" json:"unique,omitempty"`
+	MinValue *types.Value `protobuf:"bytes,3,opt,name=minValue,proto3" json:"minValue,omitempty"`
+	MaxValue *types.Value `protobuf:"bytes,4,opt,name=maxValue,proto3" json:"maxValue,omitempty"`
+	Regex    string       `protobuf:"bytes,5,opt,name=regex,proto3" json:"regex,omitempty"`
+}
+
+func (m *RelationRules) Reset()         { *m = RelationRules{} }
+func (m *RelationRules) String() string { return proto.CompactTextString(m) }
+func (*RelationRules) ProtoMessage()    {}
+func (*RelationRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{11, 0}
+}
+func (m *RelationRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelationRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelationRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelationRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationRules.Merge(m, src)
+}
+func (m *RelationRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelationRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationRules proto.InternalMessageInfo
+
+func (m *RelationRules) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *RelationRules) GetUnique() bool {
+	if m != nil {
+		return m.Unique
+	}
+	return false
+}
+
+func (m *RelationRules) GetMinValue() *types.Value {
+	if m != nil {
+		return m.MinValue
+	}
+	return nil
+}
+
+func (m *RelationRules) GetMaxValue() *types.Value {
+	if m != nil {
+		return m.MaxValue
+	}
+	return nil
+}
+
+func (m *RelationRules) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+// value of the relation set by the change of the object
+type RelationValueChange struct {
+	ObjectId    string       `protobuf:"bytes,1,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	RelationKey string       `protobuf:"bytes,2,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	Value       *types.Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	PrevValue   *types.Value `protobuf:"bytes,4,opt,name=prevValue,proto3" json:"prevValue,omitempty"`
+	AuthorId    string       `protobuf:"bytes,5,opt,name=authorId,proto3" json:"authorId,omitempty"`
+	Identity    string       `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	ChangeId    string       `protobuf:"bytes,7,opt,name=changeId,proto3" json:"changeId,omitempty"`
+	Time        int64        `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	DeviceId    string       `protobuf:"bytes,9,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+}
+
+func (m *RelationValueChange) Reset()         { *m = RelationValueChange{} }
+func (m *RelationValueChange) String() string { return proto.CompactTextString(m) }
+func (*RelationValueChange) ProtoMessage()    {}
+func (*RelationValueChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{11, 1}
+}
+func (m *RelationValueChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelationValueChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelationValueChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelationValueChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationValueChange.Merge(m, src)
+}
+func (m *RelationValueChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelationValueChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationValueChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationValueChange proto.InternalMessageInfo
+
+func (m *RelationValueChange) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *RelationValueChange) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *RelationValueChange) GetValue() *types.Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *RelationValueChange) GetPrevValue() *types.Value {
+	if m != nil {
+		return m.PrevValue
+	}
+	return nil
+}
+
+func (m *RelationValueChange) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+func (m *RelationValueChange) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *RelationValueChange) GetChangeId() string {
+	if m != nil {
+		return m.ChangeId
+	}
+	return ""
+}
+
+func (m *RelationValueChange) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *RelationValueChange) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+// value of the relation in the object that violates the rule of the relation
+type RelationViolation struct {
+	ObjectId    string                `protobuf:"bytes,1,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	RelationKey string                `protobuf:"bytes,2,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	Rule        RelationViolationRule `protobuf:"varint,3,opt,name=rule,proto3,enum=anytype.model.RelationViolationRule" json:"rule,omitempty"`
+	Description string                `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *RelationViolation) Reset()         { *m = RelationViolation{} }
+func (m *RelationViolation) String() string { return proto.CompactTextString(m) }
+func (*RelationViolation) ProtoMessage()    {}
+func (*RelationViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{11, 2}
+}
+func (m *RelationViolation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelationViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelationViolation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelationViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationViolation.Merge(m, src)
+}
+func (m *RelationViolation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelationViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationViolation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationViolation proto.InternalMessageInfo
+
+func (m *RelationViolation) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *RelationViolation) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *RelationViolation) GetRule() RelationViolationRule {
+	if m != nil {
+		return m.Rule
+	}
+	return RelationViolation_Required
+}
+
+func (m *RelationViolation) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 type RelationOption struct {
 	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Text  string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
@@ -5091,7 +5519,7 @@ func (m *RelationOption) Reset()         { *m = RelationOption{} }
 func (m *RelationOption) String() string { return proto.CompactTextString(m) }
 func (*RelationOption) ProtoMessage()    {}
 func (*RelationOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{11, 0}
+	return fileDescriptor_98a910b73321e591, []int{11, 3}
 }
 func (m *RelationOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5335,14 +5763,15 @@ func (m *InternalFlag) GetValue() InternalFlagValue {
 // Works with a smart blocks: Page, Dashboard
 // Dashboard opened, click on a page, Rpc.Block.open, Block.ShowFullscreen(PageBlock)
 type ObjectView struct {
-	RootId        string                  `protobuf:"bytes,1,opt,name=rootId,proto3" json:"rootId,omitempty"`
-	Blocks        []*Block                `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
-	Details       []*ObjectViewDetailsSet `protobuf:"bytes,3,rep,name=details,proto3" json:"details,omitempty"`
-	Type          SmartBlockType          `protobuf:"varint,4,opt,name=type,proto3,enum=anytype.model.SmartBlockType" json:"type,omitempty"`
-	Relations     []*Relation             `protobuf:"bytes,7,rep,name=relations,proto3" json:"relations,omitempty"`
-	RelationLinks []*RelationLink         `protobuf:"bytes,10,rep,name=relationLinks,proto3" json:"relationLinks,omitempty"`
-	Restrictions  *Restrictions           `protobuf:"bytes,8,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
-	History       *ObjectViewHistorySize  `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
+	RootId        string                    `protobuf:"bytes,1,opt,name=rootId,proto3" json:"rootId,omitempty"`
+	Blocks        []*Block                  `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Details       []*ObjectViewDetailsSet   `protobuf:"bytes,3,rep,name=details,proto3" json:"details,omitempty"`
+	Type          SmartBlockType            `protobuf:"varint,4,opt,name=type,proto3,enum=anytype.model.SmartBlockType" json:"type,omitempty"`
+	Relations     []*Relation               `protobuf:"bytes,7,rep,name=relations,proto3" json:"relations,omitempty"`
+	RelationLinks []*RelationLink           `protobuf:"bytes,10,rep,name=relationLinks,proto3" json:"relationLinks,omitempty"`
+	Restrictions  *Restrictions             `protobuf:"bytes,8,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	History       *ObjectViewHistorySize    `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
+	SyncedBlocks  []*ObjectViewSyncedBlocks `protobuf:"bytes,11,rep,name=syncedBlocks,proto3" json:"syncedBlocks,omitempty"`
 }
 
 func (m *ObjectView) Reset()         { *m = ObjectView{} }
@@ -5434,6 +5863,13 @@ func (m *ObjectView) GetHistory() *ObjectViewHistorySize {
 	return nil
 }
 
+func (m *ObjectView) GetSyncedBlocks() []*ObjectViewSyncedBlocks {
+	if m != nil {
+		return m.SyncedBlocks
+	}
+	return nil
+}
+
 type ObjectViewDetailsSet struct {
 	Id      string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Details *types.Struct `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
@@ -5546,23 +5982,23 @@ func (m *ObjectViewRelationWithValuePerObject) GetRelations() []*RelationWithVal
 	return nil
 }
 
-type ObjectViewHistorySize struct {
-	Undo int32 `protobuf:"varint,1,opt,name=undo,proto3" json:"undo,omitempty"`
-	Redo int32 `protobuf:"varint,2,opt,name=redo,proto3" json:"redo,omitempty"`
+type ObjectViewSyncedBlocks struct {
+	ObjectId string   `protobuf:"bytes,1,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	Blocks   []*Block `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
 }
 
-func (m *ObjectViewHistorySize) Reset()         { *m = ObjectViewHistorySize{} }
-func (m *ObjectViewHistorySize) String() string { return proto.CompactTextString(m) }
-func (*ObjectViewHistorySize) ProtoMessage()    {}
-func (*ObjectViewHistorySize) Descriptor() ([]byte, []int) {
+func (m *ObjectViewSyncedBlocks) Reset()         { *m = ObjectViewSyncedBlocks{} }
+func (m *ObjectViewSyncedBlocks) String() string { return proto.CompactTextString(m) }
+func (*ObjectViewSyncedBlocks) ProtoMessage()    {}
+func (*ObjectViewSyncedBlocks) Descriptor() ([]byte, []int) {
 	return fileDescriptor_98a910b73321e591, []int{16, 2}
 }
-func (m *ObjectViewHistorySize) XXX_Unmarshal(b []byte) error {
+func (m *ObjectViewSyncedBlocks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ObjectViewHistorySize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ObjectViewSyncedBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ObjectViewHistorySize.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ObjectViewSyncedBlocks.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5572,10 +6008,62 @@ func (m *ObjectViewHistorySize) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ObjectViewHistorySize) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ObjectViewHistorySize.Merge(m, src)
+func (m *ObjectViewSyncedBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectViewSyncedBlocks.Merge(m, src)
 }
-func (m *ObjectViewHistorySize) XXX_Size() int {
+func (m *ObjectViewSyncedBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectViewSyncedBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectViewSyncedBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectViewSyncedBlocks proto.InternalMessageInfo
+
+func (m *ObjectViewSyncedBlocks) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *ObjectViewSyncedBlocks) GetBlocks() []*Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type ObjectViewHistorySize struct {
+	Undo int32 `protobuf:"varint,1,opt,name=undo,proto3" json:"undo,omitempty"`
+	Redo int32 `protobuf:"varint,2,opt,name=redo,proto3" json:"redo,omitempty"`
+}
+
+func (m *ObjectViewHistorySize) Reset()         { *m = ObjectViewHistorySize{} }
+func (m *ObjectViewHistorySize) String() string { return proto.CompactTextString(m) }
+func (*ObjectViewHistorySize) ProtoMessage()    {}
+func (*ObjectViewHistorySize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{16, 3}
+}
+func (m *ObjectViewHistorySize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectViewHistorySize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectViewHistorySize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectViewHistorySize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectViewHistorySize.Merge(m, src)
+}
+func (m *ObjectViewHistorySize) XXX_Size() int {
 	return m.Size()
 }
 func (m *ObjectViewHistorySize) XXX_DiscardUnknown() {
@@ -5624,6 +6112,7 @@ func init() {
 	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterOperator", BlockContentDataviewFilterOperator_name, BlockContentDataviewFilterOperator_value)
 	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterCondition", BlockContentDataviewFilterCondition_name, BlockContentDataviewFilterCondition_value)
 	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterQuickOption", BlockContentDataviewFilterQuickOption_name, BlockContentDataviewFilterQuickOption_value)
+	proto.RegisterEnum("anytype.model.BlockContentTableColumnType", BlockContentTableColumnType_name, BlockContentTableColumnType_value)
 	proto.RegisterEnum("anytype.model.BlockContentWidgetLayout", BlockContentWidgetLayout_name, BlockContentWidgetLayout_value)
 	proto.RegisterEnum("anytype.model.AccountStatusType", AccountStatusType_name, AccountStatusType_value)
 	proto.RegisterEnum("anytype.model.LinkPreviewType", LinkPreviewType_name, LinkPreviewType_value)
@@ -5632,6 +6121,7 @@ func init() {
 	proto.RegisterEnum("anytype.model.ObjectTypeLayout", ObjectTypeLayout_name, ObjectTypeLayout_value)
 	proto.RegisterEnum("anytype.model.RelationScope", RelationScope_name, RelationScope_value)
 	proto.RegisterEnum("anytype.model.RelationDataSource", RelationDataSource_name, RelationDataSource_value)
+	proto.RegisterEnum("anytype.model.RelationViolationRule", RelationViolationRule_name, RelationViolationRule_value)
 	proto.RegisterEnum("anytype.model.InternalFlagValue", InternalFlagValue_name, InternalFlagValue_value)
 	proto.RegisterType((*SmartBlockSnapshotBase)(nil), "anytype.model.SmartBlockSnapshotBase")
 	proto.RegisterType((*Block)(nil), "anytype.model.Block")
@@ -5684,6 +6174,9 @@ func init() {
 	proto.RegisterType((*Layout)(nil), "anytype.model.Layout")
 	proto.RegisterType((*RelationWithValue)(nil), "anytype.model.RelationWithValue")
 	proto.RegisterType((*Relation)(nil), "anytype.model.Relation")
+	proto.RegisterType((*RelationRules)(nil), "anytype.model.Relation.Rules")
+	proto.RegisterType((*RelationValueChange)(nil), "anytype.model.Relation.ValueChange")
+	proto.RegisterType((*RelationViolation)(nil), "anytype.model.Relation.Violation")
 	proto.RegisterType((*RelationOption)(nil), "anytype.model.Relation.Option")
 	proto.RegisterType((*RelationLink)(nil), "anytype.model.RelationLink")
 	proto.RegisterType((*Relations)(nil), "anytype.model.Relations")
@@ -5692,6 +6185,7 @@ func init() {
 	proto.RegisterType((*ObjectView)(nil), "anytype.model.ObjectView")
 	proto.RegisterType((*ObjectViewDetailsSet)(nil), "anytype.model.ObjectView.DetailsSet")
 	proto.RegisterType((*ObjectViewRelationWithValuePerObject)(nil), "anytype.model.ObjectView.RelationWithValuePerObject")
+	proto.RegisterType((*ObjectViewSyncedBlocks)(nil), "anytype.model.ObjectView.SyncedBlocks")
 	proto.RegisterType((*ObjectViewHistorySize)(nil), "anytype.model.ObjectView.HistorySize")
 }
 
@@ -5700,331 +6194,358 @@ func init() {
 }
 
 var fileDescriptor_98a910b73321e591 = []byte{
-	// 5184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7b, 0x4d, 0x6c, 0x24, 0xc7,
-	0x75, 0x30, 0xe7, 0x7f, 0xe6, 0x0d, 0xc9, 0x2d, 0x96, 0xa8, 0xd5, 0x7c, 0x2d, 0x79, 0x3f, 0xba,
-	0x23, 0xcb, 0xeb, 0xb5, 0xcc, 0x95, 0xf6, 0xc7, 0x92, 0x9d, 0x48, 0x32, 0x7f, 0x76, 0x4d, 0x42,
-	0xbb, 0x22, 0xdd, 0x43, 0x71, 0x63, 0x21, 0x09, 0x5c, 0x33, 0x5d, 0x9c, 0x69, 0xb1, 0xa7, 0x6b,
-	0xdc, 0x5d, 0xc3, 0x25, 0x0d, 0x04, 0x70, 0xfe, 0x1c, 0x20, 0x87, 0xc0, 0x08, 0x90, 0x63, 0x00,
-	0xe7, 0x9e, 0x5b, 0x60, 0xc4, 0x01, 0x72, 0xc8, 0x25, 0x40, 0x80, 0x1c, 0xe2, 0xdc, 0x02, 0x04,
-	0x48, 0x02, 0xeb, 0x98, 0x43, 0x80, 0x9c, 0x73, 0x08, 0xde, 0xab, 0xea, 0x9e, 0x9e, 0x1f, 0x72,
-	0x87, 0xb2, 0x4f, 0xd3, 0xef, 0xf5, 0x7b, 0xaf, 0x5f, 0x55, 0xbd, 0x7a, 0x7f, 0x55, 0x03, 0xaf,
-	0x0f, 0x4f, 0x7b, 0x77, 0xc3, 0xa0, 0x73, 0x77, 0xd8, 0xb9, 0x3b, 0x50, 0xbe, 0x0c, 0xef, 0x0e,
-	0x63, 0xa5, 0x55, 0x62, 0x80, 0x64, 0x93, 0x20, 0xbe, 0x22, 0xa2, 0x0b, 0x7d, 0x31, 0x94, 0x9b,
-	0x84, 0x75, 0x5e, 0xeb, 0x29, 0xd5, 0x0b, 0xa5, 0x21, 0xed, 0x8c, 0x4e, 0xee, 0x26, 0x3a, 0x1e,
-	0x75, 0xb5, 0x21, 0x76, 0xff, 0xa1, 0x04, 0x37, 0xdb, 0x03, 0x11, 0xeb, 0xed, 0x50, 0x75, 0x4f,
-	0xdb, 0x91, 0x18, 0x26, 0x7d, 0xa5, 0xb7, 0x45, 0x22, 0xf9, 0x9b, 0x50, 0xed, 0x20, 0x32, 0x69,
-	0x15, 0x36, 0x4a, 0xb7, 0x9b, 0xf7, 0xd6, 0x37, 0x27, 0x04, 0x6f, 0x12, 0x87, 0x67, 0x69, 0xf8,
-	0xdb, 0x50, 0xf3, 0xa5, 0x16, 0x41, 0x98, 0xb4, 0x8a, 0x1b, 0x85, 0xdb, 0xcd, 0x7b, 0xaf, 0x6c,
-	0x9a, 0x0f, 0x6f, 0xa6, 0x1f, 0xde, 0x6c, 0xd3, 0x87, 0xbd, 0x94, 0x8e, 0xdf, 0x87, 0xfa, 0x49,
-	0x10, 0xca, 0x0f, 0xe5, 0x45, 0xd2, 0x2a, 0x5d, 0xcd, 0x93, 0x11, 0xf2, 0x0f, 0x60, 0x55, 0x9e,
-	0xeb, 0x58, 0x78, 0x32, 0x14, 0x3a, 0x50, 0x51, 0xd2, 0x2a, 0x93, 0x76, 0xaf, 0x4c, 0x69, 0x97,
-	0xbe, 0xf7, 0xa6, 0xc8, 0xf9, 0x06, 0x34, 0x55, 0xe7, 0x53, 0xd9, 0xd5, 0x47, 0x17, 0x43, 0x99,
-	0xb4, 0x2a, 0x1b, 0xa5, 0xdb, 0x0d, 0x2f, 0x8f, 0xe2, 0xdf, 0x80, 0x66, 0x57, 0x85, 0xa1, 0xec,
-	0x1a, 0xf9, 0xd5, 0xab, 0x55, 0xcb, 0xd3, 0xf2, 0x07, 0xf0, 0x72, 0x2c, 0x07, 0xea, 0x4c, 0xfa,
-	0x3b, 0x19, 0x96, 0xc6, 0x57, 0xa7, 0xcf, 0xcc, 0x7f, 0xc9, 0xb7, 0x60, 0x25, 0xb6, 0xfa, 0x3d,
-	0x09, 0xa2, 0xd3, 0xa4, 0x55, 0xa3, 0x21, 0xbd, 0x7a, 0xc9, 0x90, 0x90, 0xc6, 0x9b, 0xe4, 0x70,
-	0xff, 0x64, 0x07, 0x2a, 0xb4, 0x20, 0x7c, 0x15, 0x8a, 0x81, 0xdf, 0x2a, 0x6c, 0x14, 0x6e, 0x37,
-	0xbc, 0x62, 0xe0, 0xf3, 0xbb, 0x50, 0x3d, 0x09, 0x64, 0xe8, 0xbf, 0x70, 0x5d, 0x2c, 0x19, 0x7f,
-	0x04, 0xcb, 0xb1, 0x4c, 0x74, 0x1c, 0xd8, 0xf1, 0x9b, 0xa5, 0xf9, 0xe2, 0xbc, 0xd5, 0xdf, 0xf4,
-	0x72, 0x84, 0xde, 0x04, 0x1b, 0xce, 0x73, 0xb7, 0x1f, 0x84, 0x7e, 0x2c, 0xa3, 0x7d, 0xdf, 0xac,
-	0x52, 0xc3, 0xcb, 0xa3, 0xf8, 0x6d, 0xb8, 0xd1, 0x11, 0xdd, 0xd3, 0x5e, 0xac, 0x46, 0x11, 0x4e,
-	0x89, 0x8a, 0x5b, 0x15, 0x52, 0x7b, 0x1a, 0xcd, 0xdf, 0x82, 0x8a, 0x08, 0x83, 0x5e, 0x44, 0x6b,
-	0xb1, 0x7a, 0xcf, 0x99, 0xab, 0xcb, 0x16, 0x52, 0x78, 0x86, 0x90, 0xef, 0xc1, 0xca, 0x99, 0x8c,
-	0x75, 0xd0, 0x15, 0x21, 0xe1, 0x5b, 0x35, 0xe2, 0x74, 0xe7, 0x72, 0x1e, 0xe7, 0x29, 0xbd, 0x49,
-	0x46, 0xbe, 0x0f, 0x90, 0xe0, 0x06, 0x21, 0x3b, 0x6f, 0x35, 0x69, 0x32, 0xbe, 0x3c, 0x57, 0xcc,
-	0x8e, 0x8a, 0xb4, 0x8c, 0xf4, 0x66, 0x3b, 0x23, 0xdf, 0x5b, 0xf2, 0x72, 0xcc, 0xfc, 0x1d, 0x28,
-	0x6b, 0x79, 0xae, 0x5b, 0xab, 0x57, 0xcc, 0x68, 0x2a, 0xe4, 0x48, 0x9e, 0xeb, 0xbd, 0x25, 0x8f,
-	0x18, 0x90, 0x11, 0x37, 0x40, 0xeb, 0xc6, 0x02, 0x8c, 0x8f, 0x83, 0x50, 0x22, 0x23, 0x32, 0xf0,
-	0xf7, 0xa0, 0x1a, 0x8a, 0x0b, 0x35, 0xd2, 0x2d, 0x46, 0xac, 0xbf, 0x76, 0x25, 0xeb, 0x13, 0x22,
-	0xdd, 0x5b, 0xf2, 0x2c, 0x13, 0x7f, 0x00, 0x25, 0x3f, 0x38, 0x6b, 0xad, 0x11, 0xef, 0xc6, 0x95,
-	0xbc, 0xbb, 0xc1, 0xd9, 0xde, 0x92, 0x87, 0xe4, 0x7c, 0x07, 0xea, 0x1d, 0xa5, 0x4e, 0x07, 0x22,
-	0x3e, 0x6d, 0x71, 0x62, 0xfd, 0xd2, 0x95, 0xac, 0xdb, 0x96, 0x78, 0x6f, 0xc9, 0xcb, 0x18, 0x71,
-	0xc8, 0x41, 0x57, 0x45, 0xad, 0x97, 0x16, 0x18, 0xf2, 0x7e, 0x57, 0x45, 0x38, 0x64, 0x64, 0x40,
-	0xc6, 0x30, 0x88, 0x4e, 0x5b, 0xeb, 0x0b, 0x30, 0xe2, 0xde, 0x41, 0x46, 0x64, 0x40, 0xb5, 0x7d,
-	0xa1, 0xc5, 0x59, 0x20, 0x9f, 0xb7, 0x5e, 0x5e, 0x40, 0xed, 0x5d, 0x4b, 0x8c, 0x6a, 0xa7, 0x8c,
-	0x28, 0x24, 0xdd, 0x98, 0xad, 0x9b, 0x0b, 0x08, 0x49, 0xf7, 0x34, 0x0a, 0x49, 0x19, 0xf9, 0xef,
-	0xc0, 0xda, 0x89, 0x14, 0x7a, 0x14, 0x4b, 0x7f, 0xec, 0xe6, 0x5e, 0x21, 0x69, 0x9b, 0x57, 0xaf,
-	0xfd, 0x34, 0xd7, 0xde, 0x92, 0x37, 0x2b, 0x8a, 0x7f, 0x13, 0x2a, 0xa1, 0xd0, 0xf2, 0xbc, 0xd5,
-	0x22, 0x99, 0xee, 0x0b, 0x8c, 0x42, 0xcb, 0xf3, 0xbd, 0x25, 0xcf, 0xb0, 0xf0, 0xdf, 0x84, 0x1b,
-	0x5a, 0x74, 0x42, 0x79, 0x70, 0x62, 0x09, 0x92, 0xd6, 0xff, 0x23, 0x29, 0x6f, 0x5e, 0x6d, 0xce,
-	0x93, 0x3c, 0x7b, 0x4b, 0xde, 0xb4, 0x18, 0xd4, 0x8a, 0x50, 0x2d, 0x67, 0x01, 0xad, 0x48, 0x1e,
-	0x6a, 0x45, 0x2c, 0xfc, 0x09, 0x34, 0xe9, 0x61, 0x47, 0x85, 0xa3, 0x41, 0xd4, 0x7a, 0x95, 0x24,
-	0xdc, 0x7e, 0xb1, 0x04, 0x43, 0xbf, 0xb7, 0xe4, 0xe5, 0xd9, 0x71, 0x11, 0x09, 0xf4, 0xd4, 0xf3,
-	0xd6, 0x6b, 0x0b, 0x2c, 0xe2, 0x91, 0x25, 0xc6, 0x45, 0x4c, 0x19, 0x71, 0xeb, 0x3d, 0x0f, 0xfc,
-	0x9e, 0xd4, 0xad, 0x2f, 0x2c, 0xb0, 0xf5, 0x9e, 0x11, 0x29, 0x6e, 0x3d, 0xc3, 0xe4, 0xfc, 0x00,
-	0x96, 0xf3, 0xce, 0x95, 0x73, 0x28, 0xc7, 0x52, 0x18, 0xc7, 0x5e, 0xf7, 0xe8, 0x19, 0x71, 0xd2,
-	0x0f, 0x34, 0x39, 0xf6, 0xba, 0x47, 0xcf, 0xfc, 0x26, 0x54, 0x4d, 0x90, 0x21, 0xbf, 0x5d, 0xf7,
-	0x2c, 0x84, 0xb4, 0x7e, 0x2c, 0x7a, 0xad, 0xb2, 0xa1, 0xc5, 0x67, 0xa4, 0xf5, 0x63, 0x35, 0x3c,
-	0x88, 0xc8, 0xef, 0xd6, 0x3d, 0x0b, 0x39, 0x3f, 0x7b, 0x00, 0x35, 0xab, 0x98, 0xf3, 0x17, 0x05,
-	0xa8, 0x1a, 0xbf, 0xc0, 0x3f, 0x80, 0x4a, 0xa2, 0x2f, 0x42, 0x49, 0x3a, 0xac, 0xde, 0xfb, 0xca,
-	0x02, 0xbe, 0x64, 0xb3, 0x8d, 0x0c, 0x9e, 0xe1, 0x73, 0x3d, 0xa8, 0x10, 0xcc, 0x6b, 0x50, 0xf2,
-	0xd4, 0x73, 0xb6, 0xc4, 0x01, 0xaa, 0x66, 0xce, 0x59, 0x01, 0x91, 0xbb, 0xc1, 0x19, 0x2b, 0x22,
-	0x72, 0x4f, 0x0a, 0x5f, 0xc6, 0xac, 0xc4, 0x57, 0xa0, 0x91, 0xce, 0x6e, 0xc2, 0xca, 0x9c, 0xc1,
-	0x72, 0x6e, 0xdd, 0x12, 0x56, 0x71, 0xfe, 0xa7, 0x0c, 0x65, 0xdc, 0xc6, 0xfc, 0x75, 0x58, 0xd1,
-	0x22, 0xee, 0x49, 0x93, 0xc9, 0xec, 0xa7, 0x21, 0x70, 0x12, 0xc9, 0xdf, 0x4b, 0xc7, 0x50, 0xa4,
-	0x31, 0x7c, 0xf9, 0x85, 0xee, 0x61, 0x62, 0x04, 0xb9, 0x60, 0x5a, 0x5a, 0x2c, 0x98, 0x3e, 0x86,
-	0x3a, 0x7a, 0xa5, 0x76, 0xf0, 0x03, 0x49, 0x53, 0xbf, 0x7a, 0xef, 0xce, 0x8b, 0x3f, 0xb9, 0x6f,
-	0x39, 0xbc, 0x8c, 0x97, 0xef, 0x43, 0xa3, 0x2b, 0x62, 0x9f, 0x94, 0xa1, 0xd5, 0x5a, 0xbd, 0xf7,
-	0xd5, 0x17, 0x0b, 0xda, 0x49, 0x59, 0xbc, 0x31, 0x37, 0x3f, 0x80, 0xa6, 0x2f, 0x93, 0x6e, 0x1c,
-	0x0c, 0xc9, 0x4b, 0x99, 0x90, 0xfa, 0xb5, 0x17, 0x0b, 0xdb, 0x1d, 0x33, 0x79, 0x79, 0x09, 0xfc,
-	0x35, 0x68, 0xc4, 0x99, 0x9b, 0xaa, 0x51, 0x9c, 0x1f, 0x23, 0xdc, 0x77, 0xa0, 0x9e, 0x8e, 0x87,
-	0x2f, 0x43, 0x1d, 0x7f, 0x3f, 0x52, 0x91, 0x64, 0x4b, 0xb8, 0xb6, 0x08, 0xb5, 0x07, 0x22, 0x0c,
-	0x59, 0x81, 0xaf, 0x02, 0x20, 0xf8, 0x54, 0xfa, 0xc1, 0x68, 0xc0, 0x8a, 0xee, 0xaf, 0xa7, 0xd6,
-	0x52, 0x87, 0xf2, 0xa1, 0xe8, 0x21, 0xc7, 0x32, 0xd4, 0x53, 0xaf, 0xcb, 0x0a, 0xc8, 0xbf, 0x2b,
-	0x92, 0x7e, 0x47, 0x89, 0xd8, 0x67, 0x45, 0xde, 0x84, 0xda, 0x56, 0xdc, 0xed, 0x07, 0x67, 0x92,
-	0x95, 0xdc, 0xbb, 0xd0, 0xcc, 0xe9, 0x8b, 0x22, 0xec, 0x47, 0x1b, 0x50, 0xd9, 0xf2, 0x7d, 0xe9,
-	0xb3, 0x02, 0x32, 0xd8, 0x01, 0xb2, 0xa2, 0xfb, 0x55, 0x68, 0x64, 0xb3, 0x85, 0xe4, 0x18, 0x7f,
-	0xd9, 0x12, 0x3e, 0x21, 0x9a, 0x15, 0xd0, 0x2a, 0xf7, 0xa3, 0x30, 0x88, 0x24, 0x2b, 0x3a, 0xdf,
-	0x23, 0x53, 0xe5, 0xbf, 0x31, 0xb9, 0x21, 0xde, 0x78, 0x51, 0x80, 0x9c, 0xdc, 0x0d, 0xaf, 0xe6,
-	0xc6, 0xf7, 0x24, 0x20, 0xe5, 0xea, 0x50, 0xde, 0x55, 0x3a, 0x61, 0x05, 0xe7, 0xbf, 0x8a, 0x50,
-	0x4f, 0xe3, 0x22, 0x67, 0x50, 0x1a, 0xc5, 0xa1, 0x35, 0x68, 0x7c, 0xe4, 0xeb, 0x50, 0xd1, 0x81,
-	0xb6, 0x66, 0xdc, 0xf0, 0x0c, 0x80, 0x29, 0x57, 0x7e, 0x65, 0x4b, 0xf4, 0x6e, 0x7a, 0xa9, 0x82,
-	0x81, 0xe8, 0xc9, 0x3d, 0x91, 0xf4, 0xc9, 0x1e, 0x1b, 0xde, 0x18, 0x81, 0xfc, 0x27, 0xe2, 0x0c,
-	0x6d, 0x8e, 0xde, 0x9b, 0x64, 0x2c, 0x8f, 0xe2, 0xf7, 0xa1, 0x8c, 0x03, 0xb4, 0x46, 0xf3, 0xff,
-	0xa7, 0x06, 0x8c, 0x66, 0x72, 0x18, 0x4b, 0x5c, 0x9e, 0x4d, 0x4c, 0xa5, 0x3d, 0x22, 0xe6, 0x6f,
-	0xc0, 0xaa, 0xd9, 0x84, 0x07, 0x94, 0x64, 0xef, 0xfb, 0x94, 0x8c, 0x35, 0xbc, 0x29, 0x2c, 0xdf,
-	0xc2, 0xe9, 0x14, 0x5a, 0xb6, 0xea, 0x0b, 0xd8, 0x77, 0x3a, 0x39, 0x9b, 0x6d, 0x64, 0xf1, 0x0c,
-	0xa7, 0xfb, 0x10, 0xe7, 0x54, 0x68, 0x89, 0xcb, 0xfc, 0x68, 0x30, 0xd4, 0x17, 0xc6, 0x68, 0x1e,
-	0x4b, 0xdd, 0xed, 0x07, 0x51, 0x8f, 0x15, 0xcc, 0x14, 0xe3, 0x22, 0x12, 0x49, 0x1c, 0xab, 0x98,
-	0x95, 0x1c, 0x07, 0xca, 0x68, 0xa3, 0xe8, 0x24, 0x23, 0x31, 0x90, 0x76, 0xa6, 0xe9, 0xd9, 0x79,
-	0x09, 0xd6, 0x66, 0xc2, 0xaa, 0xf3, 0xb7, 0x55, 0x63, 0x21, 0xc8, 0x41, 0x29, 0x9d, 0xe5, 0xa0,
-	0x6c, 0xed, 0x5a, 0x3e, 0x06, 0xa5, 0x4c, 0xfa, 0x98, 0xf7, 0xa0, 0x82, 0x03, 0x4b, 0x5d, 0xcc,
-	0x02, 0xec, 0x4f, 0x91, 0xdc, 0x33, 0x5c, 0xbc, 0x05, 0xb5, 0x6e, 0x5f, 0x76, 0x4f, 0xa5, 0x6f,
-	0x7d, 0x7d, 0x0a, 0xa2, 0xd1, 0x74, 0x73, 0x59, 0xb6, 0x01, 0xc8, 0x24, 0xba, 0x2a, 0x7a, 0x34,
-	0x50, 0x9f, 0x06, 0xb4, 0xae, 0x68, 0x12, 0x29, 0x22, 0x7d, 0xbb, 0x8f, 0x36, 0x62, 0x97, 0x6d,
-	0x8c, 0x70, 0x1e, 0x41, 0x85, 0xbe, 0x8d, 0x3b, 0xc1, 0xe8, 0x6c, 0x4a, 0xc5, 0x37, 0x16, 0xd3,
-	0xd9, 0xaa, 0xec, 0xfc, 0x55, 0x11, 0xca, 0x08, 0xf3, 0x3b, 0x50, 0x89, 0x45, 0xd4, 0x33, 0x0b,
-	0x30, 0x5b, 0x71, 0x7a, 0xf8, 0xce, 0x33, 0x24, 0xfc, 0x03, 0x6b, 0x8a, 0xc5, 0x05, 0x8c, 0x25,
-	0xfb, 0x62, 0xde, 0x2c, 0xd7, 0xa1, 0x32, 0x14, 0xb1, 0x18, 0xd8, 0x7d, 0x62, 0x00, 0xf7, 0x27,
-	0x05, 0x28, 0x23, 0x11, 0x5f, 0x83, 0x95, 0xb6, 0x8e, 0x83, 0x53, 0xa9, 0xfb, 0xb1, 0x1a, 0xf5,
-	0xfa, 0xc6, 0x92, 0x3e, 0x94, 0x17, 0xc6, 0xdf, 0x18, 0x87, 0xa0, 0x45, 0x18, 0x74, 0x59, 0x11,
-	0xad, 0x6a, 0x5b, 0x85, 0x3e, 0x2b, 0xf1, 0x1b, 0xd0, 0xfc, 0x38, 0xf2, 0x65, 0x9c, 0x74, 0x55,
-	0x2c, 0x7d, 0x56, 0xb6, 0xbb, 0xfb, 0x94, 0x55, 0x28, 0x96, 0xc9, 0x73, 0x4d, 0x25, 0x0d, 0xab,
-	0xf2, 0x97, 0xe0, 0xc6, 0xf6, 0x64, 0x9d, 0xc3, 0x6a, 0xe8, 0x93, 0x9e, 0xca, 0x08, 0x8d, 0x8c,
-	0xd5, 0x8d, 0x11, 0xab, 0x4f, 0x03, 0xd6, 0xc0, 0x8f, 0x99, 0x7d, 0xc2, 0xc0, 0xfd, 0xbb, 0x42,
-	0xea, 0x39, 0x56, 0xa0, 0x71, 0x28, 0x62, 0xd1, 0x8b, 0xc5, 0x10, 0xf5, 0x6b, 0x42, 0xcd, 0x04,
-	0xce, 0xb7, 0x8d, 0x77, 0x33, 0xc0, 0x3d, 0xe3, 0x1b, 0x0d, 0x70, 0x9f, 0x95, 0xc6, 0xc0, 0x03,
-	0x56, 0xc6, 0x6f, 0x7c, 0x67, 0xa4, 0xb4, 0x64, 0x15, 0xf2, 0x75, 0xca, 0x97, 0xac, 0x8a, 0xc8,
-	0x23, 0xf4, 0x28, 0xac, 0x86, 0x63, 0xde, 0x41, 0xfb, 0xe9, 0xa8, 0x73, 0x56, 0x47, 0x35, 0x70,
-	0x1a, 0xa5, 0xcf, 0x1a, 0xf8, 0xe6, 0xa3, 0xd1, 0xa0, 0x23, 0x71, 0x98, 0x80, 0x6f, 0x8e, 0x54,
-	0xaf, 0x17, 0x4a, 0xd6, 0xc4, 0x39, 0xc8, 0x39, 0x5f, 0xb6, 0x4c, 0x9e, 0x56, 0x84, 0xa1, 0x1a,
-	0x69, 0xb6, 0xe2, 0xfc, 0xbc, 0x04, 0x65, 0x2c, 0x52, 0x70, 0xef, 0xf4, 0xd1, 0xcf, 0xd8, 0xbd,
-	0x83, 0xcf, 0xd9, 0x0e, 0x2c, 0x8e, 0x77, 0x20, 0xff, 0xa6, 0x5d, 0xe9, 0xd2, 0x02, 0x5e, 0x16,
-	0x05, 0xe7, 0x17, 0x99, 0x43, 0x79, 0x10, 0x0c, 0xa4, 0xf5, 0x75, 0xf4, 0x8c, 0xb8, 0x04, 0xe3,
-	0x31, 0x6e, 0x83, 0x92, 0x47, 0xcf, 0xb8, 0x6b, 0x04, 0x86, 0x85, 0x2d, 0x4d, 0x7b, 0xa0, 0xe4,
-	0xa5, 0xa0, 0xd9, 0xcd, 0xe8, 0x95, 0x6a, 0x0b, 0xec, 0x66, 0xfa, 0x7c, 0xde, 0x23, 0x8d, 0x9d,
-	0x41, 0x7d, 0x71, 0xf6, 0x5c, 0x90, 0xd8, 0xb5, 0xd6, 0x38, 0x0e, 0x60, 0x75, 0x33, 0x7b, 0xac,
-	0x80, 0xab, 0x44, 0xdb, 0xd0, 0xf8, 0xb2, 0xe3, 0xc0, 0x97, 0x8a, 0x95, 0x28, 0xc0, 0x8d, 0xfc,
-	0x40, 0xb1, 0x32, 0x66, 0x54, 0x87, 0xbb, 0x8f, 0x59, 0xc5, 0x7d, 0x23, 0x17, 0x6a, 0xb6, 0x46,
-	0x5a, 0x19, 0x31, 0x64, 0x96, 0x05, 0x63, 0x65, 0x1d, 0xe9, 0xb3, 0xa2, 0xfb, 0xf5, 0x39, 0xee,
-	0x73, 0x05, 0x1a, 0x1f, 0x0f, 0x43, 0x25, 0xfc, 0x2b, 0xfc, 0xe7, 0x32, 0xc0, 0xb8, 0xe8, 0x75,
-	0x7e, 0xfa, 0x85, 0x71, 0x98, 0xc6, 0x1c, 0x33, 0x51, 0xa3, 0xb8, 0x2b, 0xc9, 0x35, 0x34, 0x3c,
-	0x0b, 0xf1, 0x6f, 0x41, 0x05, 0xdf, 0x27, 0xad, 0x22, 0x79, 0x8c, 0x3b, 0x0b, 0x95, 0x5a, 0x9b,
-	0xc7, 0x81, 0x7c, 0xee, 0x19, 0x46, 0xfe, 0x30, 0x9f, 0x76, 0xbc, 0xa0, 0x09, 0x34, 0xa6, 0xe4,
-	0xb7, 0x00, 0x44, 0x57, 0x07, 0x67, 0x12, 0x65, 0xd9, 0xbd, 0x9f, 0xc3, 0x70, 0x0f, 0x9a, 0xb8,
-	0x25, 0x87, 0x07, 0x31, 0xee, 0xe2, 0xd6, 0x32, 0x09, 0x7e, 0x6b, 0x31, 0xf5, 0xbe, 0x9d, 0x31,
-	0x7a, 0x79, 0x21, 0xfc, 0x63, 0x58, 0x36, 0x0d, 0x26, 0x2b, 0x74, 0x85, 0x84, 0xbe, 0xbd, 0x98,
-	0xd0, 0x83, 0x31, 0xa7, 0x37, 0x21, 0x66, 0xb6, 0x6f, 0x54, 0xb9, 0x6e, 0xdf, 0x08, 0x63, 0xf3,
-	0xd1, 0x64, 0x6c, 0x36, 0x21, 0x60, 0x0a, 0xcb, 0x5d, 0x58, 0x0e, 0x92, 0x71, 0xdb, 0x8a, 0x5a,
-	0x18, 0x75, 0x6f, 0x02, 0xe7, 0xfc, 0xa8, 0x0a, 0x65, 0x9a, 0xc2, 0xe9, 0x16, 0xd4, 0xce, 0x84,
-	0xab, 0xbe, 0xbb, 0xf8, 0x52, 0x4f, 0xed, 0x64, 0xf2, 0x0c, 0xa5, 0x9c, 0x67, 0xf8, 0x16, 0x54,
-	0x12, 0x15, 0xeb, 0x74, 0xf9, 0x17, 0x34, 0xa2, 0xb6, 0x8a, 0xb5, 0x67, 0x18, 0xf9, 0x63, 0xa8,
-	0x9d, 0x04, 0xa1, 0xc6, 0x45, 0x31, 0x93, 0xf7, 0xe6, 0x62, 0x32, 0x1e, 0x13, 0x93, 0x97, 0x32,
-	0xf3, 0x27, 0x79, 0x63, 0xac, 0x92, 0xa4, 0xcd, 0xc5, 0x24, 0xcd, 0xb3, 0xd1, 0x3b, 0xc0, 0xba,
-	0xea, 0x4c, 0xc6, 0xe9, 0xbb, 0x0f, 0xe5, 0x85, 0x0d, 0xbe, 0x33, 0x78, 0xee, 0x40, 0xbd, 0x1f,
-	0xf8, 0x12, 0xf3, 0x17, 0xf2, 0x31, 0x75, 0x2f, 0x83, 0xf9, 0x87, 0x50, 0xa7, 0xbc, 0x1f, 0xbd,
-	0x5d, 0xe3, 0xda, 0x93, 0x6f, 0x4a, 0x90, 0x54, 0x00, 0x7e, 0x88, 0x3e, 0xfe, 0x38, 0xd0, 0x2d,
-	0x30, 0x1f, 0x4a, 0x61, 0x54, 0x98, 0xec, 0x3d, 0xaf, 0x70, 0xd3, 0x28, 0x3c, 0x8d, 0xe7, 0x0f,
-	0xe0, 0x65, 0xc2, 0x4d, 0x05, 0x3f, 0xdc, 0x6a, 0x28, 0x74, 0xfe, 0x4b, 0x4c, 0x44, 0x86, 0xa2,
-	0x27, 0x9f, 0x04, 0x83, 0x40, 0xb7, 0x56, 0x36, 0x0a, 0xb7, 0x2b, 0xde, 0x18, 0xc1, 0xdf, 0x84,
-	0x35, 0x5f, 0x9e, 0x88, 0x51, 0xa8, 0x8f, 0xe4, 0x60, 0x18, 0x0a, 0x2d, 0xf7, 0x7d, 0xb2, 0xd1,
-	0x86, 0x37, 0xfb, 0xc2, 0x7d, 0x60, 0x9d, 0x2a, 0x86, 0x39, 0xac, 0x26, 0x53, 0x77, 0x98, 0x68,
-	0x13, 0x37, 0xbf, 0x2d, 0xc2, 0x50, 0xc6, 0x17, 0xa6, 0x14, 0xfd, 0x50, 0x44, 0x1d, 0x11, 0xb1,
-	0x92, 0x7b, 0x1b, 0xca, 0x34, 0x0f, 0x0d, 0xa8, 0x98, 0x92, 0x85, 0xca, 0x57, 0x5b, 0xae, 0x90,
-	0x1b, 0x7d, 0x82, 0x7b, 0x86, 0x15, 0x9d, 0x9f, 0x95, 0xa0, 0x9e, 0x8e, 0x18, 0x93, 0xf7, 0x53,
-	0x79, 0x91, 0x26, 0xef, 0xa7, 0xf2, 0x82, 0x72, 0xaa, 0xe4, 0x38, 0x48, 0x82, 0x8e, 0xcd, 0x11,
-	0xeb, 0xde, 0x18, 0x81, 0x69, 0xc9, 0xf3, 0xc0, 0xd7, 0x7d, 0x32, 0xf4, 0x8a, 0x67, 0x00, 0x7e,
-	0x1b, 0x6e, 0xf8, 0xa8, 0x7c, 0xd4, 0x0d, 0x47, 0xbe, 0x3c, 0xc2, 0x90, 0x66, 0x6a, 0xf6, 0x69,
-	0x34, 0xff, 0x2e, 0x80, 0x0e, 0x06, 0xf2, 0xb1, 0x8a, 0x07, 0x42, 0xdb, 0x44, 0xfd, 0x1b, 0xd7,
-	0x33, 0xc5, 0xcd, 0xa3, 0x4c, 0x80, 0x97, 0x13, 0x86, 0xa2, 0xf1, 0x6b, 0x56, 0x74, 0xed, 0x73,
-	0x89, 0xde, 0xcd, 0x04, 0x78, 0x39, 0x61, 0xee, 0x6f, 0x01, 0x8c, 0xdf, 0xf0, 0x9b, 0xc0, 0x9f,
-	0xaa, 0x48, 0xf7, 0xb7, 0x3a, 0x9d, 0x78, 0x5b, 0x9e, 0xa8, 0x58, 0xee, 0x0a, 0x8c, 0x45, 0x2f,
-	0xc3, 0x5a, 0x86, 0xdf, 0x3a, 0xd1, 0x32, 0x46, 0x34, 0x4d, 0x7d, 0xbb, 0xaf, 0x62, 0x6d, 0x12,
-	0x1d, 0x7a, 0xfc, 0xb8, 0xcd, 0x4a, 0x18, 0xff, 0xf6, 0xdb, 0x07, 0xac, 0xec, 0xde, 0x06, 0x18,
-	0x0f, 0x89, 0x0a, 0x02, 0x7a, 0x7a, 0xfb, 0x9e, 0x2d, 0x0f, 0x08, 0xba, 0xf7, 0x80, 0x15, 0x9c,
-	0xbf, 0x29, 0x42, 0x19, 0xfd, 0x83, 0xf5, 0x61, 0xd5, 0xcc, 0x87, 0x6d, 0x40, 0x33, 0x6f, 0xdc,
-	0x66, 0x39, 0xf3, 0xa8, 0xcf, 0xe7, 0xe5, 0xf0, 0x5b, 0x79, 0x2f, 0xf7, 0x2e, 0x34, 0xbb, 0xa3,
-	0x44, 0xab, 0x01, 0xb9, 0xf8, 0x56, 0x89, 0x3c, 0xc9, 0xcd, 0x99, 0x2e, 0xc3, 0xb1, 0x08, 0x47,
-	0xd2, 0xcb, 0x93, 0xf2, 0x87, 0x50, 0x3d, 0x31, 0x0b, 0x63, 0xfa, 0x0c, 0x5f, 0xb8, 0x24, 0x0a,
-	0xd8, 0xc9, 0xb7, 0xc4, 0x38, 0xae, 0x60, 0xc6, 0xa8, 0xf2, 0x28, 0xf7, 0x4b, 0x76, 0xb7, 0xd4,
-	0xa0, 0xb4, 0x95, 0x74, 0x6d, 0x95, 0x2a, 0x93, 0xae, 0x49, 0x81, 0x77, 0x48, 0x05, 0x56, 0x74,
-	0xfe, 0xb9, 0x06, 0x55, 0xe3, 0x15, 0xed, 0xdc, 0x35, 0xb2, 0xb9, 0xfb, 0x0e, 0xd4, 0xd5, 0x50,
-	0xc6, 0x42, 0xab, 0xd8, 0x96, 0xca, 0x0f, 0xaf, 0xe3, 0x65, 0x37, 0x0f, 0x2c, 0xb3, 0x97, 0x89,
-	0x99, 0x5e, 0x8e, 0xe2, 0xec, 0x72, 0xdc, 0x01, 0x96, 0x3a, 0xd4, 0xc3, 0x18, 0xf9, 0xf4, 0x85,
-	0x2d, 0x7c, 0x66, 0xf0, 0xfc, 0x08, 0x1a, 0x5d, 0x15, 0xf9, 0x41, 0x56, 0x36, 0xaf, 0xde, 0xfb,
-	0xfa, 0xb5, 0x34, 0xdc, 0x49, 0xb9, 0xbd, 0xb1, 0x20, 0xfe, 0x26, 0x54, 0xce, 0x70, 0x9d, 0x68,
-	0x41, 0x2e, 0x5f, 0x45, 0x43, 0xc4, 0x3f, 0x81, 0xe6, 0xf7, 0x47, 0x41, 0xf7, 0xf4, 0x20, 0xdf,
-	0x96, 0x79, 0xf7, 0x5a, 0x5a, 0x7c, 0x67, 0xcc, 0xef, 0xe5, 0x85, 0xe5, 0x6c, 0xa3, 0xf6, 0x4b,
-	0xd8, 0x46, 0x7d, 0xd6, 0x36, 0x5e, 0x85, 0x7a, 0xba, 0x38, 0x64, 0x1f, 0x91, 0xcf, 0x96, 0x78,
-	0x15, 0x8a, 0x07, 0x31, 0x2b, 0xb8, 0xff, 0x5d, 0x80, 0x46, 0x36, 0x31, 0x93, 0x2d, 0x98, 0x47,
-	0xdf, 0x1f, 0x89, 0x90, 0x15, 0xa8, 0x86, 0x50, 0xda, 0x40, 0xb4, 0x79, 0xbf, 0x1d, 0x4b, 0xa1,
-	0xa9, 0xf3, 0x87, 0x1e, 0x59, 0x26, 0x09, 0x2b, 0x73, 0x0e, 0xab, 0x16, 0x7d, 0x10, 0x1b, 0xd2,
-	0x0a, 0x96, 0x18, 0xf8, 0x36, 0x45, 0x54, 0x8d, 0x03, 0x3f, 0x95, 0xa6, 0x84, 0xfa, 0x48, 0x69,
-	0x02, 0xea, 0xa8, 0xcb, 0x7e, 0xc4, 0x1a, 0xf8, 0xcd, 0x8f, 0x94, 0xde, 0x8f, 0x18, 0x8c, 0x73,
-	0xdb, 0x66, 0xfa, 0x79, 0x82, 0x96, 0x29, 0x73, 0x0e, 0xc3, 0xfd, 0x88, 0xad, 0xd8, 0x17, 0x06,
-	0x5a, 0x45, 0x89, 0x8f, 0xce, 0x45, 0x17, 0xd9, 0x6f, 0xf0, 0x55, 0x00, 0xe4, 0xb1, 0x30, 0xc3,
-	0x3d, 0xf0, 0xe8, 0x3c, 0x48, 0x74, 0xc2, 0xd6, 0xdc, 0x7f, 0x2a, 0x40, 0x33, 0xb7, 0x08, 0x98,
-	0x3b, 0x13, 0x21, 0xba, 0x36, 0x93, 0x4a, 0x7f, 0x57, 0x26, 0x5a, 0xc6, 0x7e, 0xea, 0xb6, 0x8e,
-	0x14, 0x3e, 0x16, 0xf1, 0x7b, 0x47, 0x6a, 0xa0, 0xe2, 0x58, 0x3d, 0x67, 0x25, 0x84, 0x9e, 0x88,
-	0x44, 0x3f, 0x93, 0xf2, 0x94, 0x95, 0x71, 0xa8, 0x3b, 0xa3, 0x38, 0x96, 0x91, 0x41, 0x54, 0x48,
-	0x39, 0x79, 0x6e, 0xa0, 0x2a, 0x0a, 0x45, 0x62, 0xf2, 0x8b, 0xac, 0xc6, 0x19, 0x2c, 0x5b, 0x6a,
-	0x83, 0xa9, 0x23, 0x01, 0x92, 0x1b, 0xb0, 0x81, 0x65, 0xa7, 0x29, 0xdb, 0x0e, 0x4e, 0x76, 0xc5,
-	0x45, 0xb2, 0xd5, 0x53, 0x0c, 0xa6, 0x91, 0x1f, 0xa9, 0xe7, 0xac, 0xe9, 0x8c, 0x00, 0xc6, 0x09,
-	0x2d, 0x26, 0xf2, 0x68, 0x6b, 0x59, 0x63, 0xd5, 0x42, 0xfc, 0x00, 0x00, 0x9f, 0x88, 0x32, 0xcd,
-	0xe6, 0xaf, 0x91, 0x65, 0x10, 0x9f, 0x97, 0x13, 0xe1, 0xfc, 0x2e, 0x34, 0xb2, 0x17, 0x58, 0x97,
-	0x51, 0x3e, 0x90, 0x7d, 0x36, 0x05, 0x31, 0x4e, 0x06, 0x91, 0x2f, 0xcf, 0x69, 0xef, 0x57, 0x3c,
-	0x03, 0xa0, 0x96, 0xfd, 0xc0, 0xf7, 0x65, 0x94, 0xb6, 0xbf, 0x0d, 0x34, 0xef, 0xac, 0xb1, 0x3c,
-	0xf7, 0xac, 0xd1, 0xf9, 0x6d, 0x68, 0xe6, 0x32, 0xee, 0x4b, 0x87, 0x9d, 0x53, 0xac, 0x38, 0xa9,
-	0xd8, 0x6b, 0xd0, 0x50, 0x36, 0x6d, 0x4e, 0xc8, 0x81, 0x37, 0xbc, 0x31, 0x02, 0x03, 0x4c, 0xc5,
-	0x0c, 0x6d, 0x3a, 0x4b, 0x7e, 0x0c, 0x55, 0x2c, 0x19, 0x47, 0xe9, 0x41, 0xed, 0x82, 0x99, 0x68,
-	0x9b, 0x78, 0xf6, 0x96, 0x3c, 0xcb, 0xcd, 0xdf, 0x83, 0x92, 0x16, 0x3d, 0xdb, 0x3d, 0xfa, 0xca,
-	0x62, 0x42, 0x8e, 0x44, 0x6f, 0x6f, 0xc9, 0x43, 0x3e, 0xfe, 0x04, 0xea, 0x5d, 0x5b, 0xf0, 0x5b,
-	0xc7, 0xb5, 0x60, 0x22, 0x9b, 0xb6, 0x09, 0xf6, 0x96, 0xbc, 0x4c, 0x02, 0xff, 0x16, 0x94, 0x31,
-	0xca, 0x93, 0xe7, 0x5d, 0x38, 0x41, 0xc7, 0xed, 0xb2, 0xb7, 0xe4, 0x11, 0xe7, 0x76, 0x0d, 0x2a,
-	0xe4, 0x27, 0x9d, 0x16, 0x54, 0xcd, 0x58, 0xa7, 0x67, 0xce, 0x79, 0x05, 0x4a, 0x47, 0xa2, 0x87,
-	0x99, 0x56, 0xe0, 0x27, 0xb6, 0xce, 0xc4, 0x47, 0xe7, 0xf5, 0x71, 0xf3, 0x22, 0xdf, 0x17, 0x2b,
-	0x4c, 0xf4, 0xc5, 0x9c, 0x2a, 0x94, 0xf1, 0x8b, 0xce, 0x6b, 0x57, 0x65, 0x6d, 0xce, 0xab, 0x98,
-	0xdf, 0x69, 0x79, 0x3e, 0xaf, 0xe5, 0xe7, 0xac, 0xc1, 0x8d, 0xa9, 0x13, 0x2e, 0xa7, 0x66, 0x93,
-	0x4b, 0x67, 0x05, 0x9a, 0xb9, 0x33, 0x0b, 0xe7, 0x0d, 0xa8, 0xa7, 0x27, 0x1a, 0x98, 0x52, 0x07,
-	0x89, 0xe9, 0xc5, 0x58, 0xa5, 0x32, 0xd8, 0xf9, 0xeb, 0x02, 0x54, 0xcd, 0xa9, 0x10, 0xdf, 0xce,
-	0x4e, 0x71, 0x0b, 0x0b, 0x1c, 0x21, 0x18, 0x26, 0x7b, 0x00, 0x93, 0x1d, 0xe5, 0xae, 0x43, 0x25,
-	0xa4, 0xdc, 0xd9, 0x6e, 0x17, 0x02, 0x72, 0xd6, 0x5d, 0xca, 0x5b, 0xb7, 0xfb, 0x4e, 0x76, 0xe8,
-	0x93, 0xf6, 0x09, 0x28, 0xec, 0x1f, 0xc5, 0x52, 0x9a, 0x1e, 0x00, 0x25, 0xcb, 0x45, 0xf2, 0x4d,
-	0x6a, 0x30, 0x14, 0x5d, 0x4d, 0x88, 0x92, 0x7b, 0x02, 0xf5, 0x43, 0x95, 0x4c, 0x7b, 0xfc, 0x1a,
-	0x94, 0x8e, 0xd4, 0xd0, 0x24, 0x0c, 0xdb, 0x4a, 0x53, 0xc2, 0x60, 0x1c, 0xfc, 0x89, 0x36, 0x2d,
-	0x0b, 0x2f, 0xe8, 0xf5, 0xb5, 0x69, 0x47, 0xed, 0x47, 0x91, 0x8c, 0x59, 0x05, 0xbd, 0xae, 0x27,
-	0x87, 0xa1, 0xe8, 0x4a, 0x56, 0x45, 0xaf, 0x4b, 0xf8, 0xc7, 0x41, 0x9c, 0x68, 0x56, 0x73, 0xdf,
-	0x41, 0x5f, 0x1d, 0xf4, 0xc8, 0xc5, 0xd2, 0x03, 0x89, 0x5a, 0x42, 0x85, 0x08, 0xdc, 0x91, 0x11,
-	0x86, 0x11, 0x3a, 0x55, 0x30, 0xc7, 0xfa, 0xf4, 0x81, 0xa2, 0xfb, 0x0c, 0x56, 0x26, 0x8e, 0xfb,
-	0xf9, 0x3a, 0xb0, 0x09, 0x04, 0x2a, 0xba, 0xc4, 0x5f, 0x81, 0x97, 0x26, 0xb0, 0x4f, 0x03, 0xdf,
-	0xa7, 0xa6, 0xcb, 0xf4, 0x8b, 0x74, 0x38, 0xdb, 0x0d, 0xa8, 0x75, 0xcd, 0x0a, 0xb8, 0x87, 0xb0,
-	0x42, 0x4b, 0xf2, 0x54, 0x6a, 0x71, 0x10, 0x85, 0x17, 0xbf, 0xf4, 0x9d, 0x0c, 0xf7, 0xab, 0x50,
-	0xa1, 0xe6, 0x27, 0x1a, 0xdf, 0x49, 0xac, 0x06, 0x24, 0xab, 0xe2, 0xd1, 0x33, 0x4a, 0xd7, 0xca,
-	0xae, 0x6b, 0x51, 0x2b, 0xf7, 0x5f, 0x1a, 0x50, 0xdb, 0xea, 0x76, 0xd5, 0x28, 0xd2, 0x33, 0x5f,
-	0x9e, 0xd7, 0x5f, 0x7b, 0x08, 0x55, 0x71, 0x26, 0xb4, 0x88, 0xad, 0xcf, 0x98, 0xce, 0x0e, 0xac,
-	0xac, 0xcd, 0x2d, 0x22, 0xf2, 0x2c, 0x31, 0xb2, 0x75, 0x55, 0x74, 0x12, 0xf4, 0xac, 0x9b, 0xb8,
-	0x8c, 0x6d, 0x87, 0x88, 0x3c, 0x4b, 0x8c, 0x6c, 0xd6, 0xcd, 0x55, 0xae, 0x64, 0x33, 0x7b, 0x3d,
-	0xf3, 0x6a, 0x77, 0xa1, 0x1c, 0x44, 0x27, 0xca, 0xde, 0xc6, 0x79, 0xf5, 0x12, 0xa6, 0xfd, 0xe8,
-	0x44, 0x79, 0x44, 0xe8, 0x48, 0xa8, 0x1a, 0x85, 0xf9, 0x37, 0xa0, 0x42, 0x67, 0x1c, 0xb6, 0xab,
-	0xbc, 0xd0, 0xf5, 0x09, 0xc3, 0xc1, 0x6f, 0xa6, 0x2d, 0x73, 0x9a, 0x2f, 0xc4, 0x13, 0xb8, 0x5d,
-	0x4f, 0xa7, 0xcc, 0xf9, 0x8f, 0x02, 0x54, 0xcd, 0x08, 0xf9, 0x1b, 0xb0, 0x2a, 0x23, 0xdc, 0xda,
-	0xa9, 0x23, 0xb3, 0x7b, 0x7a, 0x0a, 0x8b, 0x69, 0x95, 0xc5, 0xc8, 0xce, 0xa8, 0x67, 0x2b, 0xc0,
-	0x3c, 0x8a, 0xbf, 0x0b, 0xaf, 0x18, 0xf0, 0x30, 0x96, 0xb1, 0x0c, 0xa5, 0x48, 0xe4, 0x4e, 0x5f,
-	0x44, 0x91, 0x0c, 0x6d, 0x58, 0xbb, 0xec, 0x35, 0x77, 0x61, 0xd9, 0xbc, 0x6a, 0x0f, 0x45, 0x57,
-	0x26, 0xf6, 0x08, 0x60, 0x02, 0xc7, 0xbf, 0x06, 0x15, 0xba, 0x13, 0xd5, 0xf2, 0xaf, 0x36, 0x3e,
-	0x43, 0xe5, 0xa8, 0xcc, 0xef, 0x6e, 0x01, 0x98, 0xd5, 0xc0, 0x7a, 0xc0, 0xfa, 0xa2, 0x2f, 0x5e,
-	0xb9, 0x7c, 0x54, 0xd9, 0xe4, 0x98, 0x50, 0x3f, 0x5f, 0x86, 0x12, 0xfd, 0x03, 0xfa, 0x5c, 0x1a,
-	0x7c, 0xc9, 0x9b, 0xc0, 0x39, 0x7f, 0x5f, 0x82, 0x32, 0x2e, 0x24, 0x12, 0xf7, 0xd5, 0x40, 0x66,
-	0xad, 0x29, 0x63, 0xb4, 0x13, 0x38, 0x0c, 0xec, 0xc2, 0x9c, 0xfa, 0x65, 0x64, 0xc6, 0x95, 0x4d,
-	0xa3, 0x91, 0x72, 0x18, 0xab, 0x93, 0x20, 0x1c, 0x53, 0xda, 0x14, 0x60, 0x0a, 0xcd, 0xbf, 0x0e,
-	0x37, 0x07, 0x22, 0x3e, 0x95, 0x9a, 0xbc, 0xcf, 0x33, 0x15, 0x9f, 0x26, 0x38, 0x73, 0xfb, 0xbe,
-	0xed, 0x69, 0x5c, 0xf2, 0x16, 0xdd, 0xb9, 0x2f, 0xcf, 0x02, 0xa2, 0xac, 0x13, 0x65, 0x06, 0xa3,
-	0x71, 0x08, 0x33, 0x35, 0x6d, 0x2b, 0xcb, 0xd4, 0x47, 0x53, 0x58, 0xcc, 0x1e, 0xcc, 0x0d, 0x80,
-	0x64, 0xdf, 0xa7, 0x36, 0x4b, 0xc3, 0x1b, 0x23, 0xf8, 0x2d, 0x80, 0x9e, 0xd0, 0xf2, 0xb9, 0xb8,
-	0xf8, 0x38, 0x0e, 0x5b, 0xd2, 0x34, 0x2f, 0xc7, 0x18, 0x2c, 0x7a, 0x42, 0xd5, 0x15, 0x61, 0x5b,
-	0xab, 0x58, 0xf4, 0xe4, 0xa1, 0xd0, 0xfd, 0x56, 0xcf, 0x14, 0x3d, 0xd3, 0x78, 0xd4, 0x16, 0x6b,
-	0xfb, 0x4f, 0x54, 0x24, 0x5b, 0x7d, 0xa3, 0x6d, 0x0a, 0xa3, 0x89, 0x8a, 0x48, 0x84, 0x17, 0x3a,
-	0xe8, 0xa2, 0x1e, 0x81, 0x29, 0xaf, 0x72, 0x28, 0xd4, 0x33, 0x92, 0xfa, 0xb9, 0x8a, 0x4f, 0xf7,
-	0xfd, 0xd6, 0xa7, 0x46, 0xcf, 0x0c, 0xe1, 0x1e, 0x00, 0x8c, 0x0d, 0x00, 0xbd, 0xfe, 0x16, 0x35,
-	0x58, 0xd9, 0x12, 0x66, 0x9a, 0x87, 0x32, 0xf2, 0x83, 0xa8, 0xb7, 0x6b, 0xd7, 0x9c, 0x15, 0x10,
-	0xd9, 0xd6, 0x22, 0xd6, 0xd2, 0xcf, 0x90, 0x54, 0x0d, 0x10, 0x24, 0x7d, 0x56, 0x72, 0xff, 0xb7,
-	0x00, 0xcd, 0xdc, 0xf1, 0xe2, 0xaf, 0xf0, 0x48, 0x14, 0x63, 0x30, 0xee, 0x75, 0x9c, 0x50, 0x63,
-	0x0f, 0x19, 0x8c, 0xd3, 0x6d, 0x4f, 0x3f, 0xf1, 0xad, 0xa9, 0x1e, 0x73, 0x98, 0xcf, 0x75, 0x1c,
-	0xea, 0xde, 0xb3, 0xf5, 0x74, 0x13, 0x6a, 0x1f, 0x47, 0xa7, 0x91, 0x7a, 0x1e, 0x99, 0xe0, 0x4a,
-	0x67, 0xdc, 0x13, 0x5d, 0xfd, 0xf4, 0x18, 0xba, 0xe4, 0xfe, 0x59, 0x79, 0xea, 0x3a, 0xc8, 0x23,
-	0xa8, 0x9a, 0x9c, 0x92, 0xd2, 0x9d, 0xd9, 0xf3, 0xfb, 0x3c, 0xb1, 0xed, 0x20, 0xe7, 0x50, 0x9e,
-	0x65, 0xc6, 0x64, 0x2f, 0xbb, 0xf3, 0x54, 0x9c, 0xdb, 0xe9, 0x9e, 0x10, 0x94, 0xba, 0xb0, 0x89,
-	0x6b, 0x7f, 0x99, 0x04, 0xe7, 0x8f, 0x0a, 0xb0, 0x3e, 0x8f, 0x04, 0x73, 0xaf, 0xce, 0xc4, 0xad,
-	0x8c, 0x14, 0xe4, 0xed, 0xa9, 0xcb, 0x86, 0x45, 0x1a, 0xcd, 0xdd, 0x6b, 0x2a, 0x31, 0x79, 0xf5,
-	0xd0, 0xfd, 0x71, 0x01, 0xd6, 0x66, 0xc6, 0x9c, 0x4b, 0x47, 0x00, 0xaa, 0xc6, 0xb2, 0xcc, 0x25,
-	0x82, 0xec, 0x58, 0xd7, 0x34, 0xfc, 0x28, 0x1e, 0x24, 0xe6, 0x9c, 0x6c, 0xd7, 0x5c, 0x55, 0x65,
-	0x65, 0xcc, 0x23, 0x70, 0xd5, 0xd0, 0xcf, 0xf6, 0x24, 0xab, 0x60, 0x9d, 0x65, 0x32, 0x24, 0x8b,
-	0xa9, 0x52, 0x0d, 0x67, 0x7b, 0x8c, 0xac, 0x46, 0x97, 0x13, 0x46, 0xc3, 0x30, 0xe8, 0x22, 0x58,
-	0x77, 0x3d, 0x78, 0x69, 0x8e, 0xde, 0xa4, 0xc9, 0xb1, 0xd5, 0x6a, 0x15, 0x60, 0xf7, 0x38, 0xd5,
-	0x85, 0x15, 0xb0, 0xec, 0xdd, 0x3d, 0xde, 0xa1, 0xc2, 0xd7, 0x1e, 0xfd, 0x99, 0x3d, 0x71, 0x8c,
-	0xd5, 0x51, 0xc2, 0x4a, 0xee, 0xf7, 0xd2, 0x33, 0x41, 0xe7, 0x18, 0x56, 0x8c, 0x1a, 0x87, 0xe2,
-	0x22, 0x54, 0xc2, 0xe7, 0x8f, 0x60, 0x35, 0xc9, 0x6e, 0xf5, 0xe6, 0xbc, 0xf5, 0x74, 0xb0, 0x6d,
-	0x4f, 0x10, 0x79, 0x53, 0x4c, 0xee, 0x9f, 0x56, 0x00, 0x0e, 0xb2, 0x9b, 0xb1, 0x73, 0x36, 0xdd,
-	0xbc, 0x74, 0x62, 0xe6, 0x54, 0xa2, 0x74, 0xed, 0x53, 0x89, 0x77, 0xb3, 0x84, 0xd7, 0xf4, 0xb2,
-	0xa6, 0xaf, 0x1e, 0x8e, 0x75, 0x9a, 0x4e, 0x73, 0x27, 0x4e, 0xb3, 0x2b, 0xd3, 0xa7, 0xd9, 0x1b,
-	0xb3, 0x57, 0x5f, 0xa6, 0xbc, 0xc1, 0xb8, 0x7e, 0xac, 0x4d, 0xd4, 0x8f, 0x0e, 0xd4, 0x63, 0x29,
-	0x7c, 0x15, 0x85, 0x17, 0x69, 0xf3, 0x3b, 0x85, 0xf9, 0x7d, 0xa8, 0x68, 0xba, 0x4b, 0x5c, 0x27,
-	0xe3, 0x7d, 0xc1, 0x1c, 0x1b, 0x5a, 0x74, 0x2d, 0x41, 0x62, 0xef, 0xab, 0x98, 0x58, 0x50, 0xf7,
-	0x72, 0x18, 0xbe, 0x09, 0x3c, 0x88, 0x12, 0x2d, 0xc2, 0x50, 0xfa, 0xdb, 0x17, 0xbb, 0xa6, 0x87,
-	0x4d, 0xf1, 0xa7, 0xee, 0xcd, 0x79, 0xe3, 0x7e, 0x36, 0xbe, 0xa7, 0xd5, 0x80, 0x4a, 0x47, 0x24,
-	0x41, 0xd7, 0x9c, 0x08, 0xdb, 0xe0, 0x66, 0xd2, 0x76, 0xad, 0x7c, 0xc5, 0x8a, 0x98, 0x8f, 0x27,
-	0x12, 0x33, 0xef, 0x55, 0x80, 0xf1, 0xcd, 0x67, 0x56, 0x46, 0x1b, 0x4e, 0x57, 0xc2, 0x1c, 0x08,
-	0x13, 0x2b, 0x35, 0x19, 0xfc, 0xec, 0xaa, 0x4d, 0x0d, 0xbf, 0x40, 0x3e, 0x92, 0xd5, 0x91, 0x26,
-	0x52, 0x5a, 0x9a, 0x16, 0x0b, 0x05, 0x42, 0x06, 0x28, 0x26, 0xbd, 0xc8, 0xc9, 0x9a, 0x98, 0x32,
-	0xa7, 0x42, 0x4d, 0x5f, 0x24, 0xa1, 0x62, 0x61, 0x19, 0x2d, 0x7c, 0xf2, 0x05, 0x5b, 0x41, 0x8d,
-	0xc6, 0x17, 0xaa, 0xd9, 0x2a, 0x8a, 0x42, 0xff, 0xd2, 0x11, 0x89, 0x64, 0xeb, 0xee, 0x9f, 0x8f,
-	0x47, 0xf9, 0x56, 0x96, 0xd9, 0x2e, 0x62, 0x1f, 0x97, 0xe5, 0xbe, 0x8f, 0x60, 0x2d, 0x96, 0xdf,
-	0x1f, 0x05, 0x13, 0x57, 0x2d, 0x4b, 0x57, 0x1f, 0x26, 0xce, 0x72, 0xb8, 0x67, 0xb0, 0x96, 0x02,
-	0xcf, 0x02, 0xdd, 0xa7, 0x82, 0x95, 0xdf, 0xcf, 0xdd, 0x05, 0x2d, 0xd8, 0x54, 0xeb, 0x12, 0x91,
-	0xe3, 0xbb, 0x9f, 0x59, 0xd3, 0xb0, 0xb8, 0x40, 0xd3, 0xd0, 0xfd, 0xf7, 0x6a, 0xae, 0x66, 0x35,
-	0xb9, 0xbe, 0x9f, 0xe5, 0xfa, 0xb3, 0x27, 0x0f, 0xe3, 0x3e, 0x60, 0xf1, 0x3a, 0x7d, 0xc0, 0x79,
-	0x47, 0x6f, 0xdf, 0xc4, 0x44, 0x8e, 0x4c, 0xef, 0x78, 0x81, 0x1e, 0xe7, 0x04, 0x2d, 0xdf, 0xa6,
-	0x73, 0x04, 0xd1, 0x36, 0xe7, 0xc2, 0x95, 0xb9, 0x37, 0xb3, 0xf3, 0x07, 0x06, 0x96, 0xd2, 0xcb,
-	0x71, 0xe5, 0x36, 0x6a, 0x75, 0xde, 0x46, 0xc5, 0xb2, 0xcb, 0x6e, 0xe1, 0x0c, 0x36, 0x2d, 0x61,
-	0xf3, 0x9c, 0x8a, 0xa7, 0x2b, 0xd5, 0x75, 0x6f, 0x06, 0x8f, 0xe9, 0xc4, 0x60, 0x14, 0xea, 0xc0,
-	0x76, 0x3d, 0x0d, 0x30, 0xfd, 0xe7, 0x81, 0xc6, 0xec, 0x9f, 0x07, 0xde, 0x07, 0x48, 0x24, 0x9a,
-	0xef, 0x6e, 0xd0, 0xd5, 0xf6, 0xf4, 0xf8, 0xd6, 0x65, 0x63, 0xb3, 0xbd, 0xda, 0x1c, 0x07, 0xea,
-	0x3f, 0x10, 0xe7, 0x3b, 0x98, 0x12, 0xda, 0x63, 0xae, 0x0c, 0x9e, 0x76, 0x5f, 0xab, 0xb3, 0xee,
-	0xeb, 0x3e, 0x54, 0x92, 0xae, 0x1a, 0x4a, 0xba, 0xfd, 0x7c, 0xf9, 0xfa, 0x6e, 0xb6, 0x91, 0xc8,
-	0x33, 0xb4, 0xd4, 0x19, 0xc1, 0x30, 0xa3, 0x62, 0xba, 0xf7, 0xdc, 0xf0, 0x52, 0xd0, 0xf1, 0xa1,
-	0x6a, 0x3b, 0x99, 0x73, 0xea, 0x48, 0x6a, 0x82, 0x14, 0x73, 0xf7, 0x9e, 0xb2, 0xfb, 0x45, 0xa5,
-	0xfc, 0xfd, 0xa2, 0x0d, 0x68, 0xc6, 0xb9, 0x4e, 0xbd, 0xbd, 0x54, 0x96, 0x43, 0xb9, 0x9f, 0x40,
-	0x85, 0xf4, 0xc1, 0x68, 0x68, 0xa6, 0xd2, 0x24, 0x44, 0xa8, 0x38, 0x2b, 0x60, 0x81, 0x9e, 0x48,
-	0x7d, 0x70, 0x72, 0xd4, 0x97, 0x6d, 0x31, 0x90, 0xe4, 0xa9, 0x8a, 0xbc, 0x05, 0xeb, 0x86, 0x36,
-	0x99, 0x7c, 0x43, 0x61, 0x3b, 0x0c, 0x3a, 0xb1, 0x88, 0x2f, 0x58, 0xd9, 0x7d, 0x9f, 0xce, 0x95,
-	0x52, 0xa3, 0x69, 0x66, 0x7f, 0x52, 0x31, 0xbe, 0xd1, 0x97, 0x31, 0x3a, 0x5b, 0x73, 0xea, 0x67,
-	0x13, 0x71, 0x73, 0xb3, 0x81, 0xb2, 0x65, 0x56, 0x72, 0x9f, 0x61, 0xde, 0x35, 0x0e, 0x4d, 0xbf,
-	0xb2, 0x3d, 0xe5, 0x6e, 0xe7, 0xf2, 0x8e, 0xc9, 0xab, 0x0c, 0x85, 0x45, 0xaf, 0x32, 0xb8, 0x1f,
-	0xc2, 0x0d, 0x6f, 0xd2, 0xb1, 0xf2, 0x77, 0xa1, 0xa6, 0x86, 0x79, 0x39, 0x2f, 0xb2, 0xbd, 0x94,
-	0xdc, 0xfd, 0x69, 0x01, 0x96, 0xf7, 0x23, 0x2d, 0xe3, 0x48, 0x84, 0x8f, 0x43, 0xd1, 0xe3, 0xef,
-	0xa4, 0x9e, 0x68, 0x7e, 0xa1, 0x97, 0xa7, 0x9d, 0x74, 0x4a, 0xa1, 0xed, 0xd8, 0xf1, 0x97, 0x61,
-	0x4d, 0xfa, 0x81, 0x56, 0xb1, 0xc9, 0xb6, 0xd2, 0x1b, 0x25, 0xeb, 0xc0, 0x0c, 0xba, 0x4d, 0x66,
-	0x7f, 0x64, 0x96, 0xb9, 0x05, 0xeb, 0x13, 0xd8, 0x34, 0x95, 0x2a, 0xf2, 0xd7, 0xa0, 0x35, 0x0e,
-	0x09, 0xbb, 0x2a, 0xd2, 0xfb, 0x91, 0x2f, 0xcf, 0x29, 0x53, 0x60, 0x25, 0xf7, 0xdf, 0xb2, 0x1c,
-	0xe5, 0xd8, 0xde, 0x37, 0x89, 0x95, 0xd2, 0xe3, 0x7e, 0xad, 0x81, 0x72, 0xff, 0x66, 0x2a, 0x2e,
-	0xf0, 0x6f, 0xa6, 0xf7, 0xc7, 0xff, 0x66, 0x32, 0xc1, 0xe0, 0xf5, 0xb9, 0x11, 0x86, 0x8e, 0xc9,
-	0x6d, 0x8e, 0xd8, 0x96, 0xb9, 0xbf, 0x36, 0xbd, 0x6d, 0x0b, 0x83, 0xf2, 0x22, 0x59, 0x97, 0x39,
-	0xf9, 0x7b, 0x38, 0x7d, 0x8b, 0x76, 0xb1, 0xeb, 0x2c, 0x33, 0xd9, 0x16, 0x5c, 0x3b, 0xdb, 0xfa,
-	0x60, 0x2a, 0x07, 0xaf, 0xcf, 0x6d, 0xb1, 0x5c, 0xf1, 0x57, 0x9f, 0x0f, 0xa0, 0xd6, 0x0f, 0x12,
-	0xad, 0xe2, 0x0b, 0x4a, 0x64, 0x66, 0xaf, 0xcb, 0xe7, 0x66, 0x6b, 0xcf, 0x10, 0xd2, 0xdd, 0x82,
-	0x94, 0xcb, 0xe9, 0x01, 0x8c, 0x67, 0x71, 0xc6, 0xd7, 0x7c, 0x8e, 0xbf, 0x96, 0xdd, 0x84, 0x6a,
-	0x32, 0xea, 0x8c, 0x1b, 0xf0, 0x16, 0x72, 0xce, 0xc1, 0x99, 0x89, 0xd3, 0x87, 0x32, 0x36, 0xfa,
-	0xa1, 0xef, 0x4d, 0x1b, 0xf5, 0xf6, 0xf3, 0x19, 0xcc, 0xdf, 0xcf, 0x2f, 0x8f, 0x31, 0xa1, 0x8d,
-	0x4b, 0xe6, 0x38, 0x93, 0x9c, 0x5b, 0x27, 0xe7, 0x21, 0x34, 0x73, 0x43, 0x47, 0xff, 0x39, 0x8a,
-	0x7c, 0x95, 0xf6, 0xf1, 0xf0, 0xd9, 0x5c, 0xf1, 0xf7, 0xd3, 0x4e, 0x1e, 0x3d, 0xdf, 0xf9, 0x71,
-	0x11, 0x56, 0x27, 0xcd, 0x85, 0x3a, 0x9a, 0xc6, 0x55, 0x1d, 0x84, 0x7e, 0xae, 0x74, 0x64, 0xfc,
-	0x06, 0x34, 0x0f, 0x4d, 0xb6, 0x47, 0x88, 0x35, 0x7c, 0xb5, 0xa7, 0x06, 0x92, 0x6d, 0xe4, 0x2f,
-	0x47, 0xbf, 0x85, 0x7e, 0xd6, 0x34, 0x89, 0xd9, 0x90, 0x37, 0xec, 0x75, 0xb2, 0x1f, 0x16, 0xf9,
-	0x4a, 0xae, 0x80, 0xf9, 0x49, 0x91, 0xaf, 0xc3, 0x8d, 0xed, 0x51, 0xe4, 0x87, 0xd2, 0xcf, 0xb0,
-	0x7f, 0x99, 0xc7, 0x66, 0xa5, 0xca, 0x0f, 0xb1, 0x3a, 0x6a, 0xb4, 0x47, 0x1d, 0x5b, 0xa6, 0xfc,
-	0x5e, 0x99, 0xdf, 0x84, 0x35, 0x4b, 0x35, 0x4e, 0xc5, 0xd8, 0xef, 0x97, 0xf9, 0x4b, 0xb0, 0xba,
-	0x65, 0xe6, 0xcc, 0x2a, 0xca, 0xfe, 0xa0, 0x8c, 0x2a, 0xd0, 0x01, 0xd9, 0x1f, 0x92, 0x9c, 0xac,
-	0xa1, 0xc2, 0x7e, 0x54, 0xe6, 0x1c, 0x56, 0x9e, 0x06, 0x49, 0x12, 0x44, 0x3d, 0x2b, 0xfb, 0x8f,
-	0xcb, 0x77, 0x7e, 0x5a, 0x80, 0xd5, 0x49, 0xa7, 0x8a, 0x49, 0x62, 0xa8, 0xa2, 0x9e, 0x36, 0x77,
-	0xb6, 0x57, 0xa0, 0x91, 0xf4, 0x55, 0xac, 0x09, 0xa4, 0x9e, 0x73, 0x44, 0x67, 0x5b, 0xa6, 0xbc,
-	0x33, 0xcd, 0x28, 0x73, 0x3b, 0x40, 0x8b, 0x1e, 0x6b, 0xe2, 0x2c, 0xf9, 0xf8, 0xfd, 0x72, 0x96,
-	0xf0, 0xd2, 0x19, 0x5b, 0x7a, 0x86, 0xc1, 0xaa, 0x48, 0x3a, 0x8a, 0x43, 0x93, 0xf8, 0xca, 0x81,
-	0x08, 0x42, 0x73, 0x39, 0x73, 0xd8, 0xc7, 0xca, 0xad, 0x61, 0xb0, 0xea, 0xd3, 0xc0, 0x5c, 0x83,
-	0xb4, 0x21, 0xcc, 0x47, 0x3d, 0xb2, 0xf5, 0x67, 0x72, 0xfb, 0xce, 0x3f, 0xfe, 0xe2, 0x56, 0xe1,
-	0xe7, 0xbf, 0xb8, 0x55, 0xf8, 0xcf, 0x5f, 0xdc, 0x2a, 0xfc, 0xf8, 0xb3, 0x5b, 0x4b, 0x3f, 0xff,
-	0xec, 0xd6, 0xd2, 0xbf, 0x7e, 0x76, 0x6b, 0xe9, 0x13, 0x36, 0xfd, 0xbf, 0xce, 0x4e, 0x95, 0x2c,
-	0xfb, 0xfe, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe5, 0xa1, 0x33, 0xc5, 0xf2, 0x39, 0x00, 0x00,
+	// 5604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7b, 0xcd, 0x6f, 0x24, 0xc7,
+	0x75, 0x38, 0xe7, 0x7b, 0xe6, 0x0d, 0xc9, 0x2d, 0xd6, 0x52, 0xab, 0xf9, 0xb5, 0xe4, 0xfd, 0xd1,
+	0x1d, 0x59, 0x5e, 0xaf, 0x65, 0xae, 0xb4, 0xd2, 0x5a, 0xb2, 0x12, 0x49, 0xe6, 0xc7, 0xae, 0xc8,
+	0x68, 0x77, 0x49, 0xf7, 0x70, 0xb9, 0xb6, 0x90, 0x04, 0xee, 0x99, 0x2e, 0xce, 0xb4, 0xd8, 0xd3,
+	0x35, 0xea, 0xae, 0xe1, 0x72, 0x0c, 0x04, 0xb0, 0xf3, 0xe1, 0x20, 0x97, 0xc0, 0x08, 0x90, 0x63,
+	0x02, 0xe7, 0x94, 0x4b, 0x6e, 0x86, 0x11, 0x1b, 0xc8, 0x21, 0x97, 0x00, 0x0e, 0x72, 0x71, 0x6e,
+	0xb9, 0x25, 0xb0, 0x72, 0xcb, 0x1f, 0x90, 0x4b, 0x0e, 0xc1, 0x7b, 0x55, 0xfd, 0x31, 0x1f, 0xe4,
+	0x0e, 0x65, 0x9f, 0xa6, 0xeb, 0xf5, 0x7b, 0xaf, 0xeb, 0xe3, 0xd5, 0xfb, 0x1e, 0x78, 0x65, 0x78,
+	0xda, 0xbb, 0x13, 0xf8, 0x9d, 0x3b, 0xc3, 0xce, 0x9d, 0x81, 0xf4, 0x44, 0x70, 0x67, 0x18, 0x49,
+	0x25, 0x63, 0x3d, 0x88, 0x37, 0x69, 0xc4, 0x57, 0xdc, 0x70, 0xac, 0xc6, 0x43, 0xb1, 0x49, 0x50,
+	0xeb, 0xe5, 0x9e, 0x94, 0xbd, 0x40, 0x68, 0xd4, 0xce, 0xe8, 0xe4, 0x4e, 0xac, 0xa2, 0x51, 0x57,
+	0x69, 0x64, 0xfb, 0x9f, 0x4b, 0x70, 0xa3, 0x3d, 0x70, 0x23, 0xb5, 0x1d, 0xc8, 0xee, 0x69, 0x3b,
+	0x74, 0x87, 0x71, 0x5f, 0xaa, 0x6d, 0x37, 0x16, 0xfc, 0x35, 0xa8, 0x76, 0x10, 0x18, 0xb7, 0x0a,
+	0x1b, 0xa5, 0x5b, 0xcd, 0xbb, 0xeb, 0x9b, 0x13, 0x8c, 0x37, 0x89, 0xc2, 0x31, 0x38, 0xfc, 0x0d,
+	0xa8, 0x79, 0x42, 0xb9, 0x7e, 0x10, 0xb7, 0x8a, 0x1b, 0x85, 0x5b, 0xcd, 0xbb, 0x2f, 0x6e, 0xea,
+	0x0f, 0x6f, 0x26, 0x1f, 0xde, 0x6c, 0xd3, 0x87, 0x9d, 0x04, 0x8f, 0xbf, 0x09, 0xf5, 0x13, 0x3f,
+	0x10, 0x1f, 0x89, 0x71, 0xdc, 0x2a, 0x5d, 0x4e, 0x93, 0x22, 0xf2, 0x0f, 0x60, 0x55, 0x9c, 0xab,
+	0xc8, 0x75, 0x44, 0xe0, 0x2a, 0x5f, 0x86, 0x71, 0xab, 0x4c, 0xb3, 0x7b, 0x71, 0x6a, 0x76, 0xc9,
+	0x7b, 0x67, 0x0a, 0x9d, 0x6f, 0x40, 0x53, 0x76, 0x3e, 0x11, 0x5d, 0x75, 0x34, 0x1e, 0x8a, 0xb8,
+	0x55, 0xd9, 0x28, 0xdd, 0x6a, 0x38, 0x79, 0x10, 0xff, 0x06, 0x34, 0xbb, 0x32, 0x08, 0x44, 0x57,
+	0xf3, 0xaf, 0x5e, 0x3e, 0xb5, 0x3c, 0x2e, 0x7f, 0x0b, 0x5e, 0x88, 0xc4, 0x40, 0x9e, 0x09, 0x6f,
+	0x27, 0x85, 0xd2, 0xfa, 0xea, 0xf4, 0x99, 0xf9, 0x2f, 0xf9, 0x16, 0xac, 0x44, 0x66, 0x7e, 0x0f,
+	0xfd, 0xf0, 0x34, 0x6e, 0xd5, 0x68, 0x49, 0x2f, 0x5d, 0xb0, 0x24, 0xc4, 0x71, 0x26, 0x29, 0xec,
+	0x7f, 0xf9, 0x10, 0x2a, 0x74, 0x20, 0x7c, 0x15, 0x8a, 0xbe, 0xd7, 0x2a, 0x6c, 0x14, 0x6e, 0x35,
+	0x9c, 0xa2, 0xef, 0xf1, 0x3b, 0x50, 0x3d, 0xf1, 0x45, 0xe0, 0x3d, 0xf7, 0x5c, 0x0c, 0x1a, 0xbf,
+	0x0f, 0xcb, 0x91, 0x88, 0x55, 0xe4, 0x9b, 0xf5, 0xeb, 0xa3, 0xf9, 0xe2, 0xbc, 0xd3, 0xdf, 0x74,
+	0x72, 0x88, 0xce, 0x04, 0x19, 0xee, 0x73, 0xb7, 0xef, 0x07, 0x5e, 0x24, 0xc2, 0x7d, 0x4f, 0x9f,
+	0x52, 0xc3, 0xc9, 0x83, 0xf8, 0x2d, 0xb8, 0xd6, 0x71, 0xbb, 0xa7, 0xbd, 0x48, 0x8e, 0x42, 0xdc,
+	0x12, 0x19, 0xb5, 0x2a, 0x34, 0xed, 0x69, 0x30, 0x7f, 0x1d, 0x2a, 0x6e, 0xe0, 0xf7, 0x42, 0x3a,
+	0x8b, 0xd5, 0xbb, 0xd6, 0xdc, 0xb9, 0x6c, 0x21, 0x86, 0xa3, 0x11, 0xf9, 0x1e, 0xac, 0x9c, 0x89,
+	0x48, 0xf9, 0x5d, 0x37, 0x20, 0x78, 0xab, 0x46, 0x94, 0xf6, 0x5c, 0xca, 0xe3, 0x3c, 0xa6, 0x33,
+	0x49, 0xc8, 0xf7, 0x01, 0x62, 0xbc, 0x20, 0x24, 0xe7, 0xad, 0x26, 0x6d, 0xc6, 0x97, 0xe7, 0xb2,
+	0xd9, 0x91, 0xa1, 0x12, 0xa1, 0xda, 0x6c, 0xa7, 0xe8, 0x7b, 0x4b, 0x4e, 0x8e, 0x98, 0xbf, 0x0d,
+	0x65, 0x25, 0xce, 0x55, 0x6b, 0xf5, 0x92, 0x1d, 0x4d, 0x98, 0x1c, 0x89, 0x73, 0xb5, 0xb7, 0xe4,
+	0x10, 0x01, 0x12, 0xe2, 0x05, 0x68, 0x5d, 0x5b, 0x80, 0xf0, 0x81, 0x1f, 0x08, 0x24, 0x44, 0x02,
+	0xfe, 0x1e, 0x54, 0x03, 0x77, 0x2c, 0x47, 0xaa, 0xc5, 0x88, 0xf4, 0xb7, 0x2e, 0x25, 0x7d, 0x48,
+	0xa8, 0x7b, 0x4b, 0x8e, 0x21, 0xe2, 0x6f, 0x41, 0xc9, 0xf3, 0xcf, 0x5a, 0x6b, 0x44, 0xbb, 0x71,
+	0x29, 0xed, 0xae, 0x7f, 0xb6, 0xb7, 0xe4, 0x20, 0x3a, 0xdf, 0x81, 0x7a, 0x47, 0xca, 0xd3, 0x81,
+	0x1b, 0x9d, 0xb6, 0x38, 0x91, 0x7e, 0xe9, 0x52, 0xd2, 0x6d, 0x83, 0xbc, 0xb7, 0xe4, 0xa4, 0x84,
+	0xb8, 0x64, 0xbf, 0x2b, 0xc3, 0xd6, 0xf5, 0x05, 0x96, 0xbc, 0xdf, 0x95, 0x21, 0x2e, 0x19, 0x09,
+	0x90, 0x30, 0xf0, 0xc3, 0xd3, 0xd6, 0xfa, 0x02, 0x84, 0x78, 0x77, 0x90, 0x10, 0x09, 0x70, 0xda,
+	0x9e, 0xab, 0xdc, 0x33, 0x5f, 0x3c, 0x6b, 0xbd, 0xb0, 0xc0, 0xb4, 0x77, 0x0d, 0x32, 0x4e, 0x3b,
+	0x21, 0x44, 0x26, 0xc9, 0xc5, 0x6c, 0xdd, 0x58, 0x80, 0x49, 0x72, 0xa7, 0x91, 0x49, 0x42, 0xc8,
+	0xff, 0x00, 0xd6, 0x4e, 0x84, 0xab, 0x46, 0x91, 0xf0, 0x32, 0x35, 0xf7, 0x22, 0x71, 0xdb, 0xbc,
+	0xfc, 0xec, 0xa7, 0xa9, 0xf6, 0x96, 0x9c, 0x59, 0x56, 0xfc, 0x5d, 0xa8, 0x04, 0xae, 0x12, 0xe7,
+	0xad, 0x16, 0xf1, 0xb4, 0x9f, 0x23, 0x14, 0x4a, 0x9c, 0xef, 0x2d, 0x39, 0x9a, 0x84, 0x7f, 0x1b,
+	0xae, 0x29, 0xb7, 0x13, 0x88, 0x83, 0x13, 0x83, 0x10, 0xb7, 0xfe, 0x1f, 0x71, 0x79, 0xed, 0x72,
+	0x71, 0x9e, 0xa4, 0xd9, 0x5b, 0x72, 0xa6, 0xd9, 0xe0, 0xac, 0x08, 0xd4, 0xb2, 0x16, 0x98, 0x15,
+	0xf1, 0xc3, 0x59, 0x11, 0x09, 0x7f, 0x08, 0x4d, 0x7a, 0xd8, 0x91, 0xc1, 0x68, 0x10, 0xb6, 0x5e,
+	0x22, 0x0e, 0xb7, 0x9e, 0xcf, 0x41, 0xe3, 0xef, 0x2d, 0x39, 0x79, 0x72, 0x3c, 0x44, 0x1a, 0x3a,
+	0xf2, 0x59, 0xeb, 0xe5, 0x05, 0x0e, 0xf1, 0xc8, 0x20, 0xe3, 0x21, 0x26, 0x84, 0x78, 0xf5, 0x9e,
+	0xf9, 0x5e, 0x4f, 0xa8, 0xd6, 0x17, 0x16, 0xb8, 0x7a, 0x4f, 0x09, 0x15, 0xaf, 0x9e, 0x26, 0xb2,
+	0xbe, 0x07, 0xcb, 0x79, 0xe5, 0xca, 0x39, 0x94, 0x23, 0xe1, 0x6a, 0xc5, 0x5e, 0x77, 0xe8, 0x19,
+	0x61, 0xc2, 0xf3, 0x15, 0x29, 0xf6, 0xba, 0x43, 0xcf, 0xfc, 0x06, 0x54, 0xb5, 0x91, 0x21, 0xbd,
+	0x5d, 0x77, 0xcc, 0x08, 0x71, 0xbd, 0xc8, 0xed, 0xb5, 0xca, 0x1a, 0x17, 0x9f, 0x11, 0xd7, 0x8b,
+	0xe4, 0xf0, 0x20, 0x24, 0xbd, 0x5b, 0x77, 0xcc, 0xc8, 0xfa, 0x9f, 0x77, 0xa0, 0x66, 0x26, 0x66,
+	0xfd, 0x75, 0x01, 0xaa, 0x5a, 0x2f, 0xf0, 0x0f, 0xa0, 0x12, 0xab, 0x71, 0x20, 0x68, 0x0e, 0xab,
+	0x77, 0xbf, 0xb2, 0x80, 0x2e, 0xd9, 0x6c, 0x23, 0x81, 0xa3, 0xe9, 0x6c, 0x07, 0x2a, 0x34, 0xe6,
+	0x35, 0x28, 0x39, 0xf2, 0x19, 0x5b, 0xe2, 0x00, 0x55, 0xbd, 0xe7, 0xac, 0x80, 0xc0, 0x5d, 0xff,
+	0x8c, 0x15, 0x11, 0xb8, 0x27, 0x5c, 0x4f, 0x44, 0xac, 0xc4, 0x57, 0xa0, 0x91, 0xec, 0x6e, 0xcc,
+	0xca, 0x9c, 0xc1, 0x72, 0xee, 0xdc, 0x62, 0x56, 0xb1, 0xfe, 0xa6, 0x02, 0x65, 0xbc, 0xc6, 0xfc,
+	0x15, 0x58, 0x51, 0x6e, 0xd4, 0x13, 0xda, 0x93, 0xd9, 0x4f, 0x4c, 0xe0, 0x24, 0x90, 0xbf, 0x97,
+	0xac, 0xa1, 0x48, 0x6b, 0xf8, 0xf2, 0x73, 0xd5, 0xc3, 0xc4, 0x0a, 0x72, 0xc6, 0xb4, 0xb4, 0x98,
+	0x31, 0x7d, 0x00, 0x75, 0xd4, 0x4a, 0x6d, 0xff, 0x7b, 0x82, 0xb6, 0x7e, 0xf5, 0xee, 0xed, 0xe7,
+	0x7f, 0x72, 0xdf, 0x50, 0x38, 0x29, 0x2d, 0xdf, 0x87, 0x46, 0xd7, 0x8d, 0x3c, 0x9a, 0x0c, 0x9d,
+	0xd6, 0xea, 0xdd, 0xaf, 0x3e, 0x9f, 0xd1, 0x4e, 0x42, 0xe2, 0x64, 0xd4, 0xfc, 0x00, 0x9a, 0x9e,
+	0x88, 0xbb, 0x91, 0x3f, 0x24, 0x2d, 0xa5, 0x4d, 0xea, 0xd7, 0x9e, 0xcf, 0x6c, 0x37, 0x23, 0x72,
+	0xf2, 0x1c, 0xf8, 0xcb, 0xd0, 0x88, 0x52, 0x35, 0x55, 0x23, 0x3b, 0x9f, 0x01, 0xf8, 0x6d, 0x60,
+	0xfa, 0x08, 0xda, 0xa3, 0x4e, 0x72, 0x34, 0x75, 0x3a, 0x9a, 0x19, 0xb8, 0xfd, 0x36, 0xd4, 0x93,
+	0xb5, 0xf3, 0x65, 0xa8, 0xe3, 0xef, 0x63, 0x19, 0x0a, 0xb6, 0x84, 0x72, 0x80, 0xa3, 0xf6, 0xc0,
+	0x0d, 0x02, 0x56, 0xe0, 0xab, 0x00, 0x38, 0x7c, 0x24, 0x3c, 0x7f, 0x34, 0x60, 0x45, 0xfb, 0xb7,
+	0x13, 0xc9, 0xaa, 0x43, 0xf9, 0xd0, 0xed, 0x21, 0xc5, 0x32, 0xd4, 0x13, 0x0d, 0xcd, 0x0a, 0x48,
+	0xbf, 0xeb, 0xc6, 0xfd, 0x8e, 0x74, 0x23, 0x8f, 0x15, 0x79, 0x13, 0x6a, 0x5b, 0x51, 0xb7, 0xef,
+	0x9f, 0x09, 0x56, 0xb2, 0xef, 0x40, 0x33, 0xb7, 0x36, 0x64, 0x61, 0x3e, 0xda, 0x80, 0xca, 0x96,
+	0xe7, 0x09, 0x8f, 0x15, 0x90, 0xc0, 0x6c, 0x06, 0x2b, 0xda, 0x5f, 0x85, 0x46, 0xba, 0xb3, 0x88,
+	0x8e, 0xb6, 0x9a, 0x2d, 0xe1, 0x13, 0x82, 0x59, 0x01, 0x25, 0x78, 0x3f, 0x0c, 0xfc, 0x50, 0xb0,
+	0xa2, 0xf5, 0x5d, 0x12, 0x6b, 0xfe, 0x3b, 0x93, 0x97, 0xe7, 0xd5, 0xe7, 0x19, 0xd3, 0xc9, 0x9b,
+	0xf3, 0x52, 0x6e, 0x7d, 0x0f, 0x7d, 0x9a, 0x5c, 0x1d, 0xca, 0xbb, 0x52, 0xc5, 0xac, 0x60, 0xfd,
+	0x77, 0x11, 0xea, 0x89, 0x0d, 0xe5, 0x0c, 0x4a, 0xa3, 0x28, 0x30, 0xc2, 0x8f, 0x8f, 0x7c, 0x1d,
+	0x2a, 0xca, 0x57, 0x46, 0xe4, 0x1b, 0x8e, 0x1e, 0xa0, 0x7b, 0x96, 0x97, 0x82, 0x12, 0xbd, 0x9b,
+	0x3e, 0x56, 0x7f, 0xe0, 0xf6, 0xc4, 0x9e, 0x1b, 0xf7, 0x49, 0x76, 0x1b, 0x4e, 0x06, 0x40, 0xfa,
+	0x13, 0xf7, 0x0c, 0xe5, 0x93, 0xde, 0x6b, 0xc7, 0x2d, 0x0f, 0xe2, 0x6f, 0x42, 0x19, 0x17, 0x68,
+	0x04, 0xec, 0xff, 0x4f, 0x2d, 0x18, 0x45, 0xea, 0x30, 0x12, 0x78, 0x3c, 0x9b, 0xe8, 0x76, 0x3b,
+	0x84, 0xcc, 0x5f, 0x85, 0x55, 0x2d, 0x15, 0x07, 0xe4, 0x90, 0xef, 0x7b, 0xe4, 0xb8, 0x35, 0x9c,
+	0x29, 0x28, 0xdf, 0xc2, 0xed, 0x74, 0x95, 0x68, 0xd5, 0x17, 0xb8, 0x0b, 0xc9, 0xe6, 0x6c, 0xb6,
+	0x91, 0xc4, 0xd1, 0x94, 0xf6, 0x3d, 0xdc, 0x53, 0x57, 0x09, 0x3c, 0xe6, 0xfb, 0x83, 0xa1, 0x1a,
+	0x6b, 0xa1, 0x79, 0x20, 0x54, 0xb7, 0xef, 0x87, 0x3d, 0x56, 0xd0, 0x5b, 0x8c, 0x87, 0x48, 0x28,
+	0x51, 0x24, 0x23, 0x56, 0xb2, 0x2c, 0x28, 0xa3, 0x8c, 0xa2, 0x42, 0x0d, 0xdd, 0x81, 0x30, 0x3b,
+	0x4d, 0xcf, 0xd6, 0x75, 0x58, 0x9b, 0x31, 0xc1, 0xd6, 0xcf, 0xab, 0x5a, 0x42, 0x90, 0x82, 0xdc,
+	0x3f, 0x43, 0x81, 0xcf, 0x57, 0xd3, 0x47, 0xc8, 0x65, 0x52, 0x1f, 0xbd, 0x07, 0x15, 0x5c, 0x58,
+	0xa2, 0x8e, 0x16, 0x20, 0x7f, 0x84, 0xe8, 0x8e, 0xa6, 0xe2, 0x2d, 0xa8, 0x75, 0xfb, 0xa2, 0x7b,
+	0x2a, 0x3c, 0x63, 0x17, 0x92, 0x21, 0x0a, 0x4d, 0x37, 0xe7, 0x91, 0xeb, 0x01, 0x89, 0x44, 0x57,
+	0x86, 0xf7, 0x07, 0xf2, 0x13, 0xbf, 0x55, 0x35, 0x22, 0x91, 0x00, 0x92, 0xb7, 0xfb, 0x28, 0x23,
+	0xe6, 0xd8, 0x32, 0x80, 0x75, 0x1f, 0x2a, 0xf4, 0x6d, 0xbc, 0x09, 0x7a, 0xce, 0x3a, 0xac, 0x7c,
+	0x75, 0xb1, 0x39, 0x9b, 0x29, 0x5b, 0x7f, 0x5f, 0x84, 0x32, 0x8e, 0xf9, 0x6d, 0xa8, 0x44, 0x6e,
+	0xd8, 0xd3, 0x07, 0x30, 0x1b, 0x9d, 0x3a, 0xf8, 0xce, 0xd1, 0x28, 0xfc, 0x03, 0x23, 0x8a, 0xc5,
+	0x05, 0x84, 0x25, 0xfd, 0x62, 0x5e, 0x2c, 0xd7, 0xa1, 0x32, 0x74, 0x23, 0x77, 0x60, 0xee, 0x89,
+	0x1e, 0xd8, 0x3f, 0x2e, 0x40, 0x19, 0x91, 0xf8, 0x1a, 0xac, 0xb4, 0x55, 0xe4, 0x9f, 0x0a, 0xd5,
+	0x8f, 0xe4, 0xa8, 0xd7, 0xd7, 0x92, 0xf4, 0x91, 0x18, 0x77, 0x64, 0xa6, 0x10, 0x94, 0x1b, 0xf8,
+	0x5d, 0x56, 0x44, 0xa9, 0xda, 0x96, 0x81, 0xc7, 0x4a, 0xfc, 0x1a, 0x34, 0x9f, 0x84, 0x9e, 0x88,
+	0xe2, 0xae, 0x8c, 0x84, 0xc7, 0xca, 0xe6, 0x76, 0x9f, 0xb2, 0x0a, 0xd9, 0x3d, 0x71, 0xae, 0x28,
+	0xfc, 0x61, 0x55, 0x7e, 0x1d, 0xae, 0x6d, 0x4f, 0xc6, 0x44, 0xac, 0x86, 0x3a, 0xe9, 0x91, 0x08,
+	0x51, 0xc8, 0x58, 0x5d, 0x0b, 0xb1, 0xfc, 0xc4, 0x67, 0x0d, 0xfc, 0x98, 0xbe, 0x27, 0x0c, 0xec,
+	0x7f, 0x2c, 0x24, 0x9a, 0x63, 0x05, 0x1a, 0x87, 0x6e, 0xe4, 0xf6, 0x22, 0x77, 0x88, 0xf3, 0x6b,
+	0x42, 0x4d, 0x1b, 0xd9, 0x37, 0x58, 0x21, 0x1b, 0xdc, 0x65, 0xc5, 0x6c, 0xf0, 0x26, 0x2b, 0x65,
+	0x83, 0xb7, 0x58, 0x19, 0xbf, 0xf1, 0xad, 0x91, 0x54, 0x82, 0x55, 0x48, 0xd7, 0x49, 0x4f, 0xb0,
+	0x2a, 0x02, 0x8f, 0x50, 0xa3, 0xb0, 0x1a, 0xae, 0x79, 0x07, 0xe5, 0xa7, 0x23, 0xcf, 0x59, 0x1d,
+	0xa7, 0x81, 0xdb, 0x28, 0x3c, 0xd6, 0xc0, 0x37, 0x8f, 0x47, 0x83, 0x8e, 0xc0, 0x65, 0x02, 0xbe,
+	0x39, 0x92, 0xbd, 0x5e, 0x20, 0x58, 0x93, 0x5f, 0x9b, 0x50, 0xbe, 0x6c, 0x99, 0x34, 0xad, 0x1b,
+	0x04, 0x72, 0xa4, 0xd8, 0x8a, 0xf5, 0xcb, 0x12, 0x94, 0x31, 0xa0, 0xc1, 0xbb, 0xd3, 0x47, 0x3d,
+	0x63, 0xee, 0x0e, 0x3e, 0xa7, 0x37, 0xb0, 0x98, 0xdd, 0x40, 0xfe, 0xae, 0x39, 0xe9, 0xd2, 0x02,
+	0x5a, 0x16, 0x19, 0xe7, 0x0f, 0x99, 0x43, 0x79, 0xe0, 0x0f, 0x84, 0xd1, 0x75, 0xf4, 0x8c, 0xb0,
+	0x18, 0x6d, 0x37, 0x5e, 0x83, 0x92, 0x43, 0xcf, 0x78, 0x6b, 0x5c, 0x34, 0x0b, 0x5b, 0x8a, 0xee,
+	0x40, 0xc9, 0x49, 0x86, 0xfc, 0xbd, 0x44, 0x2b, 0xd5, 0x16, 0xb8, 0xcd, 0xf4, 0xf9, 0xbc, 0x46,
+	0xca, 0x94, 0x41, 0x7d, 0x71, 0xf2, 0x9c, 0x91, 0xd8, 0x35, 0xd2, 0x98, 0x19, 0xb0, 0xba, 0xde,
+	0x3d, 0x56, 0xc0, 0x53, 0xa2, 0x6b, 0xa8, 0x75, 0xd9, 0xb1, 0xef, 0x09, 0xc9, 0x4a, 0x64, 0xe0,
+	0x46, 0x9e, 0x2f, 0x59, 0x19, 0xbd, 0xaf, 0xc3, 0xdd, 0x07, 0xac, 0x62, 0xbf, 0x9a, 0x33, 0x35,
+	0x5b, 0x23, 0x25, 0xd9, 0x52, 0x2a, 0x96, 0x05, 0x2d, 0x65, 0x1d, 0xe1, 0xb1, 0xa2, 0xfd, 0xf5,
+	0x39, 0xea, 0x73, 0x05, 0x1a, 0x4f, 0x86, 0x81, 0x74, 0xbd, 0x4b, 0xf4, 0xe7, 0x32, 0x40, 0x16,
+	0x20, 0x5b, 0x3f, 0xdb, 0xc8, 0xcc, 0x34, 0xfa, 0xa3, 0xb1, 0x1c, 0x45, 0x5d, 0x41, 0xaa, 0xa1,
+	0xe1, 0x98, 0x11, 0xff, 0x26, 0x54, 0xf0, 0x3d, 0x66, 0x30, 0x50, 0x63, 0xdc, 0x5e, 0x28, 0x2c,
+	0xdb, 0x3c, 0xf6, 0xc5, 0x33, 0x47, 0x13, 0xf2, 0x7b, 0x79, 0x17, 0xe5, 0x39, 0x09, 0xa3, 0x0c,
+	0x93, 0xdf, 0x04, 0x70, 0xbb, 0xca, 0x3f, 0x13, 0xc8, 0xcb, 0xdc, 0xfd, 0x1c, 0x84, 0x3b, 0xd0,
+	0xc4, 0x2b, 0x39, 0x3c, 0x88, 0xf0, 0x16, 0xb7, 0x96, 0x89, 0xf1, 0xeb, 0x8b, 0x4d, 0xef, 0xc3,
+	0x94, 0xd0, 0xc9, 0x33, 0xe1, 0x4f, 0x60, 0x59, 0x27, 0xa3, 0x0c, 0xd3, 0x15, 0x62, 0xfa, 0xc6,
+	0x62, 0x4c, 0x0f, 0x32, 0x4a, 0x67, 0x82, 0xcd, 0x6c, 0x8e, 0xa9, 0x72, 0xd5, 0x1c, 0x13, 0xda,
+	0xe6, 0xa3, 0x49, 0xdb, 0xac, 0x4d, 0xc0, 0x14, 0x94, 0xdb, 0xb0, 0xec, 0xc7, 0x59, 0x8a, 0x8b,
+	0xd2, 0x1d, 0x75, 0x67, 0x02, 0x66, 0xfd, 0xa2, 0x06, 0x65, 0xda, 0xc2, 0xe9, 0x74, 0xd5, 0xce,
+	0x84, 0xaa, 0xbe, 0xb3, 0xf8, 0x51, 0x4f, 0xdd, 0x64, 0xd2, 0x0c, 0xa5, 0x9c, 0x66, 0xf8, 0x26,
+	0x54, 0x62, 0x19, 0xa9, 0xe4, 0xf8, 0x17, 0x14, 0xa2, 0xb6, 0x8c, 0x94, 0xa3, 0x09, 0xf9, 0x03,
+	0xa8, 0x9d, 0xf8, 0x81, 0x12, 0x51, 0xb2, 0x79, 0xaf, 0x2d, 0xc6, 0xe3, 0x01, 0x11, 0x39, 0x09,
+	0x31, 0x7f, 0x98, 0x17, 0xc6, 0xea, 0x46, 0xe9, 0xb9, 0x61, 0x7d, 0xca, 0x69, 0x9e, 0x8c, 0xde,
+	0x06, 0xd6, 0x95, 0x67, 0x22, 0x4a, 0xde, 0x7d, 0x24, 0xc6, 0xc6, 0xf8, 0xce, 0xc0, 0xb9, 0x05,
+	0xf5, 0xbe, 0xef, 0x09, 0xf4, 0x5f, 0x48, 0xc7, 0xd4, 0x9d, 0x74, 0xcc, 0x3f, 0x82, 0x3a, 0xc5,
+	0x08, 0xa8, 0xed, 0x1a, 0x57, 0xde, 0x7c, 0x1d, 0xae, 0x24, 0x0c, 0xf0, 0x43, 0xf4, 0xf1, 0x07,
+	0xbe, 0x6a, 0x81, 0xfe, 0x50, 0x32, 0xc6, 0x09, 0x93, 0xbc, 0xe7, 0x27, 0xdc, 0xd4, 0x13, 0x9e,
+	0x86, 0x63, 0x3e, 0x95, 0x60, 0x53, 0xc6, 0x0f, 0xaf, 0x1a, 0x32, 0x9d, 0xff, 0x12, 0x1d, 0x91,
+	0xa1, 0xdb, 0x13, 0x0f, 0xfd, 0x81, 0xaf, 0x5a, 0x2b, 0x1b, 0x85, 0x5b, 0x15, 0x27, 0x03, 0xf0,
+	0xd7, 0x60, 0xcd, 0x13, 0x27, 0xee, 0x28, 0x50, 0x47, 0x62, 0x30, 0x0c, 0x5c, 0x25, 0xf6, 0x3d,
+	0x92, 0xd1, 0x86, 0x33, 0xfb, 0x02, 0x93, 0x94, 0x1e, 0xaa, 0xe8, 0xdc, 0x64, 0xaf, 0xe9, 0x24,
+	0xe5, 0x14, 0x98, 0x6f, 0x02, 0x17, 0xa1, 0xb7, 0x3b, 0x85, 0xcc, 0x08, 0x79, 0xce, 0x1b, 0x5c,
+	0x9b, 0x27, 0x86, 0x22, 0xf4, 0x44, 0xd8, 0x1d, 0xe7, 0x49, 0xd6, 0x88, 0x64, 0xfe, 0x4b, 0xf4,
+	0x44, 0x3e, 0x1d, 0x89, 0x68, 0x4c, 0x99, 0xb5, 0x86, 0xa3, 0x07, 0xf6, 0xa1, 0x51, 0xfd, 0x68,
+	0x8c, 0x31, 0x3e, 0x4e, 0x94, 0x76, 0xac, 0xb4, 0x75, 0xff, 0xd0, 0x0d, 0x02, 0x11, 0x8d, 0x75,
+	0x70, 0xfd, 0x91, 0x1b, 0x76, 0xdc, 0x90, 0x95, 0xc8, 0x5e, 0xbb, 0x81, 0x08, 0x3d, 0x37, 0x62,
+	0x65, 0x1c, 0x1d, 0xf9, 0x03, 0x41, 0x61, 0x4b, 0xc5, 0xbe, 0x05, 0x65, 0x3a, 0xc9, 0x06, 0x54,
+	0x74, 0xd0, 0x45, 0xc1, 0xba, 0x09, 0xb8, 0xc8, 0x10, 0x3c, 0xc4, 0x5b, 0xcf, 0x8a, 0xd6, 0xcf,
+	0x4a, 0x50, 0x4f, 0x66, 0x88, 0xe1, 0xc7, 0xa9, 0x18, 0x27, 0xe1, 0xc7, 0xa9, 0x18, 0x93, 0x57,
+	0x18, 0x1f, 0xfb, 0xb1, 0xdf, 0x31, 0x5e, 0x6e, 0xdd, 0xc9, 0x00, 0xb8, 0x9c, 0x67, 0xbe, 0xa7,
+	0xfa, 0x74, 0x55, 0x2b, 0x8e, 0x1e, 0x24, 0x9b, 0xbe, 0x1f, 0x76, 0x83, 0x91, 0x27, 0x70, 0x56,
+	0x26, 0x43, 0x31, 0x0d, 0xe6, 0xdf, 0x01, 0x50, 0xfe, 0x40, 0x3c, 0x90, 0xd1, 0xc0, 0x55, 0x26,
+	0xd4, 0xf8, 0xc6, 0xd5, 0x2e, 0xd3, 0xe6, 0x51, 0xca, 0xc0, 0xc9, 0x31, 0x43, 0xd6, 0xf8, 0x35,
+	0xc3, 0xba, 0xf6, 0xb9, 0x58, 0xef, 0xa6, 0x0c, 0x9c, 0x1c, 0x33, 0xfb, 0xf7, 0x00, 0xb2, 0x37,
+	0xfc, 0x06, 0xf0, 0x47, 0x32, 0x54, 0xfd, 0xad, 0x4e, 0x27, 0xda, 0x16, 0x27, 0x32, 0x12, 0xbb,
+	0x2e, 0x5a, 0xd3, 0x17, 0x60, 0x2d, 0x85, 0x6f, 0x9d, 0x28, 0x11, 0x21, 0x98, 0xb6, 0xbe, 0xdd,
+	0x97, 0x91, 0xd2, 0xae, 0x1a, 0x3d, 0x3e, 0x69, 0xb3, 0x12, 0x5a, 0xf0, 0xfd, 0xf6, 0x01, 0x2b,
+	0xdb, 0xb7, 0x00, 0xb2, 0x25, 0x51, 0x48, 0x43, 0x4f, 0x6f, 0xdc, 0x65, 0x4b, 0xd9, 0xe8, 0xee,
+	0x5b, 0xac, 0x60, 0x7d, 0x56, 0x84, 0x32, 0x6a, 0x38, 0xa3, 0x85, 0xab, 0xa9, 0x16, 0xde, 0x80,
+	0x66, 0x5e, 0x22, 0xf5, 0x71, 0xe6, 0x41, 0x9f, 0x4f, 0x4f, 0xe3, 0xb7, 0xf2, 0x7a, 0xfa, 0x1d,
+	0x68, 0x76, 0x47, 0xb1, 0x92, 0x03, 0x32, 0x52, 0xad, 0x12, 0xe9, 0xc2, 0x1b, 0x33, 0x39, 0x95,
+	0x63, 0x37, 0x18, 0x09, 0x27, 0x8f, 0xca, 0xef, 0x41, 0xf5, 0x44, 0x1f, 0x8c, 0xce, 0xaa, 0x7c,
+	0xe1, 0x02, 0x3b, 0x66, 0x36, 0xdf, 0x20, 0xe3, 0xba, 0xfc, 0x19, 0xa1, 0xca, 0x83, 0x50, 0x3b,
+	0x25, 0xba, 0xf5, 0x30, 0x92, 0x43, 0x11, 0xa9, 0x54, 0x9d, 0x4e, 0xc3, 0xed, 0x2f, 0x99, 0x5b,
+	0x57, 0x83, 0xd2, 0x56, 0xdc, 0x35, 0x31, 0xb9, 0x88, 0xbb, 0xda, 0xe1, 0xdf, 0xa1, 0xe9, 0xb2,
+	0xa2, 0xf5, 0x5f, 0x75, 0xa8, 0x6a, 0x1b, 0x60, 0xf6, 0xb9, 0x91, 0xee, 0xf3, 0xb7, 0xa0, 0x8e,
+	0xbc, 0x5c, 0x25, 0x23, 0x93, 0x18, 0xb8, 0x77, 0x15, 0x9b, 0xb2, 0x79, 0x60, 0x88, 0x9d, 0x94,
+	0xcd, 0xf4, 0xd1, 0x15, 0x67, 0x8f, 0x6e, 0xde, 0x12, 0x2b, 0xf3, 0x97, 0xc8, 0x8f, 0xa0, 0xd1,
+	0x95, 0xa1, 0xe7, 0xa7, 0x49, 0x82, 0xd5, 0xbb, 0x5f, 0xbf, 0xd2, 0x0c, 0x77, 0x12, 0x6a, 0x27,
+	0x63, 0xc4, 0x5f, 0x83, 0xca, 0x19, 0x9e, 0x29, 0x1d, 0xde, 0xc5, 0x27, 0xae, 0x91, 0xf8, 0xc7,
+	0xd0, 0xfc, 0x74, 0xe4, 0x77, 0x4f, 0x0f, 0xf2, 0x09, 0xab, 0x77, 0xae, 0x34, 0x8b, 0x6f, 0x65,
+	0xf4, 0x4e, 0x9e, 0x59, 0x4e, 0x8e, 0x6a, 0xbf, 0x86, 0x1c, 0xd5, 0x67, 0xe5, 0xc8, 0x81, 0x95,
+	0x50, 0xc4, 0x4a, 0x78, 0x0f, 0x8c, 0xcb, 0x00, 0x9f, 0xc3, 0x65, 0x98, 0x64, 0x61, 0xbf, 0x02,
+	0xf5, 0xe4, 0xc0, 0x49, 0xe6, 0x42, 0x8f, 0x2d, 0xf1, 0x2a, 0x14, 0x0f, 0x22, 0x9d, 0x3e, 0x7d,
+	0x2c, 0x31, 0x3b, 0xf5, 0x77, 0x45, 0x68, 0xa4, 0xbb, 0x3e, 0x99, 0xcd, 0xba, 0xff, 0xe9, 0xc8,
+	0xc5, 0xf4, 0x19, 0x86, 0x63, 0x52, 0xe9, 0x11, 0x69, 0x91, 0x0f, 0x23, 0xe1, 0x2a, 0x4a, 0xb8,
+	0xa2, 0xd9, 0x10, 0x31, 0xe6, 0x5a, 0x39, 0xac, 0x1a, 0xf0, 0x41, 0xa4, 0x51, 0x2b, 0x18, 0xad,
+	0xe1, 0xdb, 0x04, 0x50, 0x25, 0x74, 0xff, 0x54, 0xe8, 0x68, 0xf4, 0xb1, 0x54, 0x34, 0xa8, 0xe3,
+	0xa4, 0xf6, 0x43, 0xd6, 0xc0, 0x6f, 0x3e, 0x96, 0x6a, 0x3f, 0x64, 0x90, 0x85, 0x09, 0xcd, 0xe4,
+	0xf3, 0x34, 0x5a, 0xa6, 0x20, 0x24, 0x08, 0xf6, 0x43, 0xb6, 0x62, 0x5e, 0xe8, 0xd1, 0x2a, 0x72,
+	0xbc, 0x7f, 0xee, 0x76, 0x91, 0xfc, 0x1a, 0x66, 0xfc, 0x90, 0xc6, 0x8c, 0x19, 0x5e, 0xb0, 0xfb,
+	0xe7, 0x7e, 0xac, 0x62, 0xb6, 0x86, 0x1c, 0x1c, 0xd1, 0x13, 0xe7, 0x8c, 0x53, 0x62, 0x50, 0xb9,
+	0x91, 0x8a, 0x9f, 0xfa, 0xaa, 0xcf, 0xae, 0x23, 0xc7, 0xfb, 0xa1, 0xa7, 0x47, 0xeb, 0x18, 0x9f,
+	0x3c, 0xed, 0xcb, 0x40, 0x3c, 0x95, 0x91, 0xc7, 0x5e, 0xb0, 0xff, 0xb5, 0x00, 0xcd, 0x9c, 0x64,
+	0xe0, 0x6b, 0xfa, 0x00, 0xea, 0x66, 0x1d, 0xcd, 0x7c, 0x07, 0xf7, 0x3f, 0xf2, 0x12, 0xbd, 0x7b,
+	0x24, 0xf1, 0xb1, 0x48, 0xa6, 0x52, 0x0e, 0x64, 0x14, 0xc9, 0x67, 0xda, 0x8c, 0x3e, 0x74, 0x63,
+	0xf5, 0x54, 0x88, 0x53, 0x56, 0xc6, 0x2d, 0xda, 0x19, 0x45, 0x91, 0x08, 0x35, 0xa0, 0x42, 0x8b,
+	0x12, 0xe7, 0x7a, 0x54, 0x45, 0xa6, 0x88, 0x4c, 0x8a, 0x9d, 0xd5, 0x30, 0xa1, 0x6d, 0xb0, 0x35,
+	0xa4, 0x8e, 0x08, 0x88, 0xae, 0x87, 0x0d, 0x8c, 0xfc, 0x75, 0xe4, 0x7c, 0x70, 0xb2, 0xeb, 0x8e,
+	0xe3, 0xad, 0x9e, 0x64, 0x30, 0x0d, 0x7c, 0x2c, 0x9f, 0xb1, 0xa6, 0x35, 0x02, 0xc8, 0x62, 0x0a,
+	0x8c, 0xa5, 0x50, 0x92, 0xd2, 0x3c, 0xb8, 0x19, 0xf1, 0x03, 0x00, 0x7c, 0x22, 0xcc, 0x24, 0xa0,
+	0xba, 0x82, 0xa3, 0x47, 0x74, 0x4e, 0x8e, 0x85, 0xf5, 0x87, 0xd0, 0x48, 0x5f, 0x60, 0x68, 0x4c,
+	0x2e, 0x59, 0xfa, 0xd9, 0x64, 0x88, 0x86, 0xde, 0x0f, 0x3d, 0x71, 0x4e, 0x0a, 0xa9, 0xe2, 0xe8,
+	0x01, 0xce, 0xb2, 0xef, 0x7b, 0x9e, 0x08, 0x93, 0x6a, 0x85, 0x1e, 0xcd, 0x2b, 0x0d, 0x97, 0xe7,
+	0x96, 0x86, 0xad, 0xdf, 0x87, 0x66, 0x2e, 0xe8, 0xb9, 0x70, 0xd9, 0xb9, 0x89, 0x15, 0x27, 0x27,
+	0xf6, 0x32, 0x34, 0xa4, 0x89, 0x5c, 0x62, 0xb2, 0x40, 0x0d, 0x27, 0x03, 0x58, 0xff, 0x50, 0x84,
+	0x8a, 0x5e, 0xda, 0x74, 0xa0, 0xf2, 0x00, 0xaa, 0x18, 0xb5, 0x8f, 0x92, 0xba, 0xfa, 0x82, 0x37,
+	0xbb, 0x4d, 0x34, 0x58, 0xe8, 0xd1, 0xd4, 0xfc, 0x3d, 0x28, 0x29, 0xb7, 0x67, 0x12, 0x78, 0x5f,
+	0x59, 0x8c, 0xc9, 0x91, 0xdb, 0xc3, 0x62, 0xab, 0x72, 0x7b, 0xfc, 0x21, 0xd4, 0xbb, 0x26, 0xe7,
+	0x62, 0xb4, 0xe9, 0x82, 0xb1, 0x44, 0x92, 0xa9, 0xc1, 0xa2, 0x55, 0xc2, 0x81, 0x7f, 0x13, 0xca,
+	0x9e, 0xab, 0xb4, 0x61, 0x5c, 0x38, 0x46, 0xc2, 0xeb, 0x82, 0x55, 0x54, 0xa4, 0xdc, 0xae, 0x41,
+	0x85, 0x94, 0xb7, 0xd5, 0x82, 0xaa, 0x5e, 0xeb, 0xf4, 0xce, 0x59, 0x2f, 0x42, 0xe9, 0xc8, 0xed,
+	0xa1, 0xab, 0xe8, 0x7b, 0xb1, 0x09, 0xf5, 0xf1, 0xd1, 0x7a, 0x25, 0xcb, 0x1f, 0xe5, 0x53, 0x93,
+	0x85, 0x89, 0xd4, 0xa4, 0x55, 0x85, 0x32, 0x7e, 0xd1, 0x7a, 0xf9, 0x32, 0xb7, 0xd3, 0x7a, 0x03,
+	0x1d, 0x54, 0x2c, 0x58, 0xce, 0xcb, 0xba, 0xae, 0x63, 0x01, 0xb4, 0x23, 0x82, 0x24, 0x25, 0x4e,
+	0x03, 0x6b, 0x0d, 0xae, 0x4d, 0x95, 0x29, 0xad, 0x9a, 0xf1, 0xa7, 0xad, 0x1f, 0x16, 0xa0, 0x99,
+	0xab, 0x3c, 0xf1, 0x2d, 0xe3, 0xfe, 0x14, 0x16, 0xa8, 0x9e, 0xe4, 0xe8, 0x72, 0xce, 0x8f, 0xfd,
+	0x56, 0x96, 0xae, 0x31, 0x05, 0x04, 0x80, 0xaa, 0xbe, 0xd6, 0x26, 0x77, 0x82, 0x6a, 0xa8, 0x38,
+	0x91, 0x55, 0x2b, 0x59, 0xaf, 0x42, 0x3d, 0x29, 0x88, 0x61, 0x94, 0xe5, 0xc7, 0x3a, 0x3d, 0x67,
+	0x36, 0x29, 0x1d, 0x5b, 0x3f, 0x29, 0x40, 0x55, 0x17, 0x15, 0xf9, 0x76, 0xda, 0x04, 0x50, 0x58,
+	0xa0, 0x02, 0xa5, 0x89, 0x4c, 0xfd, 0x2e, 0xed, 0x04, 0xc0, 0x1d, 0xa3, 0x70, 0xca, 0x5c, 0x5f,
+	0x1a, 0xe4, 0x6e, 0x5b, 0x29, 0x7f, 0xdb, 0xec, 0xb7, 0xd3, 0x9a, 0x61, 0x92, 0x3a, 0x22, 0xdf,
+	0xe8, 0x28, 0x12, 0x82, 0x15, 0xd2, 0xc8, 0xa4, 0x48, 0xba, 0x52, 0x0e, 0x86, 0x6e, 0x57, 0x11,
+	0xa0, 0x64, 0x9f, 0x40, 0xfd, 0x50, 0xc6, 0xd3, 0x96, 0xab, 0x06, 0xa5, 0x23, 0x39, 0xd4, 0x5e,
+	0xd5, 0xb6, 0x54, 0xe4, 0x55, 0x11, 0x17, 0x71, 0xa2, 0x74, 0x16, 0xcb, 0xf1, 0x7b, 0x7d, 0xa5,
+	0x33, 0x94, 0xfb, 0x61, 0x28, 0x22, 0x56, 0x41, 0xeb, 0xe1, 0x88, 0x61, 0xe0, 0x76, 0x31, 0x49,
+	0xb9, 0x0a, 0x40, 0xf0, 0x07, 0x7e, 0x14, 0x2b, 0x56, 0xb3, 0xdf, 0x86, 0x8a, 0xee, 0xee, 0x58,
+	0x81, 0x06, 0x3d, 0x10, 0xab, 0x25, 0x9c, 0x10, 0x0d, 0x77, 0x44, 0xa8, 0xe8, 0x18, 0x56, 0x01,
+	0x08, 0xa0, 0x3f, 0x50, 0xb4, 0x9f, 0xc2, 0xca, 0x44, 0xb7, 0x08, 0x5f, 0x07, 0x36, 0x01, 0xc0,
+	0x89, 0x2e, 0xf1, 0x17, 0xe1, 0xfa, 0x04, 0xf4, 0x91, 0xef, 0x79, 0x94, 0x87, 0x9b, 0x7e, 0x91,
+	0x2c, 0x67, 0xbb, 0x01, 0xb5, 0xae, 0x3e, 0x01, 0xfb, 0x10, 0x56, 0xe8, 0x48, 0x1e, 0x09, 0xe5,
+	0x1e, 0x84, 0xc1, 0xf8, 0xd7, 0x6e, 0xe9, 0xb1, 0xbf, 0x0a, 0x15, 0xca, 0x87, 0xe3, 0x65, 0x38,
+	0x89, 0xe4, 0x80, 0x78, 0x55, 0x1c, 0x7a, 0x46, 0xee, 0x4a, 0x9a, 0x73, 0x2d, 0x2a, 0x69, 0xff,
+	0x5b, 0x03, 0x6a, 0x5b, 0xdd, 0xae, 0x1c, 0x85, 0x6a, 0xe6, 0xcb, 0xf3, 0x52, 0xae, 0xf7, 0xa0,
+	0xea, 0x9e, 0xb9, 0xca, 0x8d, 0x8c, 0x0e, 0x9b, 0x76, 0xa1, 0x0c, 0xaf, 0xcd, 0x2d, 0x42, 0x72,
+	0x0c, 0x32, 0x92, 0x75, 0x65, 0x78, 0xe2, 0xf7, 0x5a, 0xe5, 0x4b, 0xc9, 0x76, 0x08, 0xc9, 0x31,
+	0xc8, 0x48, 0x66, 0xd4, 0x6e, 0xe5, 0x52, 0x32, 0xad, 0x7b, 0x52, 0x2d, 0x7b, 0x07, 0xca, 0x7e,
+	0x78, 0x22, 0x4d, 0x33, 0xd7, 0x4b, 0x17, 0x10, 0xed, 0x87, 0x27, 0xd2, 0x21, 0x44, 0x4b, 0x40,
+	0x55, 0x4f, 0x98, 0x7f, 0x03, 0x2a, 0x54, 0xf6, 0x6a, 0x15, 0x16, 0xe8, 0x28, 0x31, 0xdd, 0x37,
+	0x9a, 0x82, 0xdf, 0x48, 0xaa, 0x28, 0xb4, 0x5f, 0x08, 0xa7, 0xe1, 0x76, 0x3d, 0xd9, 0x32, 0xeb,
+	0x3f, 0x0a, 0x58, 0x01, 0xa7, 0x95, 0xbd, 0x0a, 0xab, 0x22, 0xc4, 0xab, 0x9d, 0x28, 0x56, 0x73,
+	0xa7, 0xa7, 0xa0, 0xe8, 0x7b, 0x1a, 0x88, 0xe8, 0x8c, 0x7a, 0x26, 0xa4, 0xce, 0x83, 0xf8, 0x3b,
+	0xf0, 0xa2, 0x1e, 0x1e, 0x46, 0x22, 0x12, 0x81, 0x70, 0x63, 0xb1, 0xd3, 0x77, 0xc3, 0x50, 0x04,
+	0xc6, 0xcc, 0x5e, 0xf4, 0x1a, 0x53, 0x77, 0xfa, 0x55, 0x7b, 0xe8, 0x76, 0x45, 0x6c, 0xaa, 0x42,
+	0x13, 0x30, 0xfe, 0x35, 0xa8, 0x50, 0x4b, 0x5d, 0xcb, 0xbb, 0x5c, 0xf8, 0x34, 0x96, 0x25, 0x53,
+	0x3b, 0xb0, 0x05, 0xa0, 0x4f, 0xe3, 0x28, 0xd3, 0x9c, 0x5f, 0xbc, 0xf4, 0xf8, 0x10, 0xd1, 0xc9,
+	0x11, 0xe1, 0xfc, 0x3c, 0x11, 0x08, 0xd4, 0x0f, 0xa8, 0x1d, 0x69, 0xf1, 0x25, 0x67, 0x02, 0x66,
+	0xfd, 0x53, 0x09, 0xca, 0x78, 0x90, 0x88, 0xdc, 0x97, 0x03, 0x91, 0x66, 0x2b, 0xb5, 0xd0, 0x4e,
+	0xc0, 0xd0, 0xd1, 0x70, 0x75, 0x21, 0x38, 0x45, 0xd3, 0xaa, 0x6c, 0x1a, 0x8c, 0x98, 0xc3, 0x48,
+	0x62, 0x57, 0x55, 0x8a, 0x69, 0x5c, 0x92, 0x29, 0x30, 0xff, 0x3a, 0xdc, 0xc0, 0x5a, 0x95, 0x50,
+	0xa4, 0x7d, 0x9e, 0xca, 0xe8, 0x34, 0xc6, 0x9d, 0xdb, 0xf7, 0x4c, 0x9a, 0xeb, 0x82, 0xb7, 0xa8,
+	0xce, 0x3d, 0x71, 0xe6, 0x13, 0xa6, 0xae, 0x90, 0xa7, 0x63, 0x14, 0x0e, 0x57, 0x6f, 0x4d, 0xdb,
+	0xf0, 0xd2, 0x41, 0xe4, 0x14, 0x14, 0xbd, 0x19, 0xdd, 0x40, 0x12, 0xef, 0x7b, 0x94, 0x79, 0x6b,
+	0x38, 0x19, 0x00, 0xf3, 0xd9, 0x3d, 0x57, 0x89, 0x67, 0xee, 0xf8, 0x49, 0x14, 0xb4, 0x04, 0xbd,
+	0xce, 0x41, 0x30, 0x32, 0x0c, 0x64, 0xd7, 0x0d, 0xda, 0x4a, 0x46, 0x6e, 0x4f, 0x1c, 0xba, 0xaa,
+	0xdf, 0xea, 0x11, 0xd6, 0x0c, 0x1c, 0x67, 0x8b, 0xc9, 0x92, 0x8f, 0x65, 0x28, 0x5a, 0x7d, 0x3d,
+	0xdb, 0x64, 0x8c, 0x22, 0xea, 0x86, 0x6e, 0x30, 0x56, 0x7e, 0x17, 0xe7, 0xe1, 0xd3, 0xeb, 0x3c,
+	0x08, 0xe7, 0x19, 0x0a, 0xf5, 0x4c, 0x46, 0xd8, 0x0e, 0xf0, 0x89, 0x9e, 0x67, 0x0a, 0xb0, 0x0f,
+	0xc8, 0x8b, 0x4f, 0x0e, 0x1d, 0xa0, 0xba, 0x45, 0x39, 0x77, 0xb6, 0x84, 0x9e, 0xef, 0xa1, 0x08,
+	0xb1, 0xbe, 0xb0, 0x6b, 0xce, 0x9c, 0x15, 0x10, 0x48, 0x4e, 0xbf, 0xf0, 0x52, 0x20, 0x45, 0x35,
+	0x34, 0x12, 0x1e, 0x2b, 0xd9, 0xff, 0x5b, 0x80, 0x66, 0xae, 0xe2, 0xfc, 0x1b, 0xac, 0x92, 0xa3,
+	0x0d, 0xc6, 0xbb, 0x8e, 0x1b, 0xaa, 0xe5, 0x21, 0x1d, 0xe3, 0x76, 0x9b, 0x82, 0x38, 0xbe, 0xd5,
+	0x21, 0x76, 0x0e, 0xf2, 0xb9, 0x2a, 0xe4, 0xf6, 0x5d, 0xe3, 0x36, 0x34, 0xa1, 0xf6, 0x24, 0x3c,
+	0x0d, 0xe5, 0xb3, 0x90, 0x2d, 0xa5, 0x6d, 0x0f, 0x13, 0x85, 0x9e, 0xc4, 0xb1, 0x28, 0xd9, 0x7f,
+	0x59, 0x9e, 0xea, 0x26, 0xba, 0x0f, 0x55, 0xed, 0xe3, 0x92, 0xfb, 0x35, 0xeb, 0xc0, 0xe4, 0x91,
+	0x4d, 0x51, 0x21, 0x07, 0x72, 0x0c, 0x31, 0x3a, 0x9f, 0x69, 0xcb, 0x5c, 0x71, 0x6e, 0xf1, 0x63,
+	0x82, 0x51, 0xa2, 0xc2, 0xf2, 0xc0, 0xac, 0x77, 0xce, 0xfa, 0xd3, 0x02, 0xac, 0xcf, 0x43, 0x41,
+	0x5f, 0xb0, 0x33, 0xd1, 0xd4, 0x93, 0x0c, 0x79, 0x7b, 0xaa, 0x57, 0xb5, 0x48, 0xab, 0xb9, 0x73,
+	0xc5, 0x49, 0x4c, 0x76, 0xae, 0xda, 0x3f, 0x2a, 0xc0, 0xda, 0xcc, 0x9a, 0x73, 0xee, 0x08, 0x40,
+	0x55, 0x4b, 0x96, 0xee, 0x2b, 0x49, 0x2b, 0xfd, 0x3a, 0xbb, 0x4a, 0xf6, 0x20, 0xd6, 0xa5, 0xd3,
+	0x5d, 0xdd, 0xe9, 0xcc, 0xca, 0xe8, 0x47, 0xe0, 0xa9, 0xa1, 0x9e, 0xed, 0x61, 0xfd, 0x94, 0xc1,
+	0xb2, 0xf6, 0x90, 0x0c, 0xa4, 0x4a, 0x31, 0xa5, 0x49, 0x3b, 0xb3, 0x1a, 0xf5, 0xab, 0x8c, 0x86,
+	0x81, 0xdf, 0xc5, 0x61, 0xdd, 0x76, 0xe0, 0xfa, 0x9c, 0x79, 0xd3, 0x4c, 0x8e, 0xcd, 0xac, 0x56,
+	0x01, 0x76, 0x8f, 0x93, 0xb9, 0xb0, 0x02, 0x86, 0xef, 0xbb, 0xc7, 0x3b, 0x14, 0xc0, 0x9b, 0x6a,
+	0xb0, 0xbe, 0x13, 0xc7, 0x18, 0xad, 0xc5, 0xac, 0x64, 0x7f, 0x37, 0x29, 0x13, 0x5b, 0xc7, 0xb0,
+	0xa2, 0xa7, 0x71, 0xe8, 0x8e, 0x03, 0xe9, 0x7a, 0xfc, 0x3e, 0xac, 0xc6, 0x69, 0x53, 0x78, 0x4e,
+	0x5b, 0x4f, 0x1b, 0xdb, 0xf6, 0x04, 0x92, 0x33, 0x45, 0x64, 0xff, 0x45, 0x05, 0xe0, 0x20, 0x6d,
+	0xac, 0x9e, 0x73, 0xe9, 0xe6, 0xb9, 0x13, 0x33, 0x85, 0xaa, 0xd2, 0x95, 0x0b, 0x55, 0xef, 0xa4,
+	0x0e, 0xaf, 0x4e, 0x0e, 0x4e, 0x77, 0xae, 0x66, 0x73, 0x9a, 0x76, 0x73, 0x27, 0x1a, 0x1c, 0x2a,
+	0xd3, 0x0d, 0x0e, 0x1b, 0xb3, 0x9d, 0x53, 0x53, 0xda, 0x20, 0x8b, 0x67, 0x6b, 0x13, 0xf1, 0xac,
+	0x85, 0x6d, 0xa1, 0xae, 0x27, 0xc3, 0x60, 0x9c, 0xd4, 0x43, 0x92, 0x31, 0x7f, 0x13, 0x2a, 0x8a,
+	0x5a, 0xd1, 0xeb, 0x1b, 0xa5, 0xe7, 0xef, 0xb1, 0xc6, 0x45, 0xd5, 0xe2, 0xc7, 0xa6, 0x85, 0x49,
+	0xdb, 0x82, 0xba, 0x93, 0x83, 0x60, 0x31, 0xc2, 0x0f, 0x63, 0xe5, 0x06, 0x81, 0xf0, 0xb6, 0xc7,
+	0xbb, 0xba, 0xac, 0x41, 0xf6, 0xa7, 0xee, 0xcc, 0x79, 0x63, 0x7f, 0x96, 0xb5, 0xf9, 0x35, 0xa0,
+	0xd2, 0x71, 0x63, 0xbf, 0xab, 0x9b, 0x04, 0x8c, 0x71, 0xd3, 0x6e, 0xbb, 0x92, 0x9e, 0x64, 0x45,
+	0xf4, 0xc7, 0x63, 0x81, 0x9e, 0xf7, 0x2a, 0x40, 0xd6, 0x38, 0xaf, 0x4b, 0x08, 0xc9, 0x49, 0xe8,
+	0x1e, 0x01, 0x22, 0xa5, 0xa4, 0x87, 0x97, 0x76, 0x5f, 0xd5, 0xf0, 0x0b, 0xa4, 0x23, 0x59, 0x1d,
+	0x71, 0x42, 0xa9, 0x84, 0x4e, 0x15, 0x91, 0x21, 0x64, 0x80, 0x6c, 0x92, 0x3e, 0x60, 0xd6, 0x44,
+	0x97, 0x39, 0x61, 0xaa, 0xf3, 0x34, 0x31, 0x05, 0x0b, 0xcb, 0x28, 0xe1, 0x93, 0x2f, 0xd8, 0x0a,
+	0xce, 0x28, 0xeb, 0xc7, 0x67, 0xab, 0xc8, 0x0a, 0xf5, 0x4b, 0xc7, 0x8d, 0x05, 0x5b, 0xb7, 0xff,
+	0x2a, 0x5b, 0xe5, 0xeb, 0xa9, 0x67, 0xbb, 0x88, 0x7c, 0x5c, 0xe4, 0xfb, 0xde, 0x87, 0xb5, 0x48,
+	0x7c, 0x3a, 0xf2, 0x27, 0x3a, 0x75, 0x4b, 0x97, 0xd7, 0x97, 0x67, 0x29, 0xec, 0x33, 0x58, 0x4b,
+	0x06, 0x98, 0xa9, 0xa2, 0x00, 0x1a, 0xff, 0x1e, 0x91, 0x2c, 0xcf, 0xb8, 0x9e, 0x17, 0xb2, 0x4c,
+	0x11, 0xb3, 0xcc, 0x6a, 0x71, 0x81, 0xcc, 0xaa, 0xfd, 0xe7, 0x2b, 0xb9, 0x18, 0x5a, 0xfb, 0xfa,
+	0x5e, 0xea, 0xeb, 0xcf, 0x96, 0x72, 0xb2, 0x64, 0x69, 0xf1, 0x2a, 0xc9, 0xd2, 0x79, 0xd5, 0xd8,
+	0x77, 0xd1, 0x91, 0x23, 0xd1, 0x3b, 0x5e, 0x20, 0x11, 0x3c, 0x81, 0xcb, 0xb7, 0xa9, 0x30, 0xe3,
+	0xb6, 0x75, 0xab, 0x40, 0x65, 0x6e, 0x63, 0x7f, 0xbe, 0x02, 0x63, 0x30, 0x9d, 0x1c, 0x55, 0xee,
+	0xa2, 0x56, 0xe7, 0x5d, 0x54, 0x0c, 0xbb, 0xcc, 0x15, 0x4e, 0xc7, 0x3a, 0x6f, 0xae, 0x9f, 0x13,
+	0xf6, 0x54, 0x0b, 0xac, 0x3b, 0x33, 0x70, 0x74, 0x27, 0x06, 0xa3, 0x40, 0xf9, 0x26, 0x35, 0xac,
+	0x07, 0xd3, 0xff, 0x3d, 0x69, 0xcc, 0xfe, 0xf7, 0xe4, 0x7d, 0x80, 0x58, 0xa0, 0xf8, 0xee, 0xfa,
+	0x5d, 0x65, 0x1a, 0x0a, 0x6e, 0x5e, 0xb4, 0x36, 0x93, 0xd0, 0xce, 0x51, 0xe0, 0xfc, 0x07, 0xee,
+	0xf9, 0x0e, 0xba, 0x84, 0xa6, 0xf2, 0x99, 0x8e, 0xa7, 0xd5, 0xd7, 0xea, 0xac, 0xfa, 0x7a, 0x13,
+	0x2a, 0x71, 0x57, 0x0e, 0x45, 0x6b, 0xfd, 0xd2, 0xf3, 0xdd, 0x6c, 0x23, 0x92, 0xa3, 0x71, 0x29,
+	0x53, 0x83, 0x66, 0x46, 0x46, 0xd4, 0x36, 0xdf, 0x70, 0x92, 0x21, 0xb2, 0x8b, 0x46, 0x81, 0x88,
+	0x4d, 0x27, 0xfc, 0x85, 0xec, 0x1c, 0x44, 0x72, 0x34, 0xae, 0xf5, 0xf3, 0x02, 0x54, 0x08, 0xa0,
+	0xcf, 0x42, 0x5f, 0x95, 0x24, 0xbd, 0x91, 0x8c, 0xf1, 0xfc, 0x46, 0xa1, 0xff, 0xe9, 0x28, 0x29,
+	0x29, 0x9a, 0x11, 0xbf, 0x0b, 0xf5, 0x81, 0x1f, 0x6a, 0x99, 0x2a, 0x5d, 0x2a, 0x53, 0x29, 0x1e,
+	0xd1, 0xb8, 0xe7, 0x8b, 0xc8, 0x61, 0x8a, 0x87, 0xe7, 0x1b, 0x61, 0xca, 0x39, 0xe9, 0x8f, 0xa3,
+	0x81, 0xf5, 0x93, 0x22, 0x34, 0xe9, 0xbd, 0x36, 0xa7, 0xb8, 0x82, 0x24, 0x95, 0x68, 0xee, 0x51,
+	0x3a, 0xc6, 0xd3, 0x88, 0x66, 0xeb, 0x34, 0x39, 0x50, 0x76, 0x97, 0x4b, 0x8b, 0x54, 0x49, 0xde,
+	0x82, 0xc6, 0x30, 0x12, 0x67, 0x8b, 0x2c, 0x23, 0x43, 0xc4, 0x19, 0xba, 0x23, 0xd5, 0x97, 0xd1,
+	0xbe, 0x67, 0x96, 0x92, 0x8e, 0xf1, 0x9d, 0xef, 0x89, 0x50, 0xf9, 0x6a, 0x6c, 0x6c, 0x5d, 0x3a,
+	0xc6, 0x77, 0x5d, 0x5a, 0x63, 0xda, 0xa1, 0x99, 0x8e, 0x29, 0xe3, 0x96, 0x54, 0x45, 0x4a, 0x0e,
+	0x3d, 0x4f, 0xc4, 0x36, 0x8d, 0xc9, 0xd8, 0xc6, 0xfa, 0x41, 0x11, 0x53, 0xc8, 0xd2, 0xdc, 0x9c,
+	0x5f, 0x6f, 0xcf, 0xde, 0x85, 0x32, 0x8a, 0xd1, 0x05, 0xfd, 0x5f, 0xa9, 0xc4, 0xa5, 0x9f, 0x23,
+	0xd9, 0x73, 0x88, 0x66, 0xfa, 0x7e, 0x94, 0x67, 0xee, 0x87, 0xfd, 0x6d, 0x28, 0x23, 0x3e, 0x5a,
+	0x15, 0xc7, 0x48, 0xa2, 0xf6, 0x07, 0x9f, 0x90, 0xf4, 0xe9, 0xca, 0xca, 0x23, 0x23, 0x57, 0x3a,
+	0x75, 0xf7, 0xc8, 0x48, 0x8c, 0xc9, 0x53, 0x51, 0x99, 0x82, 0x8a, 0x04, 0x99, 0x91, 0xc1, 0x36,
+	0x76, 0x0f, 0xaa, 0x07, 0xc3, 0x9c, 0x1a, 0x9e, 0x48, 0xb9, 0x50, 0xfe, 0xb2, 0x38, 0x99, 0xbf,
+	0xd4, 0x79, 0x85, 0x52, 0xbe, 0x3b, 0x73, 0x6a, 0x77, 0x2a, 0x33, 0xbb, 0x63, 0x7f, 0x0c, 0x15,
+	0xba, 0xba, 0x38, 0x65, 0xbd, 0xa9, 0x3a, 0x76, 0xc0, 0x2d, 0x62, 0x05, 0xcc, 0x65, 0xc5, 0x42,
+	0x1d, 0x9c, 0x1c, 0xf5, 0x45, 0xdb, 0x1d, 0x08, 0x32, 0xea, 0x45, 0xde, 0x82, 0x75, 0x8d, 0x1b,
+	0x4f, 0xbe, 0x21, 0x0f, 0x37, 0xf0, 0x3b, 0x91, 0x1b, 0x8d, 0x59, 0xd9, 0x7e, 0x9f, 0x6a, 0xda,
+	0x89, 0x7e, 0x6d, 0xa6, 0x7f, 0x07, 0xd4, 0x6e, 0x84, 0x27, 0x22, 0xf4, 0x4b, 0x74, 0x37, 0x82,
+	0x89, 0x59, 0x75, 0x5f, 0x18, 0x05, 0x96, 0xac, 0x64, 0x3f, 0xc5, 0x10, 0x25, 0xf3, 0xe2, 0x7e,
+	0x63, 0xe6, 0xc7, 0xde, 0xce, 0xb9, 0xe8, 0x93, 0x8d, 0x60, 0x85, 0x45, 0x1b, 0xc1, 0xec, 0x8f,
+	0xe0, 0x9a, 0x33, 0xe9, 0x83, 0xf0, 0x77, 0xa0, 0x26, 0x87, 0x79, 0x3e, 0xcf, 0x53, 0xd3, 0x09,
+	0xba, 0xfd, 0xd3, 0x02, 0x2c, 0xef, 0x87, 0x4a, 0x44, 0xa1, 0x1b, 0x3c, 0x08, 0xdc, 0x1e, 0x7f,
+	0x3b, 0xb9, 0xe8, 0xf3, 0x73, 0x22, 0x79, 0xdc, 0x49, 0xfb, 0x1d, 0x98, 0x64, 0x3b, 0xb6, 0x0a,
+	0x08, 0xcf, 0x57, 0x32, 0xd2, 0x81, 0x49, 0xd2, 0x8f, 0xb7, 0x0e, 0x4c, 0x83, 0xdb, 0x64, 0x21,
+	0x8e, 0xf4, 0x31, 0xb7, 0x60, 0x7d, 0x02, 0x9a, 0x44, 0x1d, 0x45, 0xfe, 0x32, 0xb4, 0x32, 0xef,
+	0x69, 0x57, 0x86, 0x6a, 0x1f, 0xab, 0x34, 0xe4, 0x54, 0xb3, 0x92, 0xfd, 0x83, 0x5a, 0xe2, 0xce,
+	0x1f, 0x9b, 0x6e, 0xbd, 0x48, 0xca, 0xec, 0x9a, 0x9a, 0x51, 0xee, 0x7f, 0xa3, 0xc5, 0x05, 0xfe,
+	0x37, 0xfa, 0x7e, 0xf6, 0xbf, 0x51, 0xed, 0x37, 0xbd, 0x32, 0xd7, 0x19, 0x3b, 0xa6, 0x42, 0x83,
+	0x46, 0x6c, 0x8b, 0xdc, 0x9f, 0x48, 0xdf, 0x30, 0x31, 0x74, 0x79, 0x91, 0x00, 0x85, 0x50, 0xf9,
+	0xbd, 0xe9, 0xff, 0x2b, 0x2c, 0xd6, 0x0c, 0x38, 0x13, 0x98, 0xc0, 0x95, 0x03, 0x93, 0x0f, 0xa6,
+	0xc2, 0xd5, 0xfa, 0xdc, 0x6c, 0xe4, 0x25, 0x7f, 0xaa, 0xfc, 0x00, 0x6a, 0x7d, 0x3f, 0x56, 0x32,
+	0x1a, 0xb7, 0x1a, 0x73, 0xff, 0x98, 0x94, 0xdb, 0xad, 0x3d, 0x8d, 0x48, 0x9d, 0x59, 0x09, 0x15,
+	0xff, 0x5d, 0x58, 0x8e, 0xc7, 0x61, 0x57, 0x78, 0x3a, 0x4c, 0x6d, 0x35, 0xe7, 0xf6, 0x60, 0xe7,
+	0xb8, 0xb4, 0x73, 0xd8, 0xce, 0x04, 0xad, 0xd5, 0x03, 0xc8, 0x4e, 0x64, 0x46, 0x6f, 0x7d, 0x8e,
+	0x3f, 0x04, 0x63, 0xff, 0xe7, 0xa8, 0x93, 0xd5, 0xe1, 0xcc, 0xc8, 0x3a, 0x07, 0x6b, 0xc6, 0x3d,
+	0x3e, 0x14, 0x91, 0x9e, 0xe5, 0xa5, 0x06, 0xe3, 0xfd, 0xfc, 0x51, 0x6b, 0x71, 0xdc, 0xb8, 0xe0,
+	0xbc, 0x52, 0xce, 0xb9, 0x33, 0xb7, 0xbe, 0x0d, 0xcb, 0xf9, 0x0d, 0xb8, 0xf4, 0x5b, 0x57, 0x92,
+	0x7b, 0xeb, 0x1e, 0x34, 0x73, 0x07, 0x84, 0x5a, 0x7e, 0x14, 0x7a, 0x32, 0x49, 0xcc, 0xe3, 0x33,
+	0xa7, 0xbf, 0x7c, 0x79, 0x49, 0x6a, 0x9e, 0x9e, 0x6f, 0xff, 0xa8, 0x08, 0xab, 0x93, 0x42, 0x4d,
+	0x25, 0x0a, 0xad, 0x50, 0x0f, 0x02, 0x2f, 0x97, 0x0b, 0x62, 0x68, 0x65, 0x0e, 0x75, 0xf8, 0x46,
+	0x80, 0x35, 0x7c, 0xb5, 0x27, 0x07, 0x82, 0x6d, 0xe4, 0xff, 0x00, 0xf3, 0x3a, 0x5a, 0x03, 0x5d,
+	0xf5, 0x61, 0x43, 0xde, 0x30, 0x2d, 0xc3, 0xdf, 0x2f, 0xf2, 0x95, 0x5c, 0x46, 0xe2, 0xc7, 0x45,
+	0xbe, 0x0e, 0xd7, 0xb6, 0x47, 0xa1, 0x17, 0x08, 0x2f, 0x85, 0xfe, 0x6d, 0x1e, 0x9a, 0xe6, 0x1e,
+	0xbe, 0x8f, 0xe9, 0x8e, 0x46, 0x7b, 0xd4, 0x31, 0x79, 0x87, 0x1f, 0x94, 0xf9, 0x0d, 0x58, 0x33,
+	0x58, 0x99, 0xd9, 0x63, 0x7f, 0x54, 0xe6, 0xd7, 0x61, 0x75, 0x4b, 0x6f, 0x92, 0x99, 0x28, 0xfb,
+	0x63, 0x2c, 0xe2, 0xe8, 0xd2, 0xd7, 0x9f, 0x10, 0x9f, 0x34, 0x43, 0xca, 0x7e, 0x88, 0x3d, 0x09,
+	0x2b, 0x8f, 0xfc, 0x38, 0xf6, 0xc3, 0x9e, 0xe1, 0xfd, 0x67, 0xe5, 0xdb, 0x3f, 0x2d, 0xc0, 0xea,
+	0xa4, 0xea, 0x47, 0xbb, 0x1b, 0xc8, 0xb0, 0xa7, 0x74, 0x59, 0x6d, 0x05, 0x1a, 0x31, 0x76, 0x49,
+	0xd1, 0x90, 0x8a, 0x48, 0xa1, 0xae, 0xb2, 0x51, 0xbe, 0x46, 0x67, 0x97, 0x75, 0xff, 0x94, 0x72,
+	0x7b, 0xac, 0x89, 0xbb, 0xe4, 0xe1, 0xf7, 0xcb, 0x69, 0x04, 0x4b, 0x45, 0xfc, 0xa4, 0x48, 0xca,
+	0xaa, 0x88, 0x3a, 0x8a, 0x02, 0x1d, 0xc9, 0x8a, 0x81, 0xeb, 0x07, 0xba, 0x01, 0x7f, 0xd8, 0x97,
+	0xa1, 0x09, 0x65, 0x05, 0xf5, 0xe2, 0x43, 0xce, 0xd0, 0x7a, 0x38, 0x8f, 0x54, 0xb2, 0x98, 0xd8,
+	0xbe, 0xfd, 0x8b, 0x5f, 0xdd, 0x2c, 0xfc, 0xf2, 0x57, 0x37, 0x0b, 0xff, 0xf9, 0xab, 0x9b, 0x85,
+	0x1f, 0x7d, 0x76, 0x73, 0xe9, 0x97, 0x9f, 0xdd, 0x5c, 0xfa, 0xf7, 0xcf, 0x6e, 0x2e, 0x7d, 0xcc,
+	0xa6, 0xff, 0xe7, 0xdf, 0xa9, 0xd2, 0x9d, 0x79, 0xf3, 0xff, 0x06, 0x00, 0xb1, 0x88, 0xcb, 0x7b,
+	0x02, 0x40, 0x00, 0x00,
 }
 
 func (m *SmartBlockSnapshotBase) Marshal() (dAtA []byte, err error) {
@@ -6764,6 +7285,13 @@ func (m *BlockContentLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TargetSubBlockId) > 0 {
+		i -= len(m.TargetSubBlockId)
+		copy(dAtA[i:], m.TargetSubBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetSubBlockId)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Relations) > 0 {
 		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Relations[iNdEx])
@@ -7371,6 +7899,40 @@ func (m *BlockContentDataviewView) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.DependencyRelationKey) > 0 {
+		i -= len(m.DependencyRelationKey)
+		copy(dAtA[i:], m.DependencyRelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DependencyRelationKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.EndDateRelationKey) > 0 {
+		i -= len(m.EndDateRelationKey)
+		copy(dAtA[i:], m.EndDateRelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.EndDateRelationKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.DateRelationKey) > 0 {
+		i -= len(m.DateRelationKey)
+		copy(dAtA[i:], m.DateRelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DateRelationKey)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.DefaultTemplateId) > 0 {
 		i -= len(m.DefaultTemplateId)
 		copy(dAtA[i:], m.DefaultTemplateId)
@@ -7581,6 +8143,13 @@ func (m *BlockContentDataviewSort) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.RelationProperty) > 0 {
+		i -= len(m.RelationProperty)
+		copy(dAtA[i:], m.RelationProperty)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationProperty)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -7652,6 +8221,20 @@ func (m *BlockContentDataviewFilter) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.NestedFilters) > 0 {
+		for iNdEx := len(m.NestedFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NestedFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -8151,6 +8734,13 @@ func (m *BlockContentLatex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Text) > 0 {
 		i -= len(m.Text)
 		copy(dAtA[i:], m.Text)
@@ -8227,6 +8817,11 @@ func (m *BlockContentTableColumn) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -9227,6 +9822,20 @@ func (m *Relation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.Rules != nil {
+		{
+			size, err := m.Rules.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -9357,7 +9966,7 @@ func (m *Relation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RelationOption) Marshal() (dAtA []byte, err error) {
+func (m *RelationRules) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9367,48 +9976,71 @@ func (m *RelationOption) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RelationOption) MarshalTo(dAtA []byte) (int, error) {
+func (m *RelationRules) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RelationOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RelationRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RelationKey) > 0 {
-		i -= len(m.RelationKey)
-		copy(dAtA[i:], m.RelationKey)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+	if len(m.Regex) > 0 {
+		i -= len(m.Regex)
+		copy(dAtA[i:], m.Regex)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Regex)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.Color) > 0 {
-		i -= len(m.Color)
-		copy(dAtA[i:], m.Color)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Color)))
+	if m.MaxValue != nil {
+		{
+			size, err := m.MaxValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinValue != nil {
+		{
+			size, err := m.MinValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Text) > 0 {
-		i -= len(m.Text)
-		copy(dAtA[i:], m.Text)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+	if m.Unique {
 		i--
-		dAtA[i] = 0x12
+		if m.Unique {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+	if m.Required {
 		i--
-		dAtA[i] = 0xa
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RelationLink) Marshal() (dAtA []byte, err error) {
+func (m *RelationValueChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9418,32 +10050,91 @@ func (m *RelationLink) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RelationLink) MarshalTo(dAtA []byte) (int, error) {
+func (m *RelationValueChange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RelationLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RelationValueChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Format != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Format))
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.DeviceId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x4a
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Key)))
+	if m.Time != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ChangeId) > 0 {
+		i -= len(m.ChangeId)
+		copy(dAtA[i:], m.ChangeId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ChangeId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AuthorId) > 0 {
+		i -= len(m.AuthorId)
+		copy(dAtA[i:], m.AuthorId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.AuthorId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PrevValue != nil {
+		{
+			size, err := m.PrevValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Relations) Marshal() (dAtA []byte, err error) {
+func (m *RelationViolation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9453,34 +10144,169 @@ func (m *Relations) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Relations) MarshalTo(dAtA []byte) (int, error) {
+func (m *RelationViolation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Relations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RelationViolation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Relations) > 0 {
-		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModels(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Rule != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Rule))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RelationOptions) Marshal() (dAtA []byte, err error) {
+func (m *RelationOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelationOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelationOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Color) > 0 {
+		i -= len(m.Color)
+		copy(dAtA[i:], m.Color)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Color)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelationLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelationLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelationLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Format != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Relations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Relations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Relations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relations) > 0 {
+		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelationOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9565,6 +10391,20 @@ func (m *ObjectView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SyncedBlocks) > 0 {
+		for iNdEx := len(m.SyncedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyncedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.RelationLinks) > 0 {
 		for iNdEx := len(m.RelationLinks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9755,6 +10595,50 @@ func (m *ObjectViewRelationWithValuePerObject) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *ObjectViewSyncedBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectViewSyncedBlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectViewSyncedBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ObjectViewHistorySize) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10171,6 +11055,10 @@ func (m *BlockContentLink) Size() (n int) {
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
+	l = len(m.TargetSubBlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -10480,6 +11368,22 @@ func (m *BlockContentDataviewView) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	l = len(m.DateRelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.EndDateRelationKey)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
+	l = len(m.DependencyRelationKey)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -10540,6 +11444,10 @@ func (m *BlockContentDataviewSort) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	l = len(m.RelationProperty)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -10580,6 +11488,12 @@ func (m *BlockContentDataviewFilter) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if len(m.NestedFilters) > 0 {
+		for _, e := range m.NestedFilters {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -10784,6 +11698,10 @@ func (m *BlockContentLatex) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -10811,6 +11729,9 @@ func (m *BlockContentTableColumn) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
+	}
 	return n
 }
 
@@ -11290,6 +12211,10 @@ func (m *Relation) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovModels(uint64(l))
 	}
+	if m.Rules != nil {
+		l = m.Rules.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
 	l = len(m.Id)
 	if l > 0 {
 		n += 2 + l + sovModels(uint64(l))
@@ -11297,61 +12222,156 @@ func (m *Relation) Size() (n int) {
 	return n
 }
 
-func (m *RelationOption) Size() (n int) {
+func (m *RelationRules) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Required {
+		n += 2
 	}
-	l = len(m.Text)
-	if l > 0 {
+	if m.Unique {
+		n += 2
+	}
+	if m.MinValue != nil {
+		l = m.MinValue.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.Color)
-	if l > 0 {
+	if m.MaxValue != nil {
+		l = m.MaxValue.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
-	l = len(m.RelationKey)
+	l = len(m.Regex)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
 
-func (m *RelationLink) Size() (n int) {
+func (m *RelationValueChange) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
+	l = len(m.ObjectId)
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
-	if m.Format != 0 {
-		n += 1 + sovModels(uint64(m.Format))
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.PrevValue != nil {
+		l = m.PrevValue.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.AuthorId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ChangeId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovModels(uint64(m.Time))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
 
-func (m *Relations) Size() (n int) {
+func (m *RelationViolation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Relations) > 0 {
-		for _, e := range m.Relations {
-			l = e.Size()
-			n += 1 + l + sovModels(uint64(l))
-		}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
 	}
-	return n
-}
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Rule != 0 {
+		n += 1 + sovModels(uint64(m.Rule))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *RelationOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Color)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *RelationLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovModels(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *Relations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Relations) > 0 {
+		for _, e := range m.Relations {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
 
 func (m *RelationOptions) Size() (n int) {
 	if m == nil {
@@ -11425,6 +12445,12 @@ func (m *ObjectView) Size() (n int) {
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
+	if len(m.SyncedBlocks) > 0 {
+		for _, e := range m.SyncedBlocks {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -11470,6 +12496,25 @@ func (m *ObjectViewRelationWithValuePerObject) Size() (n int) {
 	return n
 }
 
+func (m *ObjectViewSyncedBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ObjectViewHistorySize) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13140,6 +14185,38 @@ func (m *BlockContentLink) Unmarshal(dAtA []byte) error {
 			}
 			m.Relations = append(m.Relations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSubBlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetSubBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -15151,6 +16228,134 @@ func (m *BlockContentDataviewView) Unmarshal(dAtA []byte) error {
 			}
 			m.DefaultTemplateId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateRelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateRelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDateRelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDateRelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyRelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependencyRelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -15536,21 +16741,53 @@ func (m *BlockContentDataviewSort) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationProperty", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationProperty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -15814,6 +17051,40 @@ func (m *BlockContentDataviewFilter) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NestedFilters = append(m.NestedFilters, &BlockContentDataviewFilter{})
+			if err := m.NestedFilters[len(m.NestedFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -16899,6 +18170,38 @@ func (m *BlockContentLatex) Unmarshal(dAtA []byte) error {
 			}
 			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -17049,6 +18352,25 @@ func (m *BlockContentTableColumn) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: TableColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= BlockContentTableColumnType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -20233,6 +21555,42 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rules == nil {
+				m.Rules = &RelationRules{}
+			}
+			if err := m.Rules.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
@@ -20286,7 +21644,7 @@ func (m *Relation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelationOption) Unmarshal(dAtA []byte) error {
+func (m *RelationRules) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20309,17 +21667,17 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Option: wiretype end group for non-group")
+			return fmt.Errorf("proto: Rules: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Option: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Rules: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20329,29 +21687,37 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+			m.Required = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Unique = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20361,29 +21727,33 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Text = string(dAtA[iNdEx:postIndex])
+			if m.MinValue == nil {
+				m.MinValue = &types.Value{}
+			}
+			if err := m.MinValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20393,27 +21763,31 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Color = string(dAtA[iNdEx:postIndex])
+			if m.MaxValue == nil {
+				m.MaxValue = &types.Value{}
+			}
+			if err := m.MaxValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20441,7 +21815,7 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			m.Regex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20464,7 +21838,7 @@ func (m *RelationOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelationLink) Unmarshal(dAtA []byte) error {
+func (m *RelationValueChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20487,15 +21861,15 @@ func (m *RelationLink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RelationLink: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelationLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20523,13 +21897,13 @@ func (m *RelationLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
-			m.Format = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20539,26 +21913,702 @@ func (m *RelationLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Format |= RelationFormat(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &types.Value{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevValue == nil {
+				m.PrevValue = &types.Value{}
+			}
+			if err := m.PrevValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelationViolation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Violation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Violation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			m.Rule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rule |= RelationViolationRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelationOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Option: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Option: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Color = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelationLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelationLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelationLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= RelationFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -21090,6 +23140,40 @@ func (m *ObjectView) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncedBlocks = append(m.SyncedBlocks, &ObjectViewSyncedBlocks{})
+			if err := m.SyncedBlocks[len(m.SyncedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -21377,6 +23461,122 @@ func (m *ObjectViewRelationWithValuePerObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ObjectViewSyncedBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncedBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &Block{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ObjectViewHistorySize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,16 +28,20 @@ type FileInfoEncryptionMode int32
 const (
 	FileInfo_AES_GCM FileInfoEncryptionMode = 0
 	FileInfo_AES_CFB FileInfoEncryptionMode = 1
+	// content-defined chunks encrypted separately with keys derived from their content
+	FileInfo_AES_GCM_CHUNKS FileInfoEncryptionMode = 2
 )
 
 var FileInfoEncryptionMode_name = map[int32]string{
 	0: "AES_GCM",
 	1: "AES_CFB",
+	2: "AES_GCM_CHUNKS",
 }
 
 var FileInfoEncryptionMode_value = map[string]int32{
-	"AES_GCM": 0,
-	"AES_CFB": 1,
+	"AES_GCM":        0,
+	"AES_CFB":        1,
+	"AES_GCM_CHUNKS": 2,
 }
 
 func (x FileInfoEncryptionMode) String() string {
@@ -160,6 +164,8 @@ type FileInfo struct {
 	EncMode          FileInfoEncryptionMode `protobuf:"varint,13,opt,name=encMode,proto3,enum=anytype.storage.FileInfoEncryptionMode" json:"encMode,omitempty"`
 	MetaHash         string                 `protobuf:"bytes,14,opt,name=metaHash,proto3" json:"metaHash,omitempty"`
 	LastModifiedDate int64                  `protobuf:"varint,15,opt,name=lastModifiedDate,proto3" json:"lastModifiedDate,omitempty"`
+	// chunks of the content encrypted in AES_GCM_CHUNKS mode
+	Chunks []*FileInfoChunk `protobuf:"bytes,16,rep,name=chunks,proto3" json:"chunks,omitempty"`
 }
 
 func (m *FileInfo) Reset()         { *m = FileInfo{} }
@@ -300,6 +306,65 @@ func (m *FileInfo) GetLastModifiedDate() int64 {
 	return 0
 }
 
+func (m *FileInfo) GetChunks() []*FileInfoChunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
+type FileInfoChunk struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Size_ int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *FileInfoChunk) Reset()         { *m = FileInfoChunk{} }
+func (m *FileInfoChunk) String() string { return proto.CompactTextString(m) }
+func (*FileInfoChunk) ProtoMessage()    {}
+func (*FileInfoChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9351ff644be6424, []int{2, 0}
+}
+func (m *FileInfoChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileInfoChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileInfoChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FileInfoChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileInfoChunk.Merge(m, src)
+}
+func (m *FileInfoChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileInfoChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileInfoChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileInfoChunk proto.InternalMessageInfo
+
+func (m *FileInfoChunk) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *FileInfoChunk) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
 type Directory struct {
 	Files map[string]*FileInfo `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -570,6 +635,7 @@ func init() {
 	proto.RegisterType((*FileKeys)(nil), "anytype.storage.FileKeys")
 	proto.RegisterMapType((map[string]string)(nil), "anytype.storage.FileKeys.KeysByPathEntry")
 	proto.RegisterType((*FileInfo)(nil), "anytype.storage.FileInfo")
+	proto.RegisterType((*FileInfoChunk)(nil), "anytype.storage.FileInfo.Chunk")
 	proto.RegisterType((*Directory)(nil), "anytype.storage.Directory")
 	proto.RegisterMapType((map[string]*FileInfo)(nil), "anytype.storage.Directory.FilesEntry")
 	proto.RegisterType((*DirectoryList)(nil), "anytype.storage.DirectoryList")
@@ -585,53 +651,56 @@ func init() {
 }
 
 var fileDescriptor_c9351ff644be6424 = []byte{
-	// 736 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x6b, 0xa7, 0x49, 0x26, 0xb4, 0x8d, 0x56, 0x7c, 0x2c, 0x51, 0x15, 0xa2, 0x08, 0xa4,
-	0xb4, 0x45, 0x0e, 0x4a, 0x25, 0xa8, 0x40, 0x1c, 0x9a, 0x36, 0x85, 0xaa, 0x2d, 0x45, 0xce, 0x8d,
-	0x4b, 0xb5, 0x71, 0x36, 0xc9, 0x12, 0xc7, 0xb6, 0xbc, 0x1b, 0x84, 0x39, 0x73, 0x87, 0x03, 0x57,
-	0xfe, 0x0f, 0xc7, 0x1e, 0xb9, 0x81, 0xda, 0x3f, 0x82, 0x76, 0xfd, 0xd1, 0xb4, 0x49, 0x91, 0x40,
-	0x88, 0xdb, 0xcc, 0xf8, 0xcd, 0xce, 0x9b, 0x79, 0xb3, 0x6b, 0xb8, 0xef, 0x8f, 0x06, 0x0d, 0x87,
-	0x75, 0x1b, 0x7e, 0xb7, 0xc1, 0x85, 0x17, 0x90, 0x01, 0x6d, 0xf8, 0x81, 0x27, 0x3c, 0xde, 0xe8,
-	0x33, 0x87, 0x9a, 0xca, 0x46, 0x2b, 0xc4, 0x0d, 0x45, 0xe8, 0x53, 0x33, 0x86, 0x94, 0x57, 0x07,
-	0x9e, 0x37, 0x70, 0x62, 0x68, 0x77, 0xd2, 0x6f, 0x70, 0x11, 0x4c, 0x6c, 0x11, 0xc1, 0x6b, 0x6d,
-	0x30, 0x3a, 0x82, 0xfa, 0x08, 0x81, 0xe1, 0x92, 0x31, 0xc5, 0x5a, 0x55, 0xab, 0x17, 0x2c, 0x65,
-	0xa3, 0x35, 0x30, 0x1c, 0xe6, 0x8e, 0xf0, 0x42, 0x55, 0xab, 0x17, 0x9b, 0xb7, 0xcc, 0x2b, 0x27,
-	0x9b, 0x87, 0xcc, 0x1d, 0x59, 0x0a, 0x52, 0xfb, 0xa2, 0x41, 0x7e, 0x8f, 0x39, 0xf4, 0x80, 0x86,
-	0x1c, 0xed, 0x03, 0x8c, 0x68, 0xc8, 0x5b, 0xe1, 0x6b, 0x22, 0x86, 0x58, 0xab, 0xea, 0xf5, 0x62,
-	0x73, 0x6d, 0x26, 0x3b, 0x81, 0x9b, 0x07, 0x29, 0xb6, 0xed, 0x8a, 0x20, 0xb4, 0xa6, 0x92, 0xcb,
-	0xcf, 0x61, 0xe5, 0xca, 0x67, 0x54, 0x02, 0x7d, 0x44, 0xc3, 0x98, 0xa8, 0x34, 0xd1, 0x4d, 0xc8,
-	0xbe, 0x23, 0xce, 0x84, 0x2a, 0xa2, 0x05, 0x2b, 0x72, 0x9e, 0x2e, 0x6c, 0x69, 0xb5, 0x1f, 0x7a,
-	0x44, 0x6b, 0xdf, 0xed, 0x7b, 0xb2, 0xc5, 0x31, 0x73, 0x9c, 0xa4, 0x45, 0x69, 0xa3, 0x32, 0xe4,
-	0xed, 0x21, 0xb5, 0x47, 0x7c, 0x32, 0x8e, 0xb3, 0x53, 0x1f, 0xdd, 0x86, 0x45, 0xee, 0x4d, 0x02,
-	0x9b, 0x62, 0x5d, 0x7d, 0x89, 0x3d, 0x79, 0x8e, 0xe7, 0x0b, 0x8e, 0x8d, 0xe8, 0x1c, 0x69, 0xcb,
-	0xd8, 0x90, 0xf0, 0x21, 0xce, 0x46, 0x31, 0x69, 0x27, 0x44, 0x17, 0x2f, 0x11, 0x1d, 0xd3, 0x1e,
-	0x23, 0x38, 0x17, 0x11, 0x55, 0x4e, 0x3a, 0xfa, 0xfc, 0xd4, 0xe8, 0x11, 0x18, 0x9c, 0x7d, 0xa0,
-	0xb8, 0x50, 0xd5, 0xea, 0xba, 0xa5, 0x6c, 0x99, 0x4d, 0x7a, 0x3d, 0xda, 0xc3, 0xa0, 0x82, 0x91,
-	0x83, 0x36, 0xc0, 0x18, 0x53, 0x41, 0x70, 0x51, 0x89, 0x74, 0xc7, 0x8c, 0xd4, 0x36, 0x13, 0xb5,
-	0xcd, 0x8e, 0x52, 0xdb, 0x52, 0x20, 0x84, 0x21, 0x27, 0x48, 0x30, 0xa0, 0x82, 0xe3, 0x1b, 0x55,
-	0xbd, 0x5e, 0xb0, 0x12, 0x17, 0xb5, 0x20, 0x47, 0x5d, 0xfb, 0xc8, 0xeb, 0x51, 0xbc, 0x54, 0xd5,
-	0xea, 0xcb, 0xcd, 0xfa, 0x5c, 0xc1, 0xe4, 0x20, 0xcd, 0xb6, 0x6b, 0x07, 0xa1, 0x2f, 0x98, 0xe7,
-	0x4a, 0xbc, 0x95, 0x24, 0xca, 0x61, 0xca, 0x2a, 0x2f, 0xe5, 0x20, 0x96, 0xa3, 0x61, 0x26, 0x3e,
-	0x5a, 0x87, 0x92, 0x43, 0xb8, 0x38, 0xf2, 0x7a, 0xac, 0xcf, 0x68, 0x6f, 0x97, 0x08, 0x8a, 0x57,
-	0x54, 0x1f, 0x33, 0xf1, 0xda, 0x3a, 0x2c, 0x5f, 0x2e, 0x81, 0x8a, 0x90, 0xdb, 0x6e, 0x77, 0x4e,
-	0x5e, 0xec, 0x1c, 0x95, 0x32, 0x89, 0xb3, 0xb3, 0xd7, 0x2a, 0x69, 0xb5, 0xaf, 0x1a, 0x14, 0x76,
-	0x59, 0x40, 0x6d, 0xe1, 0x05, 0x21, 0x7a, 0x06, 0x59, 0x79, 0x15, 0x78, 0xbc, 0x74, 0x0f, 0x66,
-	0x7a, 0x48, 0xa1, 0xaa, 0x1b, 0x1e, 0x2d, 0x5c, 0x94, 0x53, 0xee, 0x00, 0x5c, 0x04, 0xe7, 0xac,
-	0x59, 0x63, 0x7a, 0xcd, 0x8a, 0xcd, 0xbb, 0xd7, 0x0e, 0x68, 0x7a, 0x03, 0xb7, 0x61, 0x29, 0xad,
-	0x79, 0xc8, 0xb8, 0x40, 0x8f, 0x20, 0xcb, 0x04, 0x1d, 0x27, 0x14, 0xcb, 0xd7, 0x53, 0xb4, 0x22,
-	0x60, 0xed, 0x93, 0x0e, 0xc6, 0x2b, 0x39, 0x85, 0x79, 0x77, 0xb4, 0x04, 0xba, 0xcf, 0x5c, 0x45,
-	0x29, 0x6f, 0x49, 0x13, 0xad, 0x42, 0xc1, 0x77, 0x08, 0x73, 0x05, 0x7d, 0x2f, 0xd4, 0xe6, 0xe6,
-	0xad, 0x8b, 0x40, 0x7a, 0x09, 0x8c, 0xa9, 0x4b, 0xb0, 0x19, 0x2f, 0x74, 0x56, 0x31, 0xba, 0x37,
-	0xc3, 0x48, 0x16, 0x37, 0x8f, 0x7d, 0x11, 0x8f, 0x2b, 0xda, 0xf8, 0x2d, 0x28, 0xbe, 0xe5, 0x9e,
-	0x7b, 0xc2, 0xed, 0x21, 0x1d, 0x13, 0xb5, 0xe5, 0xbf, 0x59, 0x3f, 0x90, 0xd8, 0x8e, 0x82, 0xa2,
-	0xc7, 0x90, 0x95, 0x6f, 0x06, 0xc7, 0x79, 0x55, 0xaf, 0x3a, 0xbf, 0x9e, 0x7c, 0x5c, 0x12, 0x7d,
-	0x14, 0xbc, 0xfc, 0x04, 0x0a, 0x29, 0x89, 0x3f, 0x79, 0x05, 0xca, 0xc7, 0x00, 0x17, 0xa7, 0xcd,
-	0xc9, 0xdc, 0xb8, 0x2c, 0xec, 0x35, 0x0f, 0xdd, 0x94, 0xa8, 0x1f, 0x17, 0xc0, 0x90, 0x31, 0x79,
-	0xd6, 0x84, 0x27, 0x82, 0x48, 0xf3, 0xbf, 0xe8, 0x21, 0x4b, 0xff, 0x3b, 0x3d, 0xfe, 0x7a, 0xae,
-	0xad, 0x87, 0xdf, 0xce, 0x2a, 0xda, 0xe9, 0x59, 0x45, 0xfb, 0x79, 0x56, 0xd1, 0x3e, 0x9f, 0x57,
-	0x32, 0xa7, 0xe7, 0x95, 0xcc, 0xf7, 0xf3, 0x4a, 0xe6, 0x0d, 0x9a, 0xfd, 0x55, 0x75, 0x17, 0x15,
-	0x87, 0xcd, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x58, 0x64, 0xbf, 0xc7, 0x06, 0x00, 0x00,
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xda, 0x48,
+	0x14, 0xc6, 0x60, 0x7e, 0x3d, 0x12, 0x82, 0x46, 0xfb, 0x63, 0xd6, 0x8a, 0x58, 0x84, 0x76, 0x25,
+	0xb2, 0xd9, 0x35, 0x2b, 0x22, 0x35, 0x51, 0xab, 0x56, 0x0a, 0x84, 0x34, 0x51, 0x7e, 0x55, 0x46,
+	0xbd, 0xf4, 0x12, 0x19, 0x33, 0xc0, 0x14, 0x63, 0x5b, 0x9e, 0xa1, 0xaa, 0x7b, 0xee, 0xbd, 0x3d,
+	0xf4, 0xda, 0xff, 0xa7, 0xc7, 0x1c, 0x2b, 0xf5, 0x52, 0x25, 0xff, 0x48, 0x35, 0x63, 0x1b, 0x48,
+	0x80, 0x4a, 0xad, 0xaa, 0xde, 0xde, 0x7b, 0xfe, 0xde, 0xcc, 0x37, 0xdf, 0xf7, 0x66, 0x0c, 0x7f,
+	0x79, 0xa3, 0x41, 0xdd, 0xa6, 0xdd, 0xba, 0xd7, 0xad, 0x33, 0xee, 0xfa, 0xe6, 0x80, 0xd4, 0x3d,
+	0xdf, 0xe5, 0x2e, 0xab, 0xf7, 0xa9, 0x4d, 0x74, 0x19, 0xa3, 0x0d, 0xd3, 0x09, 0x78, 0xe0, 0x11,
+	0x3d, 0x82, 0x68, 0x9b, 0x03, 0xd7, 0x1d, 0xd8, 0x11, 0xb4, 0x3b, 0xe9, 0xd7, 0x19, 0xf7, 0x27,
+	0x16, 0x0f, 0xe1, 0xd5, 0x36, 0xa8, 0x1d, 0x4e, 0x3c, 0x84, 0x40, 0x75, 0xcc, 0x31, 0xc1, 0x4a,
+	0x45, 0xa9, 0xe5, 0x0d, 0x19, 0xa3, 0x2d, 0x50, 0x6d, 0xea, 0x8c, 0x70, 0xb2, 0xa2, 0xd4, 0x0a,
+	0x8d, 0x5f, 0xf5, 0x3b, 0x2b, 0xeb, 0xa7, 0xd4, 0x19, 0x19, 0x12, 0x52, 0x7d, 0xa7, 0x40, 0xee,
+	0x90, 0xda, 0xe4, 0x84, 0x04, 0x0c, 0x1d, 0x03, 0x8c, 0x48, 0xc0, 0x9a, 0xc1, 0x13, 0x93, 0x0f,
+	0xb1, 0x52, 0x49, 0xd5, 0x0a, 0x8d, 0xad, 0x85, 0xee, 0x18, 0xae, 0x9f, 0x4c, 0xb1, 0x6d, 0x87,
+	0xfb, 0x81, 0x31, 0xd7, 0xac, 0x3d, 0x84, 0x8d, 0x3b, 0x9f, 0x51, 0x09, 0x52, 0x23, 0x12, 0x44,
+	0x44, 0x45, 0x88, 0x7e, 0x81, 0xf4, 0x0b, 0xd3, 0x9e, 0x10, 0x49, 0x34, 0x6f, 0x84, 0xc9, 0xfd,
+	0xe4, 0x9e, 0x52, 0xfd, 0xa4, 0x86, 0xb4, 0x8e, 0x9d, 0xbe, 0x2b, 0x8e, 0x38, 0xa6, 0xb6, 0x1d,
+	0x1f, 0x51, 0xc4, 0x48, 0x83, 0x9c, 0x35, 0x24, 0xd6, 0x88, 0x4d, 0xc6, 0x51, 0xf7, 0x34, 0x47,
+	0xbf, 0x41, 0x86, 0xb9, 0x13, 0xdf, 0x22, 0x38, 0x25, 0xbf, 0x44, 0x99, 0x58, 0xc7, 0xf5, 0x38,
+	0xc3, 0x6a, 0xb8, 0x8e, 0x88, 0x45, 0x6d, 0x68, 0xb2, 0x21, 0x4e, 0x87, 0x35, 0x11, 0xc7, 0x44,
+	0x33, 0xb7, 0x88, 0x8e, 0x49, 0x8f, 0x9a, 0x38, 0x1b, 0x12, 0x95, 0xc9, 0x54, 0xfa, 0xdc, 0x9c,
+	0xf4, 0x08, 0x54, 0x46, 0x5f, 0x11, 0x9c, 0xaf, 0x28, 0xb5, 0x94, 0x21, 0x63, 0xd1, 0x6d, 0xf6,
+	0x7a, 0xa4, 0x87, 0x41, 0x16, 0xc3, 0x04, 0x6d, 0x83, 0x3a, 0x26, 0xdc, 0xc4, 0x05, 0x69, 0xd2,
+	0xef, 0x7a, 0xe8, 0xb6, 0x1e, 0xbb, 0xad, 0x77, 0xa4, 0xdb, 0x86, 0x04, 0x21, 0x0c, 0x59, 0x6e,
+	0xfa, 0x03, 0xc2, 0x19, 0x5e, 0xab, 0xa4, 0x6a, 0x79, 0x23, 0x4e, 0x51, 0x13, 0xb2, 0xc4, 0xb1,
+	0xce, 0xdc, 0x1e, 0xc1, 0xeb, 0x15, 0xa5, 0x56, 0x6c, 0xd4, 0x96, 0x1a, 0x26, 0x84, 0xd4, 0xdb,
+	0x8e, 0xe5, 0x07, 0x1e, 0xa7, 0xae, 0x23, 0xf0, 0x46, 0xdc, 0x28, 0xc4, 0x14, 0xbb, 0x1c, 0x09,
+	0x21, 0x8a, 0xa1, 0x98, 0x71, 0x8e, 0xfe, 0x81, 0x92, 0x6d, 0x32, 0x7e, 0xe6, 0xf6, 0x68, 0x9f,
+	0x92, 0xde, 0x81, 0xc9, 0x09, 0xde, 0x90, 0xe7, 0x58, 0xa8, 0xa3, 0x5d, 0xc8, 0x58, 0xc3, 0x89,
+	0x33, 0x62, 0xb8, 0x24, 0x67, 0xe7, 0xcf, 0xd5, 0x54, 0x5a, 0x02, 0x67, 0x44, 0x70, 0xed, 0x3f,
+	0x48, 0xcb, 0xc2, 0xfc, 0x8c, 0xac, 0x85, 0xd2, 0xc7, 0x82, 0x26, 0x67, 0x82, 0x56, 0x1f, 0x41,
+	0xf1, 0xf6, 0x51, 0x50, 0x01, 0xb2, 0xfb, 0xed, 0xce, 0xe5, 0xe3, 0xd6, 0x59, 0x29, 0x11, 0x27,
+	0xad, 0xc3, 0x66, 0x49, 0x41, 0x08, 0x8a, 0xd1, 0x97, 0xcb, 0xd6, 0xd1, 0xd3, 0xf3, 0x93, 0x4e,
+	0x29, 0x59, 0x7d, 0xaf, 0x40, 0xfe, 0x80, 0xfa, 0xc4, 0xe2, 0xae, 0x1f, 0xa0, 0x07, 0x90, 0x16,
+	0xd7, 0x90, 0x45, 0x03, 0xff, 0xf7, 0x02, 0xe9, 0x29, 0x54, 0xd2, 0x67, 0xe1, 0xb0, 0x87, 0x3d,
+	0x5a, 0x07, 0x60, 0x56, 0x5c, 0x32, 0xe2, 0xf5, 0xf9, 0x11, 0x2f, 0x34, 0xfe, 0x58, 0xa9, 0xc8,
+	0xfc, 0xf4, 0xef, 0xc3, 0xfa, 0x74, 0xcf, 0x53, 0xca, 0x38, 0xfa, 0x1f, 0xd2, 0x94, 0x93, 0x71,
+	0x4c, 0x51, 0x5b, 0x4d, 0xd1, 0x08, 0x81, 0xd5, 0x37, 0x29, 0x50, 0xcf, 0x85, 0x32, 0xcb, 0xde,
+	0x87, 0x12, 0xa4, 0x3c, 0xea, 0x48, 0x4a, 0x39, 0x43, 0x84, 0x68, 0x13, 0xf2, 0x9e, 0x6d, 0x52,
+	0x87, 0x93, 0x97, 0x5c, 0xde, 0x9a, 0x9c, 0x31, 0x2b, 0x4c, 0x2f, 0xa0, 0x3a, 0x77, 0x01, 0x77,
+	0xa2, 0xcb, 0x94, 0x5e, 0xe1, 0xb4, 0xd8, 0x5c, 0xbf, 0xf0, 0x78, 0x24, 0x57, 0x78, 0xdb, 0xf6,
+	0xa0, 0xf0, 0x9c, 0xb9, 0xce, 0x25, 0xb3, 0x86, 0x64, 0x6c, 0xe2, 0xcc, 0xd7, 0x47, 0x1f, 0x04,
+	0xb6, 0x23, 0xa1, 0xe8, 0x1e, 0xa4, 0xc5, 0x7b, 0xc5, 0x70, 0x4e, 0xee, 0x57, 0x59, 0xbe, 0x9f,
+	0x78, 0xd8, 0x62, 0x7f, 0x24, 0x5c, 0xdb, 0x85, 0xfc, 0x94, 0xc4, 0xb7, 0xbc, 0x40, 0xda, 0x05,
+	0xc0, 0x6c, 0xb5, 0x25, 0x9d, 0xdb, 0xb7, 0x8d, 0x5d, 0xf1, 0xc8, 0xce, 0x99, 0xfa, 0x3a, 0x09,
+	0xaa, 0xa8, 0x89, 0xb5, 0x26, 0x2c, 0x36, 0x44, 0x84, 0x3f, 0xc5, 0x0f, 0xb1, 0xf5, 0x8f, 0xf3,
+	0xe3, 0xbb, 0x75, 0x6d, 0xfe, 0xfb, 0xe1, 0xba, 0xac, 0x5c, 0x5d, 0x97, 0x95, 0xcf, 0xd7, 0x65,
+	0xe5, 0xed, 0x4d, 0x39, 0x71, 0x75, 0x53, 0x4e, 0x7c, 0xbc, 0x29, 0x27, 0x9e, 0xa1, 0xc5, 0xdf,
+	0x64, 0x37, 0x23, 0x39, 0xec, 0x7c, 0x19, 0x00, 0xdb, 0x14, 0x22, 0xb1, 0x43, 0x07, 0x00, 0x00,
 }
 
 func (m *Step) Marshal() (dAtA []byte, err error) {
@@ -738,6 +807,22 @@ func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Chunks) > 0 {
+		for iNdEx := len(m.Chunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if m.LastModifiedDate != 0 {
 		i = encodeVarintFile(dAtA, i, uint64(m.LastModifiedDate))
 		i--
@@ -845,6 +930,41 @@ func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FileInfoChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileInfoChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileInfoChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintFile(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintFile(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Directory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1242,6 +1362,28 @@ func (m *FileInfo) Size() (n int) {
 	if m.LastModifiedDate != 0 {
 		n += 1 + sovFile(uint64(m.LastModifiedDate))
 	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 2 + l + sovFile(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FileInfoChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovFile(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovFile(uint64(m.Size_))
+	}
 	return n
 }
 
@@ -2127,6 +2269,143 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, &FileInfoChunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileInfoChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFile
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFile(dAtA[iNdEx:])
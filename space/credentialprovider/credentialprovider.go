@@ -9,6 +9,7 @@ import (
 	"github.com/anyproto/any-sync/coordinator/coordinatorclient"
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/wallet"
 )
 
@@ -17,11 +18,13 @@ func New() app.Component {
 }
 
 type credentialProvider struct {
-	client coordinatorclient.CoordinatorClient
-	wallet wallet.Wallet
+	client    coordinatorclient.CoordinatorClient
+	wallet    wallet.Wallet
+	localOnly bool
 }
 
 func (c *credentialProvider) Init(a *app.App) (err error) {
+	c.localOnly = a.MustComponent(config.CName).(*config.Config).LocalOnly
 	c.client = a.MustComponent(coordinatorclient.CName).(coordinatorclient.CoordinatorClient)
 	c.wallet = a.MustComponent(wallet.CName).(wallet.Wallet)
 	return
@@ -32,6 +35,11 @@ func (c *credentialProvider) Name() (name string) {
 }
 
 func (c *credentialProvider) GetCredential(ctx context.Context, spaceHeader *spacesyncproto.RawSpaceHeaderWithId) ([]byte, error) {
+	// there is no coordinator to sign the space in local only mode. The nil credential is safe, the same is returned
+	// by the no-op provider of any-sync: it is only put into the space push request, and clients don't check it
+	if c.localOnly {
+		return nil, nil
+	}
 	payload := coordinatorclient.SpaceSignPayload{
 		SpaceId:     spaceHeader.Id,
 		SpaceHeader: spaceHeader.RawHeader,
@@ -0,0 +1,152 @@
+package localdiscovery
+
+import (
+	"context"
+	"fmt"
+	gonet "net"
+	"strings"
+
+	"github.com/anyproto/any-sync/accountservice"
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/util/periodicsync"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/net/addrs"
+	"github.com/anyproto/anytype-heart/space/clientserver"
+	"github.com/anyproto/anytype-heart/space/peerstore"
+)
+
+const (
+	ManualCName = "client.space.manualdiscovery"
+
+	manualPeersPeriodSec = 30
+)
+
+// ManualDiscovery connects peers added by the user, it's used when mDNS is not available in the network.
+// The peers are reported to the notifier periodically, so they are reconnected after the network change
+type ManualDiscovery interface {
+	SetNotifier(Notifier)
+	AddPeer(peer peerstore.ManualPeer) error
+	RemovePeer(peerId string) error
+	Peers() []peerstore.ManualPeer
+	// PairingPayload returns the payload with the own peer id and addresses to add this device on the other one
+	PairingPayload() (string, error)
+	app.ComponentRunnable
+}
+
+func NewManual() ManualDiscovery {
+	return &manualDiscovery{}
+}
+
+type manualDiscovery struct {
+	peerId        string
+	peerStore     peerstore.PeerStore
+	drpcServer    clientserver.ClientServer
+	periodicCheck periodicsync.PeriodicSync
+	notifier      Notifier
+}
+
+func (m *manualDiscovery) Init(a *app.App) (err error) {
+	m.peerId = a.MustComponent(accountservice.CName).(accountservice.Service).Account().PeerId
+	m.peerStore = a.MustComponent(peerstore.CName).(peerstore.PeerStore)
+	m.drpcServer = a.MustComponent(clientserver.CName).(clientserver.ClientServer)
+	m.periodicCheck = periodicsync.NewPeriodicSync(manualPeersPeriodSec, 0, m.connectPeers, log)
+	return
+}
+
+func (m *manualDiscovery) Name() (name string) {
+	return ManualCName
+}
+
+func (m *manualDiscovery) Run(ctx context.Context) (err error) {
+	if !m.drpcServer.ServerStarted() {
+		return
+	}
+	m.periodicCheck.Run()
+	return
+}
+
+func (m *manualDiscovery) Close(ctx context.Context) (err error) {
+	if !m.drpcServer.ServerStarted() {
+		return
+	}
+	m.periodicCheck.Close()
+	return
+}
+
+func (m *manualDiscovery) SetNotifier(notifier Notifier) {
+	m.notifier = notifier
+}
+
+func (m *manualDiscovery) AddPeer(peer peerstore.ManualPeer) error {
+	if peer.PeerId == m.peerId {
+		return fmt.Errorf("%w: can't add own peer", peerstore.ErrInvalidPeer)
+	}
+	if err := m.peerStore.AddManualPeer(peer); err != nil {
+		return err
+	}
+	if m.drpcServer.ServerStarted() {
+		go m.connect(peer, m.ownAddresses())
+	}
+	return nil
+}
+
+func (m *manualDiscovery) RemovePeer(peerId string) error {
+	if err := m.peerStore.RemoveManualPeer(peerId); err != nil {
+		return err
+	}
+	m.peerStore.RemoveLocalPeer(peerId)
+	return nil
+}
+
+func (m *manualDiscovery) Peers() []peerstore.ManualPeer {
+	return m.peerStore.ManualPeers()
+}
+
+func (m *manualDiscovery) PairingPayload() (string, error) {
+	if !m.drpcServer.ServerStarted() {
+		return "", fmt.Errorf("local server is not started")
+	}
+	own := m.ownAddresses()
+	peer := peerstore.ManualPeer{PeerId: m.peerId}
+	for _, ip := range own.Addrs {
+		peer.Addrs = append(peer.Addrs, gonet.JoinHostPort(ip, fmt.Sprint(own.Port)))
+	}
+	return peerstore.EncodePairingPayload(peer)
+}
+
+func (m *manualDiscovery) connectPeers(ctx context.Context) error {
+	peers := m.peerStore.ManualPeers()
+	if len(peers) == 0 {
+		return nil
+	}
+	own := m.ownAddresses()
+	for _, peer := range peers {
+		m.connect(peer, own)
+	}
+	return nil
+}
+
+func (m *manualDiscovery) connect(peer peerstore.ManualPeer, own OwnAddresses) {
+	if m.notifier == nil {
+		return
+	}
+	log.Debug("connecting manual peer", zap.String("peerId", peer.PeerId), zap.Strings("addrs", peer.Addrs))
+	m.notifier.PeerDiscovered(DiscoveredPeer{PeerId: peer.PeerId, Addrs: peer.Addrs}, own)
+}
+
+func (m *manualDiscovery) ownAddresses() OwnAddresses {
+	own := OwnAddresses{Port: m.drpcServer.Port()}
+	interfaceAddrs, err := addrs.GetInterfacesAddrs()
+	if err != nil {
+		log.Warn("failed to get interface addresses", zap.Error(err))
+		return own
+	}
+	for _, addr := range interfaceAddrs.Addrs {
+		ip := strings.Split(addr.String(), "/")[0]
+		if gonet.ParseIP(ip).To4() != nil {
+			own.Addrs = append(own.Addrs, ip)
+		}
+	}
+	return own
+}
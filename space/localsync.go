@@ -0,0 +1,122 @@
+package space
+
+import (
+	"context"
+	"time"
+
+	"github.com/anyproto/any-sync/commonspace"
+	"github.com/anyproto/any-sync/commonspace/spacesyncproto"
+	"github.com/anyproto/any-sync/net/peer"
+	"github.com/anyproto/any-sync/net/rpc/rpcerr"
+	"go.uber.org/zap"
+	"storj.io/drpc"
+)
+
+// localPeerWaitTimeout is the time to wait for local peers having the missing account space on start in local only mode
+var localPeerWaitTimeout = time.Minute
+
+// spaceFromLocalPeers puts the description of the space pulled from the local peer to the context,
+// so the storage of the space missing in the repo is created without asking the nodes. When no local peer has
+// the space, it's loaded in background as soon as such a peer appears. It is used only in local only mode,
+// otherwise the space is pulled from the responsible nodes by commonspace
+func (s *service) spaceFromLocalPeers(ctx context.Context, id string) context.Context {
+	if !s.localOnly {
+		return ctx
+	}
+	if _, ok := ctx.Value(commonspace.AddSpaceCtxKey).(commonspace.SpaceDescription); ok || s.spaceStorageProvider.SpaceExists(id) {
+		return ctx
+	}
+	peerIds := s.peerStore.LocalPeerIds(id)
+	if len(peerIds) == 0 {
+		// don't wait when the space is requested by the peer, it has nowhere to come from in this case
+		if _, err := peer.CtxPeerId(ctx); err != nil {
+			s.awaitLocalSpace(id)
+		}
+		return ctx
+	}
+	for _, peerId := range peerIds {
+		description, err := s.pullSpace(ctx, peerId, id)
+		if err != nil {
+			log.Warn("failed to pull space from local peer", zap.String("spaceId", id), zap.String("peerId", peerId), zap.Error(err))
+			continue
+		}
+		return context.WithValue(ctx, commonspace.AddSpaceCtxKey, description)
+	}
+	return ctx
+}
+
+// awaitLocalSpace registers the missing space to be loaded when a local peer having it appears,
+// the returned channel is closed then
+func (s *service) awaitLocalSpace(id string) <-chan struct{} {
+	s.awaitedMu.Lock()
+	defer s.awaitedMu.Unlock()
+	ch, ok := s.awaitedSpaces[id]
+	if !ok {
+		log.Info("waiting for local peers with the space", zap.String("spaceId", id))
+		ch = make(chan struct{})
+		s.awaitedSpaces[id] = ch
+	}
+	return ch
+}
+
+// localPeerUpdated is the observer of local peers, it loads awaited spaces the peer has
+func (s *service) localPeerUpdated(peerId string, spaceIds []string) {
+	var found []string
+	s.awaitedMu.Lock()
+	for _, id := range spaceIds {
+		if ch, ok := s.awaitedSpaces[id]; ok {
+			close(ch)
+			delete(s.awaitedSpaces, id)
+			found = append(found, id)
+		}
+	}
+	s.awaitedMu.Unlock()
+	for _, id := range found {
+		go func(id string) {
+			if _, err := s.GetSpace(context.Background(), id); err != nil {
+				log.Warn("failed to load space from local peer", zap.String("spaceId", id), zap.String("peerId", peerId), zap.Error(err))
+			}
+		}(id)
+	}
+}
+
+// waitLocalSpace waits for a local peer having the missing space. It's used only for the account space on start,
+// the account can't run without it
+func (s *service) waitLocalSpace(ctx context.Context, id string) {
+	if !s.localOnly || s.spaceStorageProvider.SpaceExists(id) {
+		return
+	}
+	ch := s.awaitLocalSpace(id)
+	if len(s.peerStore.LocalPeerIds(id)) > 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, localPeerWaitTimeout)
+	defer cancel()
+	select {
+	case <-ctx.Done():
+	case <-ch:
+	}
+}
+
+func (s *service) pullSpace(ctx context.Context, peerId, id string) (description commonspace.SpaceDescription, err error) {
+	p, err := s.poolManager.UnaryPeerPool().Get(ctx, peerId)
+	if err != nil {
+		return
+	}
+	var resp *spacesyncproto.SpacePullResponse
+	err = p.DoDrpc(ctx, func(conn drpc.Conn) error {
+		resp, err = spacesyncproto.NewDRPCSpaceSyncClient(conn).SpacePull(ctx, &spacesyncproto.SpacePullRequest{Id: id})
+		return err
+	})
+	if err != nil {
+		err = rpcerr.Unwrap(err)
+		return
+	}
+	return commonspace.SpaceDescription{
+		SpaceHeader:          resp.Payload.SpaceHeader,
+		AclId:                resp.Payload.AclPayloadId,
+		AclPayload:           resp.Payload.AclPayload,
+		SpaceSettingsId:      resp.Payload.SpaceSettingsPayloadId,
+		SpaceSettingsPayload: resp.Payload.SpaceSettingsPayload,
+	}, nil
+}
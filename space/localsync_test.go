@@ -0,0 +1,188 @@
+package space
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/app/ocache"
+	"github.com/anyproto/any-sync/commonspace"
+	"github.com/anyproto/any-sync/commonspace/spacesyncproto"
+	"github.com/anyproto/any-sync/commonspace/syncstatus"
+	"github.com/anyproto/any-sync/net/pool"
+	"github.com/anyproto/any-sync/net/rpc/rpctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/space/peerstore"
+	"github.com/anyproto/anytype-heart/space/storage"
+)
+
+type testSpace struct {
+	commonspace.Space
+	id          string
+	description commonspace.SpaceDescription
+}
+
+func (s *testSpace) Id() string {
+	return s.id
+}
+
+func (s *testSpace) Init(ctx context.Context) error {
+	return nil
+}
+
+func (s *testSpace) Description() (commonspace.SpaceDescription, error) {
+	return s.description, nil
+}
+
+func (s *testSpace) SyncStatus() syncstatus.StatusUpdater {
+	return syncstatus.NewNoOpSyncStatus()
+}
+
+func (s *testSpace) TryClose(objectTTL time.Duration) (bool, error) {
+	return false, nil
+}
+
+func (s *testSpace) Close() error {
+	return nil
+}
+
+// testSpaces are stored spaces of the account. Missing spaces are created from descriptions of the context
+// the same way as commonspace does
+type testSpaces struct {
+	commonspace.SpaceService
+	mu     sync.Mutex
+	spaces map[string]*testSpace
+}
+
+func (s *testSpaces) NewSpace(ctx context.Context, id string) (commonspace.Space, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sp, ok := s.spaces[id]; ok {
+		return sp, nil
+	}
+	description, ok := ctx.Value(commonspace.AddSpaceCtxKey).(commonspace.SpaceDescription)
+	if !ok {
+		return nil, spacesyncproto.ErrSpaceMissing
+	}
+	sp := &testSpace{id: id, description: description}
+	s.spaces[id] = sp
+	return sp, nil
+}
+
+func (s *testSpaces) exists(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.spaces[id]
+	return ok
+}
+
+type testStorage struct {
+	storage.ClientStorage
+	spaces *testSpaces
+}
+
+func (s testStorage) SpaceExists(id string) bool {
+	return s.spaces.exists(id)
+}
+
+type testPoolManager struct {
+	pool pool.Pool
+}
+
+func (m testPoolManager) UnaryPeerPool() pool.Pool {
+	return m.pool
+}
+
+func (m testPoolManager) StreamPeerPool() pool.Pool {
+	return m.pool
+}
+
+func newTestService(localOnly bool, spaces *testSpaces, peerPool pool.Pool) *service {
+	s := &service{
+		localOnly:            localOnly,
+		commonSpace:          spaces,
+		spaceStorageProvider: testStorage{spaces: spaces},
+		peerStore:            peerstore.New(),
+		poolManager:          testPoolManager{pool: peerPool},
+		awaitedSpaces:        map[string]chan struct{}{},
+	}
+	if localOnly {
+		s.peerStore.AddObserver(s.localPeerUpdated)
+	}
+	s.spaceCache = ocache.New(s.loadSpace)
+	return s
+}
+
+// TestLocalSpaceSync runs two accounts in one process: the first one serves its space by the space sync rpc handler,
+// the second one pulls the space from the first one as a local peer in local only mode
+func TestLocalSpaceSync(t *testing.T) {
+	const (
+		spaceId = "space1"
+		peerId  = "peer1"
+	)
+	ctx := context.Background()
+	description := commonspace.SpaceDescription{
+		SpaceHeader:          &spacesyncproto.RawSpaceHeaderWithId{Id: spaceId, RawHeader: []byte("header")},
+		AclId:                "acl",
+		AclPayload:           []byte("acl payload"),
+		SpaceSettingsId:      "settings",
+		SpaceSettingsPayload: []byte("settings payload"),
+	}
+
+	// the serving account
+	serving := newTestService(false, &testSpaces{spaces: map[string]*testSpace{
+		spaceId: {id: spaceId, description: description},
+	}}, rpctest.NewTestPool())
+	defer serving.spaceCache.Close()
+	rserv := rpctest.NewTestServer()
+	require.NoError(t, spacesyncproto.DRPCRegisterSpaceSync(rserv.Mux, &rpcHandler{serving}))
+
+	t.Run("space of the known peer is pulled", func(t *testing.T) {
+		fetching := newTestService(true, &testSpaces{spaces: map[string]*testSpace{}}, rpctest.NewTestPool().WithServer(rserv))
+		defer fetching.spaceCache.Close()
+		fetching.peerStore.UpdateLocalPeer(peerId, []string{spaceId})
+
+		sp, err := fetching.GetSpace(ctx, spaceId)
+		require.NoError(t, err)
+		got, err := sp.Description()
+		require.NoError(t, err)
+		assert.Equal(t, description, got)
+	})
+	t.Run("space is loaded in background when the peer appears", func(t *testing.T) {
+		spaces := &testSpaces{spaces: map[string]*testSpace{}}
+		fetching := newTestService(true, spaces, rpctest.NewTestPool().WithServer(rserv))
+		defer fetching.spaceCache.Close()
+
+		// the missing space isn't waited for
+		start := time.Now()
+		_, err := fetching.GetSpace(ctx, spaceId)
+		assert.ErrorIs(t, err, spacesyncproto.ErrSpaceMissing)
+		assert.Less(t, time.Since(start), time.Second)
+
+		fetching.peerStore.UpdateLocalPeer(peerId, []string{spaceId})
+		assert.Eventually(t, func() bool {
+			return spaces.exists(spaceId)
+		}, time.Second*5, time.Millisecond*10)
+		sp, err := fetching.GetSpace(ctx, spaceId)
+		require.NoError(t, err)
+		got, err := sp.Description()
+		require.NoError(t, err)
+		assert.Equal(t, description, got)
+	})
+	t.Run("account space is waited for on start", func(t *testing.T) {
+		spaces := &testSpaces{spaces: map[string]*testSpace{}}
+		fetching := newTestService(true, spaces, rpctest.NewTestPool().WithServer(rserv))
+		defer fetching.spaceCache.Close()
+
+		go func() {
+			time.Sleep(time.Millisecond * 50)
+			fetching.peerStore.UpdateLocalPeer(peerId, []string{spaceId})
+		}()
+		fetching.waitLocalSpace(ctx, spaceId)
+		_, err := fetching.GetSpace(ctx, spaceId)
+		require.NoError(t, err)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"github.com/anyproto/anytype-heart/space/peerstore"
+	"github.com/anyproto/anytype-heart/space/syncstats"
 )
 
 type clientPeerManager struct {
@@ -22,11 +23,15 @@ type clientPeerManager struct {
 	responsiblePeerIds []string
 	p                  *provider
 	peerStore          peerstore.PeerStore
+	stats              *syncstats.SpaceStats
 	sync.Mutex
 }
 
 func (n *clientPeerManager) Init(_ *app.App) (err error) {
-	n.responsiblePeerIds = n.peerStore.ResponsibleNodeIds(n.spaceId)
+	// there are no responsible nodes in local only mode, the space is synced with local peers only
+	if !n.p.localOnly {
+		n.responsiblePeerIds = n.peerStore.ResponsibleNodeIds(n.spaceId)
+	}
 	return
 }
 
@@ -42,6 +47,7 @@ func (n *clientPeerManager) SendPeer(ctx context.Context, peerId string, msg *sp
 	// the context which comes here should not be used. It can be cancelled and thus kill the stream,
 	// because the stream will be opened with this context
 	ctx = logger.CtxWithFields(context.Background(), logger.CtxGetFields(ctx)...)
+	n.stats.AddSent(msg.Size())
 	return n.p.streamPool.Send(ctx, msg, func(ctx context.Context) (peers []peer.Peer, err error) {
 		return n.getExactPeer(ctx, peerId)
 	})
@@ -51,15 +57,20 @@ func (n *clientPeerManager) Broadcast(ctx context.Context, msg *spacesyncproto.O
 	// the context which comes here should not be used. It can be cancelled and thus kill the stream,
 	// because the stream can be opened with this context
 	ctx = logger.CtxWithFields(context.Background(), logger.CtxGetFields(ctx)...)
+	n.stats.AddSent(msg.Size())
 	return n.p.streamPool.Send(ctx, msg, func(ctx context.Context) (peers []peer.Peer, err error) {
 		return n.getStreamResponsiblePeers(ctx)
 	})
 }
 
 func (n *clientPeerManager) GetResponsiblePeers(ctx context.Context) (peers []peer.Peer, err error) {
-	p, err := n.p.commonPool.GetOneOf(ctx, n.responsiblePeerIds)
-	if err == nil {
-		peers = []peer.Peer{p}
+	if len(n.responsiblePeerIds) > 0 {
+		var p peer.Peer
+		if p, err = n.p.commonPool.GetOneOf(ctx, n.responsiblePeerIds); err == nil {
+			peers = []peer.Peer{p}
+		}
+	} else if !n.p.localOnly {
+		err = fmt.Errorf("no responsible peers for space %s", n.spaceId)
 	}
 	log.Debug("local responsible peers are", zap.Strings("local peers", n.peerStore.LocalPeerIds(n.spaceId)))
 	for _, peerId := range n.peerStore.LocalPeerIds(n.spaceId) {
@@ -77,6 +88,9 @@ func (n *clientPeerManager) GetResponsiblePeers(ctx context.Context) (peers []pe
 	if err != nil && len(peers) > 0 {
 		err = nil
 	}
+	if err == nil && len(peers) == 0 {
+		err = fmt.Errorf("no local peers for space %s", n.spaceId)
+	}
 	return
 }
 
@@ -91,11 +105,13 @@ func (n *clientPeerManager) getExactPeer(ctx context.Context, peerId string) (pe
 func (n *clientPeerManager) getStreamResponsiblePeers(ctx context.Context) (peers []peer.Peer, err error) {
 	var peerIds []string
 	// lookup in common pool for existing connection
-	p, nodeErr := n.p.commonPool.GetOneOf(ctx, n.responsiblePeerIds)
-	if nodeErr != nil {
-		log.Warn("failed to get responsible peer from common pool", zap.Error(nodeErr))
-	} else {
-		peerIds = []string{p.Id()}
+	if len(n.responsiblePeerIds) > 0 {
+		p, nodeErr := n.p.commonPool.GetOneOf(ctx, n.responsiblePeerIds)
+		if nodeErr != nil {
+			log.Warn("failed to get responsible peer from common pool", zap.Error(nodeErr))
+		} else {
+			peerIds = []string{p.Id()}
+		}
 	}
 	peerIds = append(peerIds, n.peerStore.LocalPeerIds(n.spaceId)...)
 	for _, peerId := range peerIds {
@@ -9,8 +9,10 @@ import (
 	"github.com/anyproto/any-sync/net/pool"
 	"github.com/anyproto/any-sync/net/streampool"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/space"
 	"github.com/anyproto/anytype-heart/space/peerstore"
+	"github.com/anyproto/anytype-heart/space/syncstats"
 )
 
 func New() peermanager.PeerManagerProvider {
@@ -26,10 +28,17 @@ type provider struct {
 	commonPool pool.Pool
 	streamPool streampool.StreamPool
 	peerStore  peerstore.PeerStore
+	syncStats  syncstats.Collector
+	// localOnly makes peer managers use only peers of the local network
+	localOnly bool
 }
 
 func (p *provider) Init(a *app.App) (err error) {
 	p.peerStore = a.MustComponent(peerstore.CName).(peerstore.PeerStore)
+	p.syncStats = a.MustComponent(syncstats.CName).(syncstats.Collector)
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		p.localOnly = cfg.LocalOnly
+	}
 	poolService := a.MustComponent(pool.CName).(pool.Service)
 	p.commonPool = poolService
 	p.pool = poolService
@@ -46,6 +55,7 @@ func (p *provider) NewPeerManager(ctx context.Context, spaceId string) (peermana
 		p:         p,
 		spaceId:   spaceId,
 		peerStore: p.peerStore,
+		stats:     p.syncStats.Space(spaceId),
 	}
 	return pm, nil
 }
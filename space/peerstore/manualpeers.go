@@ -0,0 +1,140 @@
+package peerstore
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	libslice "github.com/anyproto/any-sync/util/slice"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	manualPeersFileName = "manual_peers.json"
+	pairingPrefix       = "anytype-pair:"
+)
+
+var ErrInvalidPeer = errors.New("invalid peer")
+
+// ManualPeer is the peer added by the user, it's connected like the peer found by the local discovery
+type ManualPeer struct {
+	PeerId string   `json:"peerId"`
+	Addrs  []string `json:"addrs"`
+}
+
+func (m ManualPeer) Validate() error {
+	if _, err := peer.Decode(m.PeerId); err != nil {
+		return fmt.Errorf("%w: bad peer id: %s", ErrInvalidPeer, err)
+	}
+	if len(m.Addrs) == 0 {
+		return fmt.Errorf("%w: addresses are empty", ErrInvalidPeer)
+	}
+	for _, addr := range m.Addrs {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return fmt.Errorf("%w: bad address %s: %s", ErrInvalidPeer, addr, err)
+		}
+		if host == "" {
+			return fmt.Errorf("%w: host is empty in %s", ErrInvalidPeer, addr)
+		}
+		if p, err := strconv.Atoi(port); err != nil || p <= 0 || p > 65535 {
+			return fmt.Errorf("%w: bad port in %s", ErrInvalidPeer, addr)
+		}
+	}
+	return nil
+}
+
+// EncodePairingPayload returns the string to share with the other device, e.g. as the QR code
+func EncodePairingPayload(m ManualPeer) (string, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return pairingPrefix + base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func DecodePairingPayload(payload string) (m ManualPeer, err error) {
+	if !strings.HasPrefix(payload, pairingPrefix) {
+		return m, fmt.Errorf("%w: unknown pairing payload", ErrInvalidPeer)
+	}
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(payload, pairingPrefix))
+	if err != nil {
+		return m, fmt.Errorf("%w: %s", ErrInvalidPeer, err)
+	}
+	if err = json.Unmarshal(data, &m); err != nil {
+		return m, fmt.Errorf("%w: %s", ErrInvalidPeer, err)
+	}
+	return m, m.Validate()
+}
+
+func (p *peerStore) ManualPeers() []ManualPeer {
+	p.Lock()
+	defer p.Unlock()
+	return slices.Clone(p.manualPeers)
+}
+
+// AddManualPeer adds the peer or replaces addresses of the existing one
+func (p *peerStore) AddManualPeer(m ManualPeer) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	p.Lock()
+	defer p.Unlock()
+	peers := slices.Clone(p.manualPeers)
+	if idx := slices.IndexFunc(peers, func(e ManualPeer) bool { return e.PeerId == m.PeerId }); idx != -1 {
+		peers[idx] = m
+	} else {
+		peers = append(peers, m)
+	}
+	if err := p.saveManualPeers(peers); err != nil {
+		return err
+	}
+	p.manualPeers = peers
+	return nil
+}
+
+func (p *peerStore) RemoveManualPeer(peerId string) error {
+	p.Lock()
+	defer p.Unlock()
+	peers := libslice.DiscardFromSlice(slices.Clone(p.manualPeers), func(e ManualPeer) bool { return e.PeerId == peerId })
+	if len(peers) == len(p.manualPeers) {
+		return nil
+	}
+	if err := p.saveManualPeers(peers); err != nil {
+		return err
+	}
+	p.manualPeers = peers
+	return nil
+}
+
+func (p *peerStore) loadManualPeers() error {
+	if p.manualPeersPath == "" {
+		return nil
+	}
+	data, err := os.ReadFile(p.manualPeersPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &p.manualPeers)
+}
+
+// saveManualPeers must be called under the lock, peers are kept only in memory when the store has no repo
+func (p *peerStore) saveManualPeers(peers []ManualPeer) error {
+	if p.manualPeersPath == "" {
+		return nil
+	}
+	data, err := json.Marshal(peers)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p.manualPeersPath, data, 0600)
+}
@@ -0,0 +1,67 @@
+package peerstore
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/anyproto/any-sync/util/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPeerId(t *testing.T) string {
+	_, pub, err := crypto.GenerateRandomEd25519KeyPair()
+	require.NoError(t, err)
+	peerId, err := crypto.IdFromSigningPubKey(pub)
+	require.NoError(t, err)
+	return peerId.String()
+}
+
+func TestPairingPayload(t *testing.T) {
+	peer := ManualPeer{PeerId: newPeerId(t), Addrs: []string{"192.168.1.10:4000", "[fe80::1]:4000"}}
+	payload, err := EncodePairingPayload(peer)
+	require.NoError(t, err)
+	decoded, err := DecodePairingPayload(payload)
+	require.NoError(t, err)
+	assert.Equal(t, peer, decoded)
+
+	for _, payload := range []string{"", "anytype-pair:???", payload[:len(payload)-4]} {
+		_, err = DecodePairingPayload(payload)
+		assert.ErrorIs(t, err, ErrInvalidPeer, payload)
+	}
+}
+
+func TestManualPeer_Validate(t *testing.T) {
+	peerId := newPeerId(t)
+	assert.NoError(t, ManualPeer{PeerId: peerId, Addrs: []string{"10.0.0.1:443"}}.Validate())
+	for _, peer := range []ManualPeer{
+		{PeerId: "bad", Addrs: []string{"10.0.0.1:443"}},
+		{PeerId: peerId},
+		{PeerId: peerId, Addrs: []string{"10.0.0.1"}},
+		{PeerId: peerId, Addrs: []string{":443"}},
+		{PeerId: peerId, Addrs: []string{"10.0.0.1:70000"}},
+	} {
+		assert.ErrorIs(t, peer.Validate(), ErrInvalidPeer, peer)
+	}
+}
+
+func TestPeerStore_ManualPeers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), manualPeersFileName)
+	ps := New().(*peerStore)
+	ps.manualPeersPath = path
+
+	first := ManualPeer{PeerId: newPeerId(t), Addrs: []string{"10.0.0.1:443"}}
+	second := ManualPeer{PeerId: newPeerId(t), Addrs: []string{"10.0.0.2:443"}}
+	require.NoError(t, ps.AddManualPeer(first))
+	require.NoError(t, ps.AddManualPeer(second))
+	first.Addrs = []string{"10.0.0.3:443"}
+	require.NoError(t, ps.AddManualPeer(first))
+	assert.Error(t, ps.AddManualPeer(ManualPeer{PeerId: "bad"}))
+	assert.Equal(t, []ManualPeer{first, second}, ps.ManualPeers())
+
+	require.NoError(t, ps.RemoveManualPeer(second.PeerId))
+	loaded := New().(*peerStore)
+	loaded.manualPeersPath = path
+	require.NoError(t, loaded.loadManualPeers())
+	assert.Equal(t, []ManualPeer{first}, loaded.ManualPeers())
+}
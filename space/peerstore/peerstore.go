@@ -1,18 +1,24 @@
 package peerstore
 
 import (
+	"path/filepath"
 	"sync"
 
 	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/app/logger"
 	"github.com/anyproto/any-sync/nodeconf"
 	libslice "github.com/anyproto/any-sync/util/slice"
+	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
 
+	"github.com/anyproto/anytype-heart/core/wallet"
 	"github.com/anyproto/anytype-heart/util/slice"
 )
 
 const CName = "client.space.peerstore"
 
+var log = logger.NewNamed(CName)
+
 type PeerStore interface {
 	app.Component
 	ResponsibleNodeIds(spaceId string) []string
@@ -22,6 +28,9 @@ type PeerStore interface {
 	UpdateLocalPeer(peerId string, spaceIds []string)
 	RemoveLocalPeer(peerId string)
 	AddObserver(observer Observer)
+	ManualPeers() []ManualPeer
+	AddManualPeer(peer ManualPeer) error
+	RemoveManualPeer(peerId string) error
 }
 
 func New() PeerStore {
@@ -42,12 +51,21 @@ type peerStore struct {
 	spacesByLocalPeerIds map[string][]string
 	responsibleIds       map[string][]string
 	observers            []Observer
+	manualPeers          []ManualPeer
+	manualPeersPath      string
 	sync.Mutex
 }
 
 func (p *peerStore) Init(a *app.App) (err error) {
 	p.nodeConf = a.MustComponent(nodeconf.CName).(nodeconf.Service)
-	return
+	if w, ok := a.Component(wallet.CName).(wallet.Wallet); ok {
+		p.manualPeersPath = filepath.Join(w.RepoPath(), manualPeersFileName)
+	}
+	if err = p.loadManualPeers(); err != nil {
+		// manual peers can be added again, so the broken file must not prevent the app from starting
+		log.Error("failed to load manual peers", zap.Error(err))
+	}
+	return nil
 }
 
 func (p *peerStore) Name() (name string) {
@@ -24,7 +24,12 @@ func (r *rpcHandler) ObjectSync(ctx context.Context, req *spacesyncproto.ObjectS
 		}
 		return
 	}
+	stats := r.s.syncStats.Space(req.SpaceId)
+	stats.AddReceived(req.Size())
 	resp, err = sp.HandleSyncRequest(ctx, req)
+	if resp != nil {
+		stats.AddSent(resp.Size())
+	}
 	return
 }
 
@@ -3,6 +3,7 @@ package space
 import (
 	"context"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/anyproto/any-sync/app"
@@ -32,6 +33,7 @@ import (
 	"github.com/anyproto/anytype-heart/space/localdiscovery"
 	"github.com/anyproto/anytype-heart/space/peerstore"
 	"github.com/anyproto/anytype-heart/space/storage"
+	"github.com/anyproto/anytype-heart/space/syncstats"
 )
 
 const (
@@ -75,26 +77,38 @@ type service struct {
 	spaceStorageProvider storage.ClientStorage
 	streamPool           streampool.StreamPool
 	peerStore            peerstore.PeerStore
+	syncStats            syncstats.Collector
 	peerService          peerservice.PeerService
 	poolManager          PoolManager
 	streamHandler        *streamHandler
 	accountId            string
 	newAccount           bool
+	localOnly            bool
+	// awaitedSpaces are missing spaces waiting for local peers having them in local only mode
+	awaitedSpaces map[string]chan struct{}
+	awaitedMu     sync.Mutex
 }
 
 func (s *service) Init(a *app.App) (err error) {
 	conf := a.MustComponent(config.CName).(*config.Config)
 	s.conf = conf.GetSpace()
 	s.newAccount = conf.NewAccount
+	s.localOnly = conf.LocalOnly
+	s.awaitedSpaces = map[string]chan struct{}{}
 	s.commonSpace = a.MustComponent(commonspace.CName).(commonspace.SpaceService)
 	s.wallet = a.MustComponent(wallet.CName).(wallet.Wallet)
 	s.client = a.MustComponent(coordinatorclient.CName).(coordinatorclient.CoordinatorClient)
 	s.poolManager = a.MustComponent(peermanager.CName).(PoolManager)
 	s.spaceStorageProvider = a.MustComponent(spacestorage.CName).(storage.ClientStorage)
 	s.peerStore = a.MustComponent(peerstore.CName).(peerstore.PeerStore)
+	if s.localOnly {
+		s.peerStore.AddObserver(s.localPeerUpdated)
+	}
+	s.syncStats = a.MustComponent(syncstats.CName).(syncstats.Collector)
 	s.peerService = a.MustComponent(peerservice.CName).(peerservice.PeerService)
 	localDiscovery := a.MustComponent(localdiscovery.CName).(localdiscovery.LocalDiscovery)
 	localDiscovery.SetNotifier(s)
+	a.MustComponent(localdiscovery.ManualCName).(localdiscovery.ManualDiscovery).SetNotifier(s)
 	s.streamHandler = &streamHandler{s: s}
 
 	s.streamPool = a.MustComponent(streampool.CName).(streampool.Service).NewStreamPool(s.streamHandler, streampool.StreamConfig{
@@ -141,6 +155,7 @@ func (s *service) Run(ctx context.Context) (err error) {
 			return
 		}
 		// pulling space from remote
+		s.waitLocalSpace(ctx, s.accountId)
 		_, err = s.GetSpace(ctx, s.accountId)
 		if err != nil {
 			return
@@ -224,6 +239,7 @@ func (s *service) DeleteSpace(ctx context.Context, spaceID string, revert bool)
 }
 
 func (s *service) loadSpace(ctx context.Context, id string) (value ocache.Object, err error) {
+	ctx = s.spaceFromLocalPeers(ctx, id)
 	cc, err := s.commonSpace.NewSpace(ctx, id)
 	if err != nil {
 		return
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"errors"
 
@@ -118,3 +119,30 @@ func (l *listStorage) SetHead(headId string) (err error) {
 func (l *listStorage) AddRawRecord(_ context.Context, rec *consensusproto.RawRecordWithId) error {
 	return putDB(l.db, l.keys.RawRecordKey(rec.Id), rec.Payload)
 }
+
+// IterateRecords calls proc for every raw record of the list in the order of keys
+func (l *listStorage) IterateRecords(proc func(rec *consensusproto.RawRecordWithId) error) error {
+	prefix := l.keys.RawRecordKey("")
+	return l.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			if bytes.Equal(item.Key(), l.keys.RootIdKey()) || bytes.Equal(item.Key(), l.keys.HeadIdKey()) {
+				continue
+			}
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			if err = proc(&consensusproto.RawRecordWithId{Payload: value, Id: string(item.Key()[len(prefix):])}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
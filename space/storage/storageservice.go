@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonspace/object/tree/treechangeproto"
 	"github.com/anyproto/any-sync/commonspace/spacestorage"
+	"github.com/anyproto/any-sync/consensus/consensusproto"
 	"github.com/dgraph-io/badger/v3"
 
 	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
@@ -22,10 +24,25 @@ type ClientStorage interface {
 	AllSpaceIds() (ids []string, err error)
 }
 
+// TreeChangesIterator is implemented by tree storages of the client storage
+type TreeChangesIterator interface {
+	IterateChanges(proc func(change *treechangeproto.RawTreeChangeWithId) error) error
+}
+
+// AclRecordsIterator is implemented by acl list storages of the client storage
+type AclRecordsIterator interface {
+	IterateRecords(proc func(rec *consensusproto.RawRecordWithId) error) error
+}
+
 func New() ClientStorage {
 	return &storageService{}
 }
 
+// NewWithDB returns the storage over the opened database, so it can be used without the app, e.g. to restore backups
+func NewWithDB(db *badger.DB) ClientStorage {
+	return &storageService{db: db, keys: newStorageServiceKeys()}
+}
+
 func (s *storageService) Init(a *app.App) (err error) {
 	s.provider = a.MustComponent(datastore.CName).(datastore.Datastore)
 	s.keys = newStorageServiceKeys()
@@ -157,6 +157,34 @@ func (t *treeStorage) Delete() (err error) {
 	return
 }
 
+// IterateChanges calls proc for every raw change of the tree in the order of keys
+func (t *treeStorage) IterateChanges(proc func(change *treechangeproto.RawTreeChangeWithId) error) error {
+	prefix := t.keys.RawChangeKey("")
+	return t.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			id := string(item.Key()[len(prefix):])
+			if id == "heads" {
+				continue
+			}
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			if err = proc(&treechangeproto.RawTreeChangeWithId{RawChange: value, Id: id}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (t *treeStorage) storedKeys() (keys [][]byte, err error) {
 	err = t.db.View(func(txn *badger.Txn) error {
 		opts := badger.DefaultIteratorOptions
@@ -70,6 +70,7 @@ func (s *streamHandler) HandleMessage(ctx context.Context, peerId string, msg dr
 	if err != nil {
 		return
 	}
+	s.s.syncStats.Space(syncMsg.SpaceId).AddReceived(syncMsg.Size())
 	err = space.HandleMessage(ctx, objectsync.HandleMessage{
 		Id:       lastMsgId.Add(1),
 		Deadline: time.Now().Add(time.Minute),
@@ -0,0 +1,212 @@
+package syncstats
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"golang.org/x/exp/slices"
+)
+
+const CName = "client.space.syncstats"
+
+const (
+	// failedTimeout is the time after which the changed tree not confirmed by any peer is reported as failed
+	failedTimeout = 5 * time.Minute
+	maxConflicts  = 50
+)
+
+// Collector keeps sync statistics of spaces opened since the start of the app
+type Collector interface {
+	app.Component
+	Space(spaceId string) *SpaceStats
+}
+
+func New() Collector {
+	return &collector{spaces: map[string]*SpaceStats{}}
+}
+
+type collector struct {
+	mu     sync.Mutex
+	spaces map[string]*SpaceStats
+}
+
+func (c *collector) Init(a *app.App) (err error) {
+	return
+}
+
+func (c *collector) Name() (name string) {
+	return CName
+}
+
+func (c *collector) Space(spaceId string) *SpaceStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.spaces[spaceId]
+	if !ok {
+		s = newSpaceStats()
+		c.spaces[spaceId] = s
+	}
+	return s
+}
+
+type PeerStat struct {
+	PeerId     string
+	LastSyncAt time.Time
+}
+
+// Conflict is the object which tree had concurrent heads, MergedAt is zero until the heads are merged
+type Conflict struct {
+	ObjectId   string
+	Heads      []string
+	DetectedAt time.Time
+	MergedAt   time.Time
+}
+
+type Report struct {
+	TreesSynced   int
+	TreesPending  int
+	TreesFailed   int
+	Peers         []PeerStat
+	BytesSent     int64
+	BytesReceived int64
+	// Conflicts are sorted from the newest
+	Conflicts []Conflict
+}
+
+type treeState struct {
+	heads       []string
+	remoteHeads []string
+	changedAt   time.Time
+	synced      bool
+}
+
+type SpaceStats struct {
+	mu            sync.Mutex
+	trees         map[string]*treeState
+	peers         map[string]time.Time
+	bytesSent     int64
+	bytesReceived int64
+	conflicts     []Conflict
+	now           func() time.Time
+}
+
+func newSpaceStats() *SpaceStats {
+	return &SpaceStats{
+		trees: map[string]*treeState{},
+		peers: map[string]time.Time{},
+		now:   time.Now,
+	}
+}
+
+// HeadsChange is called when the local heads of the tree are changed
+func (s *SpaceStats) HeadsChange(treeId string, heads []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	t, ok := s.trees[treeId]
+	if !ok {
+		t = &treeState{}
+		s.trees[treeId] = t
+	}
+	switch {
+	case len(heads) > 1 && len(t.heads) <= 1:
+		s.addConflict(Conflict{ObjectId: treeId, Heads: slices.Clone(heads), DetectedAt: now})
+	case len(heads) == 1 && len(t.heads) > 1:
+		s.mergeConflict(treeId, now)
+	}
+	t.heads = slices.Clone(heads)
+	t.changedAt = now
+	t.synced = containsAll(t.remoteHeads, t.heads)
+}
+
+// HeadsReceive is called when the peer sends its heads of the tree
+func (s *SpaceStats) HeadsReceive(senderId, treeId string, heads []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.peers[senderId] = s.now()
+	t, ok := s.trees[treeId]
+	if !ok {
+		t = &treeState{}
+		s.trees[treeId] = t
+	}
+	t.remoteHeads = slices.Clone(heads)
+	if len(t.heads) > 0 && containsAll(heads, t.heads) {
+		t.synced = true
+	}
+}
+
+func (s *SpaceStats) AddSent(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bytesSent += int64(n)
+}
+
+func (s *SpaceStats) AddReceived(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bytesReceived += int64(n)
+}
+
+func (s *SpaceStats) Report() Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	var r Report
+	for _, t := range s.trees {
+		switch {
+		case len(t.heads) == 0:
+			// the tree is known only from the peer's heads
+		case t.synced:
+			r.TreesSynced++
+		case now.Sub(t.changedAt) > failedTimeout:
+			r.TreesFailed++
+		default:
+			r.TreesPending++
+		}
+	}
+	for peerId, lastSync := range s.peers {
+		r.Peers = append(r.Peers, PeerStat{PeerId: peerId, LastSyncAt: lastSync})
+	}
+	sort.Slice(r.Peers, func(i, j int) bool {
+		return r.Peers[i].PeerId < r.Peers[j].PeerId
+	})
+	r.BytesSent = s.bytesSent
+	r.BytesReceived = s.bytesReceived
+	r.Conflicts = make([]Conflict, 0, len(s.conflicts))
+	for i := len(s.conflicts) - 1; i >= 0; i-- {
+		r.Conflicts = append(r.Conflicts, s.conflicts[i])
+	}
+	return r
+}
+
+func (s *SpaceStats) addConflict(c Conflict) {
+	if len(s.conflicts) == maxConflicts {
+		s.conflicts = append(s.conflicts[:0], s.conflicts[1:]...)
+	}
+	s.conflicts = append(s.conflicts, c)
+}
+
+func (s *SpaceStats) mergeConflict(treeId string, at time.Time) {
+	for i := len(s.conflicts) - 1; i >= 0; i-- {
+		if s.conflicts[i].ObjectId == treeId {
+			if s.conflicts[i].MergedAt.IsZero() {
+				s.conflicts[i].MergedAt = at
+			}
+			return
+		}
+	}
+}
+
+func containsAll(set, heads []string) bool {
+	if len(set) == 0 {
+		return false
+	}
+	for _, h := range heads {
+		if !slices.Contains(set, h) {
+			return false
+		}
+	}
+	return true
+}
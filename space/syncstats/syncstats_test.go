@@ -0,0 +1,68 @@
+package syncstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaceStats_Report(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	s := newSpaceStats()
+	s.now = func() time.Time { return now }
+
+	s.HeadsChange("synced", []string{"a"})
+	s.HeadsReceive("peer1", "synced", []string{"a"})
+	s.HeadsChange("pending", []string{"b"})
+	s.HeadsChange("failed", []string{"c"})
+	s.HeadsReceive("peer2", "failed", []string{"old"})
+	s.HeadsReceive("peer2", "remoteOnly", []string{"d"})
+	s.AddSent(10)
+	s.AddReceived(20)
+	s.AddReceived(5)
+
+	now = now.Add(time.Minute)
+	s.HeadsChange("pending", []string{"b1"})
+	now = now.Add(failedTimeout)
+
+	r := s.Report()
+	assert.Equal(t, 1, r.TreesSynced)
+	assert.Equal(t, 1, r.TreesPending)
+	assert.Equal(t, 1, r.TreesFailed)
+	assert.Equal(t, int64(10), r.BytesSent)
+	assert.Equal(t, int64(25), r.BytesReceived)
+	require.Len(t, r.Peers, 2)
+	assert.Equal(t, "peer1", r.Peers[0].PeerId)
+	assert.Equal(t, "peer2", r.Peers[1].PeerId)
+}
+
+func TestSpaceStats_Conflicts(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	s := newSpaceStats()
+	s.now = func() time.Time { return now }
+
+	s.HeadsChange("obj1", []string{"a"})
+	s.HeadsChange("obj1", []string{"b", "c"})
+	detectedAt := now
+	now = now.Add(time.Second)
+	s.HeadsChange("obj2", []string{"d", "e"})
+	now = now.Add(time.Second)
+	s.HeadsChange("obj1", []string{"f"})
+
+	r := s.Report()
+	require.Len(t, r.Conflicts, 2)
+	assert.Equal(t, "obj2", r.Conflicts[0].ObjectId)
+	assert.True(t, r.Conflicts[0].MergedAt.IsZero())
+	assert.Equal(t, "obj1", r.Conflicts[1].ObjectId)
+	assert.Equal(t, []string{"b", "c"}, r.Conflicts[1].Heads)
+	assert.Equal(t, detectedAt, r.Conflicts[1].DetectedAt)
+	assert.Equal(t, now, r.Conflicts[1].MergedAt)
+
+	for i := 0; i < maxConflicts*2; i++ {
+		s.HeadsChange("obj3", []string{"x", "y"})
+		s.HeadsChange("obj3", []string{"z"})
+	}
+	assert.Len(t, s.Report().Conflicts, maxConflicts)
+}
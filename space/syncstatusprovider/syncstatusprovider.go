@@ -2,25 +2,58 @@ package syncstatusprovider
 
 import (
 	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonspace/spacestate"
 	//nolint:misspell
 	"github.com/anyproto/any-sync/commonspace/syncstatus"
+
+	"github.com/anyproto/anytype-heart/space/syncstats"
 )
 
 func New() syncstatus.StatusServiceProvider {
-	return statusProvider{}
+	return &statusProvider{}
 }
 
 type statusProvider struct {
+	syncStats syncstats.Collector
 }
 
-func (s statusProvider) Init(_ *app.App) (err error) {
+func (s *statusProvider) Init(a *app.App) (err error) {
+	s.syncStats = a.MustComponent(syncstats.CName).(syncstats.Collector)
 	return nil
 }
 
-func (s statusProvider) Name() (name string) {
+func (s *statusProvider) Name() (name string) {
 	return syncstatus.CName
 }
 
-func (s statusProvider) NewStatusService() syncstatus.StatusService {
-	return syncstatus.NewSyncStatusProvider()
+func (s *statusProvider) NewStatusService() syncstatus.StatusService {
+	return &statusService{
+		StatusService: syncstatus.NewSyncStatusProvider(),
+		syncStats:     s.syncStats,
+	}
+}
+
+// statusService passes heads updates to the sync statistics of the space
+type statusService struct {
+	syncstatus.StatusService
+	syncStats syncstats.Collector
+	stats     *syncstats.SpaceStats
+}
+
+func (s *statusService) Init(a *app.App) (err error) {
+	if err = s.StatusService.Init(a); err != nil {
+		return
+	}
+	s.stats = s.syncStats.Space(a.MustComponent(spacestate.CName).(*spacestate.SpaceState).SpaceId)
+	return
+}
+
+func (s *statusService) HeadsChange(treeId string, heads []string) {
+	s.stats.HeadsChange(treeId, heads)
+	s.StatusService.HeadsChange(treeId, heads)
+}
+
+func (s *statusService) HeadsReceive(senderId, treeId string, heads []string) {
+	s.stats.HeadsReceive(senderId, treeId, heads)
+	s.StatusService.HeadsReceive(senderId, treeId, heads)
 }
@@ -310,6 +310,9 @@ func DataviewSortEqual(sort1, sort2 *model.BlockContentDataviewSort) bool {
 	if sort1.Type != sort2.Type {
 		return false
 	}
+	if sort1.RelationProperty != sort2.RelationProperty {
+		return false
+	}
 	return true
 }
 